@@ -0,0 +1,15 @@
+-- 사용자 정의 상태 도입에 따른 데이터 마이그레이션
+-- mysqldef는 스키마만 반영하므로 기존 레코드는 이 파일로 정리한다.
+
+-- 기본 상태가 아닌 값이 들어 있는 Task는 todo로 되돌린다.
+UPDATE `task`
+SET `status` = 'todo'
+WHERE `status` NOT IN ('todo', 'doing', 'done');
+
+-- 기존 사용자에게 기본 상태 세 가지를 등록한다.
+INSERT IGNORE INTO `task_status` (`user_id`, `name`, `category`, `position`, `created`, `modified`)
+SELECT `id`, 'todo', 'open', 0, NOW(6), NOW(6) FROM `user`
+UNION ALL
+SELECT `id`, 'doing', 'in_progress', 1, NOW(6), NOW(6) FROM `user`
+UNION ALL
+SELECT `id`, 'done', 'closed', 2, NOW(6), NOW(6) FROM `user`;
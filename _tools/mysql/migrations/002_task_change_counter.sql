@@ -0,0 +1,7 @@
+-- 변경 순번을 사용자별 카운터로 발급하도록 바꾼 데 따른 데이터 마이그레이션
+-- 새 버전의 서버를 시작하기 전에 실행한다.
+
+-- 이미 발급한 동기화 토큰은 전체 변경 내역의 순번이므로, 모든 사용자의 카운터를 그 최댓값에서 시작해서
+-- 새로 발급하는 순번이 클라이언트가 가진 토큰보다 항상 크도록 한다.
+INSERT IGNORE INTO `task_change_counter` (`user_id`, `seq`, `modified`)
+SELECT `id`, (SELECT COALESCE(MAX(`seq`), 0) FROM `task_change`), NOW(6) FROM `user`;
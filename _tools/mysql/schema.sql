@@ -4,22 +4,255 @@ CREATE TABLE `user`
     `name`     varchar(20) NOT NULL COMMENT '사용자명',
     `password` VARCHAR(80) NOT NULL COMMENT '패스워드 해시',
     `role`     VARCHAR(80) NOT NULL COMMENT '역할',
+    `email`    VARCHAR(255) NULL COMMENT '메일 주소',
+    `disabled` DATETIME(6) NULL COMMENT '비활성화 시간 (NULL이면 활성)',
     `created`  DATETIME(6) NOT NULL COMMENT '레코드 작성 시간',
     `modified` DATETIME(6) NOT NULL COMMENT '레코드 수정 시간',
     PRIMARY KEY (`id`),
     UNIQUE KEY `uix_name` (`name`) USING BTREE
 ) Engine=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='사용자'; 
 
+CREATE TABLE `project`
+(
+    `id`       BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '프로젝트 식별자',
+    `owner_id` BIGINT UNSIGNED NOT NULL COMMENT '프로젝트를 만든 사용자 식별자',
+    `name`     VARCHAR(128) NOT NULL COMMENT '프로젝트명',
+    `created`  DATETIME(6) NOT NULL COMMENT '레코드 작성 시간',
+    `modified` DATETIME(6) NOT NULL COMMENT '레코드 수정 시간',
+    PRIMARY KEY (`id`),
+    CONSTRAINT `fk_project_owner_id`
+        FOREIGN KEY (`owner_id`) REFERENCES `user` (`id`)
+            ON DELETE RESTRICT ON UPDATE RESTRICT
+) Engine=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='프로젝트';
+
+CREATE TABLE `project_member`
+(
+    `project_id` BIGINT UNSIGNED NOT NULL COMMENT '프로젝트 식별자',
+    `user_id`    BIGINT UNSIGNED NOT NULL COMMENT '멤버 사용자 식별자',
+    `created`    DATETIME(6) NOT NULL COMMENT '레코드 작성 시간',
+    PRIMARY KEY (`project_id`, `user_id`),
+    KEY `ix_user_id` (`user_id`) USING BTREE,
+    CONSTRAINT `fk_project_member_project_id`
+        FOREIGN KEY (`project_id`) REFERENCES `project` (`id`)
+            ON DELETE CASCADE ON UPDATE RESTRICT,
+    CONSTRAINT `fk_project_member_user_id`
+        FOREIGN KEY (`user_id`) REFERENCES `user` (`id`)
+            ON DELETE CASCADE ON UPDATE RESTRICT
+) Engine=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='프로젝트 멤버';
+
 CREATE TABLE `task`
 (
     `id`       BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '태스크 식별자',
     `user_id`  BIGINT UNSIGNED NOT NULL COMMENT '사용자 식별자',
+    `project_id` BIGINT UNSIGNED NULL COMMENT '프로젝트 식별자 (프로젝트에 속하지 않으면 NULL)',
+    `parent_id` BIGINT UNSIGNED NULL COMMENT '상위 태스크 식별자',
+    `assignee_id` BIGINT UNSIGNED NULL COMMENT '담당자 식별자',
+    `client_id` VARCHAR(64) NULL COMMENT '오프라인 클라이언트가 생성한 식별자',
     `title`    VARCHAR(128) NOT NULL COMMENT '태스크 타이틀',
     `status`   VARCHAR(20)  NOT NULL COMMENT '태스크 상태',
+    `due`      DATETIME(6) NULL COMMENT '마감 시간',
+    `labels`   JSON NULL COMMENT '라벨 (없으면 NULL)',
+    `priority` VARCHAR(16) NOT NULL DEFAULT '' COMMENT '우선순위 (low, medium, high, urgent, 없으면 빈 문자열)',
+    `recurrence` JSON NULL COMMENT '반복 규칙 (없으면 NULL)',
     `created`  DATETIME(6) NOT NULL COMMENT '레코드 작성 시간',
     `modified` DATETIME(6) NOT NULL COMMENT '레코드 수정 시간',
     PRIMARY KEY (`id`),
+    KEY `ix_assignee_id` (`assignee_id`) USING BTREE,
+    KEY `ix_project_id` (`project_id`) USING BTREE,
+    UNIQUE KEY `uix_user_id_client_id` (`user_id`, `client_id`) USING BTREE,
     CONSTRAINT `fk_user_id`
+        FOREIGN KEY (`user_id`) REFERENCES `user` (`id`)
+            ON DELETE RESTRICT ON UPDATE RESTRICT,
+    CONSTRAINT `fk_parent_id`
+        FOREIGN KEY (`parent_id`) REFERENCES `task` (`id`)
+            ON DELETE CASCADE ON UPDATE RESTRICT,
+    CONSTRAINT `fk_assignee_id`
+        FOREIGN KEY (`assignee_id`) REFERENCES `user` (`id`)
+            ON DELETE SET NULL ON UPDATE RESTRICT,
+    CONSTRAINT `fk_project_id`
+        FOREIGN KEY (`project_id`) REFERENCES `project` (`id`)
+            ON DELETE SET NULL ON UPDATE RESTRICT
+) Engine=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='태스크';
+
+CREATE TABLE `task_change`
+(
+    `user_id` BIGINT UNSIGNED NOT NULL COMMENT '변경을 받을 사용자 식별자',
+    `seq`     BIGINT UNSIGNED NOT NULL COMMENT '사용자별 변경 순번 (task_change_counter에서 발급한다)',
+    `task_id` BIGINT UNSIGNED NOT NULL COMMENT '태스크 식별자 (삭제된 태스크도 남기기 위해 외래 키를 두지 않는다)',
+    `op`      VARCHAR(16) NOT NULL COMMENT '변경 종류 (upsert, delete)',
+    `created` DATETIME(6) NOT NULL COMMENT '레코드 작성 시간',
+    PRIMARY KEY (`user_id`, `seq`),
+    CONSTRAINT `fk_task_change_user_id`
+        FOREIGN KEY (`user_id`) REFERENCES `user` (`id`)
+            ON DELETE CASCADE ON UPDATE RESTRICT
+) Engine=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='동기화를 위한 태스크 변경 내역';
+
+CREATE TABLE `task_change_counter`
+(
+    `user_id`  BIGINT UNSIGNED NOT NULL COMMENT '사용자 식별자',
+    `seq`      BIGINT UNSIGNED NOT NULL COMMENT '마지막으로 발급한 변경 순번',
+    `modified` DATETIME(6) NOT NULL COMMENT '레코드 수정 시간',
+    PRIMARY KEY (`user_id`),
+    CONSTRAINT `fk_task_change_counter_user_id`
+        FOREIGN KEY (`user_id`) REFERENCES `user` (`id`)
+            ON DELETE CASCADE ON UPDATE RESTRICT
+) Engine=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='커밋 순서대로 변경 순번을 발급하기 위한 사용자별 카운터';
+
+CREATE TABLE `task_status`
+(
+    `id`       BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '상태 식별자',
+    `user_id`  BIGINT UNSIGNED NOT NULL COMMENT '사용자 식별자',
+    `name`     VARCHAR(20) NOT NULL COMMENT '상태명',
+    `category` VARCHAR(20) NOT NULL COMMENT '상태 분류 (open, in_progress, closed)',
+    `position` INT NOT NULL DEFAULT 0 COMMENT '표시 순서',
+    `created`  DATETIME(6) NOT NULL COMMENT '레코드 작성 시간',
+    `modified` DATETIME(6) NOT NULL COMMENT '레코드 수정 시간',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uix_user_id_name` (`user_id`, `name`) USING BTREE,
+    CONSTRAINT `fk_task_status_user_id`
         FOREIGN KEY (`user_id`) REFERENCES `user` (`id`)
             ON DELETE RESTRICT ON UPDATE RESTRICT
-) Engine=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='태스크';
\ No newline at end of file
+) Engine=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='사용자 정의 태스크 상태';
+
+CREATE TABLE `time_entry`
+(
+    `id`              BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '작업 시간 기록 식별자',
+    `user_id`         BIGINT UNSIGNED NOT NULL COMMENT '사용자 식별자',
+    `task_id`         BIGINT UNSIGNED NOT NULL COMMENT '태스크 식별자',
+    `started`         DATETIME(6) NOT NULL COMMENT '시작 시간',
+    `stopped`         DATETIME(6) NULL COMMENT '종료 시간 (NULL이면 실행 중)',
+    `manual`          BOOLEAN NOT NULL DEFAULT FALSE COMMENT '직접 입력 여부',
+    `running_user_id` BIGINT UNSIGNED AS (IF(`stopped` IS NULL, `user_id`, NULL)) STORED COMMENT '실행 중인 타이머를 사용자당 하나로 제한하기 위한 컬럼',
+    `created`         DATETIME(6) NOT NULL COMMENT '레코드 작성 시간',
+    `modified`        DATETIME(6) NOT NULL COMMENT '레코드 수정 시간',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uix_running_user_id` (`running_user_id`) USING BTREE,
+    KEY `ix_user_id_started` (`user_id`, `started`) USING BTREE,
+    CONSTRAINT `fk_time_entry_user_id`
+        FOREIGN KEY (`user_id`) REFERENCES `user` (`id`)
+            ON DELETE RESTRICT ON UPDATE RESTRICT,
+    CONSTRAINT `fk_time_entry_task_id`
+        FOREIGN KEY (`task_id`) REFERENCES `task` (`id`)
+            ON DELETE CASCADE ON UPDATE RESTRICT
+) Engine=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='작업 시간 기록';
+
+CREATE TABLE `task_template`
+(
+    `id`       BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '템플릿 식별자',
+    `user_id`  BIGINT UNSIGNED NOT NULL COMMENT '사용자 식별자',
+    `project_id` BIGINT UNSIGNED NULL COMMENT '템플릿을 공유하는 프로젝트 식별자 (공유하지 않으면 NULL)',
+    `name`     VARCHAR(128) NOT NULL COMMENT '템플릿명',
+    `items`    JSON NOT NULL COMMENT '템플릿에 포함된 태스크 트리',
+    `created`  DATETIME(6) NOT NULL COMMENT '레코드 작성 시간',
+    `modified` DATETIME(6) NOT NULL COMMENT '레코드 수정 시간',
+    PRIMARY KEY (`id`),
+    KEY `ix_project_id` (`project_id`) USING BTREE,
+    CONSTRAINT `fk_task_template_user_id`
+        FOREIGN KEY (`user_id`) REFERENCES `user` (`id`)
+            ON DELETE RESTRICT ON UPDATE RESTRICT,
+    CONSTRAINT `fk_task_template_project_id`
+        FOREIGN KEY (`project_id`) REFERENCES `project` (`id`)
+            ON DELETE SET NULL ON UPDATE RESTRICT
+) Engine=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='태스크 템플릿';
+
+CREATE TABLE `notification`
+(
+    `id`         BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '알림 식별자',
+    `user_id`    BIGINT UNSIGNED NOT NULL COMMENT '알림을 받는 사용자 식별자',
+    `type`       VARCHAR(32) NOT NULL COMMENT '알림 종류',
+    `task_id`    BIGINT UNSIGNED NULL COMMENT '관련 태스크 식별자',
+    `message`    VARCHAR(255) NOT NULL COMMENT '알림 내용',
+    `dedupe_key` VARCHAR(128) NULL COMMENT '중복 등록 방지 키',
+    `read_at`    DATETIME(6) NULL COMMENT '읽은 시간',
+    `created`    DATETIME(6) NOT NULL COMMENT '레코드 작성 시간',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uix_dedupe_key` (`dedupe_key`) USING BTREE,
+    KEY `ix_user_id_read_at` (`user_id`, `read_at`) USING BTREE,
+    CONSTRAINT `fk_notification_user_id`
+        FOREIGN KEY (`user_id`) REFERENCES `user` (`id`)
+            ON DELETE CASCADE ON UPDATE RESTRICT,
+    CONSTRAINT `fk_notification_task_id`
+        FOREIGN KEY (`task_id`) REFERENCES `task` (`id`)
+            ON DELETE CASCADE ON UPDATE RESTRICT
+) Engine=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='알림';
+
+CREATE TABLE `webhook`
+(
+    `id`            BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT 'Webhook 식별자',
+    `user_id`       BIGINT UNSIGNED NOT NULL COMMENT '사용자 식별자',
+    `url`           VARCHAR(2048) NOT NULL COMMENT '전송 대상 URL',
+    `events`        JSON NOT NULL COMMENT '구독하는 이벤트 종류',
+    `secret`        VARCHAR(128) NOT NULL COMMENT 'HMAC 서명 키',
+    `active`        BOOLEAN NOT NULL DEFAULT TRUE COMMENT '활성화 여부',
+    `failure_count` INT NOT NULL DEFAULT 0 COMMENT '연속 전송 실패 횟수',
+    `created`       DATETIME(6) NOT NULL COMMENT '레코드 작성 시간',
+    `modified`      DATETIME(6) NOT NULL COMMENT '레코드 수정 시간',
+    PRIMARY KEY (`id`),
+    KEY `ix_user_id` (`user_id`) USING BTREE,
+    CONSTRAINT `fk_webhook_user_id`
+        FOREIGN KEY (`user_id`) REFERENCES `user` (`id`)
+            ON DELETE CASCADE ON UPDATE RESTRICT
+) Engine=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='Webhook';
+
+CREATE TABLE `webhook_delivery`
+(
+    `id`          BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '전송 기록 식별자',
+    `webhook_id`  BIGINT UNSIGNED NOT NULL COMMENT 'Webhook 식별자',
+    `delivery_id` VARCHAR(64) NOT NULL COMMENT '재시도를 포함한 전송 식별자',
+    `event`       VARCHAR(32) NOT NULL COMMENT '이벤트 종류',
+    `attempt`     INT NOT NULL COMMENT '시도 횟수',
+    `status_code` INT NOT NULL DEFAULT 0 COMMENT '응답 상태 코드',
+    `error`       VARCHAR(1024) NOT NULL DEFAULT '' COMMENT '오류 내용',
+    `duration_ms` BIGINT NOT NULL DEFAULT 0 COMMENT '소요 시간 (밀리초)',
+    `created`     DATETIME(6) NOT NULL COMMENT '레코드 작성 시간',
+    PRIMARY KEY (`id`),
+    KEY `ix_webhook_id` (`webhook_id`) USING BTREE,
+    CONSTRAINT `fk_webhook_delivery_webhook_id`
+        FOREIGN KEY (`webhook_id`) REFERENCES `webhook` (`id`)
+            ON DELETE CASCADE ON UPDATE RESTRICT
+) Engine=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='Webhook 전송 기록';
+
+CREATE TABLE `mail_opt_out`
+(
+    `user_id` BIGINT UNSIGNED NOT NULL COMMENT '사용자 식별자',
+    `kind`    VARCHAR(32) NOT NULL COMMENT '수신을 거부한 메일 종류',
+    `created` DATETIME(6) NOT NULL COMMENT '레코드 작성 시간',
+    PRIMARY KEY (`user_id`, `kind`),
+    CONSTRAINT `fk_mail_opt_out_user_id`
+        FOREIGN KEY (`user_id`) REFERENCES `user` (`id`)
+            ON DELETE CASCADE ON UPDATE RESTRICT
+) Engine=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='메일 수신 거부 설정';
+
+CREATE TABLE `personal_access_token`
+(
+    `id`         BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '개인 액세스 토큰 식별자',
+    `user_id`    BIGINT UNSIGNED NOT NULL COMMENT '사용자 식별자',
+    `name`       VARCHAR(80) NOT NULL COMMENT '토큰 이름',
+    `token_hash` CHAR(64) NOT NULL COMMENT '토큰의 SHA-256 해시',
+    `scopes`     JSON NOT NULL COMMENT '허용한 범위',
+    `expires`    DATETIME(6) NULL DEFAULT NULL COMMENT '만료 시간',
+    `last_used`  DATETIME(6) NULL DEFAULT NULL COMMENT '마지막으로 사용한 시간',
+    `created`    DATETIME(6) NOT NULL COMMENT '레코드 작성 시간',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uix_token_hash` (`token_hash`) USING BTREE,
+    KEY `ix_user_id` (`user_id`) USING BTREE,
+    CONSTRAINT `fk_personal_access_token_user_id`
+        FOREIGN KEY (`user_id`) REFERENCES `user` (`id`)
+            ON DELETE CASCADE ON UPDATE RESTRICT
+) Engine=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='개인 액세스 토큰';
+
+CREATE TABLE `oauth_client`
+(
+    `id`            VARCHAR(64) NOT NULL COMMENT 'OAuth 클라이언트 식별자 (client_id)',
+    `user_id`       BIGINT UNSIGNED NOT NULL COMMENT '클라이언트를 등록한 사용자 식별자',
+    `name`          VARCHAR(80) NOT NULL COMMENT '동의 화면에 표시하는 클라이언트 이름',
+    `secret_hash`   CHAR(64) NULL DEFAULT NULL COMMENT '클라이언트 시크릿의 SHA-256 해시 (공개 클라이언트는 NULL)',
+    `redirect_uris` JSON NOT NULL COMMENT '허용한 리디렉션 URI',
+    `scopes`        JSON NOT NULL COMMENT '요청할 수 있는 범위',
+    `created`       DATETIME(6) NOT NULL COMMENT '레코드 작성 시간',
+    PRIMARY KEY (`id`),
+    KEY `ix_user_id` (`user_id`) USING BTREE,
+    CONSTRAINT `fk_oauth_client_user_id`
+        FOREIGN KEY (`user_id`) REFERENCES `user` (`id`)
+            ON DELETE CASCADE ON UPDATE RESTRICT
+) Engine=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='OAuth 클라이언트';
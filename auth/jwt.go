@@ -5,63 +5,100 @@ import (
 	_ "embed"
 	"fmt"
 	"net/http"
+	"sync/atomic"
 	"time"
 
 	"github.com/gitwub5/go_todo_app/clock"
 	"github.com/gitwub5/go_todo_app/entity"
 	"github.com/google/uuid"
-	"github.com/lestrrat-go/jwx/v2/jwa"
 	"github.com/lestrrat-go/jwx/v2/jwk"
 	"github.com/lestrrat-go/jwx/v2/jwt"
 )
 
 const (
-	RoleKey     = "role"
-	UserNameKey = "user_name"
+	RoleKey      = "role"
+	UserNameKey  = "user_name"
+	SessionIDKey = "sid"       // 액세스 토큰을 발급한 세션의 ID
+	ScopeKey     = "scope"     // OAuth 클라이언트에 허용한 범위 (공백으로 구분)
+	ClientIDKey  = "client_id" // 액세스 토큰을 발급받은 OAuth 클라이언트의 ID
 )
 
+// 키 디렉터리(KeyDir)를 설정하지 않았을 때 사용하는 개발용 키
+//
 //go:embed cert/secret.pem
 var rawPrivKey []byte
 
-//go:embed cert/public.pem
-var rawPubKey []byte
+const (
+	// DefaultAccessTokenTTL, DefaultRefreshTokenTTL은 NewJWTer가 설정하는 토큰의 유효 시간이다.
+	DefaultAccessTokenTTL  = 30 * time.Minute
+	DefaultRefreshTokenTTL = 30 * 24 * time.Hour
+	// DefaultIssuer, DefaultAudience는 NewJWTer가 설정하는 토큰의 발급자(iss)와 대상(aud)이다.
+	DefaultIssuer   = `github.com/gitwub5/go_todo_app`
+	DefaultAudience = "todo-api"
+	// DefaultClockSkew는 NewJWTer가 설정하는 서버 간 시계 차이의 허용 범위이다.
+	DefaultClockSkew = 30 * time.Second
+)
 
 // JWTer 구조체는 JWT를 생성하고 검증할 때 사용하는 키와 스토어를 포함함
 type JWTer struct {
-	PrivateKey, PublicKey jwk.Key       // 개인 키 및 공개 키를 JWK 형식으로 저장
-	Store                 Store         // JWT와 사용자 데이터를 저장 및 로드하기 위한 인터페이스
-	Clocker               clock.Clocker // 시간 관련 기능을 수행하는 clock.Clocker 객체
+	keys            atomic.Pointer[KeySet] // 서명 키와 검증 키. 서버를 멈추지 않고 교체할 수 있다.
+	Store           Store                  // JWT와 사용자 데이터를 저장 및 로드하기 위한 인터페이스
+	Clocker         clock.Clocker          // 시간 관련 기능을 수행하는 clock.Clocker 객체
+	AccessTokenTTL  time.Duration          // 액세스 토큰의 유효 시간
+	RefreshTokenTTL time.Duration          // 리프레시 토큰의 유효 시간 (교체할 때마다 다시 시작)
+	Issuer          string                 // 발급하는 토큰의 iss이며, 검증할 때 일치해야 한다.
+	Audience        string                 // 발급하는 토큰의 aud이며, 검증할 때 포함되어 있어야 한다.
+	ClockSkew       time.Duration          // exp, nbf, iat를 검증할 때 허용하는 시계 차이
 }
 
-//go:generate go run github.com/matryer/moq -out moq_test.go . Store
+//go:generate go run github.com/matryer/moq -out moq_test.go . Store PersonalAccessTokenVerifier
 type Store interface {
-	Save(ctx context.Context, key string, userID entity.UserID) error // 사용자 ID를 특정 키와 함께 저장
-	Load(ctx context.Context, key string) (entity.UserID, error)      // 특정 키를 통해 사용자 ID를 불러옴
+	Save(ctx context.Context, key string, userID entity.UserID, ttl time.Duration) error // 사용자 ID를 특정 키와 함께 ttl 동안 저장
+	Load(ctx context.Context, key string) (entity.UserID, error)                         // 특정 키를 통해 사용자 ID를 불러옴
+	Delete(ctx context.Context, key string) error                                        // 특정 키를 삭제해 액세스 토큰을 폐기
+	// 로그인한 세션을 저장하고 사용자의 세션 목록에 추가
+	SaveSession(ctx context.Context, s *entity.Session, ttl time.Duration) error
+	// 세션에서 발급한 액세스 토큰의 JTI를 기록
+	AddSessionToken(ctx context.Context, id entity.SessionID, jti string, ttl time.Duration) error
+	// 세션을 삭제하고 세션에서 발급한 액세스 토큰과 리프레시 토큰을 모두 폐기 (없으면 store.ErrNotFound)
+	DeleteSession(ctx context.Context, id entity.SessionID) error
+	// 리프레시 토큰을 세션과 함께 저장하고, 세션의 만료 시간도 ttl로 갱신
+	SaveRefreshToken(ctx context.Context, key string, sid entity.SessionID, userID entity.UserID, ttl time.Duration) error
+	// 리프레시 토큰을 사용한 것으로 표시하고 세션과 사용자 ID, 이미 사용했는지를 반환 (없으면 store.ErrNotFound)
+	UseRefreshToken(ctx context.Context, key string) (sid entity.SessionID, userID entity.UserID, used bool, err error)
 }
 
 // NewJWTer 함수는 JWTer 구조체를 초기화하는 생성자 함수
+// 내장된 개발용 키를 사용하므로, 운영 환경에서는 SetKeys로 KeyDir에서 읽은 키를 설정한다.
 func NewJWTer(s Store, c clock.Clocker) (*JWTer, error) {
-	j := &JWTer{Store: s}
-
-	// rawPrivKey를 사용하여 개인 키를 JWK 형식으로 파싱
-	privkey, err := parse(rawPrivKey)
-	if err != nil {
-		return nil, fmt.Errorf("failed in NewJWTer: private key: %w", err)
+	j := &JWTer{
+		Store: s, Clocker: c,
+		AccessTokenTTL: DefaultAccessTokenTTL, RefreshTokenTTL: DefaultRefreshTokenTTL,
+		Issuer: DefaultIssuer, Audience: DefaultAudience, ClockSkew: DefaultClockSkew,
 	}
-
-	// rawPubKey를 사용하여 공개 키를 JWK 형식으로 파싱
-	pubkey, err := parse(rawPubKey)
+	ks, err := embeddedKeySet()
 	if err != nil {
-		return nil, fmt.Errorf("failed in NewJWTer: public key: %w", err)
+		return nil, fmt.Errorf("failed in NewJWTer: %w", err)
 	}
-
-	// PrivateKey 및 PublicKey 필드에 파싱된 키를 할당
-	j.PrivateKey = privkey
-	j.PublicKey = pubkey
-	j.Clocker = c
+	j.SetKeys(ks)
 	return j, nil
 }
 
+// SetKeys 메서드는 서명 키와 검증 키를 교체한다. 처리 중인 요청에 영향을 주지 않는다.
+func (j *JWTer) SetKeys(ks *KeySet) {
+	j.keys.Store(ks)
+}
+
+// Keys 메서드는 현재 사용하는 서명 키와 검증 키를 반환한다.
+func (j *JWTer) Keys() *KeySet {
+	return j.keys.Load()
+}
+
+// PublicKeys 메서드는 토큰을 검증하는 공개 키를 반환한다.
+func (j *JWTer) PublicKeys() jwk.Set {
+	return j.Keys().PublicKeys()
+}
+
 // parse 함수는 PEM 형식의 키 데이터를 JWK 형식으로 변환
 func parse(rawKey []byte) (jwk.Key, error) {
 	key, err := jwk.ParseKey(rawKey, jwk.WithPEM(true))
@@ -71,28 +108,37 @@ func parse(rawKey []byte) (jwk.Key, error) {
 	return key, nil
 }
 
-func (j *JWTer) GenerateToken(ctx context.Context, u entity.User) ([]byte, error) {
+// GenerateToken 메서드는 세션 sid에서 사용할 액세스 토큰을 발급한다. 세션을 삭제하면 발급한 토큰도 폐기된다.
+func (j *JWTer) GenerateToken(ctx context.Context, u entity.User, sid entity.SessionID) ([]byte, error) {
+	now := j.Clocker.Now()
 	tok, err := jwt.NewBuilder().
 		JwtID(uuid.New().String()).
-		Issuer(`github.com/gitwub5/go_todo_app`).
+		Issuer(j.Issuer).
+		Audience([]string{j.Audience}).
 		Subject("access_token").
-		IssuedAt(j.Clocker.Now()).
-		// Redis의 expire(만료 시간) 설정에는 아래의 링크를 사용.
+		IssuedAt(now).
+		NotBefore(now).
+		// Redis의 expire(만료 시간)도 같은 AccessTokenTTL로 설정한다.
 		// https://pkg.go.dev/github.com/go-redis/redis/v8#Client.Set
-		// clock.Duration이기 때문에 Sub(뺄셈) 메서드를 사용하여 만료 시간을 설정
-		Expiration(j.Clocker.Now().Add(30*time.Minute)).
+		Expiration(now.Add(j.AccessTokenTTL)).
 		Claim(RoleKey, u.Role).
 		Claim(UserNameKey, u.Name).
+		Claim(SessionIDKey, string(sid)).
 		Build()
 	if err != nil {
 		return nil, fmt.Errorf("GenerateToken: failed to build token: %w", err)
 	}
-	if err := j.Store.Save(ctx, tok.JwtID(), u.ID); err != nil {
+	if err := j.Store.Save(ctx, tok.JwtID(), u.ID, j.AccessTokenTTL); err != nil {
+		return nil, err
+	}
+	// 세션은 리프레시 토큰과 같은 시간 동안 유지된다.
+	if err := j.Store.AddSessionToken(ctx, sid, tok.JwtID(), j.RefreshTokenTTL); err != nil {
 		return nil, err
 	}
 
-	// Sign a JWT!
-	signed, err := jwt.Sign(tok, jwt.WithKey(jwa.RS256, j.PrivateKey))
+	// Sign a JWT! (헤더의 kid로 서명한 키를 찾을 수 있다)
+	ks := j.Keys()
+	signed, err := jwt.Sign(tok, jwt.WithKey(ks.SigningAlgorithm(), ks.signing))
 	if err != nil {
 		return nil, err
 	}
@@ -102,15 +148,39 @@ func (j *JWTer) GenerateToken(ctx context.Context, u entity.User) ([]byte, error
 func (j *JWTer) GetToken(ctx context.Context, r *http.Request) (jwt.Token, error) {
 	token, err := jwt.ParseRequest(
 		r,
-		jwt.WithKey(jwa.RS256, j.PublicKey),
+		jwt.WithKeyProvider(j.Keys()),
+		jwt.WithValidate(false),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return j.validate(ctx, token)
+}
+
+// ParseToken 메서드는 문자열로 전달된 액세스 토큰을 검증한다.
+// WebSocket처럼 Authorization 헤더를 사용할 수 없는 경우에 사용한다.
+func (j *JWTer) ParseToken(ctx context.Context, raw string) (jwt.Token, error) {
+	token, err := jwt.ParseString(
+		raw,
+		jwt.WithKeyProvider(j.Keys()),
 		jwt.WithValidate(false),
 	)
 	if err != nil {
 		return nil, err
 	}
+	return j.validate(ctx, token)
+}
 
-	// 토큰 검증 (만료 시간 등)
-	if err := jwt.Validate(token, jwt.WithClock(j.Clocker)); err != nil {
+func (j *JWTer) validate(ctx context.Context, token jwt.Token) (jwt.Token, error) {
+	// 토큰 검증 (만료 시간, 사용 시작 시간, 발급 시간, 발급자, 대상)
+	// 서버마다 시계가 조금씩 다르므로 ClockSkew만큼의 차이는 허용한다.
+	if err := jwt.Validate(token,
+		jwt.WithClock(j.Clocker),
+		jwt.WithAcceptableSkew(j.ClockSkew),
+		jwt.WithRequiredClaim(jwt.ExpirationKey),
+		jwt.WithIssuer(j.Issuer),
+		jwt.WithAudience(j.Audience),
+	); err != nil {
 		return nil, fmt.Errorf("GetToken: failed to validate token: %w", err)
 	}
 	// 레디스에서 삭제해서 수동으로 expire 시키는 경우도 있다.
@@ -122,26 +192,79 @@ func (j *JWTer) GetToken(ctx context.Context, r *http.Request) (jwt.Token, error
 
 /* 애플리케이션 코드에서 매번 jwt를 생성하지 않고, context에 jwt에서 가져온 사용자 ID와 권한을 설정한다. */
 
-type userIDKey struct{} // context에 사용자 ID를 저장하기 위한 키
-type roleKey struct{}   // context에 권한을 저장하기 위한 키
+type userIDKey struct{}    // context에 사용자 ID를 저장하기 위한 키
+type roleKey struct{}      // context에 권한을 저장하기 위한 키
+type tokenIDKey struct{}   // context에 액세스 토큰의 JTI를 저장하기 위한 키
+type sessionIDKey struct{} // context에 세션 ID를 저장하기 위한 키
 
-// FillContext 함수는 context에 사용자 ID와 권한을 설정
+// FillContext 함수는 context에 사용자 ID와 권한, 토큰의 JTI와 세션 ID를 설정
+// OAuth 클라이언트에 발급한 토큰은 범위를 확인하는 경로(Authenticator)에서만 사용할 수 있으므로 ErrScopedToken을 반환한다.
 func (j *JWTer) FillContext(r *http.Request) (*http.Request, error) {
+	req, err := j.fillContext(r)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := GetScopes(req.Context()); ok {
+		return nil, ErrScopedToken
+	}
+	return req, nil
+}
+
+func (j *JWTer) fillContext(r *http.Request) (*http.Request, error) {
 	token, err := j.GetToken(r.Context(), r)
 	if err != nil {
 		return nil, err
 	}
-	uid, err := j.Store.Load(r.Context(), token.JwtID())
+	ctx, err := j.fill(r.Context(), token)
 	if err != nil {
 		return nil, err
 	}
-	ctx := SetUserID(r.Context(), uid)
-
-	ctx = SetRole(ctx, token)
 	clone := r.Clone(ctx)
 	return clone, nil
 }
 
+// Authenticate 메서드는 문자열로 전달된 액세스 토큰을 검증하고, 사용자 ID와 권한을 설정한 context를 반환한다.
+// FillContext와 같이 OAuth 클라이언트에 발급한 토큰이면 ErrScopedToken을 반환한다.
+func (j *JWTer) Authenticate(ctx context.Context, raw string) (context.Context, error) {
+	token, err := j.ParseToken(ctx, raw)
+	if err != nil {
+		return nil, err
+	}
+	ctx, err = j.fill(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := GetScopes(ctx); ok {
+		return nil, ErrScopedToken
+	}
+	return ctx, nil
+}
+
+func (j *JWTer) fill(ctx context.Context, token jwt.Token) (context.Context, error) {
+	uid, err := j.Store.Load(ctx, token.JwtID())
+	if err != nil {
+		return nil, err
+	}
+	ctx = SetUserID(ctx, uid)
+	ctx = SetRole(ctx, token)
+	ctx = SetTokenID(ctx, token.JwtID())
+	// 세션을 도입하기 전에 발급한 토큰에는 세션 ID가 없다.
+	if sid, ok := token.Get(SessionIDKey); ok {
+		if sid, ok := sid.(string); ok {
+			ctx = SetSessionID(ctx, entity.SessionID(sid))
+		}
+	}
+	// OAuth 클라이언트에 발급한 토큰은 허용한 범위의 API만 호출할 수 있다.
+	if v, ok := token.Get(ScopeKey); ok {
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid %s claim: %T", ScopeKey, v)
+		}
+		ctx = SetScopes(ctx, entity.ParseScopes(s))
+	}
+	return ctx, nil
+}
+
 // SetUserID 함수는 context에 사용자 ID를 설정
 func SetUserID(ctx context.Context, uid entity.UserID) context.Context {
 	return context.WithValue(ctx, userIDKey{}, uid)
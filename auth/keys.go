@@ -0,0 +1,360 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jws"
+)
+
+/*
+서명 키를 교체할 때는 서버를 멈추지 않고 다음 순서로 진행한다.
+
+ 1. 새 키를 만든다(stage). 모든 서버가 새 키로 서명한 토큰을 검증할 수 있고, JWKS로 공개된다.
+ 2. 새 키를 활성화한다(activate). 이후 발급하는 토큰은 새 키로 서명하며, 이전 키로 서명한 토큰도 계속 검증할 수 있다.
+ 3. 이전 키로 서명한 토큰이 모두 만료되면 이전 키를 폐기한다(retire). 폐기한 키로 서명한 토큰은 더 이상 검증할 수 없다.
+
+리프레시 토큰은 서명하지 않으므로, 키를 일찍 폐기해도 클라이언트는 다시 로그인하지 않고 액세스 토큰을 갱신할 수 있다.
+*/
+
+// keyBits는 새로 만드는 RSA 키의 크기이다.
+const keyBits = 2048
+
+// ErrActiveKey는 서명에 사용 중인 키를 폐기하려고 할 때 반환한다.
+var ErrActiveKey = errors.New("key is active")
+
+// Algorithms는 지원하는 서명 알고리즘이다. 키마다 키의 종류에 따라 알고리즘이 정해진다.
+var Algorithms = []jwa.SignatureAlgorithm{jwa.RS256, jwa.ES256, jwa.EdDSA}
+
+// ParseAlgorithm 함수는 지원하는 서명 알고리즘의 이름(예: "ES256")을 해석한다.
+func ParseAlgorithm(name string) (jwa.SignatureAlgorithm, error) {
+	for _, alg := range Algorithms {
+		if alg.String() == name {
+			return alg, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported signing algorithm %q (RS256, ES256 or EdDSA)", name)
+}
+
+// keyAlgorithm 함수는 키의 종류로 서명 알고리즘을 정한다.
+// RSA 키는 RS256, P-256 EC 키는 ES256, Ed25519 키는 EdDSA로 서명한다.
+func keyAlgorithm(k jwk.Key) (jwa.SignatureAlgorithm, error) {
+	switch raw := k.(type) {
+	case jwk.RSAPrivateKey, jwk.RSAPublicKey:
+		return jwa.RS256, nil
+	case jwk.ECDSAPrivateKey:
+		if raw.Crv() == jwa.P256 {
+			return jwa.ES256, nil
+		}
+	case jwk.ECDSAPublicKey:
+		if raw.Crv() == jwa.P256 {
+			return jwa.ES256, nil
+		}
+	case jwk.OKPPrivateKey:
+		if raw.Crv() == jwa.Ed25519 {
+			return jwa.EdDSA, nil
+		}
+	case jwk.OKPPublicKey:
+		if raw.Crv() == jwa.Ed25519 {
+			return jwa.EdDSA, nil
+		}
+	}
+	return "", fmt.Errorf("key %q: unsupported key type %s", k.KeyID(), k.KeyType())
+}
+
+// KeySet은 액세스 토큰에 서명하는 키와 토큰을 검증하는 공개 키의 모음이다.
+// 키를 교체하는 동안에는 이전 키와 새 키로 서명한 토큰을 모두 검증해야 하므로 공개 키는 여러 개일 수 있다.
+// 알고리즘이 다른 키로 교체할 수도 있다.
+type KeySet struct {
+	signing jwk.Key
+	public  jwk.Set
+}
+
+// NewKeySet 함수는 개인 키 keys로 KeySet을 만든다. kid가 active인 키로 서명하고, 모든 키로 검증한다.
+func NewKeySet(active string, keys ...jwk.Key) (*KeySet, error) {
+	ks := &KeySet{public: jwk.NewSet()}
+	for _, k := range keys {
+		alg, err := keyAlgorithm(k)
+		if err != nil {
+			return nil, err
+		}
+		if err := k.Set(jwk.AlgorithmKey, alg); err != nil {
+			return nil, err
+		}
+		pub, err := k.PublicKey()
+		if err != nil {
+			return nil, fmt.Errorf("key %q: %w", k.KeyID(), err)
+		}
+		if err := pub.Set(jwk.KeyUsageKey, jwk.ForSignature); err != nil {
+			return nil, err
+		}
+		if err := ks.public.AddKey(pub); err != nil {
+			return nil, fmt.Errorf("key %q: %w", k.KeyID(), err)
+		}
+		if k.KeyID() == active {
+			ks.signing = k
+		}
+	}
+	if ks.signing == nil {
+		return nil, fmt.Errorf("active key %q: %w", active, fs.ErrNotExist)
+	}
+	return ks, nil
+}
+
+// SigningKeyID 메서드는 서명에 사용하는 키의 kid를 반환한다.
+func (ks *KeySet) SigningKeyID() string {
+	return ks.signing.KeyID()
+}
+
+// SigningAlgorithm 메서드는 서명에 사용하는 키의 알고리즘을 반환한다.
+func (ks *KeySet) SigningAlgorithm() jwa.SignatureAlgorithm {
+	return jwa.SignatureAlgorithm(ks.signing.Algorithm().String())
+}
+
+// PublicKeys 메서드는 토큰을 검증하는 공개 키를 반환한다. (GET /.well-known/jwks.json)
+func (ks *KeySet) PublicKeys() jwk.Set {
+	return ks.public
+}
+
+// FetchKeys 메서드는 토큰 헤더의 kid와 일치하는 공개 키를 찾는다.
+// kid를 도입하기 전에 발급한 토큰에는 kid가 없으므로 알고리즘이 같은 모든 공개 키로 검증해 본다.
+// 헤더의 알고리즘은 키의 알고리즘과 같아야 하므로, 헤더를 바꿔 다른 알고리즘으로 검증하게 할 수 없다.
+func (ks *KeySet) FetchKeys(_ context.Context, sink jws.KeySink, sig *jws.Signature, _ *jws.Message) error {
+	h := sig.ProtectedHeaders()
+	alg := h.Algorithm()
+	if h.KeyID() == "" {
+		for i := 0; i < ks.public.Len(); i++ {
+			k, _ := ks.public.Key(i)
+			if k.Algorithm().String() == alg.String() {
+				sink.Key(alg, k)
+			}
+		}
+		return nil
+	}
+	k, ok := ks.public.LookupKeyID(h.KeyID())
+	if !ok {
+		return fmt.Errorf("unknown key ID %q", h.KeyID())
+	}
+	if k.Algorithm().String() != alg.String() {
+		return fmt.Errorf("key %q: algorithm %s does not match %s", h.KeyID(), alg, k.Algorithm())
+	}
+	sink.Key(alg, k)
+	return nil
+}
+
+// embeddedKeySet 함수는 바이너리에 내장된 개발용 키로 KeySet을 만든다.
+func embeddedKeySet() (*KeySet, error) {
+	key, err := parse(rawPrivKey)
+	if err != nil {
+		return nil, fmt.Errorf("private key: %w", err)
+	}
+	if err := assignKeyID(key); err != nil {
+		return nil, err
+	}
+	return NewKeySet(key.KeyID(), key)
+}
+
+// assignKeyID 함수는 키의 JWK Thumbprint(RFC 7638)를 kid로 설정한다.
+func assignKeyID(key jwk.Key) error {
+	tp, err := key.Thumbprint(crypto.SHA256)
+	if err != nil {
+		return err
+	}
+	return key.Set(jwk.KeyIDKey, base64.RawURLEncoding.EncodeToString(tp))
+}
+
+// KeyDir은 서명 키를 보관하는 디렉터리이다.
+// 키마다 "<kid>.pem" 파일에 PEM(PKCS #8) 형식의 개인 키를 저장하고, "active" 파일에 서명에 사용할 키의 kid를 저장한다.
+// 디렉터리의 모든 키는 토큰을 검증하는 데 사용하고 JWKS로 공개한다.
+type KeyDir string
+
+const activeKeyFile = "active"
+
+// kid는 파일 이름으로 사용하므로 경로 구분자를 포함할 수 없다.
+var kidPattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+func (d KeyDir) keyPath(kid string) (string, error) {
+	if !kidPattern.MatchString(kid) {
+		return "", fmt.Errorf("invalid key ID %q", kid)
+	}
+	return filepath.Join(string(d), kid+".pem"), nil
+}
+
+// Keys 메서드는 디렉터리에 있는 키의 kid를 정렬해 반환하고, 서명에 사용할 키의 kid를 함께 반환한다.
+func (d KeyDir) Keys() (kids []string, active string, err error) {
+	files, err := filepath.Glob(filepath.Join(string(d), "*.pem"))
+	if err != nil {
+		return nil, "", err
+	}
+	kids = []string{}
+	for _, f := range files {
+		kids = append(kids, strings.TrimSuffix(filepath.Base(f), ".pem"))
+	}
+	sort.Strings(kids)
+	b, err := os.ReadFile(filepath.Join(string(d), activeKeyFile))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, "", err
+	}
+	return kids, strings.TrimSpace(string(b)), nil
+}
+
+// Load 메서드는 디렉터리의 키를 모두 읽어 KeySet을 만든다.
+func (d KeyDir) Load() (*KeySet, error) {
+	kids, active, err := d.Keys()
+	if err != nil {
+		return nil, err
+	}
+	if active == "" {
+		return nil, fmt.Errorf("no active key in %s", d)
+	}
+	keys := make([]jwk.Key, 0, len(kids))
+	for _, kid := range kids {
+		p, err := d.keyPath(kid)
+		if err != nil {
+			return nil, err
+		}
+		b, err := os.ReadFile(p)
+		if err != nil {
+			return nil, err
+		}
+		key, err := parse(b)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: %w", kid, err)
+		}
+		if err := key.Set(jwk.KeyIDKey, kid); err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return NewKeySet(active, keys...)
+}
+
+// Generate 메서드는 alg로 서명하는 새 키를 만들어 디렉터리에 저장하고 kid를 반환한다.
+// 새 키는 Activate하기 전까지 서명에 사용하지 않는다.
+func (d KeyDir) Generate(alg jwa.SignatureAlgorithm) (string, error) {
+	var raw crypto.PrivateKey
+	var err error
+	switch alg {
+	case jwa.RS256:
+		raw, err = rsa.GenerateKey(rand.Reader, keyBits)
+	case jwa.ES256:
+		raw, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case jwa.EdDSA:
+		_, raw, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		return "", fmt.Errorf("unsupported signing algorithm %q", alg)
+	}
+	if err != nil {
+		return "", err
+	}
+	key, err := jwk.FromRaw(raw)
+	if err != nil {
+		return "", err
+	}
+	if err := assignKeyID(key); err != nil {
+		return "", err
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(raw)
+	if err != nil {
+		return "", err
+	}
+	p, err := d.keyPath(key.KeyID())
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(string(d), 0o700); err != nil {
+		return "", err
+	}
+	if err := d.writeFile(p, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})); err != nil {
+		return "", err
+	}
+	return key.KeyID(), nil
+}
+
+// Activate 메서드는 kid 키로 서명하도록 설정한다.
+func (d KeyDir) Activate(kid string) error {
+	p, err := d.keyPath(kid)
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(p); err != nil {
+		return fmt.Errorf("key %q: %w", kid, err)
+	}
+	return d.writeFile(filepath.Join(string(d), activeKeyFile), []byte(kid+"\n"))
+}
+
+// Retire 메서드는 kid 키를 삭제해 더 이상 토큰을 검증하지 않도록 한다. 서명에 사용 중인 키는 폐기할 수 없다.
+func (d KeyDir) Retire(kid string) error {
+	p, err := d.keyPath(kid)
+	if err != nil {
+		return err
+	}
+	_, active, err := d.Keys()
+	if err != nil {
+		return err
+	}
+	if kid == active {
+		return fmt.Errorf("key %q: %w", kid, ErrActiveKey)
+	}
+	if err := os.Remove(p); err != nil {
+		return fmt.Errorf("key %q: %w", kid, err)
+	}
+	return nil
+}
+
+// writeFile 메서드는 읽고 있는 서버가 쓰다 만 파일을 읽지 않도록 임시 파일에 쓴 뒤 이름을 바꾼다.
+func (d KeyDir) writeFile(name string, b []byte) error {
+	f, err := os.CreateTemp(string(d), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(b); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), name)
+}
+
+// WatchKeys 메서드는 ctx가 취소될 때까지 interval마다 디렉터리에서 키를 다시 읽는다.
+// 키를 읽지 못하면 이전 키를 계속 사용한다.
+func (j *JWTer) WatchKeys(ctx context.Context, d KeyDir, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			ks, err := d.Load()
+			if err != nil {
+				log.Printf("failed to reload signing keys: %v", err)
+				continue
+			}
+			j.SetKeys(ks)
+		}
+	}
+}
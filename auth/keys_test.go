@@ -0,0 +1,204 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"testing"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/clock"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/testutil/fixture"
+	"github.com/google/go-cmp/cmp"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jws"
+)
+
+func TestKeyDir(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dir := KeyDir(t.TempDir())
+	moq := &StoreMock{
+		SaveFunc: func(ctx context.Context, key string, userID entity.UserID, ttl time.Duration) error {
+			return nil
+		},
+		AddSessionTokenFunc: func(ctx context.Context, id entity.SessionID, jti string, ttl time.Duration) error {
+			return nil
+		},
+		LoadFunc: func(ctx context.Context, key string) (entity.UserID, error) {
+			return 1, nil
+		},
+	}
+	sut, err := NewJWTer(moq, clock.RealClocker{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	u := fixture.User(&entity.User{ID: 1})
+	sign := func(t *testing.T) string {
+		t.Helper()
+		tok, err := sut.GenerateToken(ctx, *u, "session")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(tok)
+	}
+	reload := func(t *testing.T) {
+		t.Helper()
+		ks, err := dir.Load()
+		if err != nil {
+			t.Fatal(err)
+		}
+		sut.SetKeys(ks)
+	}
+	verify := func(t *testing.T, tok string, want bool) {
+		t.Helper()
+		if _, err := sut.ParseToken(ctx, tok); (err == nil) != want {
+			t.Errorf("want valid %t, but got %v", want, err)
+		}
+	}
+
+	// 활성화한 키가 없으면 읽을 수 없다.
+	first, err := dir.Generate(jwa.RS256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dir.Load(); err == nil {
+		t.Fatal("want error without active key")
+	}
+	if err := dir.Activate(first); err != nil {
+		t.Fatal(err)
+	}
+	reload(t)
+	if got := sut.Keys().SigningKeyID(); got != first {
+		t.Errorf("want signing key %q, but got %q", first, got)
+	}
+	old := sign(t)
+
+	// 새 키를 준비하는 동안에는 이전 키로 서명하고, 새 키는 검증과 JWKS에만 사용한다.
+	// 알고리즘이 다른 키로도 교체할 수 있다.
+	second, err := dir.Generate(jwa.EdDSA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reload(t)
+	if got := sut.Keys().SigningKeyID(); got != first {
+		t.Errorf("want signing key %q, but got %q", first, got)
+	}
+	if got := sut.Keys().PublicKeys().Len(); got != 2 {
+		t.Errorf("want 2 public keys, but got %d", got)
+	}
+	kids, active, err := dir.Keys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{first, second}
+	if want[0] > want[1] {
+		want[0], want[1] = want[1], want[0]
+	}
+	if diff := cmp.Diff(kids, want); diff != "" || active != first {
+		t.Errorf("Keys differs: active %q (-got +want)\n%s", active, diff)
+	}
+
+	// 새 키를 활성화해도 이전 키로 서명한 토큰은 검증할 수 있다.
+	if err := dir.Activate(second); err != nil {
+		t.Fatal(err)
+	}
+	reload(t)
+	cur := sign(t)
+	verify(t, old, true)
+	verify(t, cur, true)
+
+	// 이전 키를 폐기하면 이전 키로 서명한 토큰만 거부한다.
+	if err := dir.Retire(first); err != nil {
+		t.Fatal(err)
+	}
+	reload(t)
+	verify(t, old, false)
+	verify(t, cur, true)
+
+	if err := dir.Retire(second); !errors.Is(err, ErrActiveKey) {
+		t.Errorf("want ErrActiveKey, but got %v", err)
+	}
+	if err := dir.Activate(first); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("want ErrNotExist, but got %v", err)
+	}
+	if err := dir.Retire("../active"); err == nil {
+		t.Error("want error for invalid key ID")
+	}
+}
+
+func TestKeyDir_Generate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		alg     jwa.SignatureAlgorithm
+		wantErr bool
+	}{
+		"RS256":       {alg: jwa.RS256},
+		"ES256":       {alg: jwa.ES256},
+		"EdDSA":       {alg: jwa.EdDSA},
+		"unsupported": {alg: jwa.HS256, wantErr: true},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			dir := KeyDir(t.TempDir())
+			kid, err := dir.Generate(tt.alg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("want error %t, but got %v", tt.wantErr, err)
+			}
+			if tt.wantErr {
+				return
+			}
+			if err := dir.Activate(kid); err != nil {
+				t.Fatal(err)
+			}
+			ks, err := dir.Load()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := ks.SigningAlgorithm(); got != tt.alg {
+				t.Errorf("want signing algorithm %s, but got %s", tt.alg, got)
+			}
+			pub, _ := ks.PublicKeys().LookupKeyID(kid)
+			if got := pub.Algorithm().String(); got != tt.alg.String() {
+				t.Errorf("want public key algorithm %s, but got %s", tt.alg, got)
+			}
+
+			moq := &StoreMock{
+				SaveFunc: func(ctx context.Context, key string, userID entity.UserID, ttl time.Duration) error {
+					return nil
+				},
+				AddSessionTokenFunc: func(ctx context.Context, id entity.SessionID, jti string, ttl time.Duration) error {
+					return nil
+				},
+				LoadFunc: func(ctx context.Context, key string) (entity.UserID, error) {
+					return 1, nil
+				},
+			}
+			sut, err := NewJWTer(moq, clock.RealClocker{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			sut.SetKeys(ks)
+			tok, err := sut.GenerateToken(context.Background(), *fixture.User(&entity.User{ID: 1}), "session")
+			if err != nil {
+				t.Fatal(err)
+			}
+			msg, err := jws.Parse(tok)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := msg.Signatures()[0].ProtectedHeaders().Algorithm(); got != tt.alg {
+				t.Errorf("want alg header %s, but got %s", tt.alg, got)
+			}
+			if _, err := sut.ParseToken(context.Background(), string(tok)); err != nil {
+				t.Errorf("want valid token, but got %v", err)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"github.com/gitwub5/go_todo_app/entity"
 	"sync"
+	"time"
 )
 
 // Ensure, that StoreMock does implement Store.
@@ -19,12 +20,30 @@ var _ Store = &StoreMock{}
 //
 //		// make and configure a mocked Store
 //		mockedStore := &StoreMock{
+//			AddSessionTokenFunc: func(ctx context.Context, id entity.SessionID, jti string, ttl time.Duration) error {
+//				panic("mock out the AddSessionToken method")
+//			},
+//			DeleteFunc: func(ctx context.Context, key string) error {
+//				panic("mock out the Delete method")
+//			},
+//			DeleteSessionFunc: func(ctx context.Context, id entity.SessionID) error {
+//				panic("mock out the DeleteSession method")
+//			},
 //			LoadFunc: func(ctx context.Context, key string) (entity.UserID, error) {
 //				panic("mock out the Load method")
 //			},
-//			SaveFunc: func(ctx context.Context, key string, userID entity.UserID) error {
+//			SaveFunc: func(ctx context.Context, key string, userID entity.UserID, ttl time.Duration) error {
 //				panic("mock out the Save method")
 //			},
+//			SaveRefreshTokenFunc: func(ctx context.Context, key string, sid entity.SessionID, userID entity.UserID, ttl time.Duration) error {
+//				panic("mock out the SaveRefreshToken method")
+//			},
+//			SaveSessionFunc: func(ctx context.Context, s *entity.Session, ttl time.Duration) error {
+//				panic("mock out the SaveSession method")
+//			},
+//			UseRefreshTokenFunc: func(ctx context.Context, key string) (entity.SessionID, entity.UserID, bool, error) {
+//				panic("mock out the UseRefreshToken method")
+//			},
 //		}
 //
 //		// use mockedStore in code that requires Store
@@ -32,14 +51,57 @@ var _ Store = &StoreMock{}
 //
 //	}
 type StoreMock struct {
+	// AddSessionTokenFunc mocks the AddSessionToken method.
+	AddSessionTokenFunc func(ctx context.Context, id entity.SessionID, jti string, ttl time.Duration) error
+
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(ctx context.Context, key string) error
+
+	// DeleteSessionFunc mocks the DeleteSession method.
+	DeleteSessionFunc func(ctx context.Context, id entity.SessionID) error
+
 	// LoadFunc mocks the Load method.
 	LoadFunc func(ctx context.Context, key string) (entity.UserID, error)
 
 	// SaveFunc mocks the Save method.
-	SaveFunc func(ctx context.Context, key string, userID entity.UserID) error
+	SaveFunc func(ctx context.Context, key string, userID entity.UserID, ttl time.Duration) error
+
+	// SaveRefreshTokenFunc mocks the SaveRefreshToken method.
+	SaveRefreshTokenFunc func(ctx context.Context, key string, sid entity.SessionID, userID entity.UserID, ttl time.Duration) error
+
+	// SaveSessionFunc mocks the SaveSession method.
+	SaveSessionFunc func(ctx context.Context, s *entity.Session, ttl time.Duration) error
+
+	// UseRefreshTokenFunc mocks the UseRefreshToken method.
+	UseRefreshTokenFunc func(ctx context.Context, key string) (entity.SessionID, entity.UserID, bool, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// AddSessionToken holds details about calls to the AddSessionToken method.
+		AddSessionToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID entity.SessionID
+			// Jti is the jti argument value.
+			Jti string
+			// TTL is the ttl argument value.
+			TTL time.Duration
+		}
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Key is the key argument value.
+			Key string
+		}
+		// DeleteSession holds details about calls to the DeleteSession method.
+		DeleteSession []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID entity.SessionID
+		}
 		// Load holds details about calls to the Load method.
 		Load []struct {
 			// Ctx is the ctx argument value.
@@ -55,10 +117,163 @@ type StoreMock struct {
 			Key string
 			// UserID is the userID argument value.
 			UserID entity.UserID
+			// TTL is the ttl argument value.
+			TTL time.Duration
 		}
+		// SaveRefreshToken holds details about calls to the SaveRefreshToken method.
+		SaveRefreshToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Key is the key argument value.
+			Key string
+			// Sid is the sid argument value.
+			Sid entity.SessionID
+			// UserID is the userID argument value.
+			UserID entity.UserID
+			// TTL is the ttl argument value.
+			TTL time.Duration
+		}
+		// SaveSession holds details about calls to the SaveSession method.
+		SaveSession []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S is the s argument value.
+			S *entity.Session
+			// TTL is the ttl argument value.
+			TTL time.Duration
+		}
+		// UseRefreshToken holds details about calls to the UseRefreshToken method.
+		UseRefreshToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Key is the key argument value.
+			Key string
+		}
+	}
+	lockAddSessionToken  sync.RWMutex
+	lockDelete           sync.RWMutex
+	lockDeleteSession    sync.RWMutex
+	lockLoad             sync.RWMutex
+	lockSave             sync.RWMutex
+	lockSaveRefreshToken sync.RWMutex
+	lockSaveSession      sync.RWMutex
+	lockUseRefreshToken  sync.RWMutex
+}
+
+// AddSessionToken calls AddSessionTokenFunc.
+func (mock *StoreMock) AddSessionToken(ctx context.Context, id entity.SessionID, jti string, ttl time.Duration) error {
+	if mock.AddSessionTokenFunc == nil {
+		panic("StoreMock.AddSessionTokenFunc: method is nil but Store.AddSessionToken was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  entity.SessionID
+		Jti string
+		TTL time.Duration
+	}{
+		Ctx: ctx,
+		ID:  id,
+		Jti: jti,
+		TTL: ttl,
+	}
+	mock.lockAddSessionToken.Lock()
+	mock.calls.AddSessionToken = append(mock.calls.AddSessionToken, callInfo)
+	mock.lockAddSessionToken.Unlock()
+	return mock.AddSessionTokenFunc(ctx, id, jti, ttl)
+}
+
+// AddSessionTokenCalls gets all the calls that were made to AddSessionToken.
+// Check the length with:
+//
+//	len(mockedStore.AddSessionTokenCalls())
+func (mock *StoreMock) AddSessionTokenCalls() []struct {
+	Ctx context.Context
+	ID  entity.SessionID
+	Jti string
+	TTL time.Duration
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  entity.SessionID
+		Jti string
+		TTL time.Duration
 	}
-	lockLoad sync.RWMutex
-	lockSave sync.RWMutex
+	mock.lockAddSessionToken.RLock()
+	calls = mock.calls.AddSessionToken
+	mock.lockAddSessionToken.RUnlock()
+	return calls
+}
+
+// Delete calls DeleteFunc.
+func (mock *StoreMock) Delete(ctx context.Context, key string) error {
+	if mock.DeleteFunc == nil {
+		panic("StoreMock.DeleteFunc: method is nil but Store.Delete was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Key string
+	}{
+		Ctx: ctx,
+		Key: key,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(ctx, key)
+}
+
+// DeleteCalls gets all the calls that were made to Delete.
+// Check the length with:
+//
+//	len(mockedStore.DeleteCalls())
+func (mock *StoreMock) DeleteCalls() []struct {
+	Ctx context.Context
+	Key string
+} {
+	var calls []struct {
+		Ctx context.Context
+		Key string
+	}
+	mock.lockDelete.RLock()
+	calls = mock.calls.Delete
+	mock.lockDelete.RUnlock()
+	return calls
+}
+
+// DeleteSession calls DeleteSessionFunc.
+func (mock *StoreMock) DeleteSession(ctx context.Context, id entity.SessionID) error {
+	if mock.DeleteSessionFunc == nil {
+		panic("StoreMock.DeleteSessionFunc: method is nil but Store.DeleteSession was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  entity.SessionID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockDeleteSession.Lock()
+	mock.calls.DeleteSession = append(mock.calls.DeleteSession, callInfo)
+	mock.lockDeleteSession.Unlock()
+	return mock.DeleteSessionFunc(ctx, id)
+}
+
+// DeleteSessionCalls gets all the calls that were made to DeleteSession.
+// Check the length with:
+//
+//	len(mockedStore.DeleteSessionCalls())
+func (mock *StoreMock) DeleteSessionCalls() []struct {
+	Ctx context.Context
+	ID  entity.SessionID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  entity.SessionID
+	}
+	mock.lockDeleteSession.RLock()
+	calls = mock.calls.DeleteSession
+	mock.lockDeleteSession.RUnlock()
+	return calls
 }
 
 // Load calls LoadFunc.
@@ -98,7 +313,7 @@ func (mock *StoreMock) LoadCalls() []struct {
 }
 
 // Save calls SaveFunc.
-func (mock *StoreMock) Save(ctx context.Context, key string, userID entity.UserID) error {
+func (mock *StoreMock) Save(ctx context.Context, key string, userID entity.UserID, ttl time.Duration) error {
 	if mock.SaveFunc == nil {
 		panic("StoreMock.SaveFunc: method is nil but Store.Save was just called")
 	}
@@ -106,15 +321,17 @@ func (mock *StoreMock) Save(ctx context.Context, key string, userID entity.UserI
 		Ctx    context.Context
 		Key    string
 		UserID entity.UserID
+		TTL    time.Duration
 	}{
 		Ctx:    ctx,
 		Key:    key,
 		UserID: userID,
+		TTL:    ttl,
 	}
 	mock.lockSave.Lock()
 	mock.calls.Save = append(mock.calls.Save, callInfo)
 	mock.lockSave.Unlock()
-	return mock.SaveFunc(ctx, key, userID)
+	return mock.SaveFunc(ctx, key, userID, ttl)
 }
 
 // SaveCalls gets all the calls that were made to Save.
@@ -125,14 +342,212 @@ func (mock *StoreMock) SaveCalls() []struct {
 	Ctx    context.Context
 	Key    string
 	UserID entity.UserID
+	TTL    time.Duration
 } {
 	var calls []struct {
 		Ctx    context.Context
 		Key    string
 		UserID entity.UserID
+		TTL    time.Duration
 	}
 	mock.lockSave.RLock()
 	calls = mock.calls.Save
 	mock.lockSave.RUnlock()
 	return calls
 }
+
+// SaveRefreshToken calls SaveRefreshTokenFunc.
+func (mock *StoreMock) SaveRefreshToken(ctx context.Context, key string, sid entity.SessionID, userID entity.UserID, ttl time.Duration) error {
+	if mock.SaveRefreshTokenFunc == nil {
+		panic("StoreMock.SaveRefreshTokenFunc: method is nil but Store.SaveRefreshToken was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Key    string
+		Sid    entity.SessionID
+		UserID entity.UserID
+		TTL    time.Duration
+	}{
+		Ctx:    ctx,
+		Key:    key,
+		Sid:    sid,
+		UserID: userID,
+		TTL:    ttl,
+	}
+	mock.lockSaveRefreshToken.Lock()
+	mock.calls.SaveRefreshToken = append(mock.calls.SaveRefreshToken, callInfo)
+	mock.lockSaveRefreshToken.Unlock()
+	return mock.SaveRefreshTokenFunc(ctx, key, sid, userID, ttl)
+}
+
+// SaveRefreshTokenCalls gets all the calls that were made to SaveRefreshToken.
+// Check the length with:
+//
+//	len(mockedStore.SaveRefreshTokenCalls())
+func (mock *StoreMock) SaveRefreshTokenCalls() []struct {
+	Ctx    context.Context
+	Key    string
+	Sid    entity.SessionID
+	UserID entity.UserID
+	TTL    time.Duration
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Key    string
+		Sid    entity.SessionID
+		UserID entity.UserID
+		TTL    time.Duration
+	}
+	mock.lockSaveRefreshToken.RLock()
+	calls = mock.calls.SaveRefreshToken
+	mock.lockSaveRefreshToken.RUnlock()
+	return calls
+}
+
+// SaveSession calls SaveSessionFunc.
+func (mock *StoreMock) SaveSession(ctx context.Context, s *entity.Session, ttl time.Duration) error {
+	if mock.SaveSessionFunc == nil {
+		panic("StoreMock.SaveSessionFunc: method is nil but Store.SaveSession was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		S   *entity.Session
+		TTL time.Duration
+	}{
+		Ctx: ctx,
+		S:   s,
+		TTL: ttl,
+	}
+	mock.lockSaveSession.Lock()
+	mock.calls.SaveSession = append(mock.calls.SaveSession, callInfo)
+	mock.lockSaveSession.Unlock()
+	return mock.SaveSessionFunc(ctx, s, ttl)
+}
+
+// SaveSessionCalls gets all the calls that were made to SaveSession.
+// Check the length with:
+//
+//	len(mockedStore.SaveSessionCalls())
+func (mock *StoreMock) SaveSessionCalls() []struct {
+	Ctx context.Context
+	S   *entity.Session
+	TTL time.Duration
+} {
+	var calls []struct {
+		Ctx context.Context
+		S   *entity.Session
+		TTL time.Duration
+	}
+	mock.lockSaveSession.RLock()
+	calls = mock.calls.SaveSession
+	mock.lockSaveSession.RUnlock()
+	return calls
+}
+
+// UseRefreshToken calls UseRefreshTokenFunc.
+func (mock *StoreMock) UseRefreshToken(ctx context.Context, key string) (entity.SessionID, entity.UserID, bool, error) {
+	if mock.UseRefreshTokenFunc == nil {
+		panic("StoreMock.UseRefreshTokenFunc: method is nil but Store.UseRefreshToken was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Key string
+	}{
+		Ctx: ctx,
+		Key: key,
+	}
+	mock.lockUseRefreshToken.Lock()
+	mock.calls.UseRefreshToken = append(mock.calls.UseRefreshToken, callInfo)
+	mock.lockUseRefreshToken.Unlock()
+	return mock.UseRefreshTokenFunc(ctx, key)
+}
+
+// UseRefreshTokenCalls gets all the calls that were made to UseRefreshToken.
+// Check the length with:
+//
+//	len(mockedStore.UseRefreshTokenCalls())
+func (mock *StoreMock) UseRefreshTokenCalls() []struct {
+	Ctx context.Context
+	Key string
+} {
+	var calls []struct {
+		Ctx context.Context
+		Key string
+	}
+	mock.lockUseRefreshToken.RLock()
+	calls = mock.calls.UseRefreshToken
+	mock.lockUseRefreshToken.RUnlock()
+	return calls
+}
+
+// Ensure, that PersonalAccessTokenVerifierMock does implement PersonalAccessTokenVerifier.
+// If this is not the case, regenerate this file with moq.
+var _ PersonalAccessTokenVerifier = &PersonalAccessTokenVerifierMock{}
+
+// PersonalAccessTokenVerifierMock is a mock implementation of PersonalAccessTokenVerifier.
+//
+//	func TestSomethingThatUsesPersonalAccessTokenVerifier(t *testing.T) {
+//
+//		// make and configure a mocked PersonalAccessTokenVerifier
+//		mockedPersonalAccessTokenVerifier := &PersonalAccessTokenVerifierMock{
+//			VerifyPersonalAccessTokenFunc: func(ctx context.Context, token string) (*entity.PersonalAccessToken, *entity.User, error) {
+//				panic("mock out the VerifyPersonalAccessToken method")
+//			},
+//		}
+//
+//		// use mockedPersonalAccessTokenVerifier in code that requires PersonalAccessTokenVerifier
+//		// and then make assertions.
+//
+//	}
+type PersonalAccessTokenVerifierMock struct {
+	// VerifyPersonalAccessTokenFunc mocks the VerifyPersonalAccessToken method.
+	VerifyPersonalAccessTokenFunc func(ctx context.Context, token string) (*entity.PersonalAccessToken, *entity.User, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// VerifyPersonalAccessToken holds details about calls to the VerifyPersonalAccessToken method.
+		VerifyPersonalAccessToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Token is the token argument value.
+			Token string
+		}
+	}
+	lockVerifyPersonalAccessToken sync.RWMutex
+}
+
+// VerifyPersonalAccessToken calls VerifyPersonalAccessTokenFunc.
+func (mock *PersonalAccessTokenVerifierMock) VerifyPersonalAccessToken(ctx context.Context, token string) (*entity.PersonalAccessToken, *entity.User, error) {
+	if mock.VerifyPersonalAccessTokenFunc == nil {
+		panic("PersonalAccessTokenVerifierMock.VerifyPersonalAccessTokenFunc: method is nil but PersonalAccessTokenVerifier.VerifyPersonalAccessToken was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Token string
+	}{
+		Ctx:   ctx,
+		Token: token,
+	}
+	mock.lockVerifyPersonalAccessToken.Lock()
+	mock.calls.VerifyPersonalAccessToken = append(mock.calls.VerifyPersonalAccessToken, callInfo)
+	mock.lockVerifyPersonalAccessToken.Unlock()
+	return mock.VerifyPersonalAccessTokenFunc(ctx, token)
+}
+
+// VerifyPersonalAccessTokenCalls gets all the calls that were made to VerifyPersonalAccessToken.
+// Check the length with:
+//
+//	len(mockedPersonalAccessTokenVerifier.VerifyPersonalAccessTokenCalls())
+func (mock *PersonalAccessTokenVerifierMock) VerifyPersonalAccessTokenCalls() []struct {
+	Ctx   context.Context
+	Token string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Token string
+	}
+	mock.lockVerifyPersonalAccessToken.RLock()
+	calls = mock.calls.VerifyPersonalAccessToken
+	mock.lockVerifyPersonalAccessToken.RUnlock()
+	return calls
+}
@@ -0,0 +1,109 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/google/uuid"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+)
+
+// OAuthClientSecretPrefix는 OAuth 클라이언트 시크릿의 접두사이다. 유출된 시크릿을 검색하기 쉽게 한다.
+const OAuthClientSecretPrefix = "todo_cs_"
+
+// ErrScopedToken은 OAuth 클라이언트에 발급한 토큰을 범위를 확인하지 않는 경로에서 사용했을 때 반환된다.
+var ErrScopedToken = errors.New("token issued to an oauth client cannot be used for this api")
+
+// NewOAuthClientSecret 함수는 새 OAuth 클라이언트 시크릿과 저장소에 보관할 해시를 만든다.
+func NewOAuthClientSecret() (secret, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	secret = OAuthClientSecretPrefix + base64.RawURLEncoding.EncodeToString(b)
+	return secret, HashOAuthClientSecret(secret), nil
+}
+
+// HashOAuthClientSecret 함수는 저장소에 보관할 OAuth 클라이언트 시크릿의 해시를 만든다.
+func HashOAuthClientSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// GenerateOAuthToken 메서드는 사용자 u가 OAuth 클라이언트 cid에 허용한 범위 scopes의 액세스 토큰을 발급한다.
+// 로그인 세션에 속하지 않으므로 리프레시 토큰은 없으며, 만료되거나 RevokeToken으로 폐기할 때까지 사용할 수 있다.
+func (j *JWTer) GenerateOAuthToken(
+	ctx context.Context, u entity.User, cid entity.OAuthClientID, scopes entity.Scopes,
+) ([]byte, error) {
+	now := j.Clocker.Now()
+	tok, err := jwt.NewBuilder().
+		JwtID(uuid.New().String()).
+		Issuer(j.Issuer).
+		Audience([]string{j.Audience}).
+		Subject("access_token").
+		IssuedAt(now).
+		NotBefore(now).
+		Expiration(now.Add(j.AccessTokenTTL)).
+		Claim(RoleKey, u.Role).
+		Claim(UserNameKey, u.Name).
+		Claim(ScopeKey, scopes.Join()).
+		Claim(ClientIDKey, string(cid)).
+		Build()
+	if err != nil {
+		return nil, fmt.Errorf("GenerateOAuthToken: failed to build token: %w", err)
+	}
+	if err := j.Store.Save(ctx, tok.JwtID(), u.ID, j.AccessTokenTTL); err != nil {
+		return nil, err
+	}
+	ks := j.Keys()
+	signed, err := jwt.Sign(tok, jwt.WithKey(ks.SigningAlgorithm(), ks.signing))
+	if err != nil {
+		return nil, err
+	}
+	return signed, nil
+}
+
+// InspectToken 메서드는 액세스 토큰을 검증하고 토큰의 내용을 반환한다.
+// 로그인해서 발급한 토큰이면 ClientID가 비어 있고, Scopes가 nil이다.
+func (j *JWTer) InspectToken(ctx context.Context, raw string) (*entity.TokenClaims, error) {
+	token, err := j.ParseToken(ctx, raw)
+	if err != nil {
+		return nil, err
+	}
+	uid, err := j.Store.Load(ctx, token.JwtID())
+	if err != nil {
+		return nil, err
+	}
+	c := &entity.TokenClaims{
+		ID:       token.JwtID(),
+		UserID:   uid,
+		IssuedAt: token.IssuedAt(),
+		Expires:  token.Expiration(),
+	}
+	if v, ok := token.Get(UserNameKey); ok {
+		c.UserName, _ = v.(string)
+	}
+	if v, ok := token.Get(ClientIDKey); ok {
+		cid, _ := v.(string)
+		c.ClientID = entity.OAuthClientID(cid)
+	}
+	if v, ok := token.Get(ScopeKey); ok {
+		s, _ := v.(string)
+		c.Scopes = entity.ParseScopes(s)
+	}
+	return c, nil
+}
+
+// RevokeToken 메서드는 JTI가 jti인 액세스 토큰을 폐기한다. 이미 만료된 토큰이어도 에러를 반환하지 않는다.
+func (j *JWTer) RevokeToken(ctx context.Context, jti string) error {
+	if err := j.Store.Delete(ctx, jti); err != nil {
+		return fmt.Errorf("failed to revoke access token: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/clock"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/gitwub5/go_todo_app/testutil/fixture"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestJWTer_GenerateOAuthToken(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var saved string
+	revoked := false
+	moq := &StoreMock{
+		SaveFunc: func(ctx context.Context, key string, userID entity.UserID, ttl time.Duration) error {
+			saved = key
+			return nil
+		},
+		LoadFunc: func(ctx context.Context, key string) (entity.UserID, error) {
+			if revoked {
+				return 0, store.ErrNotFound
+			}
+			return 20, nil
+		},
+		DeleteFunc: func(ctx context.Context, key string) error {
+			revoked = true
+			return nil
+		},
+	}
+	c := clock.FixedClocker{}
+	j, err := NewJWTer(moq, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u := fixture.User(&entity.User{ID: 20, Name: "alice", Role: "user"})
+	tok, err := j.GenerateOAuthToken(ctx, *u, "client", entity.Scopes{entity.ScopeTasksRead, entity.ScopeTasksWrite})
+	if err != nil {
+		t.Fatalf("want no error, but got %v", err)
+	}
+
+	got, err := j.InspectToken(ctx, string(tok))
+	if err != nil {
+		t.Fatalf("want no error, but got %v", err)
+	}
+	want := &entity.TokenClaims{
+		ID:       saved,
+		UserID:   20,
+		UserName: "alice",
+		ClientID: "client",
+		Scopes:   entity.Scopes{entity.ScopeTasksRead, entity.ScopeTasksWrite},
+		IssuedAt: c.Now(),
+		Expires:  c.Now().Add(DefaultAccessTokenTTL),
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("differs: (-got +want)\n%s", diff)
+	}
+
+	// 범위를 확인하지 않는 경로에서는 사용할 수 없다.
+	r := httptest.NewRequest(http.MethodGet, "/tokens", nil)
+	r.Header.Set("Authorization", "Bearer "+string(tok))
+	if _, err := j.FillContext(r); !errors.Is(err, ErrScopedToken) {
+		t.Errorf("want ErrScopedToken from FillContext, but got %v", err)
+	}
+	if _, err := j.Authenticate(ctx, string(tok)); !errors.Is(err, ErrScopedToken) {
+		t.Errorf("want ErrScopedToken from Authenticate, but got %v", err)
+	}
+
+	if err := j.RevokeToken(ctx, saved); err != nil {
+		t.Fatalf("want no error, but got %v", err)
+	}
+	if _, err := j.InspectToken(ctx, string(tok)); err == nil {
+		t.Error("want error for revoked token")
+	}
+}
@@ -0,0 +1,91 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gitwub5/go_todo_app/entity"
+)
+
+// PersonalAccessTokenPrefix는 개인 액세스 토큰의 접두사이다. JWT와 구별하고, 유출된 토큰을 검색하기 쉽게 한다.
+const PersonalAccessTokenPrefix = "todo_pat_"
+
+// ErrInvalidPersonalAccessToken은 개인 액세스 토큰이 없거나 만료되었거나 폐기되었을 때 반환된다.
+var ErrInvalidPersonalAccessToken = errors.New("invalid or expired personal access token")
+
+// NewPersonalAccessToken 함수는 새 개인 액세스 토큰과 저장소에 보관할 해시를 만든다.
+func NewPersonalAccessToken() (token, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	token = PersonalAccessTokenPrefix + base64.RawURLEncoding.EncodeToString(b)
+	return token, HashPersonalAccessToken(token), nil
+}
+
+// HashPersonalAccessToken 함수는 저장소에 보관할 개인 액세스 토큰의 해시를 만든다.
+// 토큰은 충분히 긴 난수이므로 비밀번호처럼 느린 해시를 사용하지 않아도 된다.
+func HashPersonalAccessToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// PersonalAccessTokenVerifier는 개인 액세스 토큰을 검증하고, 토큰과 토큰의 소유자를 반환한다.
+// 토큰을 사용할 수 없으면 ErrInvalidPersonalAccessToken을 반환한다. service.PersonalAccessTokens가 구현한다.
+type PersonalAccessTokenVerifier interface {
+	VerifyPersonalAccessToken(ctx context.Context, token string) (*entity.PersonalAccessToken, *entity.User, error)
+}
+
+// Authenticator 구조체는 Authorization 헤더의 JWT 또는 개인 액세스 토큰을 검증한다.
+// JWTer.FillContext와 달리 OAuth 클라이언트에 발급한 JWT도 받는다.
+type Authenticator struct {
+	JWT                  *JWTer
+	PersonalAccessTokens PersonalAccessTokenVerifier
+}
+
+// FillContext 메서드는 요청의 토큰을 검증하고, context에 사용자 ID와 권한을 설정한다.
+// 개인 액세스 토큰이나 OAuth 클라이언트에 발급한 JWT이면 토큰에 허용한 범위도 설정한다.
+func (a *Authenticator) FillContext(r *http.Request) (*http.Request, error) {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || !strings.HasPrefix(token, PersonalAccessTokenPrefix) {
+		return a.JWT.fillContext(r)
+	}
+	t, u, err := a.PersonalAccessTokens.VerifyPersonalAccessToken(r.Context(), token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify personal access token: %w", err)
+	}
+	ctx := SetUserID(r.Context(), u.ID)
+	ctx = context.WithValue(ctx, roleKey{}, u.Role)
+	ctx = SetScopes(ctx, t.Scopes)
+	return r.Clone(ctx), nil
+}
+
+type scopesKey struct{} // context에 개인 액세스 토큰이나 OAuth 토큰의 범위를 저장하기 위한 키
+
+// SetScopes 함수는 context에 개인 액세스 토큰이나 OAuth 토큰에 허용한 범위를 설정
+func SetScopes(ctx context.Context, ss entity.Scopes) context.Context {
+	return context.WithValue(ctx, scopesKey{}, ss)
+}
+
+// GetScopes 함수는 context에서 개인 액세스 토큰이나 OAuth 토큰에 허용한 범위를 가져옴
+// 로그인해서 발급한 JWT로 인증한 요청이면 범위의 제한이 없으므로 false를 반환한다.
+func GetScopes(ctx context.Context) (entity.Scopes, bool) {
+	ss, ok := ctx.Value(scopesKey{}).(entity.Scopes)
+	return ss, ok
+}
+
+// HasScope 함수는 인증한 토큰으로 범위 s의 API를 호출할 수 있는지 확인
+func HasScope(ctx context.Context, s entity.Scope) bool {
+	ss, ok := GetScopes(ctx)
+	if !ok {
+		return true
+	}
+	return ss.Has(s)
+}
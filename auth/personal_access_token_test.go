@@ -0,0 +1,103 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/clock"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/testutil/fixture"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAuthenticator_FillContext(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := &StoreMock{
+		SaveFunc: func(ctx context.Context, key string, userID entity.UserID, ttl time.Duration) error {
+			return nil
+		},
+		AddSessionTokenFunc: func(ctx context.Context, id entity.SessionID, jti string, ttl time.Duration) error {
+			return nil
+		},
+		LoadFunc: func(ctx context.Context, key string) (entity.UserID, error) {
+			return 20, nil
+		},
+	}
+	j, err := NewJWTer(store, clock.RealClocker{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwt, err := j.GenerateToken(ctx, *fixture.User(&entity.User{ID: 20, Role: "admin"}), "session")
+	if err != nil {
+		t.Fatal(err)
+	}
+	oauth, err := j.GenerateOAuthToken(ctx, *fixture.User(&entity.User{ID: 20, Role: "admin"}), "client", entity.Scopes{entity.ScopeTasksRead})
+	if err != nil {
+		t.Fatal(err)
+	}
+	pats := &PersonalAccessTokenVerifierMock{
+		VerifyPersonalAccessTokenFunc: func(
+			ctx context.Context, token string,
+		) (*entity.PersonalAccessToken, *entity.User, error) {
+			if token != "todo_pat_valid" {
+				return nil, nil, ErrInvalidPersonalAccessToken
+			}
+			return &entity.PersonalAccessToken{ID: 1, UserID: 10, Scopes: entity.Scopes{entity.ScopeTasksRead}},
+				&entity.User{ID: 10, Role: "user"}, nil
+		},
+	}
+	sut := &Authenticator{JWT: j, PersonalAccessTokens: pats}
+
+	tests := map[string]struct {
+		token      string
+		wantErr    bool
+		wantUserID entity.UserID
+		wantRole   string
+		wantScopes entity.Scopes // nil이면 범위의 제한이 없다.
+	}{
+		"jwt":     {token: string(jwt), wantUserID: 20, wantRole: "admin"},
+		"oauth":   {token: string(oauth), wantUserID: 20, wantRole: "admin", wantScopes: entity.Scopes{entity.ScopeTasksRead}},
+		"pat":     {token: "todo_pat_valid", wantUserID: 10, wantRole: "user", wantScopes: entity.Scopes{entity.ScopeTasksRead}},
+		"revoked": {token: "todo_pat_revoked", wantErr: true},
+		"invalid": {token: "garbage", wantErr: true},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			r := httptest.NewRequest(http.MethodGet, "/tasks", nil)
+			r.Header.Set("Authorization", "Bearer "+tt.token)
+			got, err := sut.FillContext(r)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("want error %t, but got %v", tt.wantErr, err)
+			}
+			if tt.wantErr {
+				return
+			}
+			ctx := got.Context()
+			if id, _ := GetUserID(ctx); id != tt.wantUserID {
+				t.Errorf("want user ID %d, but got %d", tt.wantUserID, id)
+			}
+			if role, _ := GetRole(ctx); role != tt.wantRole {
+				t.Errorf("want role %q, but got %q", tt.wantRole, role)
+			}
+			scopes, ok := GetScopes(ctx)
+			if ok != (tt.wantScopes != nil) {
+				t.Fatalf("want scopes %v, but got %v (%t)", tt.wantScopes, scopes, ok)
+			}
+			if diff := cmp.Diff(scopes, tt.wantScopes); diff != "" {
+				t.Errorf("scopes differs: (-got +want)\n%s", diff)
+			}
+			// 로그인해서 발급한 JWT는 모든 범위를 허용하고, 개인 액세스 토큰과 OAuth 토큰은 허용한 범위만 허용한다.
+			if got, want := HasScope(ctx, entity.ScopeTasksWrite), tt.wantScopes == nil; got != want {
+				t.Errorf("want HasScope(tasks:write) %t, but got %t", want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+)
+
+var (
+	// ErrInvalidRefreshToken은 리프레시 토큰이 없거나 만료되었거나 폐기되었을 때 반환된다.
+	ErrInvalidRefreshToken = errors.New("invalid or expired refresh token")
+	// ErrRefreshTokenReused는 이미 교체한 리프레시 토큰을 다시 사용했을 때 반환된다.
+	ErrRefreshTokenReused = errors.New("refresh token was already used; all refresh tokens of this login were revoked")
+)
+
+// RotateRefreshToken 메서드는 리프레시 토큰을 사용해 같은 세션의 새 리프레시 토큰을 발급하고, 토큰의 사용자 ID와 세션 ID를 반환한다.
+// 리프레시 토큰은 한 번만 사용할 수 있다. 이미 교체한 토큰을 다시 사용하면 토큰이 탈취되었을 수 있으므로
+// 세션을 삭제해 그 세션의 토큰을 모두 폐기하고 ErrRefreshTokenReused를 반환한다.
+func (j *JWTer) RotateRefreshToken(ctx context.Context, token string) (entity.UserID, entity.SessionID, string, error) {
+	sid, uid, used, err := j.Store.UseRefreshToken(ctx, hashRefreshToken(token))
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return 0, "", "", ErrInvalidRefreshToken
+		}
+		return 0, "", "", fmt.Errorf("failed to use refresh token: %w", err)
+	}
+	if used {
+		// 같은 토큰을 동시에 다시 사용한 요청이 이미 세션을 삭제했을 수 있다.
+		if err := j.Store.DeleteSession(ctx, sid); err != nil && !errors.Is(err, store.ErrNotFound) {
+			return 0, "", "", fmt.Errorf("failed to revoke session: %w", err)
+		}
+		return 0, "", "", ErrRefreshTokenReused
+	}
+	next, err := j.saveRefreshToken(ctx, sid, uid)
+	if err != nil {
+		return 0, "", "", err
+	}
+	return uid, sid, next, nil
+}
+
+func (j *JWTer) saveRefreshToken(ctx context.Context, sid entity.SessionID, uid entity.UserID) (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+	if err := j.Store.SaveRefreshToken(ctx, hashRefreshToken(token), sid, uid, j.RefreshTokenTTL); err != nil {
+		return "", fmt.Errorf("failed to save refresh token: %w", err)
+	}
+	return token, nil
+}
+
+// hashRefreshToken 함수는 저장소에 보관할 리프레시 토큰의 해시를 만든다.
+// 저장소의 내용이 유출되어도 리프레시 토큰으로 사용할 수 없도록 토큰 자체는 저장하지 않는다.
+func hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
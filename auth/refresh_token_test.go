@@ -0,0 +1,105 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/clock"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/gitwub5/go_todo_app/testutil"
+)
+
+func TestJWTer_RotateRefreshToken(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sut, err := NewJWTer(&store.KVS{Cli: testutil.OpenRedisForTest(t)}, clock.RealClocker{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sut.RefreshTokenTTL = time.Minute
+	uid := entity.UserID(time.Now().UnixNano())
+
+	sid, first, err := sut.StartSession(ctx, uid, entity.Client{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	gotID, gotSID, second, err := sut.RotateRefreshToken(ctx, first)
+	if err != nil {
+		t.Fatalf("want no error, but got %v", err)
+	}
+	if gotID != uid || gotSID != sid || second == "" || second == first {
+		t.Fatalf("want new token for user %d in session %s, but got %q for user %d in session %s",
+			uid, sid, second, gotID, gotSID)
+	}
+	_, _, third, err := sut.RotateRefreshToken(ctx, second)
+	if err != nil {
+		t.Fatalf("want no error, but got %v", err)
+	}
+
+	// 교체한 토큰을 다시 사용하면 같은 세션의 최신 토큰도 사용할 수 없다.
+	if _, _, _, err := sut.RotateRefreshToken(ctx, first); !errors.Is(err, ErrRefreshTokenReused) {
+		t.Errorf("want ErrRefreshTokenReused, but got %v", err)
+	}
+	if _, _, _, err := sut.RotateRefreshToken(ctx, third); !errors.Is(err, ErrInvalidRefreshToken) {
+		t.Errorf("want ErrInvalidRefreshToken after reuse, but got %v", err)
+	}
+	if _, _, _, err := sut.RotateRefreshToken(ctx, "unknown"); !errors.Is(err, ErrInvalidRefreshToken) {
+		t.Errorf("want ErrInvalidRefreshToken, but got %v", err)
+	}
+
+	// 다른 로그인(세션)의 토큰은 영향을 받지 않는다.
+	_, other, err := sut.StartSession(ctx, uid, entity.Client{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, _, err := sut.RotateRefreshToken(ctx, other); err != nil {
+		t.Errorf("want no error, but got %v", err)
+	}
+}
+
+func TestJWTer_RotateRefreshToken_concurrent(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sut, err := NewJWTer(&store.KVS{Cli: testutil.OpenRedisForTest(t)}, clock.RealClocker{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, token, err := sut.StartSession(ctx, entity.UserID(time.Now().UnixNano()), entity.Client{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 같은 토큰으로 동시에 교체하면 한 요청만 성공할 수 있고, 나머지 요청은 다시 사용한 것으로 보고 세션을 폐기한다.
+	// 세션을 폐기한 뒤에는 성공한 요청이 받은 새 토큰도 사용할 수 없다.
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		next []string
+	)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, _, n, err := sut.RotateRefreshToken(ctx, token); err == nil {
+				mu.Lock()
+				next = append(next, n)
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	if len(next) > 1 {
+		t.Fatalf("want at most 1 rotation to succeed, but got %d", len(next))
+	}
+	for _, n := range next {
+		if _, _, _, err := sut.RotateRefreshToken(ctx, n); !errors.Is(err, ErrInvalidRefreshToken) {
+			t.Errorf("want ErrInvalidRefreshToken after reuse, but got %v", err)
+		}
+	}
+}
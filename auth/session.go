@@ -0,0 +1,77 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/google/uuid"
+)
+
+// ErrNoSession은 context에 액세스 토큰 정보가 없을 때 반환된다.
+var ErrNoSession = errors.New("no access token in context")
+
+// StartSession 메서드는 로그인한 사용자의 새 세션을 시작하고, 세션 ID와 세션의 첫 리프레시 토큰을 반환한다.
+func (j *JWTer) StartSession(
+	ctx context.Context, uid entity.UserID, c entity.Client,
+) (entity.SessionID, string, error) {
+	s := &entity.Session{
+		ID:       entity.SessionID(uuid.New().String()),
+		UserID:   uid,
+		Client:   c,
+		IssuedAt: j.Clocker.Now(),
+	}
+	if err := j.Store.SaveSession(ctx, s, j.RefreshTokenTTL); err != nil {
+		return "", "", fmt.Errorf("failed to save session: %w", err)
+	}
+	refresh, err := j.saveRefreshToken(ctx, s.ID, uid)
+	if err != nil {
+		return "", "", err
+	}
+	return s.ID, refresh, nil
+}
+
+// Logout 메서드는 요청에 사용한 액세스 토큰을 폐기하고, 토큰의 세션을 삭제해 세션의 리프레시 토큰도 폐기한다.
+// ctx는 FillContext로 토큰 정보를 설정한 context이다.
+func (j *JWTer) Logout(ctx context.Context) error {
+	jti, ok := GetTokenID(ctx)
+	if !ok {
+		return ErrNoSession
+	}
+	if err := j.Store.Delete(ctx, jti); err != nil {
+		return fmt.Errorf("failed to revoke access token: %w", err)
+	}
+	// 세션 ID가 없는 토큰은 토큰만 폐기한다.
+	sid, ok := GetSessionID(ctx)
+	if !ok {
+		return nil
+	}
+	if err := j.Store.DeleteSession(ctx, sid); err != nil && !errors.Is(err, store.ErrNotFound) {
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+	return nil
+}
+
+// SetTokenID 함수는 context에 액세스 토큰의 JTI를 설정
+func SetTokenID(ctx context.Context, jti string) context.Context {
+	return context.WithValue(ctx, tokenIDKey{}, jti)
+}
+
+// GetTokenID 함수는 context에서 액세스 토큰의 JTI를 가져옴
+func GetTokenID(ctx context.Context) (string, bool) {
+	jti, ok := ctx.Value(tokenIDKey{}).(string)
+	return jti, ok && jti != ""
+}
+
+// SetSessionID 함수는 context에 세션 ID를 설정
+func SetSessionID(ctx context.Context, sid entity.SessionID) context.Context {
+	return context.WithValue(ctx, sessionIDKey{}, sid)
+}
+
+// GetSessionID 함수는 context에서 세션 ID를 가져옴
+func GetSessionID(ctx context.Context) (entity.SessionID, bool) {
+	sid, ok := ctx.Value(sessionIDKey{}).(entity.SessionID)
+	return sid, ok && sid != ""
+}
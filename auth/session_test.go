@@ -0,0 +1,83 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/clock"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/gitwub5/go_todo_app/testutil"
+)
+
+func TestJWTer_Logout(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	kvs := &store.KVS{Cli: testutil.OpenRedisForTest(t)}
+	sut, err := NewJWTer(kvs, clock.RealClocker{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sut.RefreshTokenTTL = time.Minute
+	u := entity.User{ID: entity.UserID(time.Now().UnixNano()), Name: "john", Role: "user"}
+
+	// login 함수는 새 세션을 시작하고, 액세스 토큰으로 인증한 요청을 반환한다.
+	login := func(t *testing.T) (*http.Request, string) {
+		t.Helper()
+		sid, refresh, err := sut.StartSession(ctx, u.ID, entity.Client{Device: "test", IP: "192.0.2.1"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		access, err := sut.GenerateToken(ctx, u, sid)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r := httptest.NewRequest(http.MethodPost, "/logout", nil)
+		r.Header.Set("Authorization", fmt.Sprintf("Bearer %s", access))
+		r, err = sut.FillContext(r)
+		if err != nil {
+			t.Fatalf("want no error, but got %v", err)
+		}
+		if got, _ := GetSessionID(r.Context()); got != sid {
+			t.Errorf("want session %s in context, but got %s", sid, got)
+		}
+		return r, refresh
+	}
+	mine, refresh := login(t)
+	other, otherRefresh := login(t)
+
+	if err := sut.Logout(mine.Context()); err != nil {
+		t.Fatalf("want no error, but got %v", err)
+	}
+	// 로그아웃한 세션의 액세스 토큰과 리프레시 토큰은 사용할 수 없다.
+	if _, err := sut.FillContext(mine); err == nil {
+		t.Error("want error for logged out access token")
+	}
+	if _, _, _, err := sut.RotateRefreshToken(ctx, refresh); !errors.Is(err, ErrInvalidRefreshToken) {
+		t.Errorf("want ErrInvalidRefreshToken, but got %v", err)
+	}
+	// 다른 세션은 영향을 받지 않는다.
+	if _, err := sut.FillContext(other); err != nil {
+		t.Errorf("want no error, but got %v", err)
+	}
+	if _, _, _, err := sut.RotateRefreshToken(ctx, otherRefresh); err != nil {
+		t.Errorf("want no error, but got %v", err)
+	}
+	ss, err := kvs.ListSessions(ctx, u.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ss) != 1 {
+		t.Errorf("want 1 session left, but got %d", len(ss))
+	}
+
+	if err := sut.Logout(ctx); !errors.Is(err, ErrNoSession) {
+		t.Errorf("want ErrNoSession, but got %v", err)
+	}
+}
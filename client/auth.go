@@ -0,0 +1,98 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gitwub5/go_todo_app/entity"
+)
+
+// RegisterRequest는 사용자를 등록할 때의 요청이다.
+type RegisterRequest struct {
+	Name     string `json:"name"`
+	Password string `json:"password"`
+	Role     string `json:"role"`
+	// Email은 메일 알림을 받을 주소이다. (선택)
+	Email *string `json:"email,omitempty"`
+}
+
+// Register 메서드는 사용자를 등록하고 ID를 반환한다. (POST /register)
+func (c *Client) Register(ctx context.Context, req RegisterRequest) (entity.UserID, error) {
+	var out struct {
+		ID entity.UserID `json:"id"`
+	}
+	if err := c.do(ctx, &request{method: http.MethodPost, path: "/register", in: req}, &out); err != nil {
+		return 0, err
+	}
+	return out.ID, nil
+}
+
+// Login 메서드는 사용자 이름과 비밀번호로 액세스 토큰과 리프레시 토큰을 발급받아 설정하고, 액세스 토큰을 반환한다. (POST /login)
+func (c *Client) Login(ctx context.Context, userName, password string) (string, error) {
+	in := struct {
+		UserName string `json:"user_name"`
+		Password string `json:"password"`
+	}{UserName: userName, Password: password}
+	var out entity.Tokens
+	// 로그인은 여러 번 보내도 토큰을 새로 발급받을 뿐이므로 다시 보낼 수 있다.
+	req := &request{method: http.MethodPost, path: "/login", in: in, idempotent: true}
+	if err := c.do(ctx, req, &out); err != nil {
+		return "", err
+	}
+	c.setTokens(out)
+	return out.AccessToken, nil
+}
+
+// Refresh 메서드는 리프레시 토큰으로 액세스 토큰과 리프레시 토큰을 새로 발급받아 설정한다. (POST /token/refresh)
+// 사용한 리프레시 토큰은 서버에서 폐기되며, 다시 보내면 같은 로그인의 리프레시 토큰이 모두 폐기되므로 재시도하지 않는다.
+func (c *Client) Refresh(ctx context.Context) error {
+	in := struct {
+		RefreshToken string `json:"refresh_token"`
+	}{RefreshToken: c.RefreshToken()}
+	if in.RefreshToken == "" {
+		return errors.New("no refresh token")
+	}
+	var out entity.Tokens
+	if err := c.do(ctx, &request{method: http.MethodPost, path: "/token/refresh", in: in}, &out); err != nil {
+		return err
+	}
+	c.setTokens(out)
+	return nil
+}
+
+// Logout 메서드는 액세스 토큰과 같은 세션의 리프레시 토큰을 폐기하고, 설정한 토큰을 지운다. (POST /logout)
+// 토큰이 이미 만료되어 로그아웃할 수 없어도 설정한 토큰은 지운다.
+func (c *Client) Logout(ctx context.Context) error {
+	req := &request{method: http.MethodPost, path: "/logout", auth: true}
+	err := c.do(ctx, req, nil)
+	c.mu.Lock()
+	c.token, c.refreshToken = "", ""
+	c.mu.Unlock()
+	return err
+}
+
+// reauth 메서드는 stale 토큰이 거부되었을 때 리프레시 토큰으로 토큰을 갱신한다.
+// 갱신할 수 없으면 UserName, Password로 다시 로그인한다.
+// 다른 요청이 이미 토큰을 갱신했다면 아무것도 하지 않는다.
+func (c *Client) reauth(ctx context.Context, stale string) error {
+	c.loginMu.Lock()
+	defer c.loginMu.Unlock()
+	if c.Token() != stale {
+		return nil
+	}
+	var err error
+	if c.RefreshToken() != "" {
+		if err = c.Refresh(ctx); err == nil {
+			return nil
+		}
+		if c.UserName == "" || isContextError(err) {
+			return fmt.Errorf("failed to refresh token: %w", err)
+		}
+	}
+	if _, err := c.Login(ctx, c.UserName, c.Password); err != nil {
+		return fmt.Errorf("failed to log in again: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,279 @@
+// Package client는 todo API를 호출하는 Go 클라이언트이다.
+//
+// Client는 요청의 context를 그대로 전달하므로, context가 취소되면 진행 중인 요청과 재시도 대기를 멈춘다.
+// 에러 응답은 *Error로 반환하며, errors.As로 서버가 보낸 *handler.ErrResponse를 꺼낼 수 있다.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/handler"
+)
+
+// APIVersion은 Client가 호출하는 API의 버전 접두사이다.
+const APIVersion = "/v1"
+
+// Client는 todo API를 호출한다. 필드를 설정한 뒤에는 여러 고루틴에서 함께 사용할 수 있다.
+type Client struct {
+	// BaseURL은 API 서버의 주소이다. (예: http://localhost:18000)
+	BaseURL string
+	// HTTPClient가 nil이면 http.DefaultClient를 사용한다.
+	HTTPClient *http.Client
+	// 토큰이 없거나 만료되어 401 에러를 받으면 리프레시 토큰으로 토큰을 갱신하고 요청을 한 번 더 보낸다.
+	// 리프레시 토큰이 없거나 갱신할 수 없을 때 UserName, Password가 설정되어 있으면 다시 로그인한다.
+	UserName string
+	Password string
+	// Retry가 nil이면 DefaultRetryPolicy를 사용한다.
+	Retry *RetryPolicy
+	// OnTokens가 nil이 아니면 로그인하거나 토큰을 갱신할 때마다 새 토큰으로 호출한다.
+	// 사용한 리프레시 토큰은 폐기되므로, 토큰을 저장해 두는 프로그램은 여기서 다시 저장해야 한다.
+	OnTokens func(entity.Tokens)
+
+	mu           sync.Mutex // token, refreshToken을 보호한다.
+	token        string
+	refreshToken string
+	// loginMu는 여러 요청이 동시에 401 에러를 받았을 때 한 번만 다시 로그인하도록 한다.
+	loginMu sync.Mutex
+}
+
+// RetryPolicy는 5xx 응답이나 네트워크 에러를 받았을 때 요청을 다시 보내는 규칙이다.
+// 여러 번 보내도 결과가 같은 요청(POST를 제외한 메서드와 로그인)만 다시 보낸다.
+type RetryPolicy struct {
+	// MaxRetries는 처음 요청을 제외하고 다시 보내는 최대 횟수이다. 0이면 다시 보내지 않는다.
+	MaxRetries int
+	// n번째 재시도 전에는 MinWait * 2^(n-1)과 MaxWait 중 작은 값까지의 임의의 시간(full jitter)을 기다린다.
+	// 응답에 Retry-After 헤더가 있으면 그 시간(최대 MaxWait)을 기다린다.
+	MinWait time.Duration
+	MaxWait time.Duration
+}
+
+// DefaultRetryPolicy는 Client.Retry가 nil일 때의 재시도 규칙이다.
+var DefaultRetryPolicy = RetryPolicy{MaxRetries: 3, MinWait: 100 * time.Millisecond, MaxWait: 2 * time.Second}
+
+// Error는 API가 에러 응답을 반환했을 때의 에러이다.
+type Error struct {
+	StatusCode int
+	// Response는 서버가 보낸 에러 응답이다. JSON이 아닌 응답은 바디를 Message에 담는다.
+	Response *handler.ErrResponse
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%d %s: %s", e.StatusCode, http.StatusText(e.StatusCode), e.Response.Error())
+}
+
+// Unwrap 메서드는 errors.As로 *handler.ErrResponse를 꺼낼 수 있도록 한다.
+func (e *Error) Unwrap() error {
+	return e.Response
+}
+
+// Token 메서드는 요청에 포함하는 액세스 토큰을 반환한다.
+func (c *Client) Token() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.token
+}
+
+// SetToken 메서드는 요청에 포함할 액세스 토큰을 설정한다. 이미 발급받은 토큰을 사용할 때 호출한다.
+func (c *Client) SetToken(token string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.token = token
+}
+
+// RefreshToken 메서드는 토큰을 갱신할 때 사용하는 리프레시 토큰을 반환한다.
+func (c *Client) RefreshToken() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.refreshToken
+}
+
+// SetRefreshToken 메서드는 토큰을 갱신할 때 사용할 리프레시 토큰을 설정한다.
+func (c *Client) SetRefreshToken(token string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.refreshToken = token
+}
+
+// setTokens 메서드는 새로 발급받은 토큰을 설정하고 OnTokens를 호출한다.
+func (c *Client) setTokens(t entity.Tokens) {
+	c.mu.Lock()
+	c.token, c.refreshToken = t.AccessToken, t.RefreshToken
+	c.mu.Unlock()
+	if c.OnTokens != nil {
+		c.OnTokens(t)
+	}
+}
+
+// request는 Client가 보내는 요청 하나이다.
+type request struct {
+	method string
+	path   string
+	query  url.Values
+	in     any
+	// auth가 true이면 액세스 토큰을 포함하고, 401 에러를 받으면 토큰을 갱신한다.
+	auth bool
+	// idempotent가 true이면 5xx 응답이나 네트워크 에러를 받았을 때 다시 보낸다.
+	idempotent bool
+}
+
+// call 메서드는 path에 토큰을 포함한 요청을 보내고, 성공하면 응답을 out으로 디코딩한다.
+func (c *Client) call(ctx context.Context, method, path string, query url.Values, in, out any) error {
+	return c.do(ctx, &request{
+		method: method, path: path, query: query, in: in,
+		auth: true, idempotent: method != http.MethodPost,
+	}, out)
+}
+
+// do 메서드는 req를 보내고, 성공하면 응답을 out으로 디코딩한다. 에러 응답은 *Error로 반환한다.
+func (c *Client) do(ctx context.Context, req *request, out any) error {
+	var body []byte
+	if req.in != nil {
+		b, err := json.Marshal(req.in)
+		if err != nil {
+			return err
+		}
+		body = b
+	}
+	policy := DefaultRetryPolicy
+	if c.Retry != nil {
+		policy = *c.Retry
+	}
+
+	reauth := req.auth && (c.UserName != "" || c.RefreshToken() != "")
+	for attempt := 0; ; attempt++ {
+		token := ""
+		if req.auth {
+			token = c.Token()
+		}
+		status, header, b, err := c.send(ctx, req, body, token)
+		if retryable(status, err) && req.idempotent && attempt < policy.MaxRetries {
+			if err := policy.wait(ctx, attempt, header); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if status == http.StatusUnauthorized && reauth {
+			// 토큰을 갱신한 뒤에는 한 번만 더 보낸다.
+			reauth = false
+			if err := c.reauth(ctx, token); err != nil {
+				return err
+			}
+			attempt--
+			continue
+		}
+		if status >= http.StatusBadRequest {
+			return newError(status, b)
+		}
+		if out == nil {
+			return nil
+		}
+		if err := json.Unmarshal(b, out); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+		return nil
+	}
+}
+
+// send 메서드는 요청을 한 번 보내고 응답의 상태 코드, 헤더, 바디를 반환한다.
+func (c *Client) send(
+	ctx context.Context, req *request, body []byte, token string,
+) (int, http.Header, []byte, error) {
+	u := strings.TrimSuffix(c.BaseURL, "/") + APIVersion + req.path
+	if len(req.query) > 0 {
+		u += "?" + req.query.Encode()
+	}
+	var r io.Reader
+	if body != nil {
+		r = bytes.NewReader(body)
+	}
+	hr, err := http.NewRequestWithContext(ctx, req.method, u, r)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	hr.Header.Set("Accept", "application/json")
+	if body != nil {
+		hr.Header.Set("Content-Type", "application/json")
+	}
+	if token != "" {
+		hr.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	hc := c.HTTPClient
+	if hc == nil {
+		hc = http.DefaultClient
+	}
+	rsp, err := hc.Do(hr)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	defer rsp.Body.Close()
+	b, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return 0, nil, nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	return rsp.StatusCode, rsp.Header, b, nil
+}
+
+func newError(status int, b []byte) *Error {
+	var er handler.ErrResponse
+	if err := json.Unmarshal(b, &er); err != nil || er.Message == "" {
+		er = handler.ErrResponse{Message: strings.TrimSpace(string(b))}
+	}
+	return &Error{StatusCode: status, Response: &er}
+}
+
+// retryable 함수는 다시 보내면 성공할 수 있는 응답인지 확인한다.
+// context가 취소되거나 시간이 지나서 실패한 요청은 다시 보내지 않는다.
+func retryable(status int, err error) bool {
+	if err != nil {
+		return !isContextError(err)
+	}
+	return status >= http.StatusInternalServerError
+}
+
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// wait 메서드는 attempt번째 요청이 실패한 뒤 다시 보내기 전까지 기다린다.
+func (p RetryPolicy) wait(ctx context.Context, attempt int, header http.Header) error {
+	d := p.backoff(attempt)
+	if s, err := strconv.Atoi(header.Get("Retry-After")); err == nil && s >= 0 {
+		d = min(time.Duration(s)*time.Second, p.MaxWait)
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	ceil := p.MinWait << attempt
+	if ceil <= 0 || ceil > p.MaxWait { // 시프트가 넘치면 음수가 된다.
+		ceil = p.MaxWait
+	}
+	if ceil <= 0 {
+		return 0
+	}
+	return rand.N(ceil + 1)
+}
@@ -0,0 +1,283 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/client/clienttest"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/handler"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestClient(t *testing.T) {
+	t.Parallel()
+
+	srv := clienttest.NewServer(t)
+	ctx := context.Background()
+	sut := &Client{BaseURL: srv.URL}
+
+	// 로그인하기 전에는 인증 에러를 반환한다.
+	_, err := sut.ListTasks(ctx, ListTasksOptions{})
+	var e *Error
+	if !errors.As(err, &e) || e.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("want 401 error, but got %v", err)
+	}
+
+	uid, err := sut.Register(ctx, RegisterRequest{Name: "jane", Password: "pass", Role: "user"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uid == 0 {
+		t.Errorf("want registered user ID, but got %d", uid)
+	}
+	token, err := sut.Login(ctx, "jane", "pass")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token == "" || sut.Token() != token {
+		t.Errorf("want token %q to be set, but got %q", token, sut.Token())
+	}
+
+	parent, err := sut.AddTask(ctx, AddTaskRequest{Title: "release"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	child, err := sut.AddTask(ctx, AddTaskRequest{Title: "write notes", ParentID: &parent.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := entity.TaskStatusDone
+	updated, err := sut.UpdateTask(ctx, child.ID, UpdateTaskRequest{Status: &done})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Status != done {
+		t.Errorf("want status %q, but got %q", done, updated.Status)
+	}
+
+	got, err := sut.ListTasks(ctx, ListTasksOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Task{
+		{ID: parent.ID, Title: "release", Status: entity.TaskStatusTodo},
+		{ID: child.ID, ParentID: &parent.ID, Title: "write notes", Status: entity.TaskStatusDone},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("ListTasks differs: (-got +want)\n%s", diff)
+	}
+
+	deleted, err := sut.DeleteTask(ctx, parent.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(deleted, []entity.TaskID{child.ID, parent.ID}); diff != "" {
+		t.Errorf("DeleteTask differs: (-got +want)\n%s", diff)
+	}
+
+	// 에러 응답은 서버가 보낸 handler.ErrResponse로 꺼낼 수 있다.
+	_, err = sut.UpdateTask(ctx, parent.ID, UpdateTaskRequest{Status: &done})
+	if !errors.As(err, &e) || e.StatusCode != http.StatusNotFound {
+		t.Fatalf("want 404 error, but got %v", err)
+	}
+	var er *handler.ErrResponse
+	if !errors.As(err, &er) || er.Message != "task 1: not found" {
+		t.Errorf("want ErrResponse %q, but got %v", "task 1: not found", er)
+	}
+	if want := "404 Not Found: task 1: not found"; err.Error() != want {
+		t.Errorf("want error %q, but got %q", want, err.Error())
+	}
+
+	// 로그아웃하면 토큰을 지우고, 이후 요청은 인증 에러를 반환한다.
+	if err := sut.Logout(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if sut.Token() != "" || sut.RefreshToken() != "" {
+		t.Errorf("want tokens to be cleared, but got %q, %q", sut.Token(), sut.RefreshToken())
+	}
+	_, err = sut.ListTasks(ctx, ListTasksOptions{})
+	if !errors.As(err, &e) || e.StatusCode != http.StatusUnauthorized {
+		t.Errorf("want 401 error, but got %v", err)
+	}
+}
+
+func TestClient_Reauth(t *testing.T) {
+	t.Parallel()
+
+	srv := clienttest.NewServer(t)
+	ctx := context.Background()
+	var saved []entity.Tokens
+	sut := &Client{
+		BaseURL:  srv.URL,
+		UserName: clienttest.UserName,
+		Password: clienttest.Password,
+		OnTokens: func(t entity.Tokens) { saved = append(saved, t) },
+	}
+
+	// 토큰이 없으면 로그인한 뒤 요청을 다시 보낸다.
+	if _, err := sut.AddTask(ctx, AddTaskRequest{Title: "first"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := srv.Logins(); got != 1 {
+		t.Errorf("want 1 login, but got %d", got)
+	}
+
+	// 토큰이 만료되면 동시에 보낸 요청이 모두 401 에러를 받아도 리프레시 토큰으로 한 번만 갱신한다.
+	srv.ExpireToken()
+	used := sut.RefreshToken()
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := sut.ListTasks(ctx, ListTasksOptions{}); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	if got := srv.Refreshes(); got != 1 {
+		t.Errorf("want 1 refresh, but got %d", got)
+	}
+	if got := srv.Logins(); got != 1 {
+		t.Errorf("want 1 login, but got %d", got)
+	}
+	want := []entity.Tokens{
+		{AccessToken: "token-1", RefreshToken: "refresh-1"},
+		{AccessToken: "token-2", RefreshToken: "refresh-2"},
+	}
+	if diff := cmp.Diff(saved, want); diff != "" {
+		t.Errorf("OnTokens differs: (-got +want)\n%s", diff)
+	}
+
+	// 리프레시 토큰을 갱신할 수 없으면 다시 로그인한다.
+	srv.ExpireToken()
+	srv.ExpireRefreshToken()
+	if _, err := sut.ListTasks(ctx, ListTasksOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if got := srv.Logins(); got != 2 {
+		t.Errorf("want 2 logins, but got %d", got)
+	}
+
+	// 이미 사용한 리프레시 토큰은 거부된다.
+	other := &Client{BaseURL: srv.URL}
+	other.SetRefreshToken(used)
+	err := other.Refresh(ctx)
+	var e *Error
+	if !errors.As(err, &e) || e.StatusCode != http.StatusUnauthorized {
+		t.Errorf("want 401 error, but got %v", err)
+	}
+
+	// 다시 로그인할 수 없으면 로그인 에러를 반환한다.
+	srv.ExpireToken()
+	srv.ExpireRefreshToken()
+	sut.Password = "wrong"
+	_, err = sut.ListTasks(ctx, ListTasksOptions{})
+	if !errors.As(err, &e) || e.StatusCode != http.StatusInternalServerError {
+		t.Errorf("want login error, but got %v", err)
+	}
+}
+
+func TestClient_Retry(t *testing.T) {
+	t.Parallel()
+
+	// failures번 503 에러를 반환한 뒤 성공하는 서버
+	newServer := func(t *testing.T, failures int32) (*httptest.Server, *atomic.Int32) {
+		var calls atomic.Int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if calls.Add(1) <= failures {
+				w.Header().Set("Retry-After", "0")
+				handler.Respond(w, r, &handler.ErrResponse{Message: "try again"}, http.StatusServiceUnavailable)
+				return
+			}
+			handler.Respond(w, r, []Task{}, http.StatusOK)
+		}))
+		t.Cleanup(srv.Close)
+		return srv, &calls
+	}
+	policy := &RetryPolicy{MaxRetries: 2, MinWait: time.Millisecond, MaxWait: 10 * time.Millisecond}
+	list := func(c *Client) error {
+		_, err := c.ListTasks(context.Background(), ListTasksOptions{})
+		return err
+	}
+
+	tests := map[string]struct {
+		failures  int32
+		call      func(c *Client) error
+		wantCalls int32
+		wantErr   bool
+	}{
+		"recovered": {
+			failures:  2,
+			call:      list,
+			wantCalls: 3,
+		},
+		"exhausted": {
+			failures:  3,
+			call:      list,
+			wantCalls: 3,
+			wantErr:   true,
+		},
+		"notIdempotent": {
+			failures: 1,
+			call: func(c *Client) error {
+				_, err := c.AddTask(context.Background(), AddTaskRequest{Title: "t"})
+				return err
+			},
+			wantCalls: 1,
+			wantErr:   true,
+		},
+		"canceled": {
+			failures: 1,
+			call: func(c *Client) error {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				_, err := c.ListTasks(ctx, ListTasksOptions{})
+				return err
+			},
+			wantCalls: 0,
+			wantErr:   true,
+		},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			srv, calls := newServer(t, tt.failures)
+			err := tt.call(&Client{BaseURL: srv.URL, Retry: policy})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("want error %t, but got %v", tt.wantErr, err)
+			}
+			if got := calls.Load(); got != tt.wantCalls {
+				t.Errorf("want %d calls, but got %d", tt.wantCalls, got)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	t.Parallel()
+
+	p := RetryPolicy{MinWait: 100 * time.Millisecond, MaxWait: time.Second}
+	for attempt, ceil := range []time.Duration{100, 200, 400, 800, 1000, 1000} {
+		ceil *= time.Millisecond
+		for i := 0; i < 100; i++ {
+			if got := p.backoff(attempt); got < 0 || got > ceil {
+				t.Fatalf("attempt %d: want wait in [0, %v], but got %v", attempt, ceil, got)
+			}
+		}
+	}
+	// 시프트가 넘쳐도 MaxWait를 넘지 않는다.
+	if got := p.backoff(100); got > p.MaxWait {
+		t.Errorf("want wait <= %v, but got %v", p.MaxWait, got)
+	}
+}
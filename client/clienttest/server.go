@@ -0,0 +1,314 @@
+// Package clienttest는 client 패키지를 사용하는 코드를 테스트하기 위한 API 서버를 제공한다.
+package clienttest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/clock"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/handler"
+	"github.com/gitwub5/go_todo_app/quickadd"
+	"github.com/gitwub5/go_todo_app/service"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+)
+
+// 서버에 처음부터 등록되어 있는 사용자이다.
+const (
+	UserID   entity.UserID = 1
+	UserName               = "john"
+	Password               = "secret"
+)
+
+// Server는 실제 핸들러를 /v1 아래에 등록한 httptest.Server이다.
+// 서비스는 사용자와 Task를 메모리에 저장하는 가짜를 사용하므로 DB와 Redis 없이 실행할 수 있다.
+// 액세스 토큰과 리프레시 토큰은 로그인하거나 토큰을 갱신할 때마다 새로 발급하며, 마지막에 발급한 토큰만 유효하다.
+// 이미 사용한 리프레시 토큰을 다시 보내면 실제 서버처럼 리프레시 토큰을 폐기한다.
+// Task는 로그인한 사용자와 관계없이 UserID의 Task로 다룬다.
+type Server struct {
+	*httptest.Server
+
+	mu     sync.Mutex
+	users  map[string]*entity.User
+	tasks  map[entity.TaskID]*entity.Task
+	lastID entity.TaskID
+	token  string
+	issued int
+	// refresh는 유효한 리프레시 토큰이고, used는 이미 사용한 리프레시 토큰이다.
+	refresh   string
+	used      map[string]bool
+	refreshes int
+}
+
+// NewServer 함수는 Server를 시작하고, 테스트가 끝나면 종료한다.
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+
+	s := &Server{
+		users: map[string]*entity.User{
+			UserName: {ID: UserID, Name: UserName, Password: Password, Role: "user"},
+		},
+		tasks: map[entity.TaskID]*entity.Task{},
+		used:  map[string]bool{},
+	}
+	v := validator.New()
+	lt := &handler.ListTask{Service: s}
+	mux := chi.NewRouter()
+	mux.Route("/v1", func(r chi.Router) {
+		r.Post("/register", (&handler.RegisterUser{Service: s, Validator: v}).ServeHTTP)
+		r.Post("/login", (&handler.Login{Service: s, Validator: v}).ServeHTTP)
+		r.Post("/token/refresh", (&handler.RefreshToken{Service: s, Validator: v}).ServeHTTP)
+		r.With(s.authMiddleware).Post("/logout", (&handler.Logout{Service: s}).ServeHTTP)
+		r.Route("/tasks", func(r chi.Router) {
+			r.Use(s.authMiddleware)
+			r.Post("/", (&handler.AddTask{
+				Service:   s,
+				Parser:    &quickadd.Parser{Clocker: clock.FixedClocker{}},
+				Validator: v,
+			}).ServeHTTP)
+			r.Get("/", lt.ServeHTTP)
+			r.Patch("/{id}", (&handler.UpdateTask{Service: s, Validator: v}).ServeHTTP)
+			r.Delete("/{id}", (&handler.DeleteTask{Service: s}).ServeHTTP)
+		})
+	})
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+	return s
+}
+
+// authMiddleware 메서드는 handler.AuthMiddleware 대신 마지막에 발급한 토큰만 허용한다.
+func (s *Server) authMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		ok := s.token != "" && r.Header.Get("Authorization") == "Bearer "+s.token
+		s.mu.Unlock()
+		if !ok {
+			handler.Respond(w, r, handler.ErrResponse{
+				Message: "not find auth info",
+			}, http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// ExpireToken 메서드는 발급한 토큰을 만료시킨다.
+func (s *Server) ExpireToken() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.token = ""
+}
+
+// ExpireRefreshToken 메서드는 발급한 리프레시 토큰을 만료시킨다.
+func (s *Server) ExpireRefreshToken() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.refresh = ""
+}
+
+// Logins 메서드는 로그인에 성공한 횟수를 반환한다.
+func (s *Server) Logins() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.issued - s.refreshes
+}
+
+// Refreshes 메서드는 리프레시 토큰으로 토큰을 갱신한 횟수를 반환한다.
+func (s *Server) Refreshes() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.refreshes
+}
+
+// AddTasks 메서드는 ts를 그대로 저장한다. ID가 0이면 새 ID를 붙인다.
+func (s *Server) AddTasks(ts ...*entity.Task) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, t := range ts {
+		t := *t
+		if t.ID == 0 {
+			s.lastID++
+			t.ID = s.lastID
+		} else if t.ID > s.lastID {
+			s.lastID = t.ID
+		}
+		if t.UserID == 0 {
+			t.UserID = UserID
+		}
+		s.tasks[t.ID] = &t
+	}
+}
+
+// Tasks 메서드는 저장된 Task를 ID 순서로 반환한다.
+func (s *Server) Tasks() entity.Tasks {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sorted(func(*entity.Task) bool { return true })
+}
+
+func (s *Server) sorted(match func(*entity.Task) bool) entity.Tasks {
+	ts := entity.Tasks{}
+	for _, t := range s.tasks {
+		if match(t) {
+			t := *t
+			ts = append(ts, &t)
+		}
+	}
+	sort.Slice(ts, func(i, j int) bool { return ts[i].ID < ts[j].ID })
+	return ts
+}
+
+// 아래의 메서드는 핸들러가 사용하는 서비스 인터페이스(handler.LoginService 등)를 구현한다.
+
+func (s *Server) RegisterUser(
+	_ context.Context, name, password, role string, email *string,
+) (*entity.User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.users[name]; ok {
+		return nil, fmt.Errorf("user %q: %w", name, store.ErrAlreadyEntry)
+	}
+	u := &entity.User{
+		ID:       entity.UserID(len(s.users) + 1),
+		Name:     name,
+		Password: password,
+		Role:     role,
+		Email:    email,
+	}
+	s.users[name] = u
+	return u, nil
+}
+
+func (s *Server) Login(_ context.Context, name, pw string, _ entity.Client) (*entity.Tokens, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if u, ok := s.users[name]; !ok || u.Password != pw {
+		return nil, errors.New("wrong user name or password")
+	}
+	return s.issue(), nil
+}
+
+func (s *Server) Refresh(_ context.Context, token string) (*entity.Tokens, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch {
+	case s.used[token]:
+		s.refresh = ""
+		return nil, auth.ErrRefreshTokenReused
+	case s.refresh == "" || token != s.refresh:
+		return nil, auth.ErrInvalidRefreshToken
+	}
+	s.used[token] = true
+	s.refreshes++
+	return s.issue(), nil
+}
+
+func (s *Server) Logout(context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.token, s.refresh = "", ""
+	return nil
+}
+
+// issue 메서드는 새 토큰을 발급한다. 이전에 발급한 토큰은 더 이상 유효하지 않다.
+func (s *Server) issue() *entity.Tokens {
+	s.issued++
+	s.token = fmt.Sprintf("token-%d", s.issued)
+	s.refresh = fmt.Sprintf("refresh-%d", s.issued)
+	return &entity.Tokens{AccessToken: s.token, RefreshToken: s.refresh}
+}
+
+func (s *Server) AddTask(
+	_ context.Context, title string, due *time.Time, parent *entity.TaskID, attrs entity.TaskAttributes,
+) (*entity.Task, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if parent != nil && s.tasks[*parent] == nil {
+		return nil, fmt.Errorf("parent %d: %w", *parent, store.ErrNotFound)
+	}
+	s.lastID++
+	t := &entity.Task{
+		ID:       s.lastID,
+		UserID:   UserID,
+		ParentID: parent,
+		Title:    title,
+		Status:   entity.TaskStatusTodo,
+		Due:      due,
+
+		TaskAttributes: attrs,
+	}
+	s.tasks[t.ID] = t
+	c := *t
+	return &c, nil
+}
+
+func (s *Server) ListTasks(_ context.Context) (entity.Tasks, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sorted(func(t *entity.Task) bool { return t.UserID == UserID }), nil
+}
+
+func (s *Server) ListAssignedTasks(_ context.Context) (entity.Tasks, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sorted(func(t *entity.Task) bool {
+		return t.AssigneeID != nil && *t.AssigneeID == UserID
+	}), nil
+}
+
+func (s *Server) UpdateTask(
+	_ context.Context, id entity.TaskID, title *string, status *entity.TaskStatus,
+) (*entity.Task, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, ok := s.tasks[id]
+	if !ok || t.UserID != UserID {
+		return nil, fmt.Errorf("task %d: %w", id, store.ErrNotFound)
+	}
+	if status != nil {
+		switch *status {
+		case entity.TaskStatusTodo, entity.TaskStatusDoing, entity.TaskStatusDone:
+		default:
+			return nil, fmt.Errorf("%q: %w", *status, service.ErrUnknownStatus)
+		}
+		t.Status = *status
+	}
+	if title != nil {
+		t.Title = *title
+	}
+	c := *t
+	return &c, nil
+}
+
+func (s *Server) DeleteTask(_ context.Context, id entity.TaskID) (entity.Tasks, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, ok := s.tasks[id]
+	if !ok || t.UserID != UserID {
+		return nil, fmt.Errorf("failed to get: %w", store.ErrNotFound)
+	}
+	// service.DeleteTask와 같이 하위 Task부터 삭제한다.
+	deleted := entity.Tasks{}
+	var remove func(t *entity.Task)
+	remove = func(t *entity.Task) {
+		for _, c := range s.sorted(func(c *entity.Task) bool {
+			return c.ParentID != nil && *c.ParentID == t.ID
+		}) {
+			remove(c)
+		}
+		delete(s.tasks, t.ID)
+		deleted = append(deleted, t)
+	}
+	remove(t)
+	return deleted, nil
+}
@@ -0,0 +1,117 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/quickadd"
+)
+
+// Task는 API가 반환하는 Task이다.
+type Task struct {
+	ID         entity.TaskID     `json:"id"`
+	ProjectID  *entity.ProjectID `json:"project_id,omitempty"`
+	ParentID   *entity.TaskID    `json:"parent_id,omitempty"`
+	AssigneeID *entity.UserID    `json:"assignee_id,omitempty"`
+	Title      string            `json:"title"`
+	Status     entity.TaskStatus `json:"status"`
+	Due        *time.Time        `json:"due,omitempty"`
+	entity.TaskAttributes
+}
+
+// AddTaskRequest는 Task를 등록할 때의 요청이다.
+type AddTaskRequest struct {
+	Title string `json:"title"`
+	// Quick이 true이면 Title을 자연어로 해석해 마감 시간 등을 추출한다.
+	Quick bool `json:"quick,omitempty"`
+	// Timezone은 Quick에서 날짜를 계산할 타임존이다. (예: Asia/Seoul)
+	Timezone string         `json:"timezone,omitempty"`
+	ParentID *entity.TaskID `json:"parent_id,omitempty"`
+}
+
+// AddTaskResponse는 등록한 Task의 ID와 Quick일 때의 해석 결과이다.
+type AddTaskResponse struct {
+	ID     entity.TaskID `json:"id"`
+	Parsed *ParsedTask   `json:"parsed,omitempty"`
+}
+
+// ParsedTask는 자연어로 쓴 제목을 해석한 결과이다.
+type ParsedTask struct {
+	Title      string            `json:"title"`
+	Labels     []string          `json:"labels"`
+	Priority   quickadd.Priority `json:"priority,omitempty"`
+	Due        *time.Time        `json:"due,omitempty"`
+	AllDay     bool              `json:"all_day"`
+	Recurrence *Recurrence       `json:"recurrence,omitempty"`
+}
+
+// Recurrence는 해석한 반복 규칙이다.
+type Recurrence struct {
+	Frequency quickadd.Frequency `json:"frequency"`
+	Interval  int                `json:"interval"`
+	Weekday   string             `json:"weekday,omitempty"`
+	MonthDay  int                `json:"month_day,omitempty"`
+}
+
+// AddTask 메서드는 Task를 등록한다. (POST /tasks)
+// 같은 Task가 두 번 등록되지 않도록 5xx 에러를 받아도 다시 보내지 않는다.
+func (c *Client) AddTask(ctx context.Context, req AddTaskRequest) (*AddTaskResponse, error) {
+	var out AddTaskResponse
+	if err := c.call(ctx, http.MethodPost, "/tasks", nil, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListTasksOptions는 Task 목록을 조회할 때의 조건이다.
+type ListTasksOptions struct {
+	// Assigned가 true이면 자신이 담당자로 지정된 Task만 조회한다.
+	Assigned bool
+}
+
+// ListTasks 메서드는 Task 목록을 조회한다. (GET /tasks)
+func (c *Client) ListTasks(ctx context.Context, opts ListTasksOptions) ([]Task, error) {
+	q := url.Values{}
+	if opts.Assigned {
+		q.Set("assignee", "me")
+	}
+	var out []Task
+	if err := c.call(ctx, http.MethodGet, "/tasks", q, nil, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UpdateTaskRequest는 Task를 변경할 때의 요청이다. nil인 항목은 변경하지 않는다.
+type UpdateTaskRequest struct {
+	Title  *string            `json:"title,omitempty"`
+	Status *entity.TaskStatus `json:"status,omitempty"`
+}
+
+// UpdateTask 메서드는 Task의 제목이나 상태를 변경한다. (PATCH /tasks/{id})
+func (c *Client) UpdateTask(ctx context.Context, id entity.TaskID, req UpdateTaskRequest) (*Task, error) {
+	var out Task
+	if err := c.call(ctx, http.MethodPatch, taskPath(id), nil, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteTask 메서드는 Task를 하위 Task와 함께 삭제하고, 삭제한 Task의 ID를 반환한다. (DELETE /tasks/{id})
+func (c *Client) DeleteTask(ctx context.Context, id entity.TaskID) ([]entity.TaskID, error) {
+	var out struct {
+		DeletedIDs []entity.TaskID `json:"deleted_ids"`
+	}
+	if err := c.call(ctx, http.MethodDelete, taskPath(id), nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return out.DeletedIDs, nil
+}
+
+func taskPath(id entity.TaskID) string {
+	return "/tasks/" + strconv.FormatInt(int64(id), 10)
+}
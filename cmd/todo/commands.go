@@ -0,0 +1,316 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gitwub5/go_todo_app/client"
+	"github.com/gitwub5/go_todo_app/entity"
+)
+
+// defaultServer는 docker-compose로 실행한 API 서버의 주소이다.
+const defaultServer = "http://localhost:18000"
+
+// runLogin 함수는 액세스 토큰과 리프레시 토큰을 발급받아 저장한다.
+// 비밀번호는 표준 입력으로 받으며, -password-stdin이면 표준 입력 전체를 비밀번호로 사용한다.
+func runLogin(ctx context.Context, c *cli, args []string) error {
+	fs := c.flagSet("login")
+	server := fs.String("server", "", "API server URL (default: the last logged in server or "+defaultServer+")")
+	user := fs.String("user", "", "user name")
+	passwordStdin := fs.Bool("password-stdin", false, "read the password from stdin")
+	if err := c.parse(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return c.usageError(fs, "unexpected arguments %q", fs.Args())
+	}
+	if *server == "" {
+		*server = defaultServer
+		if cred, err := loadCredentials(c.configDir); err == nil {
+			*server = cred.Server
+		}
+	}
+
+	in := bufio.NewReader(c.stdin)
+	if *user == "" {
+		fmt.Fprint(c.stderr, "User: ")
+		*user = readLine(in)
+	}
+	var password string
+	if *passwordStdin {
+		b, err := io.ReadAll(in)
+		if err != nil {
+			return err
+		}
+		password = strings.TrimRight(string(b), "\r\n")
+	} else {
+		fmt.Fprint(c.stderr, "Password: ")
+		password = readLine(in)
+	}
+	if *user == "" || password == "" {
+		return c.usageError(fs, "user name and password are required")
+	}
+
+	cl := &client.Client{BaseURL: *server}
+	token, err := cl.Login(ctx, *user, password)
+	if err != nil {
+		return err
+	}
+	cred := &credentials{Server: *server, AccessToken: token, RefreshToken: cl.RefreshToken()}
+	if err := saveCredentials(c.configDir, cred); err != nil {
+		return fmt.Errorf("failed to save credentials: %w", err)
+	}
+	fmt.Fprintf(c.stdout, "logged in to %s as %s\n", *server, *user)
+	return nil
+}
+
+// runLogout 함수는 서버에서 세션을 끝내고 저장한 토큰을 지운다.
+// 토큰이 이미 만료되어 서버에서 로그아웃할 수 없어도 저장한 토큰은 지운다.
+func runLogout(ctx context.Context, c *cli, args []string) error {
+	fs := c.flagSet("logout")
+	if err := c.parse(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return c.usageError(fs, "unexpected arguments %q", fs.Args())
+	}
+	cl, err := c.client()
+	if err != nil {
+		return err
+	}
+	var e *client.Error
+	if err := cl.Logout(ctx); err != nil && !(errors.As(err, &e) && e.StatusCode == http.StatusUnauthorized) {
+		return err
+	}
+	if err := removeCredentials(c.configDir); err != nil {
+		return fmt.Errorf("failed to remove credentials: %w", err)
+	}
+	fmt.Fprintf(c.stdout, "logged out of %s\n", cl.BaseURL)
+	return nil
+}
+
+func readLine(r *bufio.Reader) string {
+	s, _ := r.ReadString('\n')
+	return strings.TrimRight(s, "\r\n")
+}
+
+// runAdd 함수는 인자를 이어 붙인 제목으로 Task를 등록한다.
+func runAdd(ctx context.Context, c *cli, args []string) error {
+	fs := c.flagSet("add")
+	parent := fs.Int64("parent", 0, "register as a subtask of this task ID")
+	quick := fs.Bool("quick", false, `parse due date, labels and priority from the title (e.g. "pay rent tomorrow 9am #home")`)
+	tz := fs.String("tz", "", "time zone to resolve dates in -quick mode (e.g. Asia/Seoul)")
+	out := outputFlag(fs)
+	if err := c.parse(fs, args); err != nil {
+		return err
+	}
+	title := strings.Join(fs.Args(), " ")
+	if title == "" {
+		return c.usageError(fs, "title is required")
+	}
+	req := client.AddTaskRequest{Title: title, Quick: *quick, Timezone: *tz}
+	if *parent != 0 {
+		id := entity.TaskID(*parent)
+		req.ParentID = &id
+	}
+
+	cl, err := c.client()
+	if err != nil {
+		return err
+	}
+	rsp, err := cl.AddTask(ctx, req)
+	if err != nil {
+		return err
+	}
+	if *out == "json" {
+		return c.printJSON(rsp)
+	}
+	if rsp.Parsed != nil {
+		title = rsp.Parsed.Title
+	}
+	fmt.Fprintf(c.stdout, "added task %d: %s\n", rsp.ID, title)
+	if rsp.Parsed != nil && rsp.Parsed.Due != nil {
+		fmt.Fprintf(c.stdout, "due: %s\n", rsp.Parsed.Due.In(c.loc).Format(timeLayout))
+	}
+	return nil
+}
+
+// runList 함수는 Task 목록을 조회한다. 상태와 제목 조건은 조회한 목록에서 거른다.
+func runList(ctx context.Context, c *cli, args []string) error {
+	fs := c.flagSet("ls")
+	mine := fs.Bool("mine", false, "list only tasks assigned to me")
+	status := fs.String("status", "", "comma separated statuses to list (e.g. todo,doing)")
+	query := fs.String("q", "", "list only tasks whose title contains this text (case insensitive)")
+	out := outputFlag(fs)
+	if err := c.parse(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return c.usageError(fs, "unexpected arguments %q", fs.Args())
+	}
+
+	cl, err := c.client()
+	if err != nil {
+		return err
+	}
+	ts, err := cl.ListTasks(ctx, client.ListTasksOptions{Assigned: *mine})
+	if err != nil {
+		return err
+	}
+	statuses := map[entity.TaskStatus]bool{}
+	for _, s := range strings.Split(*status, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			statuses[entity.TaskStatus(s)] = true
+		}
+	}
+	q := strings.ToLower(*query)
+	filtered := []client.Task{}
+	for _, t := range ts {
+		if len(statuses) > 0 && !statuses[t.Status] {
+			continue
+		}
+		if q != "" && !strings.Contains(strings.ToLower(t.Title), q) {
+			continue
+		}
+		filtered = append(filtered, t)
+	}
+	return c.printTasks(*out, filtered)
+}
+
+// runDone 함수는 Task의 상태를 done으로 변경한다.
+func runDone(ctx context.Context, c *cli, args []string) error {
+	fs := c.flagSet("done")
+	out := outputFlag(fs)
+	if err := c.parse(fs, args); err != nil {
+		return err
+	}
+	ids, err := c.taskIDs(fs, fs.Args())
+	if err != nil {
+		return err
+	}
+
+	cl, err := c.client()
+	if err != nil {
+		return err
+	}
+	done := entity.TaskStatusDone
+	ts := []client.Task{}
+	for _, id := range ids {
+		t, err := cl.UpdateTask(ctx, id, client.UpdateTaskRequest{Status: &done})
+		if err != nil {
+			// 이미 변경한 Task는 출력한 뒤 에러를 반환한다.
+			if len(ts) > 0 {
+				_ = c.printTasks(*out, ts)
+			}
+			return fmt.Errorf("task %d: %w", id, err)
+		}
+		ts = append(ts, *t)
+	}
+	return c.printTasks(*out, ts)
+}
+
+// runEdit 함수는 Task의 제목이나 상태를 변경한다.
+func runEdit(ctx context.Context, c *cli, args []string) error {
+	fs := c.flagSet("edit")
+	title := fs.String("title", "", "new title")
+	status := fs.String("status", "", "new status")
+	out := outputFlag(fs)
+	if err := c.parse(fs, args); err != nil {
+		return err
+	}
+	ids, err := c.taskIDs(fs, fs.Args())
+	if err != nil {
+		return err
+	}
+	if len(ids) != 1 {
+		return c.usageError(fs, "exactly one task ID is required")
+	}
+	var req client.UpdateTaskRequest
+	fs.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "title":
+			req.Title = title
+		case "status":
+			s := entity.TaskStatus(*status)
+			req.Status = &s
+		}
+	})
+	if req.Title == nil && req.Status == nil {
+		return c.usageError(fs, "-title or -status is required")
+	}
+
+	cl, err := c.client()
+	if err != nil {
+		return err
+	}
+	t, err := cl.UpdateTask(ctx, ids[0], req)
+	if err != nil {
+		return err
+	}
+	return c.printTasks(*out, []client.Task{*t})
+}
+
+// runRemove 함수는 Task를 하위 Task와 함께 삭제한다.
+func runRemove(ctx context.Context, c *cli, args []string) error {
+	fs := c.flagSet("rm")
+	out := outputFlag(fs)
+	if err := c.parse(fs, args); err != nil {
+		return err
+	}
+	ids, err := c.taskIDs(fs, fs.Args())
+	if err != nil {
+		return err
+	}
+
+	cl, err := c.client()
+	if err != nil {
+		return err
+	}
+	rsp := struct {
+		DeletedIDs []entity.TaskID `json:"deleted_ids"`
+	}{DeletedIDs: []entity.TaskID{}}
+	printDeleted := func() error {
+		if *out == "json" {
+			return c.printJSON(rsp)
+		}
+		for _, id := range rsp.DeletedIDs {
+			fmt.Fprintf(c.stdout, "deleted task %d\n", id)
+		}
+		return nil
+	}
+	for _, id := range ids {
+		deleted, err := cl.DeleteTask(ctx, id)
+		if err != nil {
+			// 이미 삭제한 Task는 출력한 뒤 에러를 반환한다.
+			if len(rsp.DeletedIDs) > 0 {
+				_ = printDeleted()
+			}
+			return fmt.Errorf("task %d: %w", id, err)
+		}
+		rsp.DeletedIDs = append(rsp.DeletedIDs, deleted...)
+	}
+	return printDeleted()
+}
+
+// taskIDs 메서드는 인자를 Task ID로 변환한다. 인자가 없거나 숫자가 아니면 사용법을 출력한다.
+func (c *cli) taskIDs(fs *flag.FlagSet, args []string) ([]entity.TaskID, error) {
+	if len(args) == 0 {
+		return nil, c.usageError(fs, "task ID is required")
+	}
+	ids := make([]entity.TaskID, 0, len(args))
+	for _, a := range args {
+		id, err := strconv.ParseInt(a, 10, 64)
+		if err != nil || id <= 0 {
+			return nil, c.usageError(fs, "invalid task ID %q", a)
+		}
+		ids = append(ids, entity.TaskID(id))
+	}
+	return ids, nil
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/gitwub5/go_todo_app/client"
+	"github.com/gitwub5/go_todo_app/entity"
+)
+
+const credentialsFile = "credentials.json"
+
+// credentials는 login으로 발급받은 토큰과 토큰을 발급한 서버의 주소이다.
+type credentials struct {
+	Server       string `json:"server"`
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+}
+
+// errNotLoggedIn은 저장된 액세스 토큰이 없을 때 반환한다.
+var errNotLoggedIn = errors.New("not logged in; run 'todo login' first")
+
+// loadCredentials 함수는 dir에 저장된 credentials를 읽는다.
+func loadCredentials(dir string) (*credentials, error) {
+	b, err := os.ReadFile(filepath.Join(dir, credentialsFile))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, errNotLoggedIn
+	}
+	if err != nil {
+		return nil, err
+	}
+	var cred credentials
+	if err := json.Unmarshal(b, &cred); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", credentialsFile, err)
+	}
+	if cred.AccessToken == "" {
+		return nil, errNotLoggedIn
+	}
+	return &cred, nil
+}
+
+// saveCredentials 함수는 cred를 dir에 본인만 읽고 쓸 수 있도록 저장한다.
+// 저장 중에 실패해도 기존 파일이 깨지지 않도록 임시 파일에 쓴 뒤 이름을 바꾼다.
+func saveCredentials(dir string, cred *credentials) error {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+	b, err := json.MarshalIndent(cred, "", "  ")
+	if err != nil {
+		return err
+	}
+	// os.CreateTemp는 0600 권한으로 파일을 만든다.
+	f, err := os.CreateTemp(dir, credentialsFile+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), filepath.Join(dir, credentialsFile))
+}
+
+// removeCredentials 함수는 dir에 저장된 credentials를 지운다.
+func removeCredentials(dir string) error {
+	err := os.Remove(filepath.Join(dir, credentialsFile))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// client 메서드는 저장된 credentials로 API 클라이언트를 만든다.
+// 액세스 토큰이 만료되어 리프레시 토큰으로 갱신하면 새 토큰을 다시 저장한다.
+// 사용한 리프레시 토큰은 폐기되므로 저장하지 못하면 다음 실행에서는 다시 로그인해야 한다.
+func (c *cli) client() (*client.Client, error) {
+	cred, err := loadCredentials(c.configDir)
+	if err != nil {
+		return nil, err
+	}
+	cl := &client.Client{BaseURL: cred.Server}
+	cl.SetToken(cred.AccessToken)
+	cl.SetRefreshToken(cred.RefreshToken)
+	cl.OnTokens = func(t entity.Tokens) {
+		next := &credentials{Server: cred.Server, AccessToken: t.AccessToken, RefreshToken: t.RefreshToken}
+		if err := saveCredentials(c.configDir, next); err != nil {
+			fmt.Fprintf(c.stderr, "todo: failed to save refreshed credentials: %v\n", err)
+		}
+	}
+	return cl, nil
+}
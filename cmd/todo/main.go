@@ -0,0 +1,136 @@
+// todo는 todo API를 사용하는 커맨드라인 클라이언트이다.
+//
+//	todo login [-server URL] [-user NAME] [-password-stdin]
+//	todo logout
+//	todo add [-parent ID] [-quick] [-tz ZONE] TITLE...
+//	todo ls [-mine] [-status STATUS,...] [-q TEXT]
+//	todo done ID...
+//	todo edit [-title TITLE] [-status STATUS] ID
+//	todo rm ID...
+//
+// login으로 발급받은 토큰은 사용자 설정 디렉터리(예: ~/.config/todo)의 credentials.json에
+// 본인만 읽고 쓸 수 있는 권한(0600)으로 저장하고, 다른 명령은 저장된 토큰을 사용한다.
+// 결과를 출력하는 명령은 -o json으로 JSON을 출력할 수 있다. (기본값은 table)
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+func main() {
+	c := &cli{stdin: os.Stdin, stdout: os.Stdout, stderr: os.Stderr, loc: time.Local}
+	os.Exit(c.run(context.Background(), os.Args[1:]))
+}
+
+// errUsage는 명령이나 인자가 잘못되었을 때 반환한다. 사용법은 이미 출력한 상태이다.
+var errUsage = errors.New("usage")
+
+// cli는 명령을 실행하는 데 필요한 입출력과 설정이다.
+type cli struct {
+	configDir string
+	stdin     io.Reader
+	stdout    io.Writer
+	stderr    io.Writer
+	// loc은 마감 시간을 표로 출력할 때의 타임존이다.
+	loc *time.Location
+}
+
+// command는 하위 명령이다. args는 하위 명령 이름을 제외한 인자이다.
+type command struct {
+	usage string
+	run   func(ctx context.Context, c *cli, args []string) error
+}
+
+var commands map[string]command
+
+// 하위 명령은 사용법을 출력할 때 commands를 참조하므로 init에서 등록한다.
+func init() {
+	commands = map[string]command{
+		"login":  {"login [-server URL] [-user NAME] [-password-stdin]", runLogin},
+		"logout": {"logout", runLogout},
+		"add":    {"add [-parent ID] [-quick] [-tz ZONE] [-o table|json] TITLE...", runAdd},
+		"ls":     {"ls [-mine] [-status STATUS,...] [-q TEXT] [-o table|json]", runList},
+		"done":   {"done [-o table|json] ID...", runDone},
+		"edit":   {"edit [-title TITLE] [-status STATUS] [-o table|json] ID", runEdit},
+		"rm":     {"rm [-o table|json] ID...", runRemove},
+	}
+}
+
+// run 메서드는 명령을 실행하고 종료 코드를 반환한다.
+func (c *cli) run(ctx context.Context, args []string) int {
+	fs := flag.NewFlagSet("todo", flag.ContinueOnError)
+	fs.SetOutput(c.stderr)
+	fs.Usage = func() { usage(c.stderr) }
+	fs.StringVar(&c.configDir, "config", defaultConfigDir(), "directory to store credentials")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	cmd, ok := commands[fs.Arg(0)]
+	if !ok {
+		usage(c.stderr)
+		return 2
+	}
+	if err := cmd.run(ctx, c, fs.Args()[1:]); err != nil {
+		if errors.Is(err, errUsage) {
+			return 2
+		}
+		fmt.Fprintf(c.stderr, "todo %s: %v\n", fs.Arg(0), err)
+		return 1
+	}
+	return 0
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "usage: todo [-config DIR] COMMAND [ARGS]")
+	names := make([]string, 0, len(commands))
+	for n := range commands {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	for _, n := range names {
+		fmt.Fprintf(w, "  todo %s\n", commands[n].usage)
+	}
+}
+
+// defaultConfigDir 함수는 사용자 설정 디렉터리 아래의 todo 디렉터리를 반환한다.
+func defaultConfigDir() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ".todo"
+	}
+	return filepath.Join(dir, "todo")
+}
+
+// flagSet 함수는 하위 명령의 플래그를 만든다. 잘못된 플래그는 하위 명령의 사용법과 함께 출력한다.
+func (c *cli) flagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(c.stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(c.stderr, "usage: todo %s\n", commands[name].usage)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// parse 메서드는 플래그를 해석하고, 실패하면 errUsage를 반환한다.
+func (c *cli) parse(fs *flag.FlagSet, args []string) error {
+	if err := fs.Parse(args); err != nil {
+		return errUsage
+	}
+	return nil
+}
+
+// usageError 메서드는 사용법을 출력하고 errUsage를 반환한다.
+func (c *cli) usageError(fs *flag.FlagSet, format string, a ...any) error {
+	fmt.Fprintf(c.stderr, "todo %s: %s\n", fs.Name(), fmt.Sprintf(format, a...))
+	fs.Usage()
+	return errUsage
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/client/clienttest"
+	"github.com/gitwub5/go_todo_app/testutil"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCLI(t *testing.T) {
+	srv := clienttest.NewServer(t)
+	dir := filepath.Join(t.TempDir(), "todo")
+
+	// 명령은 순서대로 실행하며, 앞의 명령이 만든 Task를 뒤의 명령이 사용한다.
+	steps := []struct {
+		name   string
+		args   []string
+		stdin  string
+		code   int
+		stdout string // golden 파일 이름. 비어 있으면 출력이 없어야 한다.
+		stderr string // stderr에 포함되어야 하는 문자열
+		setup  func() // 명령을 실행하기 전에 호출한다.
+	}{
+		{name: "notLoggedIn", args: []string{"ls"}, code: 1, stderr: "not logged in"},
+		{
+			name:   "wrongPassword",
+			args:   []string{"login", "-server", srv.URL, "-user", clienttest.UserName, "-password-stdin"},
+			stdin:  "wrong\n",
+			code:   1,
+			stderr: "wrong user name or password",
+		},
+		{
+			name:   "login",
+			args:   []string{"login", "-server", srv.URL, "-password-stdin"},
+			stdin:  clienttest.UserName + "\n" + clienttest.Password + "\n",
+			stdout: "login.golden",
+		},
+		{name: "add", args: []string{"add", "release", "v2"}, stdout: "add.golden"},
+		{name: "addSubtask", args: []string{"add", "-parent", "1", "write", "changelog"}, stdout: "add_subtask.golden"},
+		{name: "addQuick", args: []string{"add", "-quick", "-tz", "Asia/Seoul", "pay rent tomorrow 9am #home"}, stdout: "add_quick.golden"},
+		{name: "ls", args: []string{"ls"}, stdout: "ls.golden"},
+		// 액세스 토큰이 만료되면 리프레시 토큰으로 갱신하고, 갱신한 토큰을 다음 명령에서 사용한다.
+		{name: "done", args: []string{"done", "2", "3"}, stdout: "done.golden", setup: srv.ExpireToken},
+		{name: "edit", args: []string{"edit", "-title", "release v2.0", "-status", "doing", "1"}, stdout: "edit.golden"},
+		{name: "lsFilter", args: []string{"ls", "-status", "done", "-q", "RENT", "-o", "json"}, stdout: "ls_filter.json.golden"},
+		{name: "editUnknownStatus", args: []string{"edit", "-status", "review", "1"}, code: 1, stderr: `"review": unknown status`},
+		{name: "rm", args: []string{"rm", "1"}, stdout: "rm.golden"},
+		{name: "rmNotFound", args: []string{"rm", "-o", "json", "3", "1"}, code: 1, stdout: "rm_not_found.json.golden", stderr: "task 1: 404 Not Found"},
+		{name: "badID", args: []string{"done", "abc"}, code: 2, stderr: `invalid task ID "abc"`},
+		// 로그아웃하면 저장한 토큰을 지우므로 다시 로그인해야 한다.
+		{name: "logout", args: []string{"logout"}, stdout: "logout.golden", setup: func() {
+			// 액세스 토큰은 본인만 읽고 쓸 수 있어야 한다.
+			fi, err := os.Stat(filepath.Join(dir, credentialsFile))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if perm := fi.Mode().Perm(); perm != 0o600 {
+				t.Errorf("want credentials permission 0600, but got %o", perm)
+			}
+		}},
+		{name: "loggedOut", args: []string{"ls"}, code: 1, stderr: "not logged in"},
+		{name: "unknownCommand", args: []string{"list"}, code: 2, stderr: "usage: todo"},
+	}
+	for _, s := range steps {
+		if s.setup != nil {
+			s.setup()
+		}
+		var stdout, stderr bytes.Buffer
+		c := &cli{stdin: strings.NewReader(s.stdin), stdout: &stdout, stderr: &stderr, loc: time.UTC}
+		code := c.run(context.Background(), append([]string{"-config", dir}, s.args...))
+
+		if code != s.code {
+			t.Fatalf("%s: want exit code %d, but got %d, stderr: %q", s.name, s.code, code, stderr.String())
+		}
+		if !strings.Contains(stderr.String(), s.stderr) {
+			t.Errorf("%s: want stderr to contain %q, but got %q", s.name, s.stderr, stderr.String())
+		}
+		var want string
+		if s.stdout != "" {
+			// 서버 주소는 테스트마다 다르므로 golden 파일에는 {{server}}로 적는다.
+			golden := testutil.LoadFile(t, filepath.Join("testdata", s.stdout))
+			want = strings.ReplaceAll(string(golden), "{{server}}", srv.URL)
+		}
+		if diff := cmp.Diff(stdout.String(), want); diff != "" {
+			t.Errorf("%s: stdout differs: (-got +want)\n%s", s.name, diff)
+		}
+	}
+
+	if got := srv.Refreshes(); got != 1 {
+		t.Errorf("want 1 refresh, but got %d", got)
+	}
+	if got := srv.Logins(); got != 1 {
+		t.Errorf("want 1 login, but got %d", got)
+	}
+
+	// 로그아웃하면 저장한 토큰 파일을 지운다.
+	if _, err := os.Stat(filepath.Join(dir, credentialsFile)); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("want credentials to be removed, but got %v", err)
+	}
+}
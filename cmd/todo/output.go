@@ -0,0 +1,60 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/gitwub5/go_todo_app/client"
+)
+
+// timeLayout은 마감 시간을 표로 출력할 때의 형식이다.
+const timeLayout = "2006-01-02 15:04"
+
+// outputFormat은 -o 플래그의 값이다.
+type outputFormat string
+
+func (o *outputFormat) String() string { return string(*o) }
+
+func (o *outputFormat) Set(v string) error {
+	switch v {
+	case "table", "json":
+		*o = outputFormat(v)
+		return nil
+	}
+	return fmt.Errorf("unknown output format %q (table or json)", v)
+}
+
+// outputFlag 함수는 출력 형식을 고르는 -o 플래그를 추가한다.
+func outputFlag(fs *flag.FlagSet) *outputFormat {
+	o := outputFormat("table")
+	fs.Var(&o, "o", "output format (table or json)")
+	return &o
+}
+
+func (c *cli) printJSON(v any) error {
+	enc := json.NewEncoder(c.stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// printTasks 메서드는 Task 목록을 out 형식으로 출력한다.
+func (c *cli) printTasks(out outputFormat, ts []client.Task) error {
+	if out == "json" {
+		return c.printJSON(ts)
+	}
+	tw := tabwriter.NewWriter(c.stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tSTATUS\tDUE\tPARENT\tTITLE")
+	for _, t := range ts {
+		due, parent := "-", "-"
+		if t.Due != nil {
+			due = t.Due.In(c.loc).Format(timeLayout)
+		}
+		if t.ParentID != nil {
+			parent = fmt.Sprint(*t.ParentID)
+		}
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%s\n", t.ID, t.Status, due, parent, t.Title)
+	}
+	return tw.Flush()
+}
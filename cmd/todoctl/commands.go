@@ -0,0 +1,453 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/service"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/jmoiron/sqlx"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// runUserCreate 함수는 사용자를 등록한다. -password-stdin이 아니면 비밀번호를 만들어 출력한다.
+func runUserCreate(ctx context.Context, c *cli, args []string) error {
+	fs := c.flagSet("user create")
+	role := fs.String("role", "user", "role of the user")
+	email := fs.String("email", "", "mail address of the user")
+	passwordStdin := fs.Bool("password-stdin", false, "read the password from stdin")
+	if err := c.parse(fs, args, "user name"); err != nil {
+		return err
+	}
+	rsp := &userResult{DryRun: c.dryRun}
+	password, err := c.password(*passwordStdin, rsp)
+	if err != nil {
+		return err
+	}
+	var e *string
+	if *email != "" {
+		e = email
+	}
+
+	err = c.inTx(ctx, func(tx *sqlx.Tx) error {
+		s := &service.RegisterUser{DB: tx, Repo: c.repo}
+		u, err := s.RegisterUser(ctx, fs.Arg(0), password, *role, e)
+		if err != nil {
+			return err
+		}
+		rsp.User = newUserView(u)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return c.print(rsp)
+}
+
+// runUserDisable 함수는 사용자를 비활성화하고 발급한 액세스 토큰과 세션을 모두 폐기한다.
+// 비활성화한 사용자는 로그인할 수 없다.
+func runUserDisable(ctx context.Context, c *cli, args []string) error {
+	fs := c.flagSet("user disable")
+	if err := c.parse(fs, args, "user name"); err != nil {
+		return err
+	}
+	rsp := &userResult{DryRun: c.dryRun}
+	err := c.updateUser(ctx, fs.Arg(0), rsp, func(tx *sqlx.Tx, u *entity.User) error {
+		// 이미 비활성화한 사용자는 처음 비활성화한 시간을 유지한다.
+		if u.Disabled != nil {
+			return nil
+		}
+		now := c.repo.Clocker.Now()
+		u.Disabled = &now
+		return c.repo.UpdateUserDisabled(ctx, tx, u.ID, u.Disabled)
+	})
+	if err != nil {
+		return err
+	}
+	// DB에 반영한 뒤에 폐기해야 그 사이에 로그인한 토큰이 남지 않는다.
+	if err := c.revokeTokens(ctx, rsp); err != nil {
+		return err
+	}
+	return c.print(rsp)
+}
+
+// runUserEnable 함수는 비활성화한 사용자를 다시 활성화한다.
+func runUserEnable(ctx context.Context, c *cli, args []string) error {
+	fs := c.flagSet("user enable")
+	if err := c.parse(fs, args, "user name"); err != nil {
+		return err
+	}
+	rsp := &userResult{DryRun: c.dryRun}
+	err := c.updateUser(ctx, fs.Arg(0), rsp, func(tx *sqlx.Tx, u *entity.User) error {
+		if u.Disabled == nil {
+			return nil
+		}
+		u.Disabled = nil
+		return c.repo.UpdateUserDisabled(ctx, tx, u.ID, nil)
+	})
+	if err != nil {
+		return err
+	}
+	return c.print(rsp)
+}
+
+// runUserResetPassword 함수는 사용자의 비밀번호를 변경하고 세션을 모두 폐기한다. -password-stdin이 아니면 비밀번호를 만들어 출력한다.
+// 세션에서 발급한 액세스 토큰은 세션과 함께 폐기되지만 개인 액세스 토큰은 남으므로, 필요하면 tokens revoke를 함께 실행한다.
+func runUserResetPassword(ctx context.Context, c *cli, args []string) error {
+	fs := c.flagSet("user reset-password")
+	passwordStdin := fs.Bool("password-stdin", false, "read the new password from stdin")
+	if err := c.parse(fs, args, "user name"); err != nil {
+		return err
+	}
+	rsp := &userResult{DryRun: c.dryRun}
+	password, err := c.password(*passwordStdin, rsp)
+	if err != nil {
+		return err
+	}
+	err = c.updateUser(ctx, fs.Arg(0), rsp, func(tx *sqlx.Tx, u *entity.User) error {
+		pw, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+		if err != nil {
+			return fmt.Errorf("cannot hash password: %w", err)
+		}
+		return c.repo.UpdateUserPassword(ctx, tx, u.ID, string(pw))
+	})
+	if err != nil {
+		return err
+	}
+	if err := c.revokeSessions(ctx, rsp); err != nil {
+		return err
+	}
+	return c.print(rsp)
+}
+
+// runUserPromote 함수는 사용자의 역할을 변경하고, 이전 역할이 담긴 액세스 토큰을 더 사용하지 못하도록 세션을 모두 폐기한다.
+func runUserPromote(ctx context.Context, c *cli, args []string) error {
+	fs := c.flagSet("user promote")
+	role := fs.String("role", "admin", "new role of the user")
+	if err := c.parse(fs, args, "user name"); err != nil {
+		return err
+	}
+	if *role == "" {
+		return c.usageError(fs, "role must not be empty")
+	}
+	rsp := &userResult{DryRun: c.dryRun}
+	err := c.updateUser(ctx, fs.Arg(0), rsp, func(tx *sqlx.Tx, u *entity.User) error {
+		u.Role = *role
+		return c.repo.UpdateUserRole(ctx, tx, u.ID, *role)
+	})
+	if err != nil {
+		return err
+	}
+	if err := c.revokeSessions(ctx, rsp); err != nil {
+		return err
+	}
+	return c.print(rsp)
+}
+
+// runTokensRevoke 함수는 사용자에게 발급한 액세스 토큰과 세션, 개인 액세스 토큰을 모두 폐기한다.
+func runTokensRevoke(ctx context.Context, c *cli, args []string) error {
+	fs := c.flagSet("tokens revoke")
+	if err := c.parse(fs, args, "user name"); err != nil {
+		return err
+	}
+	u, err := c.repo.GetUser(ctx, c.db, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	rsp := &userResult{DryRun: c.dryRun, User: newUserView(u)}
+	if err := c.revokeTokens(ctx, rsp); err != nil {
+		return err
+	}
+	return c.print(rsp)
+}
+
+// runPurge 함수는 보관 기간이 지난 읽은 알림과 Webhook 전송 기록을 삭제한다. 기간이 0이면 삭제하지 않는다.
+// 마감 초과 알림은 같은 태스크를 다시 알리지 않도록 남겨 둔다.
+// task_change는 오래된 동기화 토큰으로 요청한 클라이언트가 변경을 놓치지 않도록 삭제하지 않는다.
+func runPurge(ctx context.Context, c *cli, args []string) error {
+	fs := c.flagSet("purge")
+	notificationDays := fs.Int("notification-days", 90, "delete notifications read more than N days ago")
+	deliveryDays := fs.Int("delivery-days", 30, "delete webhook deliveries recorded more than N days ago")
+	if err := c.parse(fs, args, ""); err != nil {
+		return err
+	}
+	if *notificationDays < 0 || *deliveryDays < 0 {
+		return c.usageError(fs, "retention days must not be negative")
+	}
+
+	now := c.repo.Clocker.Now()
+	targets := []struct {
+		name   string
+		days   int
+		delete func(ctx context.Context, db store.Execer, before time.Time) (int64, error)
+	}{
+		{"notification", *notificationDays, c.repo.DeleteReadNotifications},
+		{"webhook_delivery", *deliveryDays, c.repo.DeleteWebhookDeliveries},
+	}
+	rsp := &purgeResult{DryRun: c.dryRun, Deleted: map[string]int64{}}
+	err := c.inTx(ctx, func(tx *sqlx.Tx) error {
+		for _, t := range targets {
+			if t.days == 0 {
+				continue
+			}
+			n, err := t.delete(ctx, tx, now.AddDate(0, 0, -t.days))
+			if err != nil {
+				return fmt.Errorf("failed to purge %s: %w", t.name, err)
+			}
+			rsp.Deleted[t.name] = n
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return c.print(rsp)
+}
+
+// runStats 함수는 DB와 Redis의 통계를 출력한다.
+func runStats(ctx context.Context, c *cli, args []string) error {
+	fs := c.flagSet("stats")
+	if err := c.parse(fs, args, ""); err != nil {
+		return err
+	}
+	s, err := c.repo.Stats(ctx, c.db)
+	if err != nil {
+		return err
+	}
+	keys, err := c.kvs.Cli.DBSize(ctx).Result()
+	if err != nil {
+		return err
+	}
+	tokens, err := c.kvs.ListTokenKeys(ctx, 0)
+	if err != nil {
+		return err
+	}
+	sessions, err := c.kvs.CountSessions(ctx)
+	if err != nil {
+		return err
+	}
+	rsp := &statsResult{DB: s}
+	rsp.Redis.Keys = keys
+	rsp.Redis.AccessTokens = len(tokens)
+	rsp.Redis.Sessions = sessions
+	return c.print(rsp)
+}
+
+// runKeysList 함수는 서명 키 디렉터리의 키 목록을 출력한다.
+func runKeysList(ctx context.Context, c *cli, args []string) error {
+	fs := c.flagSet("keys list")
+	if err := c.parse(fs, args, ""); err != nil {
+		return err
+	}
+	rsp := &keysResult{DryRun: c.dryRun}
+	return c.printKeys(rsp)
+}
+
+// runKeysGenerate 함수는 새 서명 키를 만든다. 새 키는 JWKS로 공개되고 토큰을 검증하는 데 사용되지만,
+// keys activate로 활성화하기 전까지 서명에는 사용하지 않는다.
+func runKeysGenerate(ctx context.Context, c *cli, args []string) error {
+	fs := c.flagSet("keys generate")
+	name := fs.String("alg", c.keyAlgorithm, "signing algorithm of the key (RS256, ES256 or EdDSA)")
+	if err := c.parse(fs, args, ""); err != nil {
+		return err
+	}
+	alg, err := auth.ParseAlgorithm(*name)
+	if err != nil {
+		return c.usageError(fs, "%v", err)
+	}
+	if c.keyDir == "" {
+		return errNoKeyDir
+	}
+	rsp := &keysResult{DryRun: c.dryRun}
+	if !c.dryRun {
+		kid, err := c.keyDir.Generate(alg)
+		if err != nil {
+			return err
+		}
+		rsp.Generated = kid
+	}
+	return c.printKeys(rsp)
+}
+
+// runKeysActivate 함수는 서명에 사용할 키를 바꾼다.
+// 모든 서버가 키를 다시 읽은 뒤에 활성화해야 새 키로 서명한 토큰을 모든 서버에서 검증할 수 있다.
+func runKeysActivate(ctx context.Context, c *cli, args []string) error {
+	fs := c.flagSet("keys activate")
+	if err := c.parse(fs, args, "key ID"); err != nil {
+		return err
+	}
+	kid := fs.Arg(0)
+	if err := c.checkKey(kid); err != nil {
+		return err
+	}
+	if !c.dryRun {
+		if err := c.keyDir.Activate(kid); err != nil {
+			return err
+		}
+	}
+	return c.printKeys(&keysResult{DryRun: c.dryRun})
+}
+
+// runKeysRetire 함수는 키를 삭제해 그 키로 서명한 토큰을 더 이상 검증하지 않는다. 활성화한 키는 폐기할 수 없다.
+// 이전 키로 서명한 액세스 토큰이 모두 만료된 뒤에 폐기하면 사용자는 영향을 받지 않는다.
+func runKeysRetire(ctx context.Context, c *cli, args []string) error {
+	fs := c.flagSet("keys retire")
+	if err := c.parse(fs, args, "key ID"); err != nil {
+		return err
+	}
+	kid := fs.Arg(0)
+	if err := c.checkKey(kid); err != nil {
+		return err
+	}
+	_, active, err := c.keyDir.Keys()
+	if err != nil {
+		return err
+	}
+	if kid == active {
+		return fmt.Errorf("key %q: %w", kid, auth.ErrActiveKey)
+	}
+	if !c.dryRun {
+		if err := c.keyDir.Retire(kid); err != nil {
+			return err
+		}
+	}
+	return c.printKeys(&keysResult{DryRun: c.dryRun})
+}
+
+// errNoKeyDir는 서명 키 디렉터리를 설정하지 않고 keys 명령을 실행했을 때 반환한다.
+var errNoKeyDir = errors.New("signing key directory is not configured (TODO_JWT_KEY_DIR)")
+
+// checkKey 메서드는 서명 키 디렉터리에 kid 키가 있는지 확인한다.
+func (c *cli) checkKey(kid string) error {
+	if c.keyDir == "" {
+		return errNoKeyDir
+	}
+	kids, _, err := c.keyDir.Keys()
+	if err != nil {
+		return err
+	}
+	for _, k := range kids {
+		if k == kid {
+			return nil
+		}
+	}
+	return fmt.Errorf("key %q: not found", kid)
+}
+
+// printKeys 메서드는 서명 키 디렉터리의 키 목록을 rsp에 담아 출력한다.
+func (c *cli) printKeys(rsp *keysResult) error {
+	if c.keyDir == "" {
+		return errNoKeyDir
+	}
+	kids, active, err := c.keyDir.Keys()
+	if err != nil {
+		return err
+	}
+	rsp.Keys = make([]keyView, 0, len(kids))
+	for _, k := range kids {
+		rsp.Keys = append(rsp.Keys, keyView{ID: k, Active: k == active})
+	}
+	return c.print(rsp)
+}
+
+// inTx 메서드는 f를 트랜잭션 안에서 실행한다. -dry-run이면 f가 성공해도 롤백한다.
+func (c *cli) inTx(ctx context.Context, f func(tx *sqlx.Tx) error) error {
+	tx, err := c.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := f(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if c.dryRun {
+		return tx.Rollback()
+	}
+	return tx.Commit()
+}
+
+// updateUser 메서드는 이름으로 조회한 사용자를 트랜잭션 안에서 f로 변경하고, 변경한 사용자를 rsp에 담는다.
+func (c *cli) updateUser(
+	ctx context.Context, name string, rsp *userResult, f func(tx *sqlx.Tx, u *entity.User) error,
+) error {
+	return c.inTx(ctx, func(tx *sqlx.Tx) error {
+		u, err := c.repo.GetUser(ctx, tx, name)
+		if err != nil {
+			return err
+		}
+		if err := f(tx, u); err != nil {
+			return err
+		}
+		rsp.User = newUserView(u)
+		return nil
+	})
+}
+
+// revokeTokens 메서드는 rsp의 사용자에게 발급한 액세스 토큰과 세션, 개인 액세스 토큰을 찾아 폐기한다. -dry-run이면 찾기만 한다.
+func (c *cli) revokeTokens(ctx context.Context, rsp *userResult) error {
+	// 액세스 토큰을 먼저 폐기하면 그 사이에 세션의 리프레시 토큰으로 새 액세스 토큰을 발급받을 수 있다.
+	if err := c.revokeSessions(ctx, rsp); err != nil {
+		return err
+	}
+	tokens, err := c.kvs.ListTokenKeys(ctx, rsp.User.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list tokens: %w", err)
+	}
+	pats, err := c.repo.ListPersonalAccessTokens(ctx, c.db, rsp.User.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list personal access tokens: %w", err)
+	}
+	if !c.dryRun {
+		for _, k := range tokens {
+			if err := c.kvs.Delete(ctx, k); err != nil {
+				return fmt.Errorf("failed to revoke token: %w", err)
+			}
+		}
+		for _, p := range pats {
+			err := c.repo.DeletePersonalAccessToken(ctx, c.db, rsp.User.ID, p.ID)
+			if err != nil && !errors.Is(err, store.ErrNotFound) {
+				return fmt.Errorf("failed to revoke personal access token: %w", err)
+			}
+		}
+	}
+	n, l := len(tokens), len(pats)
+	rsp.RevokedTokens, rsp.RevokedPATs = &n, &l
+	return nil
+}
+
+// revokeSessions 메서드는 rsp의 사용자의 세션을 찾아 세션의 리프레시 토큰과 함께 폐기한다. -dry-run이면 찾기만 한다.
+func (c *cli) revokeSessions(ctx context.Context, rsp *userResult) error {
+	sessions, err := c.kvs.ListSessions(ctx, rsp.User.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list sessions: %w", err)
+	}
+	if !c.dryRun {
+		for _, s := range sessions {
+			if err := c.kvs.DeleteSession(ctx, s.ID); err != nil && !errors.Is(err, store.ErrNotFound) {
+				return fmt.Errorf("failed to revoke session: %w", err)
+			}
+		}
+	}
+	m := len(sessions)
+	rsp.RevokedSessions = &m
+	return nil
+}
+
+// password 메서드는 -password-stdin이면 표준 입력에서 비밀번호를 읽고, 아니면 새로 만들어 rsp에 담는다.
+func (c *cli) password(stdin bool, rsp *userResult) (string, error) {
+	if stdin {
+		return c.readPassword()
+	}
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	rsp.Password = base64.RawURLEncoding.EncodeToString(b)
+	return rsp.Password, nil
+}
@@ -0,0 +1,222 @@
+// todoctl은 API 서버를 거치지 않고 DB와 Redis를 직접 다루는 운영자용 커맨드라인 도구이다.
+//
+//	todoctl user create [-role ROLE] [-email EMAIL] [-password-stdin] NAME
+//	todoctl user disable NAME
+//	todoctl user enable NAME
+//	todoctl user reset-password [-password-stdin] NAME
+//	todoctl user promote [-role ROLE] NAME
+//	todoctl tokens revoke NAME
+//	todoctl keys list
+//	todoctl keys generate [-alg RS256|ES256|EdDSA]
+//	todoctl keys activate KID
+//	todoctl keys retire KID
+//	todoctl purge [-notification-days N] [-delivery-days N]
+//	todoctl stats
+//
+// 접속 정보와 서명 키 디렉터리(TODO_JWT_KEY_DIR)는 API 서버와 같은 환경 변수(config.Config)에서 읽는다.
+// 모든 명령은 -dry-run이면 DB 변경을 트랜잭션 안에서 실행한 뒤 롤백하고 Redis의 키와 서명 키는 바꾸지 않으므로,
+// 실제로 실행했을 때의 결과를 미리 확인할 수 있다. -o json이면 결과를 JSON으로 출력한다. (기본값은 text)
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/clock"
+	"github.com/gitwub5/go_todo_app/config"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/jmoiron/sqlx"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+)
+
+func main() {
+	c := &cli{stdin: os.Stdin, stdout: os.Stdout, stderr: os.Stderr}
+	os.Exit(c.run(context.Background(), os.Args[1:]))
+}
+
+// errUsage는 명령이나 인자가 잘못되었을 때 반환한다. 사용법은 이미 출력한 상태이다.
+var errUsage = errors.New("usage")
+
+// cli는 명령을 실행하는 데 필요한 입출력과 저장소이다.
+type cli struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+	// db, kvs가 nil이면 config.Config의 접속 정보로 연결한다.
+	db   *sqlx.DB
+	kvs  *store.KVS
+	repo *store.Repository
+	// keyDir은 액세스 토큰의 서명 키를 보관하는 디렉터리이다. 비어 있으면 keys 명령을 실행할 수 없다.
+	keyDir auth.KeyDir
+	// keyAlgorithm은 keys generate가 기본으로 사용하는 서명 알고리즘이다. 비어 있으면 RS256이다.
+	keyAlgorithm string
+
+	// 모든 명령에 공통인 플래그
+	dryRun bool
+	output outputFormat
+}
+
+// command는 하위 명령이다. args는 하위 명령 이름을 제외한 인자이다.
+type command struct {
+	usage string
+	run   func(ctx context.Context, c *cli, args []string) error
+}
+
+var commands map[string]command
+
+// 하위 명령은 사용법을 출력할 때 commands를 참조하므로 init에서 등록한다.
+// 이름이 두 단어인 명령은 "user create"처럼 공백으로 구분해 등록한다.
+func init() {
+	commands = map[string]command{
+		"user create":         {"user create [-role ROLE] [-email EMAIL] [-password-stdin] NAME", runUserCreate},
+		"user disable":        {"user disable NAME", runUserDisable},
+		"user enable":         {"user enable NAME", runUserEnable},
+		"user reset-password": {"user reset-password [-password-stdin] NAME", runUserResetPassword},
+		"user promote":        {"user promote [-role ROLE] NAME", runUserPromote},
+		"tokens revoke":       {"tokens revoke NAME", runTokensRevoke},
+		"keys list":           {"keys list", runKeysList},
+		"keys generate":       {"keys generate [-alg RS256|ES256|EdDSA]", runKeysGenerate},
+		"keys activate":       {"keys activate KID", runKeysActivate},
+		"keys retire":         {"keys retire KID", runKeysRetire},
+		"purge":               {"purge [-notification-days N] [-delivery-days N]", runPurge},
+		"stats":               {"stats", runStats},
+	}
+}
+
+// run 메서드는 명령을 실행하고 종료 코드를 반환한다.
+func (c *cli) run(ctx context.Context, args []string) int {
+	name, cmd, rest, ok := lookup(args)
+	if !ok {
+		usage(c.stderr)
+		return 2
+	}
+	if c.db == nil || c.kvs == nil {
+		cleanup, err := c.connect(ctx)
+		defer cleanup()
+		if err != nil {
+			fmt.Fprintf(c.stderr, "todoctl: %v\n", err)
+			return 1
+		}
+	}
+	if c.repo == nil {
+		c.repo = &store.Repository{Clocker: clock.RealClocker{}}
+	}
+	if c.keyAlgorithm == "" {
+		c.keyAlgorithm = jwa.RS256.String()
+	}
+	if err := cmd.run(ctx, c, rest); err != nil {
+		if errors.Is(err, errUsage) {
+			return 2
+		}
+		fmt.Fprintf(c.stderr, "todoctl %s: %v\n", name, err)
+		return 1
+	}
+	return 0
+}
+
+// lookup 함수는 인자의 앞부분에서 하위 명령을 찾는다. 두 단어 명령을 먼저 찾는다.
+func lookup(args []string) (string, command, []string, bool) {
+	if len(args) >= 2 {
+		name := args[0] + " " + args[1]
+		if cmd, ok := commands[name]; ok {
+			return name, cmd, args[2:], true
+		}
+	}
+	if len(args) >= 1 {
+		if cmd, ok := commands[args[0]]; ok {
+			return args[0], cmd, args[1:], true
+		}
+	}
+	return "", command{}, nil, false
+}
+
+// connect 메서드는 config.Config의 접속 정보로 DB와 Redis에 연결한다.
+func (c *cli) connect(ctx context.Context) (func(), error) {
+	cfg, err := config.New()
+	if err != nil {
+		return func() {}, err
+	}
+	db, cleanup, err := store.New(ctx, cfg)
+	if err != nil {
+		return cleanup, fmt.Errorf("failed to connect database: %w", err)
+	}
+	kvs, err := store.NewKVS(ctx, cfg)
+	if err != nil {
+		return cleanup, fmt.Errorf("failed to connect redis: %w", err)
+	}
+	c.db, c.kvs = db, kvs
+	c.keyDir, c.keyAlgorithm = auth.KeyDir(cfg.JWTKeyDir), cfg.JWTAlgorithm
+	return func() {
+		_ = kvs.Cli.Close()
+		cleanup()
+	}, nil
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "usage: todoctl COMMAND [-dry-run] [-o text|json] [ARGS]")
+	names := make([]string, 0, len(commands))
+	for n := range commands {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	for _, n := range names {
+		fmt.Fprintf(w, "  todoctl %s\n", commands[n].usage)
+	}
+}
+
+// flagSet 메서드는 -dry-run, -o 플래그를 추가한 하위 명령의 플래그를 만든다.
+// 잘못된 플래그는 하위 명령의 사용법과 함께 출력한다.
+func (c *cli) flagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(c.stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(c.stderr, "usage: todoctl %s\n", commands[name].usage)
+		fs.PrintDefaults()
+	}
+	fs.BoolVar(&c.dryRun, "dry-run", false, "show what would be changed without changing anything")
+	c.output = "text"
+	fs.Var(&c.output, "o", "output format (text or json)")
+	return fs
+}
+
+// parse 메서드는 플래그를 해석하고, 실패하면 errUsage를 반환한다.
+// 인자를 하나 받는 명령은 arg에 인자의 이름(예: "user name")을 전달하며, 인자가 정확히 하나가 아니면 사용법을 출력한다.
+func (c *cli) parse(fs *flag.FlagSet, args []string, arg string) error {
+	if err := fs.Parse(args); err != nil {
+		return errUsage
+	}
+	switch {
+	case arg != "" && fs.NArg() != 1:
+		return c.usageError(fs, "exactly one %s is required", arg)
+	case arg == "" && fs.NArg() > 0:
+		return c.usageError(fs, "unexpected arguments %q", fs.Args())
+	}
+	return nil
+}
+
+// usageError 메서드는 사용법을 출력하고 errUsage를 반환한다.
+func (c *cli) usageError(fs *flag.FlagSet, format string, a ...any) error {
+	fmt.Fprintf(c.stderr, "todoctl %s: %s\n", fs.Name(), fmt.Sprintf(format, a...))
+	fs.Usage()
+	return errUsage
+}
+
+// readPassword 메서드는 표준 입력 전체를 비밀번호로 읽는다.
+func (c *cli) readPassword() (string, error) {
+	b, err := io.ReadAll(c.stdin)
+	if err != nil {
+		return "", err
+	}
+	pw := strings.TrimRight(string(b), "\r\n")
+	if pw == "" {
+		return "", errors.New("password from stdin is empty")
+	}
+	return pw, nil
+}
@@ -0,0 +1,445 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/clock"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/gitwub5/go_todo_app/testutil"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+)
+
+// newCLI 함수는 테스트용 DB와 Redis를 사용하는 cli를 만든다.
+func newCLI(t *testing.T, db *sqlx.DB, kvs *store.KVS, c clock.Clocker) func(stdin string) (*cli, *bytes.Buffer, *bytes.Buffer) {
+	t.Helper()
+	return func(stdin string) (*cli, *bytes.Buffer, *bytes.Buffer) {
+		var stdout, stderr bytes.Buffer
+		return &cli{
+			stdin: strings.NewReader(stdin), stdout: &stdout, stderr: &stderr,
+			db: db, kvs: kvs, repo: &store.Repository{Clocker: c},
+		}, &stdout, &stderr
+	}
+}
+
+func TestCLI_User(t *testing.T) {
+	db := testutil.OpenDBForTest(t)
+	kvs := &store.KVS{Cli: testutil.OpenRedisForTest(t)}
+	ctx := context.Background()
+	repo := &store.Repository{Clocker: clock.FixedClocker{}}
+	newCLI := newCLI(t, db, kvs, clock.FixedClocker{})
+
+	// 다른 테스트와 겹치지 않는 사용자 이름을 사용한다.
+	name := fmt.Sprintf("ctl%x", time.Now().UnixNano())
+	var uid entity.UserID
+	// saveToken 함수는 사용자에게 액세스 토큰을 발급한 것처럼 Redis에 JTI를 저장한다.
+	saveToken := func(t *testing.T) string {
+		key := uuid.New().String()
+		if err := kvs.Save(ctx, key, uid, time.Minute); err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { _ = kvs.Delete(ctx, key) })
+		return key
+	}
+	var tokens []string
+	// saveRefreshToken 함수는 사용자가 로그인한 것처럼 Redis에 세션과 리프레시 토큰을 저장한다.
+	saveRefreshToken := func(t *testing.T) string {
+		s := &entity.Session{ID: entity.SessionID(uuid.New().String()), UserID: uid, IssuedAt: time.Now()}
+		if err := kvs.SaveSession(ctx, s, time.Minute); err != nil {
+			t.Fatal(err)
+		}
+		key := uuid.New().String()
+		if err := kvs.SaveRefreshToken(ctx, key, s.ID, uid, time.Minute); err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { _ = kvs.DeleteSession(ctx, s.ID) })
+		return key
+	}
+	var refreshTokens []string
+	// assertSessionsRevoked 함수는 지금까지 저장한 세션의 리프레시 토큰이 모두 폐기되었는지 확인한다.
+	assertSessionsRevoked := func(t *testing.T) {
+		t.Helper()
+		for _, k := range refreshTokens {
+			if _, _, _, err := kvs.UseRefreshToken(ctx, k); !errors.Is(err, store.ErrNotFound) {
+				t.Errorf("want refresh token %s to be revoked, but got %v", k, err)
+			}
+		}
+	}
+	// savePAT 함수는 사용자가 개인 액세스 토큰을 발급한 것처럼 DB에 저장한다.
+	savePAT := func(t *testing.T) *entity.PersonalAccessToken {
+		_, hash, err := auth.NewPersonalAccessToken()
+		if err != nil {
+			t.Fatal(err)
+		}
+		p := &entity.PersonalAccessToken{UserID: uid, Name: "ci", TokenHash: hash, Scopes: entity.AllScopes}
+		if err := repo.AddPersonalAccessToken(ctx, db, p); err != nil {
+			t.Fatal(err)
+		}
+		return p
+	}
+	var pats []*entity.PersonalAccessToken
+
+	// -dry-run으로 등록한 사용자는 남아 있지 않아야 한다.
+	c, _, stderr := newCLI("secret\n")
+	if code := c.run(ctx, []string{"user", "create", "-dry-run", "-password-stdin", name}); code != 0 {
+		t.Fatalf("want exit code 0, but got %d, stderr: %q", code, stderr.String())
+	}
+	if _, err := repo.GetUser(ctx, db, name); !errors.Is(err, store.ErrNotFound) {
+		t.Fatalf("want ErrNotFound after dry run, but got %v", err)
+	}
+
+	// 명령은 순서대로 실행하며, 앞의 명령이 변경한 사용자를 뒤의 명령이 사용한다.
+	steps := []struct {
+		name   string
+		setup  func(t *testing.T)
+		args   []string
+		stdin  string
+		code   int
+		stdout string // golden 파일 이름. 비어 있으면 출력이 없어야 한다.
+		stderr string // stderr에 포함되어야 하는 문자열
+		check  func(t *testing.T)
+	}{
+		{
+			name:   "create",
+			args:   []string{"user", "create", "-email", "ops@example.com", "-password-stdin", name},
+			stdin:  "secret\n",
+			stdout: "user_create.golden",
+		},
+		{name: "createDuplicate", args: []string{"user", "create", "-password-stdin", name}, stdin: "secret", code: 1, stderr: "duplicate entry"},
+		{
+			name:   "disableDryRun",
+			setup:  func(t *testing.T) { tokens = append(tokens, saveToken(t), saveToken(t)) },
+			args:   []string{"user", "disable", "-dry-run", "-o", "json", name},
+			stdout: "user_disable_dry_run.json.golden",
+		},
+		{name: "disable", args: []string{"user", "disable", name}, stdout: "user_disable.golden"},
+		{name: "enable", args: []string{"user", "enable", "-o", "json", name}, stdout: "user_enable.json.golden"},
+		// 역할이나 비밀번호를 바꾸면 세션을 모두 폐기한다.
+		{
+			name:   "promote",
+			setup:  func(t *testing.T) { refreshTokens = append(refreshTokens, saveRefreshToken(t)) },
+			args:   []string{"user", "promote", name},
+			stdout: "user_promote.golden",
+			check:  assertSessionsRevoked,
+		},
+		{
+			name:   "resetPassword",
+			setup:  func(t *testing.T) { refreshTokens = append(refreshTokens, saveRefreshToken(t)) },
+			args:   []string{"user", "reset-password", "-password-stdin", name},
+			stdin:  "changed\n",
+			stdout: "user_promote.golden",
+			check:  assertSessionsRevoked,
+		},
+		{
+			name: "revokeDryRun",
+			setup: func(t *testing.T) {
+				tokens = append(tokens, saveToken(t))
+				refreshTokens = append(refreshTokens, saveRefreshToken(t))
+				pats = append(pats, savePAT(t))
+			},
+			args:   []string{"tokens", "revoke", "-dry-run", name},
+			stdout: "tokens_revoke_dry_run.golden",
+		},
+		{name: "revoke", args: []string{"tokens", "revoke", "-o", "json", name}, stdout: "tokens_revoke.json.golden"},
+		{name: "notFound", args: []string{"user", "disable", name + "x"}, code: 1, stderr: "not found"},
+		{name: "noName", args: []string{"user", "enable"}, code: 2, stderr: "exactly one user name is required"},
+		{name: "badOutput", args: []string{"stats", "-o", "yaml"}, code: 2, stderr: `unknown output format "yaml"`},
+		{name: "unknownCommand", args: []string{"user", "remove", name}, code: 2, stderr: "usage: todoctl"},
+	}
+	for _, s := range steps {
+		if s.setup != nil {
+			s.setup(t)
+		}
+		c, stdout, stderr := newCLI(s.stdin)
+		code := c.run(ctx, s.args)
+
+		if code != s.code {
+			t.Fatalf("%s: want exit code %d, but got %d, stderr: %q", s.name, s.code, code, stderr.String())
+		}
+		if !strings.Contains(stderr.String(), s.stderr) {
+			t.Errorf("%s: want stderr to contain %q, but got %q", s.name, s.stderr, stderr.String())
+		}
+		if s.name == "create" {
+			u, err := repo.GetUser(ctx, db, name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			uid = u.ID
+		}
+		var want string
+		if s.stdout != "" {
+			// 사용자 이름과 ID는 테스트마다 다르므로 golden 파일에는 {{name}}, {{id}}로 적는다.
+			golden := testutil.LoadFile(t, filepath.Join("testdata", s.stdout))
+			want = strings.NewReplacer("{{name}}", name, "{{id}}", fmt.Sprint(uid)).Replace(string(golden))
+		}
+		if diff := cmp.Diff(stdout.String(), want); diff != "" {
+			t.Errorf("%s: stdout differs: (-got +want)\n%s", s.name, diff)
+		}
+		if s.check != nil {
+			s.check(t)
+		}
+	}
+
+	// 모든 토큰이 폐기되고, 변경한 비밀번호로 로그인할 수 있어야 한다.
+	for _, k := range tokens {
+		if _, err := kvs.Load(ctx, k); !errors.Is(err, store.ErrNotFound) {
+			t.Errorf("want token %s to be revoked, but got %v", k, err)
+		}
+	}
+	assertSessionsRevoked(t)
+	for _, p := range pats {
+		if _, err := repo.GetPersonalAccessTokenByHash(ctx, db, p.TokenHash); !errors.Is(err, store.ErrNotFound) {
+			t.Errorf("want personal access token %d to be revoked, but got %v", p.ID, err)
+		}
+	}
+	u, err := repo.GetUser(ctx, db, name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := u.ComparePassword("changed"); err != nil {
+		t.Errorf("want password to be changed, but got %v", err)
+	}
+}
+
+// pastClocker는 다른 테스트가 기록한 데이터보다 이전 시점을 현재로 사용한다.
+// purge는 모든 사용자의 데이터를 삭제하므로 다른 테스트가 기록한 데이터는 삭제되지 않도록 한다.
+type pastClocker struct{}
+
+func (pastClocker) Now() time.Time {
+	return time.Date(2000, 4, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func TestCLI_Purge(t *testing.T) {
+	db := testutil.OpenDBForTest(t)
+	kvs := &store.KVS{Cli: testutil.OpenRedisForTest(t)}
+	ctx := context.Background()
+	newCLI := newCLI(t, db, kvs, pastClocker{})
+
+	// 현재(pastClocker) 기준으로 100일 전과 10일 전의 알림과 Webhook 전송 기록을 만든다.
+	name := fmt.Sprintf("ctl%x", time.Now().UnixNano())
+	res, err := db.ExecContext(ctx,
+		`INSERT INTO user (name, password, role, created, modified) VALUES (?, 'x', 'user', NOW(6), NOW(6))`, name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	uid, _ := res.LastInsertId()
+	var wid int64
+	// 다른 테스트와 DB를 함께 사용하므로 남은 레코드 수는 이 테스트가 만든 레코드의 ID로 한정해 센다.
+	var nids, dids []int64
+	t.Cleanup(func() {
+		_, _ = db.ExecContext(ctx, `DELETE FROM webhook_delivery WHERE webhook_id = ?`, wid)
+		_, _ = db.ExecContext(ctx, `DELETE FROM webhook WHERE id = ?`, wid)
+		_, _ = db.ExecContext(ctx, `DELETE FROM notification WHERE user_id = ?`, uid)
+		_, _ = db.ExecContext(ctx, `DELETE FROM user WHERE id = ?`, uid)
+	})
+	res, err = db.ExecContext(ctx,
+		`INSERT INTO webhook (user_id, url, events, secret, created, modified) VALUES (?, 'http://example.com', '[]', 's', NOW(6), NOW(6))`, uid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wid, _ = res.LastInsertId()
+	now := pastClocker{}.Now()
+	for _, days := range []int{100, 10} {
+		at := now.AddDate(0, 0, -days)
+		// 읽은 알림, 읽지 않은 알림, 읽은 마감 초과 알림
+		for _, n := range []struct {
+			typ    entity.NotificationType
+			readAt *time.Time
+		}{
+			{entity.NotificationTaskAssigned, &at},
+			{entity.NotificationTaskAssigned, nil},
+			{entity.NotificationTaskOverdue, &at},
+		} {
+			res, err := db.ExecContext(ctx,
+				`INSERT INTO notification (user_id, type, message, read_at, created) VALUES (?, ?, 'm', ?, ?)`,
+				uid, n.typ, n.readAt, at)
+			if err != nil {
+				t.Fatal(err)
+			}
+			id, _ := res.LastInsertId()
+			nids = append(nids, id)
+		}
+		res, err := db.ExecContext(ctx,
+			`INSERT INTO webhook_delivery (webhook_id, delivery_id, event, attempt, created) VALUES (?, 'd', 'task.created', 1, ?)`,
+			wid, at)
+		if err != nil {
+			t.Fatal(err)
+		}
+		id, _ := res.LastInsertId()
+		dids = append(dids, id)
+	}
+	count := func(t *testing.T, table string) int {
+		t.Helper()
+		ids := nids
+		if table == "webhook_delivery" {
+			ids = dids
+		}
+		q, args, err := sqlx.In(fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE id IN (?)", table), ids)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var n int
+		if err := db.GetContext(ctx, &n, q, args...); err != nil {
+			t.Fatal(err)
+		}
+		return n
+	}
+
+	// 출력한 삭제 수에는 다른 테스트의 레코드가 포함될 수 있으므로 이 테스트가 만든 레코드가 포함되는지만 확인한다.
+	tests := []struct {
+		name   string
+		args   []string
+		dryRun bool
+		// 출력한 삭제 수의 하한. 키가 없는 테이블은 출력하지 않아야 한다.
+		deleted map[string]int64
+		// 실행한 뒤에 남아 있어야 하는 레코드 수
+		notifications, deliveries int
+	}{
+		{"deliveriesOnly", []string{"purge", "-notification-days", "0"}, false, map[string]int64{"webhook_delivery": 1}, 6, 1},
+		// 읽지 않은 알림과 마감 초과 알림은 오래되어도 삭제하지 않는다.
+		{"dryRun", []string{"purge", "-dry-run", "-notification-days", "5", "-delivery-days", "5"}, true, map[string]int64{"notification": 2, "webhook_delivery": 1}, 6, 1},
+		{"all", []string{"purge", "-notification-days", "5", "-delivery-days", "5"}, false, map[string]int64{"notification": 2, "webhook_delivery": 1}, 4, 0},
+	}
+	for _, tt := range tests {
+		c, stdout, stderr := newCLI("")
+		if code := c.run(ctx, append(tt.args, "-o", "json")); code != 0 {
+			t.Fatalf("%s: want exit code 0, but got %d, stderr: %q", tt.name, code, stderr.String())
+		}
+		var got purgeResult
+		if err := json.Unmarshal(stdout.Bytes(), &got); err != nil {
+			t.Fatalf("%s: cannot parse output: %v", tt.name, err)
+		}
+		if got.DryRun != tt.dryRun {
+			t.Errorf("%s: want dry_run %v, but got %v", tt.name, tt.dryRun, got.DryRun)
+		}
+		if d := cmp.Diff(sortedKeys(got.Deleted), sortedKeys(tt.deleted)); d != "" {
+			t.Errorf("%s: tables differ: (-got +want)\n%s", tt.name, d)
+		}
+		for table, n := range tt.deleted {
+			if got.Deleted[table] < n {
+				t.Errorf("%s: want at least %d %s deleted, but got %d", tt.name, n, table, got.Deleted[table])
+			}
+		}
+		if got := count(t, "notification"); got != tt.notifications {
+			t.Errorf("%s: want %d notifications, but got %d", tt.name, tt.notifications, got)
+		}
+		if got := count(t, "webhook_delivery"); got != tt.deliveries {
+			t.Errorf("%s: want %d deliveries, but got %d", tt.name, tt.deliveries, got)
+		}
+	}
+}
+
+func TestCLI_Stats(t *testing.T) {
+	db := testutil.OpenDBForTest(t)
+	kvs := &store.KVS{Cli: testutil.OpenRedisForTest(t)}
+	c, stdout, stderr := newCLI(t, db, kvs, clock.FixedClocker{})("")
+
+	if code := c.run(context.Background(), []string{"stats", "-o", "json"}); code != 0 {
+		t.Fatalf("want exit code 0, but got %d, stderr: %q", code, stderr.String())
+	}
+	// 다른 테스트와 DB를 함께 사용하므로 값이 아닌 형태만 확인한다.
+	var got statsResult
+	if err := json.Unmarshal(stdout.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	for _, table := range []string{"user", "task", "notification", "webhook_delivery"} {
+		if _, ok := got.DB.Rows[table]; !ok {
+			t.Errorf("want rows of %s", table)
+		}
+	}
+	if got.DB.UsersByRole == nil || got.DB.TasksByStatus == nil {
+		t.Errorf("want users by role and tasks by status, but got %+v", got.DB)
+	}
+}
+
+func TestCLI_Keys(t *testing.T) {
+	db := testutil.OpenDBForTest(t)
+	kvs := &store.KVS{Cli: testutil.OpenRedisForTest(t)}
+	ctx := context.Background()
+	newCLI := newCLI(t, db, kvs, clock.FixedClocker{})
+	dir := auth.KeyDir(t.TempDir())
+
+	// run 함수는 명령을 -o json으로 실행하고 결과를 반환한다.
+	run := func(t *testing.T, code int, args ...string) (*keysResult, string) {
+		t.Helper()
+		c, stdout, stderr := newCLI("")
+		c.keyDir = dir
+		// 플래그는 인자보다 앞에 있어야 한다.
+		args = append([]string{args[0], args[1], "-o", "json"}, args[2:]...)
+		if got := c.run(ctx, args); got != code {
+			t.Fatalf("%v: want exit code %d, but got %d, stderr: %q", args, code, got, stderr.String())
+		}
+		var rsp keysResult
+		if code == 0 {
+			if err := json.Unmarshal(stdout.Bytes(), &rsp); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return &rsp, stderr.String()
+	}
+	generate := func(t *testing.T, args ...string) string {
+		t.Helper()
+		rsp, _ := run(t, 0, append([]string{"keys", "generate"}, args...)...)
+		if rsp.Generated == "" {
+			t.Fatal("want generated key ID")
+		}
+		return rsp.Generated
+	}
+
+	first := generate(t)
+	run(t, 0, "keys", "activate", first)
+	// 알고리즘이 다른 키로도 교체할 수 있다.
+	second := generate(t, "-alg", "EdDSA")
+	if _, stderr := run(t, 2, "keys", "generate", "-alg", "HS256"); !strings.Contains(stderr, "unsupported signing algorithm") {
+		t.Errorf("want unsupported algorithm error, but got %q", stderr)
+	}
+	// 새 키를 활성화하기 전에는 이전 키로 서명한다.
+	rsp, _ := run(t, 0, "keys", "list")
+	want := []keyView{{ID: first, Active: true}, {ID: second}}
+	sort.Slice(want, func(i, j int) bool { return want[i].ID < want[j].ID })
+	if diff := cmp.Diff(rsp.Keys, want); diff != "" {
+		t.Errorf("keys differs: (-got +want)\n%s", diff)
+	}
+
+	// 활성화한 키는 폐기할 수 없다.
+	if _, stderr := run(t, 1, "keys", "retire", first); !strings.Contains(stderr, auth.ErrActiveKey.Error()) {
+		t.Errorf("want ErrActiveKey, but got %q", stderr)
+	}
+	run(t, 0, "keys", "activate", second)
+	// -dry-run이면 폐기하지 않는다.
+	if rsp, _ := run(t, 0, "keys", "retire", "-dry-run", first); !rsp.DryRun || len(rsp.Keys) != 2 {
+		t.Errorf("want 2 keys after dry run, but got %+v", rsp)
+	}
+	rsp, _ = run(t, 0, "keys", "retire", first)
+	if diff := cmp.Diff(rsp.Keys, []keyView{{ID: second, Active: true}}); diff != "" {
+		t.Errorf("keys differs: (-got +want)\n%s", diff)
+	}
+	if _, stderr := run(t, 1, "keys", "activate", first); !strings.Contains(stderr, "not found") {
+		t.Errorf("want not found error, but got %q", stderr)
+	}
+
+	// 서버가 읽을 수 있는 키 디렉터리여야 한다.
+	ks, err := dir.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := ks.SigningKeyID(); got != second {
+		t.Errorf("want signing key %q, but got %q", second, got)
+	}
+	if got := ks.SigningAlgorithm(); got != jwa.EdDSA {
+		t.Errorf("want signing algorithm EdDSA, but got %s", got)
+	}
+}
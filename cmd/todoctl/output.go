@@ -0,0 +1,194 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+)
+
+// outputFormat은 -o 플래그의 값이다.
+type outputFormat string
+
+func (o *outputFormat) String() string { return string(*o) }
+
+func (o *outputFormat) Set(v string) error {
+	switch v {
+	case "text", "json":
+		*o = outputFormat(v)
+		return nil
+	}
+	return fmt.Errorf("unknown output format %q (text or json)", v)
+}
+
+// result는 명령의 실행 결과이다. -o text이면 writeText로 출력한다.
+type result interface {
+	writeText(w io.Writer)
+}
+
+// print 메서드는 명령의 실행 결과를 -o 형식으로 출력한다.
+func (c *cli) print(r result) error {
+	if c.output == "json" {
+		enc := json.NewEncoder(c.stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(r)
+	}
+	tw := tabwriter.NewWriter(c.stdout, 0, 4, 2, ' ', 0)
+	r.writeText(tw)
+	return tw.Flush()
+}
+
+// userView는 출력하는 사용자 정보이다. 비밀번호 해시는 출력하지 않는다.
+type userView struct {
+	ID       entity.UserID `json:"id"`
+	Name     string        `json:"name"`
+	Role     string        `json:"role"`
+	Email    *string       `json:"email"`
+	Disabled *time.Time    `json:"disabled"`
+}
+
+func newUserView(u *entity.User) *userView {
+	return &userView{ID: u.ID, Name: u.Name, Role: u.Role, Email: u.Email, Disabled: u.Disabled}
+}
+
+// userResult는 사용자를 다루는 명령의 결과이다.
+type userResult struct {
+	DryRun bool      `json:"dry_run"`
+	User   *userView `json:"user"`
+	// Password는 비밀번호를 입력받지 않았을 때 새로 만든 비밀번호이다.
+	Password string `json:"password,omitempty"`
+	// RevokedTokens는 폐기한(-dry-run이면 폐기할) 액세스 토큰 수이다. 토큰을 폐기하지 않는 명령은 nil이다.
+	RevokedTokens *int `json:"revoked_tokens,omitempty"`
+	// RevokedSessions는 폐기한(-dry-run이면 폐기할) 세션 수이다. 세션을 폐기하면 세션의 리프레시 토큰도 폐기된다.
+	RevokedSessions *int `json:"revoked_sessions,omitempty"`
+	// RevokedPATs는 폐기한(-dry-run이면 폐기할) 개인 액세스 토큰 수이다.
+	RevokedPATs *int `json:"revoked_personal_access_tokens,omitempty"`
+}
+
+func (r *userResult) writeText(w io.Writer) {
+	writeDryRun(w, r.DryRun)
+	email, disabled := "-", "-"
+	if r.User.Email != nil {
+		email = *r.User.Email
+	}
+	if r.User.Disabled != nil {
+		disabled = r.User.Disabled.UTC().Format(time.RFC3339)
+	}
+	fmt.Fprintf(w, "id:\t%d\n", r.User.ID)
+	fmt.Fprintf(w, "name:\t%s\n", r.User.Name)
+	fmt.Fprintf(w, "role:\t%s\n", r.User.Role)
+	fmt.Fprintf(w, "email:\t%s\n", email)
+	fmt.Fprintf(w, "disabled:\t%s\n", disabled)
+	if r.Password != "" {
+		fmt.Fprintf(w, "password:\t%s\n", r.Password)
+	}
+	if r.RevokedTokens != nil {
+		fmt.Fprintf(w, "revoked tokens:\t%d\n", *r.RevokedTokens)
+	}
+	if r.RevokedSessions != nil {
+		fmt.Fprintf(w, "revoked sessions:\t%d\n", *r.RevokedSessions)
+	}
+	if r.RevokedPATs != nil {
+		fmt.Fprintf(w, "revoked PATs:\t%d\n", *r.RevokedPATs)
+	}
+}
+
+// purgeResult는 purge 명령의 결과이다.
+type purgeResult struct {
+	DryRun bool `json:"dry_run"`
+	// Deleted는 테이블별로 삭제한(-dry-run이면 삭제할) 레코드 수이다.
+	Deleted map[string]int64 `json:"deleted"`
+}
+
+func (r *purgeResult) writeText(w io.Writer) {
+	writeDryRun(w, r.DryRun)
+	fmt.Fprintln(w, "TABLE\tDELETED")
+	for _, k := range sortedKeys(r.Deleted) {
+		fmt.Fprintf(w, "%s\t%d\n", k, r.Deleted[k])
+	}
+}
+
+// keysResult는 keys 명령의 결과이다.
+type keysResult struct {
+	DryRun bool `json:"dry_run"`
+	// Generated는 keys generate로 만든 키의 kid이다.
+	Generated string    `json:"generated,omitempty"`
+	Keys      []keyView `json:"keys"`
+}
+
+// keyView는 출력하는 서명 키 정보이다. 개인 키는 출력하지 않는다.
+type keyView struct {
+	ID     string `json:"kid"`
+	Active bool   `json:"active"`
+}
+
+func (r *keysResult) writeText(w io.Writer) {
+	writeDryRun(w, r.DryRun)
+	if r.Generated != "" {
+		fmt.Fprintf(w, "generated:\t%s\n", r.Generated)
+		fmt.Fprintln(w)
+	}
+	fmt.Fprintln(w, "KID\tSTATUS")
+	for _, k := range r.Keys {
+		// 활성화하지 않은 키는 토큰을 검증하는 데만 사용한다.
+		status := "verify only"
+		if k.Active {
+			status = "active"
+		}
+		fmt.Fprintf(w, "%s\t%s\n", k.ID, status)
+	}
+}
+
+// statsResult는 stats 명령의 결과이다.
+type statsResult struct {
+	DB    *store.Stats `json:"db"`
+	Redis struct {
+		Keys         int64 `json:"keys"`
+		AccessTokens int   `json:"access_tokens"`
+		Sessions     int   `json:"sessions"`
+	} `json:"redis"`
+}
+
+func (r *statsResult) writeText(w io.Writer) {
+	fmt.Fprintln(w, "TABLE\tROWS")
+	for _, k := range sortedKeys(r.DB.Rows) {
+		fmt.Fprintf(w, "%s\t%d\n", k, r.DB.Rows[k])
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "ROLE\tUSERS")
+	for _, k := range sortedKeys(r.DB.UsersByRole) {
+		fmt.Fprintf(w, "%s\t%d\n", k, r.DB.UsersByRole[k])
+	}
+	fmt.Fprintf(w, "(disabled)\t%d\n", r.DB.DisabledUsers)
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "STATUS\tTASKS")
+	for _, k := range sortedKeys(r.DB.TasksByStatus) {
+		fmt.Fprintf(w, "%s\t%d\n", k, r.DB.TasksByStatus[k])
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "unread notifications:\t%d\n", r.DB.UnreadNotifications)
+	fmt.Fprintf(w, "redis keys:\t%d\n", r.Redis.Keys)
+	fmt.Fprintf(w, "access tokens:\t%d\n", r.Redis.AccessTokens)
+	fmt.Fprintf(w, "sessions:\t%d\n", r.Redis.Sessions)
+}
+
+// writeDryRun 함수는 -dry-run으로 실행한 결과이면 그 사실을 먼저 출력한다.
+func writeDryRun(w io.Writer, dryRun bool) {
+	if dryRun {
+		fmt.Fprintln(w, "dry run: no changes were made")
+	}
+}
+
+func sortedKeys(m map[string]int64) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"time"
+
 	"github.com/caarlos0/env/v6"
 )
 
@@ -16,6 +18,50 @@ type Config struct {
 	DBName     string `env:"TODO_DB_NAME" envDefault:"todo"`
 	RedisHost  string `env:"TODO_REDIS_HOST" envDefault:"127.0.0.1"`
 	RedisPort  int    `env:"TODO_REDIS_PORT" envDefault:"36379"`
+	// AccessTokenTTL은 액세스 토큰의 유효 시간이다.
+	AccessTokenTTL time.Duration `env:"TODO_ACCESS_TOKEN_TTL" envDefault:"30m"`
+	// RefreshTokenTTL은 리프레시 토큰의 유효 시간이다. 토큰을 교체할 때마다 다시 시작한다.
+	RefreshTokenTTL time.Duration `env:"TODO_REFRESH_TOKEN_TTL" envDefault:"720h"`
+	// JWTKeyDir은 액세스 토큰의 서명 키를 보관하는 디렉터리이다. (auth.KeyDir) 비어 있으면 내장된 개발용 키를 사용한다.
+	JWTKeyDir string `env:"TODO_JWT_KEY_DIR"`
+	// JWTKeyReloadInterval은 JWTKeyDir에서 키를 다시 읽는 주기이다. 키를 교체한 뒤 이 시간이 지나면 모든 서버에 반영된다. 0이면 다시 읽지 않는다.
+	JWTKeyReloadInterval time.Duration `env:"TODO_JWT_KEY_RELOAD_INTERVAL" envDefault:"1m"`
+	// JWTAlgorithm은 todoctl keys generate가 만드는 키의 서명 알고리즘이다. (RS256, ES256, EdDSA)
+	// 서버는 키마다 키의 종류에 맞는 알고리즘으로 서명하고 검증한다.
+	JWTAlgorithm string `env:"TODO_JWT_ALGORITHM" envDefault:"RS256"`
+	// JWTIssuer, JWTAudience는 액세스 토큰의 iss, aud이며, 검증할 때 일치하지 않으면 거부한다.
+	JWTIssuer   string `env:"TODO_JWT_ISSUER" envDefault:"github.com/gitwub5/go_todo_app"`
+	JWTAudience string `env:"TODO_JWT_AUDIENCE" envDefault:"todo-api"`
+	// JWTClockSkew는 액세스 토큰의 exp, nbf, iat를 검증할 때 허용하는 서버 간 시계 차이이다.
+	JWTClockSkew time.Duration `env:"TODO_JWT_CLOCK_SKEW" envDefault:"30s"`
+	// OAuthCodeTTL은 OAuth 클라이언트가 인가 코드를 액세스 토큰으로 교환할 수 있는 시간이다.
+	OAuthCodeTTL time.Duration `env:"TODO_OAUTH_CODE_TTL" envDefault:"1m"`
+	// GRPCPort는 내부 서비스를 위한 gRPC 서버의 포트이다. 0이면 gRPC 서버를 시작하지 않는다.
+	GRPCPort int `env:"TODO_GRPC_PORT" envDefault:"50051"`
+	// OverdueCheckInterval은 마감 초과 알림을 확인하는 주기이다. 0이면 확인하지 않는다.
+	OverdueCheckInterval time.Duration `env:"TODO_OVERDUE_CHECK_INTERVAL" envDefault:"1m"`
+	// SMTPHost가 비어 있으면 메일을 전송하지 않는다.
+	SMTPHost     string `env:"TODO_SMTP_HOST"`
+	SMTPPort     int    `env:"TODO_SMTP_PORT" envDefault:"587"`
+	SMTPUsername string `env:"TODO_SMTP_USERNAME"`
+	SMTPPassword string `env:"TODO_SMTP_PASSWORD"`
+	SMTPFrom     string `env:"TODO_SMTP_FROM" envDefault:"todo@localhost"`
+	// BaseURL은 메일 본문의 링크를 만들 때 사용하는 서비스의 URL이다.
+	BaseURL string `env:"TODO_BASE_URL" envDefault:"http://localhost"`
+	// EventReplaySize는 GET /events의 Last-Event-ID 재전송을 위해 사용자별로 보관하는 이벤트 수이다.
+	EventReplaySize int64 `env:"TODO_EVENT_REPLAY_SIZE" envDefault:"100"`
+	// EventHeartbeatInterval은 GET /events 연결을 유지하기 위해 heartbeat를 보내는 주기이다.
+	EventHeartbeatInterval time.Duration `env:"TODO_EVENT_HEARTBEAT_INTERVAL" envDefault:"15s"`
+	// WSOriginPatterns는 GET /ws 연결을 허용할 다른 출처(Origin)의 호스트 패턴이다. (예: "app.example.com,*.example.com")
+	WSOriginPatterns []string `env:"TODO_WS_ORIGIN_PATTERNS" envSeparator:","`
+	// GraphQLMaxDepth와 GraphQLMaxComplexity를 넘는 POST /graphql 요청은 실행하지 않는다.
+	GraphQLMaxDepth      int `env:"TODO_GRAPHQL_MAX_DEPTH" envDefault:"10"`
+	GraphQLMaxComplexity int `env:"TODO_GRAPHQL_MAX_COMPLEXITY" envDefault:"5000"`
+	// OpenAPIValidation이 true이면 요청을 API 명세로 검증한다. Env가 dev, test이면 응답도 검증한다.
+	OpenAPIValidation bool `env:"TODO_OPENAPI_VALIDATION" envDefault:"false"`
+	// LegacyDeprecatedAt, LegacySunset은 접두사(/v1)가 없는 경로가 폐기 예정이 된 시각과 제거될 시각이다.
+	LegacyDeprecatedAt time.Time `env:"TODO_LEGACY_DEPRECATED_AT" envDefault:"2026-11-01T00:00:00Z"`
+	LegacySunset       time.Time `env:"TODO_LEGACY_SUNSET" envDefault:"2027-05-01T00:00:00Z"`
 }
 
 func New() (*Config, error) {
@@ -0,0 +1,40 @@
+package entity
+
+import (
+	"time"
+)
+
+type EventType string // Task 변경 이벤트의 종류를 나타내는 타입
+
+// EventType 상수
+const (
+	EventTaskCreated   EventType = "task.created"
+	EventTaskUpdated   EventType = "task.updated"
+	EventTaskCompleted EventType = "task.completed"
+	EventTaskAssigned  EventType = "task.assigned"
+	EventTaskDeleted   EventType = "task.deleted"
+)
+
+// EventTypes는 구독할 수 있는 모든 이벤트 종류이다.
+var EventTypes = []EventType{
+	EventTaskCreated, EventTaskUpdated, EventTaskCompleted, EventTaskAssigned, EventTaskDeleted,
+}
+
+// TaskEvent 구조체는 서비스 계층에서 발생한 Task 변경 이벤트를 나타낸다.
+type TaskEvent struct {
+	Type     EventType `json:"type"`
+	UserID   UserID    `json:"user_id"` // 이벤트가 발생한 Task의 소유자 ID
+	Task     *Task     `json:"task"`
+	Occurred time.Time `json:"occurred"`
+}
+
+// StreamEvent 구조체는 실시간 스트림(SSE)으로 사용자에게 전달되는 이벤트이다.
+type StreamEvent struct {
+	ID     int64  `json:"id"`      // 모든 서버 인스턴스에서 단조 증가하는 ID (Last-Event-ID로 사용)
+	UserID UserID `json:"user_id"` // 이벤트를 받을 사용자 ID
+	// ProjectID는 프로젝트 구독자에게 전달하는 이벤트일 때 이벤트를 받을 프로젝트 ID이다. 이때 UserID는 0이다.
+	ProjectID ProjectID `json:"project_id,omitempty"`
+	Type      EventType `json:"type"`
+	Task      *Task     `json:"task"`
+	Occurred  time.Time `json:"occurred"`
+}
@@ -0,0 +1,34 @@
+package entity
+
+// MailKind는 사용자에게 보내는 메일의 종류를 나타내는 타입이다.
+type MailKind string
+
+// MailKind 상수
+const (
+	MailAssigned      MailKind = "assigned"
+	MailReminder      MailKind = "reminder"
+	MailPasswordReset MailKind = "password_reset"
+)
+
+// OptOutMailKinds는 사용자가 수신을 거부할 수 있는 메일 종류이다.
+// 패스워드 재설정 메일은 계정 보안을 위해 거부할 수 없다.
+var OptOutMailKinds = []MailKind{MailAssigned, MailReminder}
+
+// MailPreference 구조체는 사용자의 메일 수신 설정을 나타낸다.
+type MailPreference struct {
+	Email   *string    `json:"email"`
+	OptOuts []MailKind `json:"opt_outs"` // 수신을 거부한 메일 종류
+}
+
+// Allows 메서드는 메일 종류를 받을 수 있는지 확인한다. 메일 주소가 없으면 어떤 메일도 받지 않는다.
+func (p *MailPreference) Allows(k MailKind) bool {
+	if p.Email == nil || *p.Email == "" {
+		return false
+	}
+	for _, o := range p.OptOuts {
+		if o == k {
+			return false
+		}
+	}
+	return true
+}
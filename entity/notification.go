@@ -0,0 +1,36 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+type NotificationID int64    // Notification의 ID를 나타내는 타입
+type NotificationType string // Notification의 종류를 나타내는 타입
+
+// NotificationType 상수
+const (
+	NotificationTaskAssigned NotificationType = "task_assigned"
+	NotificationTaskOverdue  NotificationType = "task_overdue"
+)
+
+// Notification 구조체는 사용자의 알림함에 쌓이는 알림을 나타내는 구조체이다.
+type Notification struct {
+	ID      NotificationID   `json:"id" db:"id"`
+	UserID  UserID           `json:"user_id" db:"user_id"` // 알림을 받는 사용자의 ID
+	Type    NotificationType `json:"type" db:"type"`
+	TaskID  *TaskID          `json:"task_id" db:"task_id"` // 알림과 관련된 Task의 ID (없으면 nil)
+	Message string           `json:"message" db:"message"`
+	// DedupeKey는 같은 알림이 두 번 등록되지 않도록 하는 키이다. (중복을 허용하면 nil)
+	DedupeKey *string    `json:"-" db:"dedupe_key"`
+	ReadAt    *time.Time `json:"read_at" db:"read_at"` // 읽은 시간 (읽지 않았으면 nil)
+	Created   time.Time  `json:"created" db:"created"`
+}
+
+// Notifications는 Notification의 슬라이스이다.
+type Notifications []*Notification
+
+// OverdueDedupeKey 함수는 Task의 마감 초과 알림이 사용자당 한 번만 등록되도록 하는 키를 반환한다.
+func OverdueDedupeKey(tid TaskID, uid UserID) string {
+	return fmt.Sprintf("%s:%d:%d", NotificationTaskOverdue, tid, uid)
+}
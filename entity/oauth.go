@@ -0,0 +1,152 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type OAuthClientID string // OAuth 클라이언트의 ID(client_id)를 나타내는 타입
+
+// ParseScopes 함수는 OAuth 2.0 요청의 scope 파라미터(공백으로 구분한 범위)를 Scopes로 변환한다.
+func ParseScopes(s string) Scopes {
+	ss := Scopes{}
+	for _, f := range strings.Fields(s) {
+		if !ss.Has(Scope(f)) {
+			ss = append(ss, Scope(f))
+		}
+	}
+	return ss
+}
+
+// Join 메서드는 범위를 OAuth 2.0 응답의 scope 형식(공백으로 구분)으로 변환한다.
+func (ss Scopes) Join() string {
+	fs := make([]string, len(ss))
+	for i, s := range ss {
+		fs[i] = string(s)
+	}
+	return strings.Join(fs, " ")
+}
+
+// Contains 메서드는 other의 범위를 모두 허용하고 있는지 확인한다.
+func (ss Scopes) Contains(other Scopes) bool {
+	for _, s := range other {
+		if !ss.Has(s) {
+			return false
+		}
+	}
+	return true
+}
+
+// RedirectURIs는 OAuth 클라이언트에 허용한 리디렉션 URI의 슬라이스이다. RDBMS에는 JSON 컬럼으로 저장한다.
+type RedirectURIs []string
+
+// Value 메서드는 driver.Valuer 인터페이스를 구현한다.
+func (us RedirectURIs) Value() (driver.Value, error) {
+	b, err := json.Marshal(us)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// Scan 메서드는 sql.Scanner 인터페이스를 구현한다.
+func (us *RedirectURIs) Scan(src any) error {
+	switch v := src.(type) {
+	case []byte:
+		return json.Unmarshal(v, us)
+	case string:
+		return json.Unmarshal([]byte(v), us)
+	case nil:
+		*us = nil
+		return nil
+	default:
+		return fmt.Errorf("cannot scan %T into RedirectURIs", src)
+	}
+}
+
+// Has 메서드는 리디렉션 URI를 허용하고 있는지 확인한다. 등록한 URI와 정확히 같아야 한다.
+func (us RedirectURIs) Has(u string) bool {
+	for _, v := range us {
+		if v == u {
+			return true
+		}
+	}
+	return false
+}
+
+// OAuthClient 구조체는 사용자를 대신해 API를 호출하는 서드파티 애플리케이션(OAuth 2.0 클라이언트)을 나타낸다.
+// 시크릿은 등록할 때 한 번만 응답하고, RDBMS에는 해시만 저장한다. 시크릿이 없는 클라이언트는 공개 클라이언트이다.
+type OAuthClient struct {
+	ID           OAuthClientID `json:"id" db:"id"`
+	UserID       UserID        `json:"user_id" db:"user_id"` // 클라이언트를 등록한 사용자
+	Name         string        `json:"name" db:"name"`
+	SecretHash   *string       `json:"-" db:"secret_hash"`
+	RedirectURIs RedirectURIs  `json:"redirect_uris" db:"redirect_uris"`
+	Scopes       Scopes        `json:"scopes" db:"scopes"` // 클라이언트가 요청할 수 있는 범위
+	Created      time.Time     `json:"created" db:"created"`
+}
+
+// Confidential 메서드는 시크릿으로 인증하는 기밀 클라이언트인지 확인한다.
+// 브라우저나 모바일 앱처럼 시크릿을 안전하게 보관할 수 없는 공개 클라이언트는 PKCE로만 보호한다.
+func (c *OAuthClient) Confidential() bool {
+	return c.SecretHash != nil
+}
+
+// OAuthClients는 OAuthClient의 슬라이스이다.
+type OAuthClients []*OAuthClient
+
+// AuthorizationRequest 구조체는 클라이언트가 사용자의 동의를 받기 위해 보낸 인가 요청(GET /oauth/authorize)이다.
+type AuthorizationRequest struct {
+	ResponseType        string        `json:"response_type"`
+	ClientID            OAuthClientID `json:"client_id"`
+	RedirectURI         string        `json:"redirect_uri"`
+	Scope               string        `json:"scope"` // 공백으로 구분한 범위
+	State               string        `json:"state"`
+	CodeChallenge       string        `json:"code_challenge"`
+	CodeChallengeMethod string        `json:"code_challenge_method"`
+}
+
+// AuthorizationCode 구조체는 사용자가 동의한 뒤 클라이언트에 발급한 인가 코드의 내용이다.
+// Redis에 짧은 시간 동안 보관하며, 액세스 토큰으로 한 번만 교환할 수 있다.
+type AuthorizationCode struct {
+	ClientID      OAuthClientID `json:"client_id"`
+	UserID        UserID        `json:"user_id"`
+	RedirectURI   string        `json:"redirect_uri"`
+	Scopes        Scopes        `json:"scopes"`
+	CodeChallenge string        `json:"code_challenge"`
+}
+
+// OAuthToken 구조체는 토큰 엔드포인트(POST /oauth/token)의 응답이다. (RFC 6749 5.1)
+type OAuthToken struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+	Scope       string `json:"scope"`
+}
+
+// TokenClaims 구조체는 OAuth 클라이언트에 발급한 액세스 토큰의 내용이다.
+type TokenClaims struct {
+	ID       string // JTI
+	UserID   UserID
+	UserName string
+	ClientID OAuthClientID
+	Scopes   Scopes
+	IssuedAt time.Time
+	Expires  time.Time
+}
+
+// TokenIntrospection 구조체는 토큰 확인 엔드포인트(POST /oauth/introspect)의 응답이다. (RFC 7662 2.2)
+// 토큰을 사용할 수 없으면 Active만 false로 응답한다.
+type TokenIntrospection struct {
+	Active    bool          `json:"active"`
+	Scope     string        `json:"scope,omitempty"`
+	ClientID  OAuthClientID `json:"client_id,omitempty"`
+	Username  string        `json:"username,omitempty"`
+	Subject   string        `json:"sub,omitempty"`
+	TokenType string        `json:"token_type,omitempty"`
+	Expires   int64         `json:"exp,omitempty"`
+	IssuedAt  int64         `json:"iat,omitempty"`
+}
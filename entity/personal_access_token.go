@@ -0,0 +1,80 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type PersonalAccessTokenID int64 // 개인 액세스 토큰의 ID를 나타내는 타입
+
+// Scope는 개인 액세스 토큰으로 호출할 수 있는 API의 범위를 나타내는 타입이다.
+type Scope string
+
+// Scope 상수
+const (
+	ScopeTasksRead  Scope = "tasks:read"  // Task와 작업 시간 조회
+	ScopeTasksWrite Scope = "tasks:write" // Task와 작업 시간 등록, 수정, 삭제
+)
+
+// Scopes는 Scope의 슬라이스이다. RDBMS에는 JSON 컬럼으로 저장한다.
+type Scopes []Scope
+
+// AllScopes는 개인 액세스 토큰에 허용할 수 있는 모든 범위이다.
+var AllScopes = Scopes{ScopeTasksRead, ScopeTasksWrite}
+
+// Value 메서드는 driver.Valuer 인터페이스를 구현한다.
+func (ss Scopes) Value() (driver.Value, error) {
+	b, err := json.Marshal(ss)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// Scan 메서드는 sql.Scanner 인터페이스를 구현한다.
+func (ss *Scopes) Scan(src any) error {
+	switch v := src.(type) {
+	case []byte:
+		return json.Unmarshal(v, ss)
+	case string:
+		return json.Unmarshal([]byte(v), ss)
+	case nil:
+		*ss = nil
+		return nil
+	default:
+		return fmt.Errorf("cannot scan %T into Scopes", src)
+	}
+}
+
+// Has 메서드는 범위를 허용하고 있는지 확인한다.
+func (ss Scopes) Has(s Scope) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// PersonalAccessToken 구조체는 스크립트나 CI에서 로그인하지 않고 API를 호출할 때 사용하는 개인 액세스 토큰을 나타낸다.
+// 토큰 자체는 발급할 때 한 번만 응답하고, RDBMS에는 해시만 저장한다.
+type PersonalAccessToken struct {
+	ID        PersonalAccessTokenID `json:"id" db:"id"`
+	UserID    UserID                `json:"user_id" db:"user_id"`
+	Name      string                `json:"name" db:"name"`
+	TokenHash string                `json:"-" db:"token_hash"`
+	Scopes    Scopes                `json:"scopes" db:"scopes"`
+	Expires   *time.Time            `json:"expires" db:"expires"`     // 만료 시간 (만료되지 않으면 nil)
+	LastUsed  *time.Time            `json:"last_used" db:"last_used"` // 마지막으로 사용한 시간 (사용한 적이 없으면 nil)
+	Created   time.Time             `json:"created" db:"created"`
+}
+
+// Expired 메서드는 now 시점에 토큰이 만료되었는지 확인한다.
+func (t *PersonalAccessToken) Expired(now time.Time) bool {
+	return t.Expires != nil && !now.Before(*t.Expires)
+}
+
+// PersonalAccessTokens는 PersonalAccessToken의 슬라이스이다.
+type PersonalAccessTokens []*PersonalAccessToken
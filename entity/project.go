@@ -0,0 +1,18 @@
+package entity
+
+import "time"
+
+type ProjectID int64 // 프로젝트의 ID를 나타내는 타입
+
+// Project 구조체는 여러 사용자가 Task를 함께 관리하는 프로젝트를 나타낸다.
+// 프로젝트를 만든 사용자(OwnerID)도 멤버로 등록된다.
+type Project struct {
+	ID       ProjectID `json:"id" db:"id"`
+	OwnerID  UserID    `json:"owner_id" db:"owner_id"`
+	Name     string    `json:"name" db:"name"`
+	Created  time.Time `json:"created" db:"created"`
+	Modified time.Time `json:"modified" db:"modified"`
+}
+
+// Projects는 Project의 슬라이스이다.
+type Projects []*Project
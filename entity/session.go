@@ -0,0 +1,23 @@
+package entity
+
+import "time"
+
+// SessionID는 로그인 한 번으로 시작하는 세션의 ID이다. 세션의 리프레시 토큰 계열(family)의 ID이기도 하다.
+type SessionID string
+
+// Client는 세션을 시작한 기기의 정보이다.
+type Client struct {
+	Device string `json:"device"` // 로그인 요청의 User-Agent
+	IP     string `json:"ip"`     // 로그인 요청을 보낸 주소
+}
+
+// Session은 로그인해서 시작하고, 로그아웃하거나 리프레시 토큰이 만료되면 끝나는 세션이다.
+// 세션에서 발급한 액세스 토큰과 리프레시 토큰은 세션을 삭제하면 모두 폐기된다.
+type Session struct {
+	ID     SessionID `json:"id"`
+	UserID UserID    `json:"-"`
+	Client
+	IssuedAt time.Time `json:"issued_at"` // 로그인한 시간
+	// Current는 요청에 사용한 액세스 토큰의 세션이면 true이다.
+	Current bool `json:"current"`
+}
@@ -0,0 +1,81 @@
+package entity
+
+import "time"
+
+type TaskChangeOp string // Task 변경 내역의 종류를 나타내는 타입
+
+// TaskChangeOp 상수
+const (
+	TaskChangeUpsert TaskChangeOp = "upsert" // Task가 생성되거나 변경되었다.
+	TaskChangeDelete TaskChangeOp = "delete" // Task가 삭제되었거나 더 이상 볼 수 없다. (tombstone)
+)
+
+// TaskChange 구조체는 동기화를 위해 기록하는 Task 변경 내역이다.
+// 변경을 받을 사용자(소유자와 담당자)마다 한 행씩 기록하며, Seq는 모든 변경 내역에서 단조 증가한다.
+type TaskChange struct {
+	Seq     int64        `json:"seq" db:"seq"`
+	UserID  UserID       `json:"user_id" db:"user_id"`
+	TaskID  TaskID       `json:"task_id" db:"task_id"`
+	Op      TaskChangeOp `json:"op" db:"op"`
+	Created time.Time    `json:"created" db:"created"`
+}
+
+// TaskChanges는 TaskChange의 슬라이스이다.
+type TaskChanges []*TaskChange
+
+type SyncMutationOp string // 클라이언트가 보내는 변경 요청의 종류를 나타내는 타입
+
+// SyncMutationOp 상수
+const (
+	SyncCreate SyncMutationOp = "create"
+	SyncUpdate SyncMutationOp = "update"
+	SyncDelete SyncMutationOp = "delete"
+)
+
+// SyncMutation 구조체는 오프라인 클라이언트가 보내는 Task 변경 요청이다.
+// 대상 Task는 서버의 ID나 클라이언트가 생성한 ID로 지정한다.
+type SyncMutation struct {
+	Op             SyncMutationOp
+	ID             *TaskID
+	ClientID       string
+	ParentID       *TaskID
+	ParentClientID string
+	Title          *string
+	Status         *TaskStatus
+	Due            *time.Time
+	// BaseModified는 클라이언트가 마지막으로 받은 Task의 수정 시간이다.
+	// 지정하면 그 이후에 서버에서 변경된 Task에는 요청을 적용하지 않는다.
+	BaseModified *time.Time
+}
+
+type SyncResultStatus string // 변경 요청을 처리한 결과를 나타내는 타입
+
+// SyncResultStatus 상수
+const (
+	SyncApplied  SyncResultStatus = "applied"  // 적용했다. (이미 적용된 요청을 다시 보낸 경우도 포함)
+	SyncConflict SyncResultStatus = "conflict" // 서버의 변경과 충돌해 적용하지 않았다.
+	SyncRejected SyncResultStatus = "rejected" // 잘못된 요청이라 적용하지 않았다.
+)
+
+// SyncResult 구조체는 변경 요청 하나를 처리한 결과이다.
+type SyncResult struct {
+	ClientID string
+	ID       *TaskID
+	Status   SyncResultStatus
+	Reason   string
+	Task     *Task // 적용 후의 Task 또는 충돌한 서버의 Task (삭제되었으면 nil)
+}
+
+// SyncChange 구조체는 클라이언트에 전달하는 Task의 최신 상태이다.
+type SyncChange struct {
+	Op     TaskChangeOp
+	TaskID TaskID
+	Task   *Task // Op가 TaskChangeDelete이면 nil
+}
+
+// SyncDelta 구조체는 동기화 토큰 이후의 변경 내역이다.
+type SyncDelta struct {
+	Token   string
+	Changes []*SyncChange
+	HasMore bool // 남은 변경 내역이 있으면 Token으로 다시 요청해야 한다.
+}
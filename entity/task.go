@@ -1,11 +1,15 @@
 package entity
 
 import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
 	"time"
 )
 
-type TaskID int64      // Task의 ID를 나타내는 타입
-type TaskStatus string // Task의 상태를 나타내는 타입
+type TaskID int64        // Task의 ID를 나타내는 타입
+type TaskStatus string   // Task의 상태를 나타내는 타입
+type TaskPriority string // Task의 우선순위를 나타내는 타입
 
 // TaskStatus 상수
 const (
@@ -14,15 +18,109 @@ const (
 	TaskStatusDone  TaskStatus = "done"
 )
 
+// TaskPriority 상수. 우선순위를 지정하지 않으면 빈 문자열이다.
+const (
+	TaskPriorityNone   TaskPriority = ""
+	TaskPriorityLow    TaskPriority = "low"
+	TaskPriorityMedium TaskPriority = "medium"
+	TaskPriorityHigh   TaskPriority = "high"
+	TaskPriorityUrgent TaskPriority = "urgent"
+)
+
 // Task 구조체는 할 일을 나타내는 구조체이다.
 type Task struct {
-	ID       TaskID     `json:"id" db:"id"`
-	UserID   UserID     `json:"user_id" db:"user_id"`
-	Title    string     `json:"title" db:"title"`
-	Status   TaskStatus `json:"status" db:"status"`
-	Created  time.Time  `json:"created" db:"created"`
-	Modified time.Time  `json:"modified" db:"modified"`
+	ID         TaskID     `json:"id" db:"id"`
+	UserID     UserID     `json:"user_id" db:"user_id"`         // Task를 등록한 소유자의 ID
+	ProjectID  *ProjectID `json:"project_id" db:"project_id"`   // 속한 프로젝트의 ID (프로젝트에 속하지 않으면 nil)
+	ParentID   *TaskID    `json:"parent_id" db:"parent_id"`     // 상위 Task의 ID (하위 Task가 아니면 nil)
+	AssigneeID *UserID    `json:"assignee_id" db:"assignee_id"` // 담당자의 ID (담당자가 없으면 nil)
+	ClientID   *string    `json:"client_id" db:"client_id"`     // 오프라인 클라이언트가 생성한 ID (POST /sync로 생성하지 않았으면 nil)
+	Title      string     `json:"title" db:"title"`
+	Status     TaskStatus `json:"status" db:"status"`
+	Due        *time.Time `json:"due" db:"due"` // 마감 시간 (없으면 nil)
+	TaskAttributes
+	Created  time.Time `json:"created" db:"created"`
+	Modified time.Time `json:"modified" db:"modified"`
 }
 
 // Tasks는 Task의 슬라이스이다.
 type Tasks []*Task
+
+// TaskAttributes 구조체는 Task를 분류하는 라벨, 우선순위, 반복 규칙이다.
+// Task와 템플릿 항목이 함께 사용하며, 지정하지 않은 항목은 JSON에서 생략한다.
+type TaskAttributes struct {
+	Labels     Labels       `json:"labels,omitempty" db:"labels"`
+	Priority   TaskPriority `json:"priority,omitempty" db:"priority"`
+	Recurrence *Recurrence  `json:"recurrence,omitempty" db:"recurrence"`
+}
+
+// Labels는 Task에 붙인 라벨의 슬라이스이다. RDBMS에는 JSON 컬럼으로 저장하고, 라벨이 없으면 NULL로 저장한다.
+type Labels []string
+
+// Value 메서드는 driver.Valuer 인터페이스를 구현한다.
+func (ls Labels) Value() (driver.Value, error) {
+	if len(ls) == 0 {
+		return nil, nil
+	}
+	b, err := json.Marshal(ls)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// Scan 메서드는 sql.Scanner 인터페이스를 구현한다.
+func (ls *Labels) Scan(src any) error {
+	switch v := src.(type) {
+	case []byte:
+		return json.Unmarshal(v, ls)
+	case string:
+		return json.Unmarshal([]byte(v), ls)
+	case nil:
+		*ls = nil
+		return nil
+	default:
+		return fmt.Errorf("cannot scan %T into Labels", src)
+	}
+}
+
+// Has 메서드는 라벨이 붙어 있는지 확인한다.
+func (ls Labels) Has(l string) bool {
+	for _, v := range ls {
+		if v == l {
+			return true
+		}
+	}
+	return false
+}
+
+// Recurrence 구조체는 Task의 반복 규칙이다. RDBMS에는 JSON 컬럼으로 저장한다.
+// Frequency는 daily, weekly, monthly, yearly 중 하나이며, Interval 주기마다 반복한다.
+// Weekday(sunday~saturday)는 매주 반복할 요일, MonthDay는 매달 반복할 날짜이며 지정하지 않으면 생략한다.
+type Recurrence struct {
+	Frequency string `json:"frequency"`
+	Interval  int    `json:"interval"`
+	Weekday   string `json:"weekday,omitempty"`
+	MonthDay  int    `json:"month_day,omitempty"`
+}
+
+// Value 메서드는 driver.Valuer 인터페이스를 구현한다.
+func (r Recurrence) Value() (driver.Value, error) {
+	b, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// Scan 메서드는 sql.Scanner 인터페이스를 구현한다. NULL은 *Recurrence가 nil이 되므로 호출되지 않는다.
+func (r *Recurrence) Scan(src any) error {
+	switch v := src.(type) {
+	case []byte:
+		return json.Unmarshal(v, r)
+	case string:
+		return json.Unmarshal([]byte(v), r)
+	default:
+		return fmt.Errorf("cannot scan %T into Recurrence", src)
+	}
+}
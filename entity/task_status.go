@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"time"
+)
+
+type TaskStatusDefID int64     // 사용자 정의 상태의 ID를 나타내는 타입
+type TaskStatusCategory string // 사용자 정의 상태가 속하는 분류를 나타내는 타입
+
+// TaskStatusCategory 상수
+// 사용자가 상태를 자유롭게 정의하더라도 "완료 여부" 같은 로직은 분류를 기준으로 판단한다.
+const (
+	TaskStatusCategoryOpen       TaskStatusCategory = "open"
+	TaskStatusCategoryInProgress TaskStatusCategory = "in_progress"
+	TaskStatusCategoryClosed     TaskStatusCategory = "closed"
+)
+
+// TaskStatusDef 구조체는 사용자가 정의한 Task 상태를 나타내는 구조체이다.
+type TaskStatusDef struct {
+	ID       TaskStatusDefID    `json:"id" db:"id"`
+	UserID   UserID             `json:"user_id" db:"user_id"`
+	Name     TaskStatus         `json:"name" db:"name"`
+	Category TaskStatusCategory `json:"category" db:"category"`
+	Position int                `json:"position" db:"position"`
+	Created  time.Time          `json:"created" db:"created"`
+	Modified time.Time          `json:"modified" db:"modified"`
+}
+
+// TaskStatusDefs는 TaskStatusDef의 슬라이스이다.
+type TaskStatusDefs []*TaskStatusDef
+
+// DefaultTaskStatusDefs 함수는 모든 사용자가 기본으로 가지는 세 가지 상태를 반환한다.
+func DefaultTaskStatusDefs(uid UserID) TaskStatusDefs {
+	return TaskStatusDefs{
+		{UserID: uid, Name: TaskStatusTodo, Category: TaskStatusCategoryOpen, Position: 0},
+		{UserID: uid, Name: TaskStatusDoing, Category: TaskStatusCategoryInProgress, Position: 1},
+		{UserID: uid, Name: TaskStatusDone, Category: TaskStatusCategoryClosed, Position: 2},
+	}
+}
+
+// Find 메서드는 이름이 일치하는 상태 정의를 반환한다.
+func (ds TaskStatusDefs) Find(name TaskStatus) (*TaskStatusDef, bool) {
+	for _, d := range ds {
+		if d.Name == name {
+			return d, true
+		}
+	}
+	return nil, false
+}
+
+// IsClosed 메서드는 상태가 완료 분류에 속하는지 확인한다.
+// 정의되지 않은 상태는 기본 상태 이름으로 판단한다.
+func (ds TaskStatusDefs) IsClosed(name TaskStatus) bool {
+	if d, ok := ds.Find(name); ok {
+		return d.Category == TaskStatusCategoryClosed
+	}
+	return name == TaskStatusDone
+}
@@ -0,0 +1,71 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type TemplateID int64 // Task 템플릿의 ID를 나타내는 타입
+
+// TemplateItem 구조체는 템플릿에 포함된 Task 하나를 나타낸다.
+// DueOffset은 인스턴스화할 때 기준 시각(anchor)으로부터의 마감 시간 차이(초)이며, 마감 시간이 없으면 nil이다.
+// 라벨, 우선순위, 반복 규칙은 인스턴스화한 Task에 그대로 저장한다.
+type TemplateItem struct {
+	Title     string `json:"title"`
+	DueOffset *int64 `json:"due_offset,omitempty"`
+	TaskAttributes
+	Children TemplateItems `json:"children,omitempty"`
+}
+
+// TemplateItems는 TemplateItem의 슬라이스이다. RDBMS에는 JSON 컬럼으로 저장한다.
+type TemplateItems []*TemplateItem
+
+// Value 메서드는 driver.Valuer 인터페이스를 구현한다.
+func (ti TemplateItems) Value() (driver.Value, error) {
+	b, err := json.Marshal(ti)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// Scan 메서드는 sql.Scanner 인터페이스를 구현한다.
+func (ti *TemplateItems) Scan(src any) error {
+	switch v := src.(type) {
+	case []byte:
+		return json.Unmarshal(v, ti)
+	case string:
+		return json.Unmarshal([]byte(v), ti)
+	case nil:
+		*ti = nil
+		return nil
+	default:
+		return fmt.Errorf("cannot scan %T into TemplateItems", src)
+	}
+}
+
+// Count 메서드는 하위 항목을 포함한 전체 항목 수를 반환한다.
+func (ti TemplateItems) Count() int {
+	n := 0
+	for _, i := range ti {
+		n += 1 + i.Children.Count()
+	}
+	return n
+}
+
+// Template 구조체는 반복해서 사용하는 Task 묶음(체크리스트)을 나타낸다.
+// ProjectID가 있으면 프로젝트 멤버 모두가 템플릿을 사용할 수 있다.
+type Template struct {
+	ID        TemplateID    `json:"id" db:"id"`
+	UserID    UserID        `json:"user_id" db:"user_id"`
+	ProjectID *ProjectID    `json:"project_id" db:"project_id"` // 템플릿을 공유하는 프로젝트의 ID (공유하지 않으면 nil)
+	Name      string        `json:"name" db:"name"`
+	Items     TemplateItems `json:"items" db:"items"`
+	Created   time.Time     `json:"created" db:"created"`
+	Modified  time.Time     `json:"modified" db:"modified"`
+}
+
+// Templates는 Template의 슬라이스이다.
+type Templates []*Template
@@ -0,0 +1,106 @@
+package entity
+
+import (
+	"time"
+)
+
+type TimeEntryID int64 // 작업 시간 기록의 ID를 나타내는 타입
+
+// TimeEntry 구조체는 Task에 기록된 작업 시간 하나를 나타내는 구조체이다.
+// Stopped가 nil이면 타이머가 실행 중인 상태이다.
+type TimeEntry struct {
+	ID       TimeEntryID `json:"id" db:"id"`
+	UserID   UserID      `json:"user_id" db:"user_id"`
+	TaskID   TaskID      `json:"task_id" db:"task_id"`
+	Started  time.Time   `json:"started" db:"started"`
+	Stopped  *time.Time  `json:"stopped" db:"stopped"`
+	Manual   bool        `json:"manual" db:"manual"`
+	Created  time.Time   `json:"created" db:"created"`
+	Modified time.Time   `json:"modified" db:"modified"`
+}
+
+// TimeEntries는 TimeEntry의 슬라이스이다.
+type TimeEntries []*TimeEntry
+
+// Running 메서드는 타이머가 실행 중인지 확인한다.
+func (e *TimeEntry) Running() bool {
+	return e.Stopped == nil
+}
+
+// End 메서드는 기록의 종료 시각을 반환한다. 실행 중이면 now를 종료 시각으로 본다.
+func (e *TimeEntry) End(now time.Time) time.Time {
+	if e.Stopped != nil {
+		return *e.Stopped
+	}
+	return now
+}
+
+// Duration 메서드는 기록된 작업 시간을 반환한다.
+func (e *TimeEntry) Duration(now time.Time) time.Duration {
+	d := e.End(now).Sub(e.Started)
+	if d < 0 {
+		return 0
+	}
+	return d
+}
+
+// DailyTime은 하루 동안의 작업 시간 합계이다. Date는 "2006-01-02" 형식이다.
+type DailyTime struct {
+	Date     string
+	Duration time.Duration
+}
+
+// SplitByDay 함수는 구간 [start, end)를 loc 기준의 날짜별 작업 시간으로 나눈다.
+func SplitByDay(start, end time.Time, loc *time.Location) []DailyTime {
+	var days []DailyTime
+	start, end = start.In(loc), end.In(loc)
+	for start.Before(end) {
+		y, m, d := start.Date()
+		next := time.Date(y, m, d+1, 0, 0, 0, 0, loc)
+		if next.After(end) {
+			next = end
+		}
+		days = append(days, DailyTime{
+			Date:     start.Format(time.DateOnly),
+			Duration: next.Sub(start),
+		})
+		start = next
+	}
+	return days
+}
+
+// TimedEntry는 계산된 작업 시간을 포함한 TimeEntry이다.
+type TimedEntry struct {
+	*TimeEntry
+	Duration time.Duration
+}
+
+// TaskTime은 Task 하나에 기록된 작업 시간의 집계이다.
+type TaskTime struct {
+	TaskID  TaskID
+	Total   time.Duration
+	Running bool
+	Days    []DailyTime
+	Entries []TimedEntry
+}
+
+// TaskDuration은 Task 하나의 작업 시간 합계이다.
+type TaskDuration struct {
+	TaskID   TaskID
+	Duration time.Duration
+}
+
+// TimesheetDay는 하루 동안 Task별로 기록된 작업 시간이다.
+type TimesheetDay struct {
+	Date  string
+	Total time.Duration
+	Tasks []TaskDuration
+}
+
+// Timesheet는 기간 내의 작업 시간을 날짜별, Task별로 집계한 보고서이다.
+type Timesheet struct {
+	From  string
+	To    string
+	Total time.Duration
+	Days  []TimesheetDay
+}
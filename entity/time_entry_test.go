@@ -0,0 +1,63 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSplitByDay(t *testing.T) {
+	seoul, err := time.LoadLocation("Asia/Seoul")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := map[string]struct {
+		start, end time.Time
+		loc        *time.Location
+		want       []DailyTime
+	}{
+		"sameDay": {
+			start: time.Date(2022, 5, 10, 9, 0, 0, 0, time.UTC),
+			end:   time.Date(2022, 5, 10, 10, 30, 0, 0, time.UTC),
+			loc:   time.UTC,
+			want:  []DailyTime{{Date: "2022-05-10", Duration: 90 * time.Minute}},
+		},
+		"acrossMidnight": {
+			start: time.Date(2022, 5, 10, 23, 0, 0, 0, time.UTC),
+			end:   time.Date(2022, 5, 11, 1, 0, 0, 0, time.UTC),
+			loc:   time.UTC,
+			want: []DailyTime{
+				{Date: "2022-05-10", Duration: time.Hour},
+				{Date: "2022-05-11", Duration: time.Hour},
+			},
+		},
+		// UTC 14:00~16:00은 서울 시간으로 23:00~01:00이다.
+		"timezone": {
+			start: time.Date(2022, 5, 10, 14, 0, 0, 0, time.UTC),
+			end:   time.Date(2022, 5, 10, 16, 0, 0, 0, time.UTC),
+			loc:   seoul,
+			want: []DailyTime{
+				{Date: "2022-05-10", Duration: time.Hour},
+				{Date: "2022-05-11", Duration: time.Hour},
+			},
+		},
+		"empty": {
+			start: time.Date(2022, 5, 10, 9, 0, 0, 0, time.UTC),
+			end:   time.Date(2022, 5, 10, 9, 0, 0, 0, time.UTC),
+			loc:   time.UTC,
+			want:  nil,
+		},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			got := SplitByDay(tt.start, tt.end, tt.loc)
+			if d := cmp.Diff(got, tt.want); len(d) != 0 {
+				t.Errorf("differs: (-got +want)\n%s", d)
+			}
+		})
+	}
+}
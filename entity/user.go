@@ -9,14 +9,23 @@ import (
 type UserID int64
 
 type User struct {
-	ID       UserID    `json:"id" db:"id"`
-	Name     string    `json:"name" db:"name"`
-	Password string    `json:"password" db:"password"`
-	Role     string    `json:"role" db:"role"`
-	Created  time.Time `json:"created" db:"created"`
-	Modified time.Time `json:"modified" db:"modified"`
+	ID       UserID     `json:"id" db:"id"`
+	Name     string     `json:"name" db:"name"`
+	Password string     `json:"password" db:"password"`
+	Role     string     `json:"role" db:"role"`
+	Email    *string    `json:"email" db:"email"`       // 메일 주소 (등록하지 않았으면 nil)
+	Disabled *time.Time `json:"disabled" db:"disabled"` // 운영자가 비활성화한 시간 (활성 사용자는 nil)
+	Created  time.Time  `json:"created" db:"created"`
+	Modified time.Time  `json:"modified" db:"modified"`
 }
 
 func (u *User) ComparePassword(pw string) error {
 	return bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(pw))
 }
+
+// Tokens는 로그인하거나 리프레시 토큰을 교체할 때 발급하는 토큰이다.
+type Tokens struct {
+	AccessToken string `json:"access_token"`
+	// RefreshToken은 액세스 토큰이 만료되었을 때 POST /token/refresh로 새 토큰을 발급받는 데 사용한다.
+	RefreshToken string `json:"refresh_token"`
+}
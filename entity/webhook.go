@@ -0,0 +1,83 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type WebhookID int64         // Webhook의 ID를 나타내는 타입
+type WebhookDeliveryID int64 // Webhook 전송 기록의 ID를 나타내는 타입
+
+// WebhookEvents는 Webhook이 구독하는 이벤트 종류의 슬라이스이다. RDBMS에는 JSON 컬럼으로 저장한다.
+type WebhookEvents []EventType
+
+// Value 메서드는 driver.Valuer 인터페이스를 구현한다.
+func (we WebhookEvents) Value() (driver.Value, error) {
+	b, err := json.Marshal(we)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// Scan 메서드는 sql.Scanner 인터페이스를 구현한다.
+func (we *WebhookEvents) Scan(src any) error {
+	switch v := src.(type) {
+	case []byte:
+		return json.Unmarshal(v, we)
+	case string:
+		return json.Unmarshal([]byte(v), we)
+	case nil:
+		*we = nil
+		return nil
+	default:
+		return fmt.Errorf("cannot scan %T into WebhookEvents", src)
+	}
+}
+
+// Has 메서드는 이벤트 종류를 구독하고 있는지 확인한다.
+func (we WebhookEvents) Has(t EventType) bool {
+	for _, e := range we {
+		if e == t {
+			return true
+		}
+	}
+	return false
+}
+
+// Webhook 구조체는 사용자가 등록한 외부 전송 대상(endpoint)을 나타낸다.
+type Webhook struct {
+	ID     WebhookID     `json:"id" db:"id"`
+	UserID UserID        `json:"user_id" db:"user_id"`
+	URL    string        `json:"url" db:"url"`
+	Events WebhookEvents `json:"events" db:"events"`
+	// Secret은 전송 본문의 HMAC 서명에 사용하는 키이다. 등록할 때 한 번만 응답한다.
+	Secret string `json:"-" db:"secret"`
+	Active bool   `json:"active" db:"active"`
+	// FailureCount는 연속으로 전송에 실패한 횟수이다. 일정 횟수를 넘으면 Active가 false가 된다.
+	FailureCount int       `json:"failure_count" db:"failure_count"`
+	Created      time.Time `json:"created" db:"created"`
+	Modified     time.Time `json:"modified" db:"modified"`
+}
+
+// Webhooks는 Webhook의 슬라이스이다.
+type Webhooks []*Webhook
+
+// WebhookDelivery 구조체는 Webhook 전송 시도 한 번의 기록을 나타낸다.
+type WebhookDelivery struct {
+	ID        WebhookDeliveryID `json:"id" db:"id"`
+	WebhookID WebhookID         `json:"webhook_id" db:"webhook_id"`
+	// DeliveryID는 재시도를 포함한 같은 전송을 식별하는 값으로, X-Todo-Delivery 헤더로 전달된다.
+	DeliveryID string    `json:"delivery_id" db:"delivery_id"`
+	Event      EventType `json:"event" db:"event"`
+	Attempt    int       `json:"attempt" db:"attempt"`
+	StatusCode int       `json:"status_code" db:"status_code"` // 응답을 받지 못했으면 0
+	Error      string    `json:"error" db:"error"`
+	DurationMS int64     `json:"duration_ms" db:"duration_ms"`
+	Created    time.Time `json:"created" db:"created"`
+}
+
+// WebhookDeliveries는 WebhookDelivery의 슬라이스이다.
+type WebhookDeliveries []*WebhookDelivery
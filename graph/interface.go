@@ -0,0 +1,44 @@
+package graph
+
+import (
+	"context"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+)
+
+// Task를 변경하는 Mutation은 REST 핸들러와 같은 service 패키지의 타입을 사용한다.
+// 중첩된 필드는 요청마다 만드는 Loader가 Repository를 통해 한 번에 가져온다.
+
+//go:generate go run github.com/matryer/moq -out moq_test.go . Repository TaskLister ProjectLister TaskAdder TaskUpdater TaskAssigner
+type Repository interface {
+	ListUsersByIDs(ctx context.Context, db store.Queryer, ids []entity.UserID) ([]*entity.User, error)
+	ListRelatedUserIDs(ctx context.Context, db store.Queryer, uid entity.UserID, ids []entity.UserID) ([]entity.UserID, error)
+	ListTasksByIDs(ctx context.Context, db store.Queryer, ids []entity.TaskID) (entity.Tasks, error)
+	ListSubtasks(ctx context.Context, db store.Queryer, parents []entity.TaskID) (entity.Tasks, error)
+	ListProjectsByIDs(ctx context.Context, db store.Queryer, uid entity.UserID, ids []entity.ProjectID) (entity.Projects, error)
+}
+
+type TaskLister interface {
+	ListTasks(ctx context.Context) (entity.Tasks, error)
+	ListAssignedTasks(ctx context.Context) (entity.Tasks, error)
+	ListWorkTasks(ctx context.Context) (entity.Tasks, error)
+}
+
+type ProjectLister interface {
+	ListProjects(ctx context.Context) (entity.Projects, error)
+}
+
+type TaskAdder interface {
+	AddTask(ctx context.Context, title string, due *time.Time, parent *entity.TaskID, attrs entity.TaskAttributes) (*entity.Task, error)
+}
+
+type TaskUpdater interface {
+	UpdateTask(ctx context.Context, id entity.TaskID, title *string, status *entity.TaskStatus) (*entity.Task, error)
+}
+
+type TaskAssigner interface {
+	AssignTask(ctx context.Context, id entity.TaskID, assignee entity.UserID) (*entity.Task, error)
+	UnassignTask(ctx context.Context, id entity.TaskID) (*entity.Task, error)
+}
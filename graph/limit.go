@@ -0,0 +1,105 @@
+package graph
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// 목록 필드의 복잡도는 하위 필드의 복잡도에 예상 항목 수를 곱해 계산한다.
+// first 인자가 있으면 그 값을, 없으면 listSizes의 값을 사용한다.
+var listSizes = map[string]int{
+	"tasks":    DefaultPageSize,
+	"subtasks": 10,
+	"projects": 10,
+}
+
+// cost는 실행하기 전에 계산한 operation의 깊이와 복잡도이다.
+type cost struct {
+	Depth      int
+	Complexity int
+}
+
+// measure 함수는 operation의 깊이와 복잡도를 계산한다. 인트로스펙션 필드는 계산하지 않는다.
+// 문서는 검증을 통과해 프래그먼트의 순환이 없어야 한다.
+func measure(doc *ast.Document, operationName string, vars map[string]interface{}) cost {
+	m := &measurer{fragments: map[string]*ast.FragmentDefinition{}, vars: vars}
+	var op *ast.OperationDefinition
+	for _, d := range doc.Definitions {
+		switch d := d.(type) {
+		case *ast.FragmentDefinition:
+			m.fragments[d.Name.Value] = d
+		case *ast.OperationDefinition:
+			if op == nil && (operationName == "" || (d.Name != nil && d.Name.Value == operationName)) {
+				op = d
+			}
+		}
+	}
+	if op == nil {
+		return cost{}
+	}
+	return m.selectionSet(op.SelectionSet, 0)
+}
+
+type measurer struct {
+	fragments map[string]*ast.FragmentDefinition
+	vars      map[string]interface{}
+}
+
+func (m *measurer) selectionSet(ss *ast.SelectionSet, depth int) cost {
+	c := cost{Depth: depth}
+	if ss == nil {
+		return c
+	}
+	add := func(sub cost) {
+		c.Complexity += sub.Complexity
+		if sub.Depth > c.Depth {
+			c.Depth = sub.Depth
+		}
+	}
+	for _, s := range ss.Selections {
+		switch s := s.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(s.Name.Value, "__") {
+				continue
+			}
+			sub := m.selectionSet(s.SelectionSet, depth+1)
+			sub.Complexity = 1 + m.size(s)*sub.Complexity
+			add(sub)
+		case *ast.InlineFragment:
+			add(m.selectionSet(s.SelectionSet, depth))
+		case *ast.FragmentSpread:
+			if f, ok := m.fragments[s.Name.Value]; ok {
+				add(m.selectionSet(f.SelectionSet, depth))
+			}
+		}
+	}
+	return c
+}
+
+// size 메서드는 필드가 반환할 것으로 예상하는 항목 수를 반환한다.
+func (m *measurer) size(f *ast.Field) int {
+	for _, a := range f.Arguments {
+		if a.Name.Value != "first" {
+			continue
+		}
+		switch v := a.Value.(type) {
+		case *ast.IntValue:
+			if n, err := strconv.Atoi(v.Value); err == nil {
+				return max(n, 0)
+			}
+		case *ast.Variable:
+			switch n := m.vars[v.Name.Value].(type) {
+			case int:
+				return max(n, 0)
+			case float64:
+				return max(int(n), 0)
+			}
+		}
+	}
+	if n, ok := listSizes[f.Name.Value]; ok {
+		return n
+	}
+	return 1
+}
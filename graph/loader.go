@@ -0,0 +1,146 @@
+package graph
+
+import (
+	"context"
+	"sync"
+
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/entity"
+)
+
+// loader는 같은 깊이의 필드에서 요청한 키를 모아 한 번에 가져온다. (N+1 쿼리 방지)
+// 리졸버는 키를 등록하고 thunk를 반환한다. graphql-go는 같은 깊이의 필드를 모두 처리한 뒤에
+// thunk를 실행하므로, 첫 번째 thunk가 그때까지 등록된 키를 한 번에 가져온다.
+type loader[K comparable, V any] struct {
+	fetch func(ctx context.Context, keys []K) (map[K]V, error)
+
+	mu      sync.Mutex
+	pending []K
+	queued  map[K]bool
+	values  map[K]V
+	errs    map[K]error
+}
+
+func newLoader[K comparable, V any](
+	fetch func(ctx context.Context, keys []K) (map[K]V, error),
+) *loader[K, V] {
+	return &loader[K, V]{
+		fetch:  fetch,
+		queued: map[K]bool{},
+		values: map[K]V{},
+		errs:   map[K]error{},
+	}
+}
+
+// load 메서드는 키를 등록하고, 값을 반환하는 thunk를 반환한다. 가져온 값은 요청이 끝날 때까지 재사용한다.
+func (l *loader[K, V]) load(ctx context.Context, key K) func() (V, error) {
+	l.mu.Lock()
+	if !l.queued[key] {
+		l.queued[key] = true
+		l.pending = append(l.pending, key)
+	}
+	l.mu.Unlock()
+	return func() (V, error) {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		if _, ok := l.values[key]; !ok && l.errs[key] == nil {
+			l.flush(ctx)
+		}
+		return l.values[key], l.errs[key]
+	}
+}
+
+func (l *loader[K, V]) flush(ctx context.Context) {
+	keys := l.pending
+	l.pending = nil
+	values, err := l.fetch(ctx, keys)
+	for _, k := range keys {
+		if err != nil {
+			l.errs[k] = err
+			continue
+		}
+		// 찾지 못한 키는 zero value로 기억해 다시 가져오지 않는다.
+		l.values[k] = values[k]
+	}
+}
+
+// loaders는 요청마다 만드는 loader의 묶음이다.
+type loaders struct {
+	users    *loader[entity.UserID, *entity.User]
+	related  *loader[entity.UserID, bool] // 요청한 사용자가 조회할 수 있는 사용자인지
+	tasks    *loader[entity.TaskID, *entity.Task]
+	subtasks *loader[entity.TaskID, entity.Tasks]
+	projects *loader[entity.ProjectID, *entity.Project] // 요청한 사용자가 멤버인 프로젝트만 가져온다.
+}
+
+func (r *Resolver) newLoaders() *loaders {
+	return &loaders{
+		users: newLoader(func(ctx context.Context, ids []entity.UserID) (map[entity.UserID]*entity.User, error) {
+			us, err := r.Repo.ListUsersByIDs(ctx, r.DB, ids)
+			if err != nil {
+				return nil, err
+			}
+			m := make(map[entity.UserID]*entity.User, len(us))
+			for _, u := range us {
+				m[u.ID] = u
+			}
+			return m, nil
+		}),
+		related: newLoader(func(ctx context.Context, ids []entity.UserID) (map[entity.UserID]bool, error) {
+			uid, _ := auth.GetUserID(ctx)
+			related, err := r.Repo.ListRelatedUserIDs(ctx, r.DB, uid, ids)
+			if err != nil {
+				return nil, err
+			}
+			m := make(map[entity.UserID]bool, len(related))
+			for _, id := range related {
+				m[id] = true
+			}
+			return m, nil
+		}),
+		tasks: newLoader(func(ctx context.Context, ids []entity.TaskID) (map[entity.TaskID]*entity.Task, error) {
+			ts, err := r.Repo.ListTasksByIDs(ctx, r.DB, ids)
+			if err != nil {
+				return nil, err
+			}
+			m := make(map[entity.TaskID]*entity.Task, len(ts))
+			for _, t := range ts {
+				m[t.ID] = t
+			}
+			return m, nil
+		}),
+		subtasks: newLoader(func(ctx context.Context, ids []entity.TaskID) (map[entity.TaskID]entity.Tasks, error) {
+			ts, err := r.Repo.ListSubtasks(ctx, r.DB, ids)
+			if err != nil {
+				return nil, err
+			}
+			m := make(map[entity.TaskID]entity.Tasks, len(ids))
+			for _, t := range ts {
+				m[*t.ParentID] = append(m[*t.ParentID], t)
+			}
+			return m, nil
+		}),
+		projects: newLoader(func(ctx context.Context, ids []entity.ProjectID) (map[entity.ProjectID]*entity.Project, error) {
+			uid, _ := auth.GetUserID(ctx)
+			ps, err := r.Repo.ListProjectsByIDs(ctx, r.DB, uid, ids)
+			if err != nil {
+				return nil, err
+			}
+			m := make(map[entity.ProjectID]*entity.Project, len(ps))
+			for _, p := range ps {
+				m[p.ID] = p
+			}
+			return m, nil
+		}),
+	}
+}
+
+type loadersKey struct{}
+
+func withLoaders(ctx context.Context, l *loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+func getLoaders(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
@@ -0,0 +1,848 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package graph
+
+import (
+	"context"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+	"sync"
+	"time"
+)
+
+// Ensure, that RepositoryMock does implement Repository.
+// If this is not the case, regenerate this file with moq.
+var _ Repository = &RepositoryMock{}
+
+// RepositoryMock is a mock implementation of Repository.
+//
+//	func TestSomethingThatUsesRepository(t *testing.T) {
+//
+//		// make and configure a mocked Repository
+//		mockedRepository := &RepositoryMock{
+//			ListProjectsByIDsFunc: func(ctx context.Context, db store.Queryer, uid entity.UserID, ids []entity.ProjectID) (entity.Projects, error) {
+//				panic("mock out the ListProjectsByIDs method")
+//			},
+//			ListRelatedUserIDsFunc: func(ctx context.Context, db store.Queryer, uid entity.UserID, ids []entity.UserID) ([]entity.UserID, error) {
+//				panic("mock out the ListRelatedUserIDs method")
+//			},
+//			ListSubtasksFunc: func(ctx context.Context, db store.Queryer, parents []entity.TaskID) (entity.Tasks, error) {
+//				panic("mock out the ListSubtasks method")
+//			},
+//			ListTasksByIDsFunc: func(ctx context.Context, db store.Queryer, ids []entity.TaskID) (entity.Tasks, error) {
+//				panic("mock out the ListTasksByIDs method")
+//			},
+//			ListUsersByIDsFunc: func(ctx context.Context, db store.Queryer, ids []entity.UserID) ([]*entity.User, error) {
+//				panic("mock out the ListUsersByIDs method")
+//			},
+//		}
+//
+//		// use mockedRepository in code that requires Repository
+//		// and then make assertions.
+//
+//	}
+type RepositoryMock struct {
+	// ListProjectsByIDsFunc mocks the ListProjectsByIDs method.
+	ListProjectsByIDsFunc func(ctx context.Context, db store.Queryer, uid entity.UserID, ids []entity.ProjectID) (entity.Projects, error)
+
+	// ListRelatedUserIDsFunc mocks the ListRelatedUserIDs method.
+	ListRelatedUserIDsFunc func(ctx context.Context, db store.Queryer, uid entity.UserID, ids []entity.UserID) ([]entity.UserID, error)
+
+	// ListSubtasksFunc mocks the ListSubtasks method.
+	ListSubtasksFunc func(ctx context.Context, db store.Queryer, parents []entity.TaskID) (entity.Tasks, error)
+
+	// ListTasksByIDsFunc mocks the ListTasksByIDs method.
+	ListTasksByIDsFunc func(ctx context.Context, db store.Queryer, ids []entity.TaskID) (entity.Tasks, error)
+
+	// ListUsersByIDsFunc mocks the ListUsersByIDs method.
+	ListUsersByIDsFunc func(ctx context.Context, db store.Queryer, ids []entity.UserID) ([]*entity.User, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ListProjectsByIDs holds details about calls to the ListProjectsByIDs method.
+		ListProjectsByIDs []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// UID is the uid argument value.
+			UID entity.UserID
+			// Ids is the ids argument value.
+			Ids []entity.ProjectID
+		}
+		// ListRelatedUserIDs holds details about calls to the ListRelatedUserIDs method.
+		ListRelatedUserIDs []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// UID is the uid argument value.
+			UID entity.UserID
+			// Ids is the ids argument value.
+			Ids []entity.UserID
+		}
+		// ListSubtasks holds details about calls to the ListSubtasks method.
+		ListSubtasks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// Parents is the parents argument value.
+			Parents []entity.TaskID
+		}
+		// ListTasksByIDs holds details about calls to the ListTasksByIDs method.
+		ListTasksByIDs []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// Ids is the ids argument value.
+			Ids []entity.TaskID
+		}
+		// ListUsersByIDs holds details about calls to the ListUsersByIDs method.
+		ListUsersByIDs []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// Ids is the ids argument value.
+			Ids []entity.UserID
+		}
+	}
+	lockListProjectsByIDs  sync.RWMutex
+	lockListRelatedUserIDs sync.RWMutex
+	lockListSubtasks       sync.RWMutex
+	lockListTasksByIDs     sync.RWMutex
+	lockListUsersByIDs     sync.RWMutex
+}
+
+// ListProjectsByIDs calls ListProjectsByIDsFunc.
+func (mock *RepositoryMock) ListProjectsByIDs(ctx context.Context, db store.Queryer, uid entity.UserID, ids []entity.ProjectID) (entity.Projects, error) {
+	if mock.ListProjectsByIDsFunc == nil {
+		panic("RepositoryMock.ListProjectsByIDsFunc: method is nil but Repository.ListProjectsByIDs was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+		Ids []entity.ProjectID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		UID: uid,
+		Ids: ids,
+	}
+	mock.lockListProjectsByIDs.Lock()
+	mock.calls.ListProjectsByIDs = append(mock.calls.ListProjectsByIDs, callInfo)
+	mock.lockListProjectsByIDs.Unlock()
+	return mock.ListProjectsByIDsFunc(ctx, db, uid, ids)
+}
+
+// ListProjectsByIDsCalls gets all the calls that were made to ListProjectsByIDs.
+// Check the length with:
+//
+//	len(mockedRepository.ListProjectsByIDsCalls())
+func (mock *RepositoryMock) ListProjectsByIDsCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	UID entity.UserID
+	Ids []entity.ProjectID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+		Ids []entity.ProjectID
+	}
+	mock.lockListProjectsByIDs.RLock()
+	calls = mock.calls.ListProjectsByIDs
+	mock.lockListProjectsByIDs.RUnlock()
+	return calls
+}
+
+// ListRelatedUserIDs calls ListRelatedUserIDsFunc.
+func (mock *RepositoryMock) ListRelatedUserIDs(ctx context.Context, db store.Queryer, uid entity.UserID, ids []entity.UserID) ([]entity.UserID, error) {
+	if mock.ListRelatedUserIDsFunc == nil {
+		panic("RepositoryMock.ListRelatedUserIDsFunc: method is nil but Repository.ListRelatedUserIDs was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+		Ids []entity.UserID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		UID: uid,
+		Ids: ids,
+	}
+	mock.lockListRelatedUserIDs.Lock()
+	mock.calls.ListRelatedUserIDs = append(mock.calls.ListRelatedUserIDs, callInfo)
+	mock.lockListRelatedUserIDs.Unlock()
+	return mock.ListRelatedUserIDsFunc(ctx, db, uid, ids)
+}
+
+// ListRelatedUserIDsCalls gets all the calls that were made to ListRelatedUserIDs.
+// Check the length with:
+//
+//	len(mockedRepository.ListRelatedUserIDsCalls())
+func (mock *RepositoryMock) ListRelatedUserIDsCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	UID entity.UserID
+	Ids []entity.UserID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+		Ids []entity.UserID
+	}
+	mock.lockListRelatedUserIDs.RLock()
+	calls = mock.calls.ListRelatedUserIDs
+	mock.lockListRelatedUserIDs.RUnlock()
+	return calls
+}
+
+// ListSubtasks calls ListSubtasksFunc.
+func (mock *RepositoryMock) ListSubtasks(ctx context.Context, db store.Queryer, parents []entity.TaskID) (entity.Tasks, error) {
+	if mock.ListSubtasksFunc == nil {
+		panic("RepositoryMock.ListSubtasksFunc: method is nil but Repository.ListSubtasks was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Db      store.Queryer
+		Parents []entity.TaskID
+	}{
+		Ctx:     ctx,
+		Db:      db,
+		Parents: parents,
+	}
+	mock.lockListSubtasks.Lock()
+	mock.calls.ListSubtasks = append(mock.calls.ListSubtasks, callInfo)
+	mock.lockListSubtasks.Unlock()
+	return mock.ListSubtasksFunc(ctx, db, parents)
+}
+
+// ListSubtasksCalls gets all the calls that were made to ListSubtasks.
+// Check the length with:
+//
+//	len(mockedRepository.ListSubtasksCalls())
+func (mock *RepositoryMock) ListSubtasksCalls() []struct {
+	Ctx     context.Context
+	Db      store.Queryer
+	Parents []entity.TaskID
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Db      store.Queryer
+		Parents []entity.TaskID
+	}
+	mock.lockListSubtasks.RLock()
+	calls = mock.calls.ListSubtasks
+	mock.lockListSubtasks.RUnlock()
+	return calls
+}
+
+// ListTasksByIDs calls ListTasksByIDsFunc.
+func (mock *RepositoryMock) ListTasksByIDs(ctx context.Context, db store.Queryer, ids []entity.TaskID) (entity.Tasks, error) {
+	if mock.ListTasksByIDsFunc == nil {
+		panic("RepositoryMock.ListTasksByIDsFunc: method is nil but Repository.ListTasksByIDs was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		Ids []entity.TaskID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		Ids: ids,
+	}
+	mock.lockListTasksByIDs.Lock()
+	mock.calls.ListTasksByIDs = append(mock.calls.ListTasksByIDs, callInfo)
+	mock.lockListTasksByIDs.Unlock()
+	return mock.ListTasksByIDsFunc(ctx, db, ids)
+}
+
+// ListTasksByIDsCalls gets all the calls that were made to ListTasksByIDs.
+// Check the length with:
+//
+//	len(mockedRepository.ListTasksByIDsCalls())
+func (mock *RepositoryMock) ListTasksByIDsCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	Ids []entity.TaskID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		Ids []entity.TaskID
+	}
+	mock.lockListTasksByIDs.RLock()
+	calls = mock.calls.ListTasksByIDs
+	mock.lockListTasksByIDs.RUnlock()
+	return calls
+}
+
+// ListUsersByIDs calls ListUsersByIDsFunc.
+func (mock *RepositoryMock) ListUsersByIDs(ctx context.Context, db store.Queryer, ids []entity.UserID) ([]*entity.User, error) {
+	if mock.ListUsersByIDsFunc == nil {
+		panic("RepositoryMock.ListUsersByIDsFunc: method is nil but Repository.ListUsersByIDs was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		Ids []entity.UserID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		Ids: ids,
+	}
+	mock.lockListUsersByIDs.Lock()
+	mock.calls.ListUsersByIDs = append(mock.calls.ListUsersByIDs, callInfo)
+	mock.lockListUsersByIDs.Unlock()
+	return mock.ListUsersByIDsFunc(ctx, db, ids)
+}
+
+// ListUsersByIDsCalls gets all the calls that were made to ListUsersByIDs.
+// Check the length with:
+//
+//	len(mockedRepository.ListUsersByIDsCalls())
+func (mock *RepositoryMock) ListUsersByIDsCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	Ids []entity.UserID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		Ids []entity.UserID
+	}
+	mock.lockListUsersByIDs.RLock()
+	calls = mock.calls.ListUsersByIDs
+	mock.lockListUsersByIDs.RUnlock()
+	return calls
+}
+
+// Ensure, that TaskListerMock does implement TaskLister.
+// If this is not the case, regenerate this file with moq.
+var _ TaskLister = &TaskListerMock{}
+
+// TaskListerMock is a mock implementation of TaskLister.
+//
+//	func TestSomethingThatUsesTaskLister(t *testing.T) {
+//
+//		// make and configure a mocked TaskLister
+//		mockedTaskLister := &TaskListerMock{
+//			ListAssignedTasksFunc: func(ctx context.Context) (entity.Tasks, error) {
+//				panic("mock out the ListAssignedTasks method")
+//			},
+//			ListTasksFunc: func(ctx context.Context) (entity.Tasks, error) {
+//				panic("mock out the ListTasks method")
+//			},
+//			ListWorkTasksFunc: func(ctx context.Context) (entity.Tasks, error) {
+//				panic("mock out the ListWorkTasks method")
+//			},
+//		}
+//
+//		// use mockedTaskLister in code that requires TaskLister
+//		// and then make assertions.
+//
+//	}
+type TaskListerMock struct {
+	// ListAssignedTasksFunc mocks the ListAssignedTasks method.
+	ListAssignedTasksFunc func(ctx context.Context) (entity.Tasks, error)
+
+	// ListTasksFunc mocks the ListTasks method.
+	ListTasksFunc func(ctx context.Context) (entity.Tasks, error)
+
+	// ListWorkTasksFunc mocks the ListWorkTasks method.
+	ListWorkTasksFunc func(ctx context.Context) (entity.Tasks, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ListAssignedTasks holds details about calls to the ListAssignedTasks method.
+		ListAssignedTasks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// ListTasks holds details about calls to the ListTasks method.
+		ListTasks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// ListWorkTasks holds details about calls to the ListWorkTasks method.
+		ListWorkTasks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+	}
+	lockListAssignedTasks sync.RWMutex
+	lockListTasks         sync.RWMutex
+	lockListWorkTasks     sync.RWMutex
+}
+
+// ListAssignedTasks calls ListAssignedTasksFunc.
+func (mock *TaskListerMock) ListAssignedTasks(ctx context.Context) (entity.Tasks, error) {
+	if mock.ListAssignedTasksFunc == nil {
+		panic("TaskListerMock.ListAssignedTasksFunc: method is nil but TaskLister.ListAssignedTasks was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListAssignedTasks.Lock()
+	mock.calls.ListAssignedTasks = append(mock.calls.ListAssignedTasks, callInfo)
+	mock.lockListAssignedTasks.Unlock()
+	return mock.ListAssignedTasksFunc(ctx)
+}
+
+// ListAssignedTasksCalls gets all the calls that were made to ListAssignedTasks.
+// Check the length with:
+//
+//	len(mockedTaskLister.ListAssignedTasksCalls())
+func (mock *TaskListerMock) ListAssignedTasksCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockListAssignedTasks.RLock()
+	calls = mock.calls.ListAssignedTasks
+	mock.lockListAssignedTasks.RUnlock()
+	return calls
+}
+
+// ListTasks calls ListTasksFunc.
+func (mock *TaskListerMock) ListTasks(ctx context.Context) (entity.Tasks, error) {
+	if mock.ListTasksFunc == nil {
+		panic("TaskListerMock.ListTasksFunc: method is nil but TaskLister.ListTasks was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListTasks.Lock()
+	mock.calls.ListTasks = append(mock.calls.ListTasks, callInfo)
+	mock.lockListTasks.Unlock()
+	return mock.ListTasksFunc(ctx)
+}
+
+// ListTasksCalls gets all the calls that were made to ListTasks.
+// Check the length with:
+//
+//	len(mockedTaskLister.ListTasksCalls())
+func (mock *TaskListerMock) ListTasksCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockListTasks.RLock()
+	calls = mock.calls.ListTasks
+	mock.lockListTasks.RUnlock()
+	return calls
+}
+
+// ListWorkTasks calls ListWorkTasksFunc.
+func (mock *TaskListerMock) ListWorkTasks(ctx context.Context) (entity.Tasks, error) {
+	if mock.ListWorkTasksFunc == nil {
+		panic("TaskListerMock.ListWorkTasksFunc: method is nil but TaskLister.ListWorkTasks was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListWorkTasks.Lock()
+	mock.calls.ListWorkTasks = append(mock.calls.ListWorkTasks, callInfo)
+	mock.lockListWorkTasks.Unlock()
+	return mock.ListWorkTasksFunc(ctx)
+}
+
+// ListWorkTasksCalls gets all the calls that were made to ListWorkTasks.
+// Check the length with:
+//
+//	len(mockedTaskLister.ListWorkTasksCalls())
+func (mock *TaskListerMock) ListWorkTasksCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockListWorkTasks.RLock()
+	calls = mock.calls.ListWorkTasks
+	mock.lockListWorkTasks.RUnlock()
+	return calls
+}
+
+// Ensure, that ProjectListerMock does implement ProjectLister.
+// If this is not the case, regenerate this file with moq.
+var _ ProjectLister = &ProjectListerMock{}
+
+// ProjectListerMock is a mock implementation of ProjectLister.
+//
+//	func TestSomethingThatUsesProjectLister(t *testing.T) {
+//
+//		// make and configure a mocked ProjectLister
+//		mockedProjectLister := &ProjectListerMock{
+//			ListProjectsFunc: func(ctx context.Context) (entity.Projects, error) {
+//				panic("mock out the ListProjects method")
+//			},
+//		}
+//
+//		// use mockedProjectLister in code that requires ProjectLister
+//		// and then make assertions.
+//
+//	}
+type ProjectListerMock struct {
+	// ListProjectsFunc mocks the ListProjects method.
+	ListProjectsFunc func(ctx context.Context) (entity.Projects, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ListProjects holds details about calls to the ListProjects method.
+		ListProjects []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+	}
+	lockListProjects sync.RWMutex
+}
+
+// ListProjects calls ListProjectsFunc.
+func (mock *ProjectListerMock) ListProjects(ctx context.Context) (entity.Projects, error) {
+	if mock.ListProjectsFunc == nil {
+		panic("ProjectListerMock.ListProjectsFunc: method is nil but ProjectLister.ListProjects was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListProjects.Lock()
+	mock.calls.ListProjects = append(mock.calls.ListProjects, callInfo)
+	mock.lockListProjects.Unlock()
+	return mock.ListProjectsFunc(ctx)
+}
+
+// ListProjectsCalls gets all the calls that were made to ListProjects.
+// Check the length with:
+//
+//	len(mockedProjectLister.ListProjectsCalls())
+func (mock *ProjectListerMock) ListProjectsCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockListProjects.RLock()
+	calls = mock.calls.ListProjects
+	mock.lockListProjects.RUnlock()
+	return calls
+}
+
+// Ensure, that TaskAdderMock does implement TaskAdder.
+// If this is not the case, regenerate this file with moq.
+var _ TaskAdder = &TaskAdderMock{}
+
+// TaskAdderMock is a mock implementation of TaskAdder.
+//
+//	func TestSomethingThatUsesTaskAdder(t *testing.T) {
+//
+//		// make and configure a mocked TaskAdder
+//		mockedTaskAdder := &TaskAdderMock{
+//			AddTaskFunc: func(ctx context.Context, title string, due *time.Time, parent *entity.TaskID, attrs entity.TaskAttributes) (*entity.Task, error) {
+//				panic("mock out the AddTask method")
+//			},
+//		}
+//
+//		// use mockedTaskAdder in code that requires TaskAdder
+//		// and then make assertions.
+//
+//	}
+type TaskAdderMock struct {
+	// AddTaskFunc mocks the AddTask method.
+	AddTaskFunc func(ctx context.Context, title string, due *time.Time, parent *entity.TaskID, attrs entity.TaskAttributes) (*entity.Task, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AddTask holds details about calls to the AddTask method.
+		AddTask []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Title is the title argument value.
+			Title string
+			// Due is the due argument value.
+			Due *time.Time
+			// Parent is the parent argument value.
+			Parent *entity.TaskID
+			// Attrs is the attrs argument value.
+			Attrs entity.TaskAttributes
+		}
+	}
+	lockAddTask sync.RWMutex
+}
+
+// AddTask calls AddTaskFunc.
+func (mock *TaskAdderMock) AddTask(ctx context.Context, title string, due *time.Time, parent *entity.TaskID, attrs entity.TaskAttributes) (*entity.Task, error) {
+	if mock.AddTaskFunc == nil {
+		panic("TaskAdderMock.AddTaskFunc: method is nil but TaskAdder.AddTask was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Title  string
+		Due    *time.Time
+		Parent *entity.TaskID
+		Attrs  entity.TaskAttributes
+	}{
+		Ctx:    ctx,
+		Title:  title,
+		Due:    due,
+		Parent: parent,
+		Attrs:  attrs,
+	}
+	mock.lockAddTask.Lock()
+	mock.calls.AddTask = append(mock.calls.AddTask, callInfo)
+	mock.lockAddTask.Unlock()
+	return mock.AddTaskFunc(ctx, title, due, parent, attrs)
+}
+
+// AddTaskCalls gets all the calls that were made to AddTask.
+// Check the length with:
+//
+//	len(mockedTaskAdder.AddTaskCalls())
+func (mock *TaskAdderMock) AddTaskCalls() []struct {
+	Ctx    context.Context
+	Title  string
+	Due    *time.Time
+	Parent *entity.TaskID
+	Attrs  entity.TaskAttributes
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Title  string
+		Due    *time.Time
+		Parent *entity.TaskID
+		Attrs  entity.TaskAttributes
+	}
+	mock.lockAddTask.RLock()
+	calls = mock.calls.AddTask
+	mock.lockAddTask.RUnlock()
+	return calls
+}
+
+// Ensure, that TaskUpdaterMock does implement TaskUpdater.
+// If this is not the case, regenerate this file with moq.
+var _ TaskUpdater = &TaskUpdaterMock{}
+
+// TaskUpdaterMock is a mock implementation of TaskUpdater.
+//
+//	func TestSomethingThatUsesTaskUpdater(t *testing.T) {
+//
+//		// make and configure a mocked TaskUpdater
+//		mockedTaskUpdater := &TaskUpdaterMock{
+//			UpdateTaskFunc: func(ctx context.Context, id entity.TaskID, title *string, status *entity.TaskStatus) (*entity.Task, error) {
+//				panic("mock out the UpdateTask method")
+//			},
+//		}
+//
+//		// use mockedTaskUpdater in code that requires TaskUpdater
+//		// and then make assertions.
+//
+//	}
+type TaskUpdaterMock struct {
+	// UpdateTaskFunc mocks the UpdateTask method.
+	UpdateTaskFunc func(ctx context.Context, id entity.TaskID, title *string, status *entity.TaskStatus) (*entity.Task, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// UpdateTask holds details about calls to the UpdateTask method.
+		UpdateTask []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID entity.TaskID
+			// Title is the title argument value.
+			Title *string
+			// Status is the status argument value.
+			Status *entity.TaskStatus
+		}
+	}
+	lockUpdateTask sync.RWMutex
+}
+
+// UpdateTask calls UpdateTaskFunc.
+func (mock *TaskUpdaterMock) UpdateTask(ctx context.Context, id entity.TaskID, title *string, status *entity.TaskStatus) (*entity.Task, error) {
+	if mock.UpdateTaskFunc == nil {
+		panic("TaskUpdaterMock.UpdateTaskFunc: method is nil but TaskUpdater.UpdateTask was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		ID     entity.TaskID
+		Title  *string
+		Status *entity.TaskStatus
+	}{
+		Ctx:    ctx,
+		ID:     id,
+		Title:  title,
+		Status: status,
+	}
+	mock.lockUpdateTask.Lock()
+	mock.calls.UpdateTask = append(mock.calls.UpdateTask, callInfo)
+	mock.lockUpdateTask.Unlock()
+	return mock.UpdateTaskFunc(ctx, id, title, status)
+}
+
+// UpdateTaskCalls gets all the calls that were made to UpdateTask.
+// Check the length with:
+//
+//	len(mockedTaskUpdater.UpdateTaskCalls())
+func (mock *TaskUpdaterMock) UpdateTaskCalls() []struct {
+	Ctx    context.Context
+	ID     entity.TaskID
+	Title  *string
+	Status *entity.TaskStatus
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ID     entity.TaskID
+		Title  *string
+		Status *entity.TaskStatus
+	}
+	mock.lockUpdateTask.RLock()
+	calls = mock.calls.UpdateTask
+	mock.lockUpdateTask.RUnlock()
+	return calls
+}
+
+// Ensure, that TaskAssignerMock does implement TaskAssigner.
+// If this is not the case, regenerate this file with moq.
+var _ TaskAssigner = &TaskAssignerMock{}
+
+// TaskAssignerMock is a mock implementation of TaskAssigner.
+//
+//	func TestSomethingThatUsesTaskAssigner(t *testing.T) {
+//
+//		// make and configure a mocked TaskAssigner
+//		mockedTaskAssigner := &TaskAssignerMock{
+//			AssignTaskFunc: func(ctx context.Context, id entity.TaskID, assignee entity.UserID) (*entity.Task, error) {
+//				panic("mock out the AssignTask method")
+//			},
+//			UnassignTaskFunc: func(ctx context.Context, id entity.TaskID) (*entity.Task, error) {
+//				panic("mock out the UnassignTask method")
+//			},
+//		}
+//
+//		// use mockedTaskAssigner in code that requires TaskAssigner
+//		// and then make assertions.
+//
+//	}
+type TaskAssignerMock struct {
+	// AssignTaskFunc mocks the AssignTask method.
+	AssignTaskFunc func(ctx context.Context, id entity.TaskID, assignee entity.UserID) (*entity.Task, error)
+
+	// UnassignTaskFunc mocks the UnassignTask method.
+	UnassignTaskFunc func(ctx context.Context, id entity.TaskID) (*entity.Task, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AssignTask holds details about calls to the AssignTask method.
+		AssignTask []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID entity.TaskID
+			// Assignee is the assignee argument value.
+			Assignee entity.UserID
+		}
+		// UnassignTask holds details about calls to the UnassignTask method.
+		UnassignTask []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID entity.TaskID
+		}
+	}
+	lockAssignTask   sync.RWMutex
+	lockUnassignTask sync.RWMutex
+}
+
+// AssignTask calls AssignTaskFunc.
+func (mock *TaskAssignerMock) AssignTask(ctx context.Context, id entity.TaskID, assignee entity.UserID) (*entity.Task, error) {
+	if mock.AssignTaskFunc == nil {
+		panic("TaskAssignerMock.AssignTaskFunc: method is nil but TaskAssigner.AssignTask was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		ID       entity.TaskID
+		Assignee entity.UserID
+	}{
+		Ctx:      ctx,
+		ID:       id,
+		Assignee: assignee,
+	}
+	mock.lockAssignTask.Lock()
+	mock.calls.AssignTask = append(mock.calls.AssignTask, callInfo)
+	mock.lockAssignTask.Unlock()
+	return mock.AssignTaskFunc(ctx, id, assignee)
+}
+
+// AssignTaskCalls gets all the calls that were made to AssignTask.
+// Check the length with:
+//
+//	len(mockedTaskAssigner.AssignTaskCalls())
+func (mock *TaskAssignerMock) AssignTaskCalls() []struct {
+	Ctx      context.Context
+	ID       entity.TaskID
+	Assignee entity.UserID
+} {
+	var calls []struct {
+		Ctx      context.Context
+		ID       entity.TaskID
+		Assignee entity.UserID
+	}
+	mock.lockAssignTask.RLock()
+	calls = mock.calls.AssignTask
+	mock.lockAssignTask.RUnlock()
+	return calls
+}
+
+// UnassignTask calls UnassignTaskFunc.
+func (mock *TaskAssignerMock) UnassignTask(ctx context.Context, id entity.TaskID) (*entity.Task, error) {
+	if mock.UnassignTaskFunc == nil {
+		panic("TaskAssignerMock.UnassignTaskFunc: method is nil but TaskAssigner.UnassignTask was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  entity.TaskID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockUnassignTask.Lock()
+	mock.calls.UnassignTask = append(mock.calls.UnassignTask, callInfo)
+	mock.lockUnassignTask.Unlock()
+	return mock.UnassignTaskFunc(ctx, id)
+}
+
+// UnassignTaskCalls gets all the calls that were made to UnassignTask.
+// Check the length with:
+//
+//	len(mockedTaskAssigner.UnassignTaskCalls())
+func (mock *TaskAssignerMock) UnassignTaskCalls() []struct {
+	Ctx context.Context
+	ID  entity.TaskID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  entity.TaskID
+	}
+	mock.lockUnassignTask.RLock()
+	calls = mock.calls.UnassignTask
+	mock.lockUnassignTask.RUnlock()
+	return calls
+}
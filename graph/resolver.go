@@ -0,0 +1,526 @@
+package graph
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/service"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/graphql-go/graphql"
+)
+
+const (
+	scopeAll      = "all"
+	scopeOwned    = "owned"
+	scopeAssigned = "assigned"
+)
+
+// codedError는 클라이언트가 에러의 종류를 구분할 수 있도록 extensions.code를 함께 반환한다.
+type codedError struct {
+	err  error
+	code string
+}
+
+func (e *codedError) Error() string { return e.err.Error() }
+
+func (e *codedError) Unwrap() error { return e.err }
+
+func (e *codedError) Extensions() map[string]interface{} {
+	return map[string]interface{}{"code": e.code}
+}
+
+// wrapError 함수는 서비스의 에러에 해당하는 코드를 붙인다.
+func wrapError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, store.ErrNotFound):
+		return &codedError{err: err, code: "NOT_FOUND"}
+	case errors.Is(err, service.ErrUnknownStatus), errors.Is(err, service.ErrUnknownAssignee):
+		return &codedError{err: err, code: "BAD_USER_INPUT"}
+	}
+	return err
+}
+
+func badInput(format string, a ...any) error {
+	return &codedError{err: fmt.Errorf(format, a...), code: "BAD_USER_INPUT"}
+}
+
+func viewer(ctx context.Context) (entity.UserID, error) {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return 0, &codedError{err: fmt.Errorf("user_id not found"), code: "UNAUTHENTICATED"}
+	}
+	return id, nil
+}
+
+// visible 함수는 사용자가 Task의 소유자나 담당자인지 확인한다.
+func visible(t *entity.Task, uid entity.UserID) bool {
+	return t.UserID == uid || (t.AssigneeID != nil && *t.AssigneeID == uid)
+}
+
+// thunk 함수는 loader의 thunk를 graphql-go가 나중에 실행하는 형태로 바꾼다.
+func thunk[V any](f func() (V, error)) func() (interface{}, error) {
+	return func() (interface{}, error) {
+		v, err := f()
+		return v, wrapError(err)
+	}
+}
+
+func parseID(v interface{}) (int64, error) {
+	s, _ := v.(string)
+	id, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || id <= 0 {
+		return 0, badInput("invalid id %q", s)
+	}
+	return id, nil
+}
+
+func formatID(id int64) string {
+	return strconv.FormatInt(id, 10)
+}
+
+// 커서는 Task ID를 감싼 값이며, 클라이언트는 해석하지 않고 그대로 돌려보내야 한다.
+func encodeCursor(id entity.TaskID) string {
+	return base64.RawURLEncoding.EncodeToString([]byte("task:" + formatID(int64(id))))
+}
+
+func decodeCursor(c string) (entity.TaskID, error) {
+	b, err := base64.RawURLEncoding.DecodeString(c)
+	if err == nil {
+		if s, ok := strings.CutPrefix(string(b), "task:"); ok {
+			if id, err := strconv.ParseInt(s, 10, 64); err == nil {
+				return entity.TaskID(id), nil
+			}
+		}
+	}
+	return 0, badInput("invalid cursor %q", c)
+}
+
+func (r *Resolver) userID(p graphql.ResolveParams) (interface{}, error) {
+	return formatID(int64(p.Source.(*entity.User).ID)), nil
+}
+
+func (r *Resolver) userName(p graphql.ResolveParams) (interface{}, error) {
+	return p.Source.(*entity.User).Name, nil
+}
+
+func (r *Resolver) userRole(p graphql.ResolveParams) (interface{}, error) {
+	return p.Source.(*entity.User).Role, nil
+}
+
+func (r *Resolver) taskID(p graphql.ResolveParams) (interface{}, error) {
+	return formatID(int64(p.Source.(*entity.Task).ID)), nil
+}
+
+func (r *Resolver) taskClientID(p graphql.ResolveParams) (interface{}, error) {
+	return p.Source.(*entity.Task).ClientID, nil
+}
+
+func (r *Resolver) taskTitle(p graphql.ResolveParams) (interface{}, error) {
+	return p.Source.(*entity.Task).Title, nil
+}
+
+func (r *Resolver) taskStatus(p graphql.ResolveParams) (interface{}, error) {
+	return string(p.Source.(*entity.Task).Status), nil
+}
+
+func (r *Resolver) taskDue(p graphql.ResolveParams) (interface{}, error) {
+	return p.Source.(*entity.Task).Due, nil
+}
+
+func (r *Resolver) taskLabels(p graphql.ResolveParams) (interface{}, error) {
+	if ls := p.Source.(*entity.Task).Labels; ls != nil {
+		return []string(ls), nil
+	}
+	return []string{}, nil
+}
+
+// taskProject 메서드는 Task가 속한 프로젝트를 반환한다. 멤버가 아닌 프로젝트는 null이다.
+func (r *Resolver) taskProject(p graphql.ResolveParams) (interface{}, error) {
+	t := p.Source.(*entity.Task)
+	if t.ProjectID == nil {
+		return nil, nil
+	}
+	return thunk(getLoaders(p.Context).projects.load(p.Context, *t.ProjectID)), nil
+}
+
+func (r *Resolver) taskCreated(p graphql.ResolveParams) (interface{}, error) {
+	return p.Source.(*entity.Task).Created, nil
+}
+
+func (r *Resolver) taskModified(p graphql.ResolveParams) (interface{}, error) {
+	return p.Source.(*entity.Task).Modified, nil
+}
+
+func (r *Resolver) taskOwner(p graphql.ResolveParams) (interface{}, error) {
+	t := p.Source.(*entity.Task)
+	return thunk(getLoaders(p.Context).users.load(p.Context, t.UserID)), nil
+}
+
+func (r *Resolver) taskAssignee(p graphql.ResolveParams) (interface{}, error) {
+	t := p.Source.(*entity.Task)
+	if t.AssigneeID == nil {
+		return nil, nil
+	}
+	return thunk(getLoaders(p.Context).users.load(p.Context, *t.AssigneeID)), nil
+}
+
+// taskParent 메서드는 상위 Task를 반환한다. 담당자는 상위 Task를 볼 수 없으면 null을 받는다.
+func (r *Resolver) taskParent(p graphql.ResolveParams) (interface{}, error) {
+	t := p.Source.(*entity.Task)
+	if t.ParentID == nil {
+		return nil, nil
+	}
+	uid, err := viewer(p.Context)
+	if err != nil {
+		return nil, err
+	}
+	load := getLoaders(p.Context).tasks.load(p.Context, *t.ParentID)
+	return thunk(func() (*entity.Task, error) {
+		pt, err := load()
+		if err != nil || pt == nil || !visible(pt, uid) {
+			return nil, err
+		}
+		return pt, nil
+	}), nil
+}
+
+// taskSubtasks 메서드는 사용자가 볼 수 있는 하위 Task를 반환한다.
+func (r *Resolver) taskSubtasks(p graphql.ResolveParams) (interface{}, error) {
+	t := p.Source.(*entity.Task)
+	uid, err := viewer(p.Context)
+	if err != nil {
+		return nil, err
+	}
+	load := getLoaders(p.Context).subtasks.load(p.Context, t.ID)
+	return thunk(func() (entity.Tasks, error) {
+		ts, err := load()
+		if err != nil {
+			return nil, err
+		}
+		subtasks := entity.Tasks{}
+		for _, st := range ts {
+			if visible(st, uid) {
+				subtasks = append(subtasks, st)
+			}
+		}
+		return subtasks, nil
+	}), nil
+}
+
+func (r *Resolver) projectID(p graphql.ResolveParams) (interface{}, error) {
+	return formatID(int64(p.Source.(*entity.Project).ID)), nil
+}
+
+func (r *Resolver) projectName(p graphql.ResolveParams) (interface{}, error) {
+	return p.Source.(*entity.Project).Name, nil
+}
+
+func (r *Resolver) projectOwner(p graphql.ResolveParams) (interface{}, error) {
+	pj := p.Source.(*entity.Project)
+	return thunk(getLoaders(p.Context).users.load(p.Context, pj.OwnerID)), nil
+}
+
+func (r *Resolver) projectCreated(p graphql.ResolveParams) (interface{}, error) {
+	return p.Source.(*entity.Project).Created, nil
+}
+
+func (r *Resolver) me(p graphql.ResolveParams) (interface{}, error) {
+	uid, err := viewer(p.Context)
+	if err != nil {
+		return nil, err
+	}
+	return thunk(getLoaders(p.Context).users.load(p.Context, uid)), nil
+}
+
+// user 메서드는 사용자 자신이나 Task 또는 프로젝트를 공유하는 사용자를 반환한다. 그 밖의 사용자는 null이다.
+func (r *Resolver) user(p graphql.ResolveParams) (interface{}, error) {
+	if _, err := viewer(p.Context); err != nil {
+		return nil, err
+	}
+	id, err := parseID(p.Args["id"])
+	if err != nil {
+		return nil, err
+	}
+	l := getLoaders(p.Context)
+	related := l.related.load(p.Context, entity.UserID(id))
+	load := l.users.load(p.Context, entity.UserID(id))
+	return thunk(func() (*entity.User, error) {
+		ok, err := related()
+		if err != nil || !ok {
+			return nil, err
+		}
+		return load()
+	}), nil
+}
+
+// labels 메서드는 사용자가 소유하거나 담당하는 Task에 붙은 레이블을 중복 없이 이름순으로 반환한다.
+func (r *Resolver) labels(p graphql.ResolveParams) (interface{}, error) {
+	ts, err := r.Lister.ListWorkTasks(p.Context)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	seen := map[string]bool{}
+	labels := []string{}
+	for _, t := range ts {
+		for _, l := range t.Labels {
+			if !seen[l] {
+				seen[l] = true
+				labels = append(labels, l)
+			}
+		}
+	}
+	sort.Strings(labels)
+	return labels, nil
+}
+
+func (r *Resolver) projects(p graphql.ResolveParams) (interface{}, error) {
+	ps, err := r.Projects.ListProjects(p.Context)
+	return ps, wrapError(err)
+}
+
+// task 메서드는 사용자가 소유하거나 담당하는 Task를 반환한다. 볼 수 없는 Task는 null이다.
+func (r *Resolver) task(p graphql.ResolveParams) (interface{}, error) {
+	uid, err := viewer(p.Context)
+	if err != nil {
+		return nil, err
+	}
+	id, err := parseID(p.Args["id"])
+	if err != nil {
+		return nil, err
+	}
+	load := getLoaders(p.Context).tasks.load(p.Context, entity.TaskID(id))
+	return thunk(func() (*entity.Task, error) {
+		t, err := load()
+		if err != nil || t == nil || !visible(t, uid) {
+			return nil, err
+		}
+		return t, nil
+	}), nil
+}
+
+// taskFilter는 tasks 필드의 filter 인자이다.
+type taskFilter struct {
+	scope     string
+	statuses  map[entity.TaskStatus]bool
+	parentID  *entity.TaskID
+	rootOnly  bool
+	dueBefore *time.Time
+	dueAfter  *time.Time
+	search    string
+	labels    []string
+	projectID *entity.ProjectID
+}
+
+func newTaskFilter(args map[string]interface{}) (*taskFilter, error) {
+	f := &taskFilter{scope: scopeAll}
+	if s, ok := args["scope"].(string); ok {
+		f.scope = s
+	}
+	if ss, ok := args["status"].([]interface{}); ok {
+		f.statuses = map[entity.TaskStatus]bool{}
+		for _, s := range ss {
+			f.statuses[entity.TaskStatus(s.(string))] = true
+		}
+	}
+	if v, ok := args["parentId"]; ok && v != nil {
+		id, err := parseID(v)
+		if err != nil {
+			return nil, err
+		}
+		pid := entity.TaskID(id)
+		f.parentID = &pid
+	}
+	f.rootOnly, _ = args["rootOnly"].(bool)
+	if t, ok := args["dueBefore"].(time.Time); ok {
+		f.dueBefore = &t
+	}
+	if t, ok := args["dueAfter"].(time.Time); ok {
+		f.dueAfter = &t
+	}
+	f.search, _ = args["search"].(string)
+	if ls, ok := args["labels"].([]interface{}); ok {
+		for _, l := range ls {
+			f.labels = append(f.labels, l.(string))
+		}
+	}
+	if v, ok := args["projectId"]; ok && v != nil {
+		id, err := parseID(v)
+		if err != nil {
+			return nil, err
+		}
+		pid := entity.ProjectID(id)
+		f.projectID = &pid
+	}
+	return f, nil
+}
+
+func (f *taskFilter) match(t *entity.Task) bool {
+	if f.statuses != nil && !f.statuses[t.Status] {
+		return false
+	}
+	if f.parentID != nil && (t.ParentID == nil || *t.ParentID != *f.parentID) {
+		return false
+	}
+	if f.rootOnly && t.ParentID != nil {
+		return false
+	}
+	if f.dueBefore != nil && (t.Due == nil || !t.Due.Before(*f.dueBefore)) {
+		return false
+	}
+	if f.dueAfter != nil && (t.Due == nil || t.Due.Before(*f.dueAfter)) {
+		return false
+	}
+	if f.search != "" && !strings.Contains(strings.ToLower(t.Title), strings.ToLower(f.search)) {
+		return false
+	}
+	for _, l := range f.labels {
+		if !t.Labels.Has(l) {
+			return false
+		}
+	}
+	if f.projectID != nil && (t.ProjectID == nil || *t.ProjectID != *f.projectID) {
+		return false
+	}
+	return true
+}
+
+// tasks 메서드는 REST의 GET /tasks, GET /mywork와 같은 서비스로 Task를 조회하고,
+// 조건에 맞는 Task를 ID 순서로 first개씩 나누어 반환한다.
+func (r *Resolver) tasks(p graphql.ResolveParams) (interface{}, error) {
+	args, _ := p.Args["filter"].(map[string]interface{})
+	f, err := newTaskFilter(args)
+	if err != nil {
+		return nil, err
+	}
+	first, _ := p.Args["first"].(int)
+	if first < 0 || first > MaxPageSize {
+		return nil, badInput("first must be between 0 and %d", MaxPageSize)
+	}
+	var after entity.TaskID
+	if c, ok := p.Args["after"].(string); ok {
+		if after, err = decodeCursor(c); err != nil {
+			return nil, err
+		}
+	}
+	var ts entity.Tasks
+	switch f.scope {
+	case scopeOwned:
+		ts, err = r.Lister.ListTasks(p.Context)
+	case scopeAssigned:
+		ts, err = r.Lister.ListAssignedTasks(p.Context)
+	default:
+		ts, err = r.Lister.ListWorkTasks(p.Context)
+	}
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	matched := entity.Tasks{}
+	for _, t := range ts {
+		if f.match(t) {
+			matched = append(matched, t)
+		}
+	}
+	edges := []map[string]interface{}{}
+	var endCursor *string
+	hasNext := false
+	for _, t := range matched {
+		if t.ID <= after {
+			continue
+		}
+		if len(edges) == first {
+			hasNext = true
+			break
+		}
+		c := encodeCursor(t.ID)
+		endCursor = &c
+		edges = append(edges, map[string]interface{}{"cursor": c, "node": t})
+	}
+	return map[string]interface{}{
+		"edges":      edges,
+		"pageInfo":   map[string]interface{}{"hasNextPage": hasNext, "endCursor": endCursor},
+		"totalCount": len(matched),
+	}, nil
+}
+
+func (r *Resolver) createTask(p graphql.ResolveParams) (interface{}, error) {
+	in := p.Args["input"].(map[string]interface{})
+	title, _ := in["title"].(string)
+	if title == "" {
+		return nil, badInput("title must not be empty")
+	}
+	var due *time.Time
+	if t, ok := in["due"].(time.Time); ok {
+		due = &t
+	}
+	var parent *entity.TaskID
+	if v, ok := in["parentId"]; ok && v != nil {
+		id, err := parseID(v)
+		if err != nil {
+			return nil, err
+		}
+		pid := entity.TaskID(id)
+		parent = &pid
+	}
+	var attrs entity.TaskAttributes
+	if ls, ok := in["labels"].([]interface{}); ok {
+		for _, l := range ls {
+			attrs.Labels = append(attrs.Labels, l.(string))
+		}
+	}
+	t, err := r.Adder.AddTask(p.Context, title, due, parent, attrs)
+	return t, wrapError(err)
+}
+
+func (r *Resolver) updateTask(p graphql.ResolveParams) (interface{}, error) {
+	id, err := parseID(p.Args["id"])
+	if err != nil {
+		return nil, err
+	}
+	in := p.Args["input"].(map[string]interface{})
+	var title *string
+	if s, ok := in["title"].(string); ok {
+		if s == "" {
+			return nil, badInput("title must not be empty")
+		}
+		title = &s
+	}
+	var status *entity.TaskStatus
+	if s, ok := in["status"].(string); ok {
+		st := entity.TaskStatus(s)
+		status = &st
+	}
+	t, err := r.Updater.UpdateTask(p.Context, entity.TaskID(id), title, status)
+	return t, wrapError(err)
+}
+
+func (r *Resolver) assignTask(p graphql.ResolveParams) (interface{}, error) {
+	id, err := parseID(p.Args["id"])
+	if err != nil {
+		return nil, err
+	}
+	assignee, err := parseID(p.Args["assigneeId"])
+	if err != nil {
+		return nil, err
+	}
+	t, err := r.Assigner.AssignTask(p.Context, entity.TaskID(id), entity.UserID(assignee))
+	return t, wrapError(err)
+}
+
+func (r *Resolver) unassignTask(p graphql.ResolveParams) (interface{}, error) {
+	id, err := parseID(p.Args["id"])
+	if err != nil {
+		return nil, err
+	}
+	t, err := r.Assigner.UnassignTask(p.Context, entity.TaskID(id))
+	return t, wrapError(err)
+}
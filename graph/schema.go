@@ -0,0 +1,271 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/location"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+)
+
+const (
+	// DefaultPageSize는 tasks 필드의 first 인자를 생략했을 때 반환하는 Task 수이다.
+	DefaultPageSize = 20
+	// MaxPageSize는 tasks 필드의 first 인자로 지정할 수 있는 최대 Task 수이다.
+	MaxPageSize = 100
+	// DefaultMaxDepth는 기본으로 허용하는 필드의 최대 중첩 깊이이다.
+	DefaultMaxDepth = 10
+	// DefaultMaxComplexity는 기본으로 허용하는 최대 복잡도이다. 필드 하나의 복잡도는 1이고, 목록 필드는 항목 수를 곱한다.
+	DefaultMaxComplexity = 5000
+)
+
+// Resolver는 GraphQL 필드를 처리하는 데 필요한 의존성이다.
+type Resolver struct {
+	DB       store.Queryer
+	Repo     Repository
+	Lister   TaskLister
+	Projects ProjectLister
+	Adder    TaskAdder
+	Updater  TaskUpdater
+	Assigner TaskAssigner
+}
+
+// Schema는 실행할 수 있는 GraphQL 스키마이다.
+type Schema struct {
+	// MaxDepth와 MaxComplexity를 넘는 요청은 실행하지 않는다. 0이면 기본값을 사용한다.
+	MaxDepth      int
+	MaxComplexity int
+
+	resolver *Resolver
+	schema   graphql.Schema
+}
+
+// NewSchema 함수는 User, Task, 레이블, 프로젝트를 조회하고 Task를 변경하는 스키마를 만든다.
+func NewSchema(r *Resolver) (*Schema, error) {
+	userType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "User",
+		Fields: graphql.Fields{
+			"id":   &graphql.Field{Type: graphql.NewNonNull(graphql.ID), Resolve: r.userID},
+			"name": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: r.userName},
+			"role": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: r.userRole},
+		},
+	})
+	projectType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Project",
+		Fields: graphql.Fields{
+			"id":      &graphql.Field{Type: graphql.NewNonNull(graphql.ID), Resolve: r.projectID},
+			"name":    &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: r.projectName},
+			"owner":   &graphql.Field{Type: graphql.NewNonNull(userType), Resolve: r.projectOwner},
+			"created": &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime), Resolve: r.projectCreated},
+		},
+	})
+	labelsType := graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.String)))
+	var taskType *graphql.Object
+	taskType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Task",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id":       &graphql.Field{Type: graphql.NewNonNull(graphql.ID), Resolve: r.taskID},
+				"clientId": &graphql.Field{Type: graphql.String, Resolve: r.taskClientID},
+				"title":    &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: r.taskTitle},
+				"status":   &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: r.taskStatus},
+				"due":      &graphql.Field{Type: graphql.DateTime, Resolve: r.taskDue},
+				"labels":   &graphql.Field{Type: labelsType, Resolve: r.taskLabels},
+				"project":  &graphql.Field{Type: projectType, Resolve: r.taskProject},
+				"created":  &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime), Resolve: r.taskCreated},
+				"modified": &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime), Resolve: r.taskModified},
+				"owner":    &graphql.Field{Type: graphql.NewNonNull(userType), Resolve: r.taskOwner},
+				"assignee": &graphql.Field{Type: userType, Resolve: r.taskAssignee},
+				"parent":   &graphql.Field{Type: taskType, Resolve: r.taskParent},
+				"subtasks": &graphql.Field{
+					Type:    graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(taskType))),
+					Resolve: r.taskSubtasks,
+				},
+			}
+		}),
+	})
+	pageInfoType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "PageInfo",
+		Fields: graphql.Fields{
+			"hasNextPage": &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean)},
+			"endCursor":   &graphql.Field{Type: graphql.String},
+		},
+	})
+	taskEdgeType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "TaskEdge",
+		Fields: graphql.Fields{
+			"cursor": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"node":   &graphql.Field{Type: graphql.NewNonNull(taskType)},
+		},
+	})
+	taskConnectionType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "TaskConnection",
+		Fields: graphql.Fields{
+			"edges":      &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(taskEdgeType)))},
+			"pageInfo":   &graphql.Field{Type: graphql.NewNonNull(pageInfoType)},
+			"totalCount": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+		},
+	})
+	taskScopeType := graphql.NewEnum(graphql.EnumConfig{
+		Name: "TaskScope",
+		Values: graphql.EnumValueConfigMap{
+			"ALL":      &graphql.EnumValueConfig{Value: scopeAll, Description: "소유하거나 담당하는 Task"},
+			"OWNED":    &graphql.EnumValueConfig{Value: scopeOwned, Description: "소유한 Task"},
+			"ASSIGNED": &graphql.EnumValueConfig{Value: scopeAssigned, Description: "담당하는 Task"},
+		},
+	})
+	taskFilterType := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "TaskFilter",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"scope":     &graphql.InputObjectFieldConfig{Type: taskScopeType, DefaultValue: scopeAll},
+			"status":    &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.String))},
+			"parentId":  &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"rootOnly":  &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"dueBefore": &graphql.InputObjectFieldConfig{Type: graphql.DateTime},
+			"dueAfter":  &graphql.InputObjectFieldConfig{Type: graphql.DateTime},
+			"search":    &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"labels": &graphql.InputObjectFieldConfig{
+				Type:        graphql.NewList(graphql.NewNonNull(graphql.String)),
+				Description: "모든 레이블이 붙은 Task",
+			},
+			"projectId": &graphql.InputObjectFieldConfig{Type: graphql.ID},
+		},
+	})
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"me": &graphql.Field{Type: graphql.NewNonNull(userType), Resolve: r.me},
+			"user": &graphql.Field{
+				Type:    userType,
+				Args:    graphql.FieldConfigArgument{"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)}},
+				Resolve: r.user,
+			},
+			"labels": &graphql.Field{
+				Type:        labelsType,
+				Description: "소유하거나 담당하는 Task에 붙은 레이블",
+				Resolve:     r.labels,
+			},
+			"projects": &graphql.Field{
+				Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(projectType))),
+				Description: "멤버인 프로젝트",
+				Resolve:     r.projects,
+			},
+			"task": &graphql.Field{
+				Type:    taskType,
+				Args:    graphql.FieldConfigArgument{"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)}},
+				Resolve: r.task,
+			},
+			"tasks": &graphql.Field{
+				Type: graphql.NewNonNull(taskConnectionType),
+				Args: graphql.FieldConfigArgument{
+					"filter": &graphql.ArgumentConfig{Type: taskFilterType},
+					"first":  &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: DefaultPageSize},
+					"after":  &graphql.ArgumentConfig{Type: graphql.String},
+				},
+				Resolve: r.tasks,
+			},
+		},
+	})
+	mutation := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Mutation",
+		Fields: graphql.Fields{
+			"createTask": &graphql.Field{
+				Type: graphql.NewNonNull(taskType),
+				Args: graphql.FieldConfigArgument{
+					"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.NewInputObject(graphql.InputObjectConfig{
+						Name: "CreateTaskInput",
+						Fields: graphql.InputObjectConfigFieldMap{
+							"title":    &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+							"due":      &graphql.InputObjectFieldConfig{Type: graphql.DateTime},
+							"parentId": &graphql.InputObjectFieldConfig{Type: graphql.ID},
+							"labels":   &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.String))},
+						},
+					}))},
+				},
+				Resolve: r.createTask,
+			},
+			"updateTask": &graphql.Field{
+				Type: graphql.NewNonNull(taskType),
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+					"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.NewInputObject(graphql.InputObjectConfig{
+						Name: "UpdateTaskInput",
+						Fields: graphql.InputObjectConfigFieldMap{
+							"title":  &graphql.InputObjectFieldConfig{Type: graphql.String},
+							"status": &graphql.InputObjectFieldConfig{Type: graphql.String},
+						},
+					}))},
+				},
+				Resolve: r.updateTask,
+			},
+			"assignTask": &graphql.Field{
+				Type: graphql.NewNonNull(taskType),
+				Args: graphql.FieldConfigArgument{
+					"id":         &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+					"assigneeId": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				Resolve: r.assignTask,
+			},
+			"unassignTask": &graphql.Field{
+				Type:    graphql.NewNonNull(taskType),
+				Args:    graphql.FieldConfigArgument{"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)}},
+				Resolve: r.unassignTask,
+			},
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{Query: query, Mutation: mutation})
+	if err != nil {
+		return nil, fmt.Errorf("failed to build schema: %w", err)
+	}
+	return &Schema{resolver: r, schema: schema}, nil
+}
+
+// Execute 메서드는 GraphQL 요청을 실행한다.
+// 문법이나 스키마 검증에 실패하거나, 깊이나 복잡도가 제한을 넘으면 실행하지 않고 에러만 반환한다.
+func (s *Schema) Execute(
+	ctx context.Context, query, operationName string, variables map[string]interface{},
+) *graphql.Result {
+	doc, err := parser.Parse(parser.ParseParams{Source: source.NewSource(&source.Source{
+		Body: []byte(query),
+		Name: "GraphQL request",
+	})})
+	if err != nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}
+	}
+	if vr := graphql.ValidateDocument(&s.schema, doc, nil); !vr.IsValid {
+		return &graphql.Result{Errors: vr.Errors}
+	}
+	maxDepth, maxComplexity := s.MaxDepth, s.MaxComplexity
+	if maxDepth <= 0 {
+		maxDepth = DefaultMaxDepth
+	}
+	if maxComplexity <= 0 {
+		maxComplexity = DefaultMaxComplexity
+	}
+	c := measure(doc, operationName, variables)
+	if c.Depth > maxDepth {
+		return limitExceeded(fmt.Sprintf("query depth %d exceeds the limit %d", c.Depth, maxDepth))
+	}
+	if c.Complexity > maxComplexity {
+		return limitExceeded(fmt.Sprintf("query complexity %d exceeds the limit %d", c.Complexity, maxComplexity))
+	}
+	return graphql.Execute(graphql.ExecuteParams{
+		Schema:        s.schema,
+		AST:           doc,
+		OperationName: operationName,
+		Args:          variables,
+		Context:       withLoaders(ctx, s.resolver.newLoaders()),
+	})
+}
+
+func limitExceeded(msg string) *graphql.Result {
+	return &graphql.Result{Errors: []gqlerrors.FormattedError{{
+		Message:    msg,
+		Locations:  []location.SourceLocation{},
+		Extensions: map[string]interface{}{"code": "QUERY_TOO_COMPLEX"},
+	}}}
+}
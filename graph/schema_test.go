@@ -0,0 +1,346 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/clock"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/google/go-cmp/cmp"
+	"github.com/graphql-go/graphql"
+)
+
+func prepareResolver() (*Resolver, *RepositoryMock) {
+	now := clock.FixedClocker{}.Now()
+	me, other, stranger := entity.UserID(1), entity.UserID(2), entity.UserID(3)
+	parent := entity.TaskID(1)
+	pid := entity.ProjectID(1)
+	projects := entity.Projects{
+		{ID: pid, OwnerID: other, Name: "launch", Created: now, Modified: now},
+	}
+	tasks := entity.Tasks{
+		{ID: 1, UserID: me, ProjectID: &pid, Title: "release", Status: entity.TaskStatusTodo, Created: now, Modified: now,
+			TaskAttributes: entity.TaskAttributes{Labels: entity.Labels{"ops", "urgent"}}},
+		{ID: 2, UserID: me, ParentID: &parent, AssigneeID: &other, Title: "changelog", Status: entity.TaskStatusDoing, Created: now, Modified: now},
+		{ID: 3, UserID: me, ParentID: &parent, Title: "tag", Status: entity.TaskStatusDone, Created: now, Modified: now,
+			TaskAttributes: entity.TaskAttributes{Labels: entity.Labels{"ops"}}},
+		{ID: 4, UserID: other, AssigneeID: &me, Title: "review", Status: entity.TaskStatusTodo, Created: now, Modified: now},
+	}
+	users := map[entity.UserID]*entity.User{
+		me:       {ID: me, Name: "alice", Role: "user"},
+		other:    {ID: other, Name: "bob", Role: "admin"},
+		stranger: {ID: stranger, Name: "carol", Role: "user"},
+	}
+	repo := &RepositoryMock{
+		ListUsersByIDsFunc: func(ctx context.Context, db store.Queryer, ids []entity.UserID) ([]*entity.User, error) {
+			us := []*entity.User{}
+			for _, id := range ids {
+				if u, ok := users[id]; ok {
+					us = append(us, u)
+				}
+			}
+			return us, nil
+		},
+		ListRelatedUserIDsFunc: func(ctx context.Context, db store.Queryer, uid entity.UserID, ids []entity.UserID) ([]entity.UserID, error) {
+			related := []entity.UserID{}
+			for _, id := range ids {
+				if id == me || id == other {
+					related = append(related, id)
+				}
+			}
+			return related, nil
+		},
+		ListProjectsByIDsFunc: func(ctx context.Context, db store.Queryer, uid entity.UserID, ids []entity.ProjectID) (entity.Projects, error) {
+			ps := entity.Projects{}
+			for _, p := range projects {
+				for _, id := range ids {
+					if p.ID == id {
+						ps = append(ps, p)
+					}
+				}
+			}
+			return ps, nil
+		},
+		ListTasksByIDsFunc: func(ctx context.Context, db store.Queryer, ids []entity.TaskID) (entity.Tasks, error) {
+			ts := entity.Tasks{}
+			for _, t := range tasks {
+				for _, id := range ids {
+					if t.ID == id {
+						ts = append(ts, t)
+					}
+				}
+			}
+			return ts, nil
+		},
+		ListSubtasksFunc: func(ctx context.Context, db store.Queryer, parents []entity.TaskID) (entity.Tasks, error) {
+			ts := entity.Tasks{}
+			for _, t := range tasks {
+				for _, id := range parents {
+					if t.ParentID != nil && *t.ParentID == id {
+						ts = append(ts, t)
+					}
+				}
+			}
+			return ts, nil
+		},
+	}
+	lister := &TaskListerMock{
+		ListWorkTasksFunc: func(ctx context.Context) (entity.Tasks, error) {
+			return tasks, nil
+		},
+		ListAssignedTasksFunc: func(ctx context.Context) (entity.Tasks, error) {
+			return tasks[3:], nil
+		},
+	}
+	pl := &ProjectListerMock{
+		ListProjectsFunc: func(ctx context.Context) (entity.Projects, error) {
+			return projects, nil
+		},
+	}
+	return &Resolver{Repo: repo, Lister: lister, Projects: pl}, repo
+}
+
+func execute(t *testing.T, s *Schema, query string, vars map[string]interface{}) *graphql.Result {
+	t.Helper()
+	ctx := auth.SetUserID(context.Background(), 1)
+	return s.Execute(ctx, query, "", vars)
+}
+
+func assertData(t *testing.T, got *graphql.Result, want string) {
+	t.Helper()
+	if len(got.Errors) > 0 {
+		t.Fatalf("want no errors, but got %v", got.Errors)
+	}
+	var gv, wv interface{}
+	b, err := json.Marshal(got.Data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(b, &gv); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(want), &wv); err != nil {
+		t.Fatal(err)
+	}
+	if d := cmp.Diff(wv, gv); d != "" {
+		t.Errorf("differs: (-want +got)\n%s", d)
+	}
+}
+
+func TestSchema_Query(t *testing.T) {
+	t.Parallel()
+
+	r, repo := prepareResolver()
+	s, err := NewSchema(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := execute(t, s, `{
+		me { name }
+		tasks(filter: {rootOnly: true}) {
+			totalCount
+			edges {
+				node {
+					id
+					title
+					owner { name }
+					assignee { name }
+					subtasks { id owner { name } assignee { name } }
+				}
+			}
+		}
+	}`, nil)
+	assertData(t, got, `{
+		"me": {"name": "alice"},
+		"tasks": {
+			"totalCount": 2,
+			"edges": [
+				{"node": {
+					"id": "1", "title": "release", "owner": {"name": "alice"}, "assignee": null,
+					"subtasks": [
+						{"id": "2", "owner": {"name": "alice"}, "assignee": {"name": "bob"}},
+						{"id": "3", "owner": {"name": "alice"}, "assignee": null}
+					]
+				}},
+				{"node": {
+					"id": "4", "title": "review", "owner": {"name": "bob"}, "assignee": {"name": "alice"},
+					"subtasks": []
+				}}
+			]
+		}
+	}`)
+	// 같은 깊이의 필드는 한 번에 가져온다.
+	if n := len(repo.ListSubtasksCalls()); n != 1 {
+		t.Errorf("want subtasks loaded once, but got %d calls", n)
+	}
+	// me와 1단계 Task의 소유자, 담당자를 한 번에 가져오고, 하위 Task의 사용자는 이미 가져온 값을 사용한다.
+	if n := len(repo.ListUsersByIDsCalls()); n != 1 {
+		t.Errorf("want users loaded once, but got %d calls", n)
+	}
+}
+
+func TestSchema_User(t *testing.T) {
+	t.Parallel()
+
+	r, _ := prepareResolver()
+	s, err := NewSchema(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Task나 프로젝트를 공유하지 않는 사용자는 조회할 수 없다.
+	got := execute(t, s, `{
+		self: user(id: "1") { name }
+		related: user(id: "2") { name }
+		stranger: user(id: "3") { name }
+	}`, nil)
+	assertData(t, got, `{"self": {"name": "alice"}, "related": {"name": "bob"}, "stranger": null}`)
+}
+
+func TestSchema_LabelsAndProjects(t *testing.T) {
+	t.Parallel()
+
+	r, _ := prepareResolver()
+	s, err := NewSchema(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := execute(t, s, `{
+		labels
+		projects { id name owner { name } }
+		tasks(filter: {labels: ["ops"], projectId: "1"}) {
+			edges { node { id labels project { name } } }
+		}
+	}`, nil)
+	assertData(t, got, `{
+		"labels": ["ops", "urgent"],
+		"projects": [{"id": "1", "name": "launch", "owner": {"name": "bob"}}],
+		"tasks": {"edges": [{"node": {"id": "1", "labels": ["ops", "urgent"], "project": {"name": "launch"}}}]}
+	}`)
+
+	got = execute(t, s, `{ tasks(filter: {labels: ["ops"]}) { edges { node { id labels project { id } } } } }`, nil)
+	assertData(t, got, `{"tasks": {"edges": [
+		{"node": {"id": "1", "labels": ["ops", "urgent"], "project": {"id": "1"}}},
+		{"node": {"id": "3", "labels": ["ops"], "project": null}}
+	]}}`)
+}
+
+func TestSchema_Pagination(t *testing.T) {
+	t.Parallel()
+
+	r, _ := prepareResolver()
+	s, err := NewSchema(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := `query($after: String) {
+		tasks(first: 2, after: $after) { edges { node { id } } pageInfo { hasNextPage endCursor } }
+	}`
+	got := execute(t, s, query, nil)
+	assertData(t, got, fmt.Sprintf(`{"tasks": {
+		"edges": [{"node": {"id": "1"}}, {"node": {"id": "2"}}],
+		"pageInfo": {"hasNextPage": true, "endCursor": %q}
+	}}`, encodeCursor(2)))
+
+	got = execute(t, s, query, map[string]interface{}{"after": encodeCursor(2)})
+	assertData(t, got, fmt.Sprintf(`{"tasks": {
+		"edges": [{"node": {"id": "3"}}, {"node": {"id": "4"}}],
+		"pageInfo": {"hasNextPage": false, "endCursor": %q}
+	}}`, encodeCursor(4)))
+
+	got = execute(t, s, `{ tasks(filter: {scope: ASSIGNED, status: ["todo"]}) { edges { node { id parent { id } } } } }`, nil)
+	assertData(t, got, `{"tasks": {"edges": [{"node": {"id": "4", "parent": null}}]}}`)
+}
+
+func TestSchema_Limits(t *testing.T) {
+	t.Parallel()
+
+	r, _ := prepareResolver()
+	s, err := NewSchema(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.MaxDepth = 5
+	s.MaxComplexity = 100
+
+	tests := map[string]struct {
+		query string
+		vars  map[string]interface{}
+		want  string
+	}{
+		"depth": {
+			query: `{ task(id: "1") { subtasks { subtasks { subtasks { subtasks { id } } } } } }`,
+			want:  "query depth 6 exceeds the limit 5",
+		},
+		"complexity": {
+			query: `query($n: Int) { tasks(first: $n) { edges { node { id title status } } } }`,
+			vars:  map[string]interface{}{"n": 30},
+			want:  "query complexity 151 exceeds the limit 100",
+		},
+		"fragment": {
+			query: `{ tasks { ...page } } fragment page on TaskConnection { edges { node { id title status } } }`,
+			want:  "query complexity 101 exceeds the limit 100",
+		},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			got := execute(t, s, tt.query, tt.vars)
+			if len(got.Errors) != 1 || got.Errors[0].Message != tt.want {
+				t.Fatalf("want %q, but got %v", tt.want, got.Errors)
+			}
+			if got.Errors[0].Extensions["code"] != "QUERY_TOO_COMPLEX" {
+				t.Errorf("want QUERY_TOO_COMPLEX, but got %v", got.Errors[0].Extensions)
+			}
+		})
+	}
+}
+
+func TestSchema_Mutation(t *testing.T) {
+	t.Parallel()
+
+	r, _ := prepareResolver()
+	due := time.Date(2022, 5, 11, 9, 0, 0, 0, time.UTC)
+	r.Adder = &TaskAdderMock{
+		AddTaskFunc: func(ctx context.Context, title string, d *time.Time, parent *entity.TaskID, attrs entity.TaskAttributes) (*entity.Task, error) {
+			if title != "write docs" || d == nil || !d.Equal(due) || parent == nil || *parent != 1 {
+				t.Fatalf("unexpected input: %q, %v, %v", title, d, parent)
+			}
+			if d := cmp.Diff(attrs.Labels, entity.Labels{"docs"}); d != "" {
+				t.Fatalf("labels differ: (-got +want)\n%s", d)
+			}
+			return &entity.Task{ID: 5, UserID: 1, ParentID: parent, Title: title, Status: entity.TaskStatusTodo, Due: d, TaskAttributes: attrs}, nil
+		},
+	}
+	r.Updater = &TaskUpdaterMock{
+		UpdateTaskFunc: func(ctx context.Context, id entity.TaskID, title *string, status *entity.TaskStatus) (*entity.Task, error) {
+			return nil, fmt.Errorf("failed to get: task %d: %w", id, store.ErrNotFound)
+		},
+	}
+	s, err := NewSchema(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := execute(t, s, `mutation {
+		createTask(input: {title: "write docs", due: "2022-05-11T09:00:00Z", parentId: "1", labels: ["docs"]}) {
+			id status due labels parent { title }
+		}
+	}`, nil)
+	assertData(t, got, `{"createTask": {"id": "5", "status": "todo", "due": "2022-05-11T09:00:00Z", "labels": ["docs"], "parent": {"title": "release"}}}`)
+
+	got = execute(t, s, `mutation { updateTask(id: "9", input: {status: "done"}) { id } }`, nil)
+	if len(got.Errors) != 1 || !strings.Contains(got.Errors[0].Message, "not found") {
+		t.Fatalf("want not found error, but got %v", got.Errors)
+	}
+	if got.Errors[0].Extensions["code"] != "NOT_FOUND" {
+		t.Errorf("want NOT_FOUND, but got %v", got.Errors[0].Extensions)
+	}
+}
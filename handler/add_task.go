@@ -1,10 +1,12 @@
 package handler
 
 import (
-	"encoding/json"
+	"errors"
 	"net/http"
+	"time"
 
 	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
 	"github.com/go-playground/validator/v10"
 )
 
@@ -16,6 +18,7 @@ import (
 // AddTask 구조체는 HTTP 요청을 처리하고 새로운 Task를 저장하는 핸들러이다.
 type AddTask struct {
 	Service   AddTaskService // 비즈니스 로직을 처리하는 서비스
+	Parser    QuickAddParser // quick 모드에서 제목을 해석하는 파서
 	Validator *validator.Validate
 }
 
@@ -25,35 +28,60 @@ func (at *AddTask) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	// 요청 본문에서 데이터를 읽어와서 구조체에 디코딩한다.
 	var b struct {
-		Title string `json:"title" validate:"required"` // Title 필드는 JSON에서 가져오며, 필수 값임을 검증합니다.
+		Title    string         `json:"title" validate:"required"` // Title 필드는 JSON에서 가져오며, 필수 값임을 검증합니다.
+		Quick    bool           `json:"quick"`                     // true이면 Title을 자연어로 해석한다.
+		Timezone string         `json:"timezone"`                  // quick 모드에서 날짜를 계산할 타임존
+		ParentID *entity.TaskID `json:"parent_id"`                 // 하위 Task로 등록할 때 상위 Task의 ID
 	}
-	if err := json.NewDecoder(r.Body).Decode(&b); err != nil {
+	if err := decodeBody(r, &b); err != nil {
 		// 요청 본문 디코딩에 실패하면 에러 응답을 반환한다.
-		RespondJSON(ctx, w, &ErrResponse{
+		Respond(w, r, &ErrResponse{
 			Message: err.Error(),
-		}, http.StatusInternalServerError)
+		}, decodeStatus(err))
 		return
 	}
 
 	// 유효성 검사를 수행. Title 필드가 비어 있는 경우 에러가 반환된다.
 	if err := at.Validator.Struct(b); err != nil {
-		RespondJSON(ctx, w, &ErrResponse{
+		Respond(w, r, &ErrResponse{
 			Message: err.Error(),
 		}, http.StatusBadRequest)
 		return
 	}
 
-	t, err := at.Service.AddTask(ctx, b.Title)
+	// quick 모드에서는 "Pay rent tomorrow 9am #finance" 같은 문자열에서 제목과 마감 시간,
+	// 라벨, 우선순위, 반복 규칙을 추출해서 Task에 저장하고, 해석 결과를 응답으로 돌려준다.
+	title := b.Title
+	var due *time.Time
+	var attrs entity.TaskAttributes
+	var parsed *parsedTask
+	if b.Quick {
+		res, status, err := parseQuick(at.Parser, b.Title, b.Timezone)
+		if err != nil {
+			Respond(w, r, &ErrResponse{
+				Message: err.Error(),
+			}, status)
+			return
+		}
+		title, due, attrs, parsed = res.Title, res.Due, newTaskAttributes(res), newParsedTask(res)
+	}
+
+	t, err := at.Service.AddTask(ctx, title, due, b.ParentID, attrs)
 	if err != nil {
-		RespondJSON(ctx, w, &ErrResponse{
+		status := http.StatusInternalServerError
+		if errors.Is(err, store.ErrNotFound) {
+			status = http.StatusBadRequest
+		}
+		Respond(w, r, &ErrResponse{
 			Message: err.Error(),
-		}, http.StatusInternalServerError)
+		}, status)
 		return
 	}
 
 	// 성공 시 생성된 Task의 ID를 JSON 응답으로 반환한다.
 	rsp := struct {
-		ID entity.TaskID `json:"id"`
-	}{ID: t.ID}
-	RespondJSON(ctx, w, rsp, http.StatusOK)
+		ID     entity.TaskID `json:"id"`
+		Parsed *parsedTask   `json:"parsed,omitempty"`
+	}{ID: t.ID, Parsed: parsed}
+	Respond(w, r, rsp, http.StatusOK)
 }
@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/go-playground/validator/v10"
+)
+
+// AddTaskStatus는 사용자 정의 Task 상태를 등록하는 핸들러이다.
+type AddTaskStatus struct {
+	Service   AddTaskStatusService
+	Validator *validator.Validate
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, AddTaskStatus 핸들러의 엔트리 포인트이다. (POST /statuses)
+func (as *AddTaskStatus) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var b struct {
+		Name     entity.TaskStatus         `json:"name" validate:"required,max=20"`
+		Category entity.TaskStatusCategory `json:"category" validate:"required,oneof=open in_progress closed"`
+	}
+	if err := decodeBody(r, &b); err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, decodeStatus(err))
+		return
+	}
+	if err := as.Validator.Struct(b); err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+
+	s, err := as.Service.AddTaskStatus(ctx, b.Name, b.Category)
+	if err != nil {
+		status := http.StatusInternalServerError
+		// 같은 이름의 상태가 이미 있는 경우
+		if errors.Is(err, store.ErrAlreadyEntry) {
+			status = http.StatusConflict
+		}
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, status)
+		return
+	}
+	rsp := taskStatus{
+		Name:     s.Name,
+		Category: s.Category,
+	}
+	Respond(w, r, rsp, http.StatusOK)
+}
@@ -7,10 +7,14 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/gitwub5/go_todo_app/clock"
 	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/quickadd"
 	"github.com/gitwub5/go_todo_app/testutil"
 	"github.com/go-playground/validator/v10"
+	"github.com/google/go-cmp/cmp"
 )
 
 /*
@@ -26,8 +30,11 @@ func TestAddTask(t *testing.T) {
 		rspFile string
 	}
 	tests := map[string]struct {
-		reqFile string
-		want    want
+		reqFile     string
+		contentType string
+		accept      string
+		attrs       entity.TaskAttributes // 서비스에 전달해야 하는 라벨, 우선순위, 반복 규칙
+		want        want
 	}{
 		"ok": {
 			reqFile: "testdata/add_task/ok_req.json.golden",
@@ -36,6 +43,18 @@ func TestAddTask(t *testing.T) {
 				rspFile: "testdata/add_task/ok_rsp.json.golden",
 			},
 		},
+		"quick": {
+			reqFile: "testdata/add_task/quick_req.json.golden",
+			attrs: entity.TaskAttributes{
+				Labels:     entity.Labels{"finance"},
+				Priority:   entity.TaskPriorityHigh,
+				Recurrence: &entity.Recurrence{Frequency: "monthly", Interval: 1, MonthDay: 1},
+			},
+			want: want{
+				status:  http.StatusOK,
+				rspFile: "testdata/add_task/quick_rsp.json.golden",
+			},
+		},
 		"badRequest": {
 			reqFile: "testdata/add_task/bad_req.json.golden",
 			want: want{
@@ -43,23 +62,57 @@ func TestAddTask(t *testing.T) {
 				rspFile: "testdata/add_task/bad_rsp.json.golden",
 			},
 		},
+		"msgpack": {
+			reqFile:     "testdata/add_task/ok_req.json.golden",
+			contentType: "application/msgpack",
+			accept:      "application/msgpack",
+			want: want{
+				status:  http.StatusOK,
+				rspFile: "testdata/add_task/ok_rsp.json.golden",
+			},
+		},
+		"unsupportedMediaType": {
+			reqFile:     "testdata/add_task/ok_req.json.golden",
+			contentType: "text/plain",
+			want: want{
+				status:  http.StatusUnsupportedMediaType,
+				rspFile: "testdata/add_task/unsupported_rsp.json.golden",
+			},
+		},
+		"notAcceptable": {
+			reqFile: "testdata/add_task/ok_req.json.golden",
+			accept:  "text/csv",
+			want: want{
+				status:  http.StatusNotAcceptable,
+				rspFile: "testdata/add_task/not_acceptable_rsp.json.golden",
+			},
+		},
 	}
 	for n, tt := range tests {
 		tt := tt
 		t.Run(n, func(t *testing.T) {
 			t.Parallel()
 
+			body := testutil.LoadFile(t, tt.reqFile)
+			if tt.contentType == "application/msgpack" {
+				body = testutil.JSONToMsgpack(t, body)
+			}
 			w := httptest.NewRecorder()
 			r := httptest.NewRequest(
 				http.MethodPost,
 				"/tasks",
-				bytes.NewReader(testutil.LoadFile(t, tt.reqFile)),
+				bytes.NewReader(body),
 			)
+			r.Header.Set("Content-Type", tt.contentType)
+			r.Header.Set("Accept", tt.accept)
 			moq := &AddTaskServiceMock{}
 			moq.AddTaskFunc = func(
-				ctx context.Context, title string,
+				ctx context.Context, title string, due *time.Time, parent *entity.TaskID, attrs entity.TaskAttributes,
 			) (*entity.Task, error) {
-				if tt.want.status == http.StatusOK {
+				if d := cmp.Diff(attrs, tt.attrs); d != "" {
+					t.Errorf("attributes differ: (-got +want)\n%s", d)
+				}
+				if tt.want.status == http.StatusOK || tt.want.status == http.StatusNotAcceptable {
 					return &entity.Task{ID: 1}, nil
 				}
 				return nil, errors.New("error from mock")
@@ -67,6 +120,7 @@ func TestAddTask(t *testing.T) {
 
 			sut := AddTask{
 				Service:   moq,
+				Parser:    &quickadd.Parser{Clocker: clock.FixedClocker{}},
 				Validator: validator.New(),
 			}
 			sut.ServeHTTP(w, r)
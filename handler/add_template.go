@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/go-playground/validator/v10"
+)
+
+// AddTemplate는 Task와 그 하위 Task를 템플릿으로 저장하는 핸들러이다.
+type AddTemplate struct {
+	Service   AddTemplateService
+	Validator *validator.Validate
+}
+
+type template struct {
+	ID        entity.TemplateID    `json:"id"`
+	ProjectID *entity.ProjectID    `json:"project_id,omitempty"`
+	Name      string               `json:"name"`
+	Items     entity.TemplateItems `json:"items"`
+}
+
+func newTemplate(t *entity.Template) template {
+	return template{ID: t.ID, ProjectID: t.ProjectID, Name: t.Name, Items: t.Items}
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, AddTemplate 핸들러의 엔트리 포인트이다. (POST /templates)
+func (at *AddTemplate) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	// project_id를 지정하면 프로젝트의 멤버와 템플릿을 공유한다.
+	var b struct {
+		Name      string            `json:"name" validate:"required,max=128"`
+		TaskID    entity.TaskID     `json:"task_id" validate:"required"`
+		ProjectID *entity.ProjectID `json:"project_id"`
+	}
+	if err := decodeBody(r, &b); err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, decodeStatus(err))
+		return
+	}
+	if err := at.Validator.Struct(b); err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+	t, err := at.Service.AddTemplate(ctx, b.Name, b.TaskID, b.ProjectID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, store.ErrNotFound) {
+			status = http.StatusNotFound
+		}
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, status)
+		return
+	}
+	Respond(w, r, newTemplate(t), http.StatusOK)
+}
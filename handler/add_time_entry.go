@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/service"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/go-playground/validator/v10"
+)
+
+// AddTimeEntry는 작업 시간을 직접 기록하는 핸들러이다.
+type AddTimeEntry struct {
+	Service   AddTimeEntryService
+	Validator *validator.Validate
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, AddTimeEntry 핸들러의 엔트리 포인트이다. (POST /tasks/{id}/time)
+func (at *AddTimeEntry) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id, err := taskIDParam(r)
+	if err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+	// 시각은 RFC3339 형식으로 받는다.
+	var b struct {
+		Started time.Time `json:"started" validate:"required"`
+		Stopped time.Time `json:"stopped" validate:"required"`
+	}
+	if err := decodeBody(r, &b); err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, decodeStatus(err))
+		return
+	}
+	if err := at.Validator.Struct(b); err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+
+	e, err := at.Service.AddTimeEntry(ctx, id, b.Started, b.Stopped)
+	if err != nil {
+		status := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, store.ErrNotFound):
+			status = http.StatusNotFound
+		case errors.Is(err, service.ErrInvalidPeriod):
+			status = http.StatusBadRequest
+		}
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, status)
+		return
+	}
+	Respond(w, r, newTimeEntry(e, e.Duration(*e.Stopped)), http.StatusOK)
+}
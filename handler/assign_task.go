@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/service"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/go-playground/validator/v10"
+)
+
+// AssignTask는 Task의 담당자를 지정하는 핸들러이다.
+type AssignTask struct {
+	Service   AssignTaskService
+	Validator *validator.Validate
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, AssignTask 핸들러의 엔트리 포인트이다. (PUT /tasks/{id}/assignee)
+func (at *AssignTask) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id, err := taskIDParam(r)
+	if err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+	var b struct {
+		UserID entity.UserID `json:"user_id" validate:"required"`
+	}
+	if err := decodeBody(r, &b); err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, decodeStatus(err))
+		return
+	}
+	if err := at.Validator.Struct(b); err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+	t, err := at.Service.AssignTask(ctx, id, b.UserID)
+	if err != nil {
+		respondAssignError(w, r, err)
+		return
+	}
+	Respond(w, r, newTask(t), http.StatusOK)
+}
+
+// UnassignTask는 Task의 담당자를 해제하는 핸들러이다.
+type UnassignTask struct {
+	Service AssignTaskService
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, UnassignTask 핸들러의 엔트리 포인트이다. (DELETE /tasks/{id}/assignee)
+func (ut *UnassignTask) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id, err := taskIDParam(r)
+	if err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+	t, err := ut.Service.UnassignTask(ctx, id)
+	if err != nil {
+		respondAssignError(w, r, err)
+		return
+	}
+	Respond(w, r, newTask(t), http.StatusOK)
+}
+
+func respondAssignError(w http.ResponseWriter, r *http.Request, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, store.ErrNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, service.ErrUnknownAssignee):
+		status = http.StatusBadRequest
+	}
+	Respond(w, r, &ErrResponse{
+		Message: err.Error(),
+	}, status)
+}
@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/service"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/gitwub5/go_todo_app/testutil"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+)
+
+func TestAssignTask(t *testing.T) {
+	type want struct {
+		status  int
+		rspFile string
+	}
+	tests := map[string]struct {
+		reqFile string
+		err     error
+		want    want
+	}{
+		"ok": {
+			reqFile: "testdata/assign_task/ok_req.json.golden",
+			want: want{
+				status:  http.StatusOK,
+				rspFile: "testdata/assign_task/ok_rsp.json.golden",
+			},
+		},
+		"badRequest": {
+			reqFile: "testdata/assign_task/bad_req.json.golden",
+			want: want{
+				status:  http.StatusBadRequest,
+				rspFile: "testdata/assign_task/bad_rsp.json.golden",
+			},
+		},
+		"unknownAssignee": {
+			reqFile: "testdata/assign_task/ok_req.json.golden",
+			err:     fmt.Errorf("user 10: %w", service.ErrUnknownAssignee),
+			want: want{
+				status:  http.StatusBadRequest,
+				rspFile: "testdata/assign_task/unknown_assignee_rsp.json.golden",
+			},
+		},
+		"notFound": {
+			reqFile: "testdata/assign_task/ok_req.json.golden",
+			err:     fmt.Errorf("failed to get: task 1: %w", store.ErrNotFound),
+			want: want{
+				status:  http.StatusNotFound,
+				rspFile: "testdata/assign_task/not_found_rsp.json.golden",
+			},
+		},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(
+				http.MethodPut,
+				"/tasks/1/assignee",
+				bytes.NewReader(testutil.LoadFile(t, tt.reqFile)),
+			)
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("id", "1")
+			r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+
+			moq := &AssignTaskServiceMock{}
+			moq.AssignTaskFunc = func(
+				ctx context.Context, id entity.TaskID, assignee entity.UserID,
+			) (*entity.Task, error) {
+				if tt.err != nil {
+					return nil, tt.err
+				}
+				return &entity.Task{
+					ID: id, AssigneeID: &assignee, Title: "test1", Status: entity.TaskStatusTodo,
+				}, nil
+			}
+
+			sut := AssignTask{
+				Service:   moq,
+				Validator: validator.New(),
+			}
+			sut.ServeHTTP(w, r)
+
+			resp := w.Result()
+			testutil.AssertResponse(t,
+				resp, tt.want.status, testutil.LoadFile(t, tt.want.rspFile),
+			)
+		})
+	}
+}
@@ -0,0 +1,299 @@
+package handler
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+)
+
+const (
+	collabAuthTimeout  = 10 * time.Second // 첫 번째 메시지로 인증할 때까지 기다리는 시간
+	collabWriteTimeout = 10 * time.Second
+)
+
+// collabRequest는 클라이언트가 보내는 메시지이다.
+type collabRequest struct {
+	Type      string           `json:"type"` // auth, subscribe, unsubscribe
+	Token     string           `json:"token,omitempty"`
+	ProjectID entity.ProjectID `json:"project_id,omitempty"`
+}
+
+// collabMessage는 서버가 보내는 메시지이다.
+type collabMessage struct {
+	Type      string           `json:"type"` // authenticated, subscribed, unsubscribed, presence, event, error
+	ProjectID entity.ProjectID `json:"project_id,omitempty"`
+	Viewers   []entity.UserID  `json:"viewers,omitempty"`
+	ID        int64            `json:"id,omitempty"`
+	Event     entity.EventType `json:"event,omitempty"`
+	Task      *task            `json:"task,omitempty"`
+	Message   string           `json:"message,omitempty"`
+}
+
+// Collab은 WebSocket으로 프로젝트 보드를 구독해 실시간 변경 이벤트와 presence를 주고받는 핸들러이다.
+// 프로젝트를 구독하면 그 프로젝트에 속한 모든 Task의 변경 이벤트를 받는다.
+type Collab struct {
+	Auth     Authenticator
+	Events   ProjectEventService
+	Presence PresenceService
+	// Interval은 presence를 갱신하고 클라이언트에 보고하는 주기이다.
+	Interval time.Duration
+	// SendBuffer는 연결마다 쌓아 둘 수 있는 메시지 수이다. 가득 차면 느린 클라이언트로 보고 연결을 끊는다.
+	SendBuffer int
+	// OriginPatterns는 연결을 허용할 다른 출처(Origin)의 호스트 패턴이다.
+	OriginPatterns []string
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, Collab 핸들러의 엔트리 포인트이다. (GET /ws)
+// 액세스 토큰은 ?token= 쿼리 파라미터나 첫 번째 메시지({"type":"auth","token":"..."})로 전달한다.
+func (c *Collab) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	conn, err := websocket.Accept(w, r, &websocket.AcceptOptions{OriginPatterns: c.OriginPatterns})
+	if err != nil {
+		log.Printf("failed to accept websocket: %v", err)
+		return
+	}
+	defer conn.CloseNow()
+
+	ctx, err := c.authenticate(r.Context(), r, conn)
+	if err != nil {
+		_ = conn.Close(websocket.StatusPolicyViolation, "unauthorized")
+		return
+	}
+	if err := wsjson.Write(ctx, conn, &collabMessage{Type: "authenticated"}); err != nil {
+		return
+	}
+	status, reason := c.run(ctx, conn)
+	_ = conn.Close(status, reason)
+}
+
+func (c *Collab) authenticate(ctx context.Context, r *http.Request, conn *websocket.Conn) (context.Context, error) {
+	token := r.URL.Query().Get("token")
+	if token == "" {
+		actx, cancel := context.WithTimeout(ctx, collabAuthTimeout)
+		defer cancel()
+		var req collabRequest
+		if err := wsjson.Read(actx, conn, &req); err != nil {
+			return nil, err
+		}
+		if req.Type != "auth" {
+			return nil, fmt.Errorf("want auth message, but got %q", req.Type)
+		}
+		token = req.Token
+	}
+	return c.Auth.Authenticate(ctx, token)
+}
+
+// run 메서드는 연결이 끊길 때까지 메시지를 주고받고, 연결을 닫을 때 사용할 상태 코드와 이유를 반환한다.
+func (c *Collab) run(ctx context.Context, conn *websocket.Conn) (websocket.StatusCode, string) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	session, err := newSessionID()
+	if err != nil {
+		return websocket.StatusInternalError, "failed to create session"
+	}
+
+	// 쓰기는 별도 고루틴에서 처리해, 느린 클라이언트가 이벤트 수신과 다른 연결을 막지 않도록 한다.
+	size := c.SendBuffer
+	if size <= 0 {
+		size = 16
+	}
+	out := make(chan *collabMessage, size)
+	defer close(out)
+	writeErr := make(chan error, 1)
+	go func() {
+		for m := range out {
+			wctx, wcancel := context.WithTimeout(ctx, collabWriteTimeout)
+			err := wsjson.Write(wctx, conn, m)
+			wcancel()
+			if err != nil {
+				writeErr <- err
+				return
+			}
+		}
+	}()
+	send := func(m *collabMessage) bool {
+		select {
+		case out <- m:
+			return true
+		default:
+			return false
+		}
+	}
+
+	in := make(chan collabRequest)
+	readErr := make(chan error, 1)
+	go func() {
+		for {
+			var req collabRequest
+			if err := wsjson.Read(ctx, conn, &req); err != nil {
+				readErr <- err
+				return
+			}
+			select {
+			case in <- req:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	// 구독한 프로젝트의 이벤트를 한 채널로 모은다. 프로젝트 구독이 느린 구독자로 끊기면 slow에 알린다.
+	events := make(chan *entity.StreamEvent)
+	slow := make(chan struct{}, 1)
+	views := map[entity.ProjectID]*collabView{}
+	defer func() {
+		// 연결이 끊겨도 presence를 지울 수 있도록 취소되지 않는 context를 사용한다.
+		lctx := context.WithoutCancel(ctx)
+		for pid, v := range views {
+			v.close()
+			if err := c.Presence.Leave(lctx, pid, session); err != nil {
+				log.Printf("failed to leave project %d: %v", pid, err)
+			}
+		}
+	}()
+	subscribe := func(pid entity.ProjectID) error {
+		ch, unsubscribe, err := c.Events.SubscribeProject(ctx, pid)
+		if err != nil {
+			return err
+		}
+		vctx, vcancel := context.WithCancel(ctx)
+		views[pid] = &collabView{close: func() {
+			// 먼저 취소해야 채널이 닫혀도 느린 구독자로 보지 않는다.
+			vcancel()
+			unsubscribe()
+		}}
+		go func() {
+			for e := range ch {
+				select {
+				case events <- e:
+				case <-vctx.Done():
+					return
+				}
+			}
+			if vctx.Err() == nil {
+				select {
+				case slow <- struct{}{}:
+				default:
+				}
+			}
+		}()
+		return nil
+	}
+	leave := func(pid entity.ProjectID) error {
+		v, ok := views[pid]
+		if !ok {
+			return nil
+		}
+		delete(views, pid)
+		v.close()
+		return c.Presence.Leave(ctx, pid, session)
+	}
+	interval := c.Interval
+	if interval <= 0 {
+		interval = 15 * time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		ok := true
+		select {
+		case <-ctx.Done():
+			return websocket.StatusGoingAway, "server shutting down"
+		case err := <-readErr:
+			if s := websocket.CloseStatus(err); s != -1 {
+				return s, ""
+			}
+			return websocket.StatusNormalClosure, ""
+		case err := <-writeErr:
+			log.Printf("failed to write websocket message: %v", err)
+			return websocket.StatusInternalError, "write failed"
+		case req := <-in:
+			ok = send(c.handle(ctx, req, session, views, subscribe, leave))
+		case <-slow:
+			// 프로젝트 이벤트를 제때 읽지 못했으므로 다시 연결하도록 한다.
+			return websocket.StatusTryAgainLater, "slow consumer"
+		case e := <-events:
+			// 구독을 해제하기 직전에 모은 이벤트는 버린다.
+			if _, subscribed := views[e.ProjectID]; subscribed {
+				t := newTask(e.Task)
+				ok = send(&collabMessage{Type: "event", ProjectID: e.ProjectID, ID: e.ID, Event: e.Type, Task: &t})
+			}
+		case <-ticker.C:
+			for pid, v := range views {
+				uids, err := c.Presence.Join(ctx, pid, session)
+				if err != nil {
+					// 프로젝트에서 제외되었으면 이벤트도 더 이상 받지 않는다.
+					delete(views, pid)
+					v.close()
+					ok = send(&collabMessage{Type: "unsubscribed", ProjectID: pid, Message: err.Error()})
+				} else {
+					ok = send(&collabMessage{Type: "presence", ProjectID: pid, Viewers: uids})
+				}
+				if !ok {
+					break
+				}
+			}
+		}
+		if !ok {
+			return websocket.StatusTryAgainLater, "slow consumer"
+		}
+	}
+}
+
+// collabView는 연결이 구독 중인 프로젝트이다.
+type collabView struct {
+	close func() // 프로젝트 이벤트 구독을 해제한다.
+}
+
+// handle 메서드는 클라이언트의 메시지를 처리하고, 응답할 메시지를 반환한다.
+func (c *Collab) handle(
+	ctx context.Context, req collabRequest, session string, views map[entity.ProjectID]*collabView,
+	subscribe, leave func(entity.ProjectID) error,
+) *collabMessage {
+	pid := req.ProjectID
+	switch req.Type {
+	case "subscribe":
+		// 멤버인지 먼저 확인한 뒤에 이벤트를 구독한다.
+		uids, err := c.Presence.Join(ctx, pid, session)
+		if err != nil {
+			if errors.Is(err, store.ErrNotFound) {
+				return &collabMessage{Type: "error", ProjectID: pid, Message: fmt.Sprintf("project %d not found", pid)}
+			}
+			return &collabMessage{Type: "error", ProjectID: pid, Message: err.Error()}
+		}
+		if _, ok := views[pid]; !ok {
+			if err := subscribe(pid); err != nil {
+				_ = c.Presence.Leave(ctx, pid, session)
+				return &collabMessage{Type: "error", ProjectID: pid, Message: err.Error()}
+			}
+		}
+		return &collabMessage{Type: "subscribed", ProjectID: pid, Viewers: uids}
+	case "unsubscribe":
+		if err := leave(pid); err != nil {
+			return &collabMessage{Type: "error", ProjectID: pid, Message: err.Error()}
+		}
+		return &collabMessage{Type: "unsubscribed", ProjectID: pid}
+	default:
+		return &collabMessage{Type: "error", Message: fmt.Sprintf("unknown message type %q", req.Type)}
+	}
+}
+
+// newSessionID 함수는 연결을 구분하는 임의의 ID를 만든다.
+func newSessionID() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCollab(t *testing.T) {
+	t.Parallel()
+
+	events := make(chan *entity.StreamEvent, 4)
+	var (
+		mu           sync.Mutex
+		left         []entity.ProjectID
+		unsubscribed int
+	)
+	authMoq := &AuthenticatorMock{}
+	authMoq.AuthenticateFunc = func(ctx context.Context, token string) (context.Context, error) {
+		if token != "good" {
+			return nil, errors.New("invalid token")
+		}
+		return auth.SetUserID(ctx, 1), nil
+	}
+	eventMoq := &ProjectEventServiceMock{}
+	eventMoq.SubscribeProjectFunc = func(ctx context.Context, pid entity.ProjectID) (<-chan *entity.StreamEvent, func(), error) {
+		if pid != 1 {
+			t.Errorf("want to subscribe project 1, but got %d", pid)
+		}
+		return events, func() {
+			mu.Lock()
+			defer mu.Unlock()
+			unsubscribed++
+		}, nil
+	}
+	presenceMoq := &PresenceServiceMock{}
+	presenceMoq.JoinFunc = func(ctx context.Context, pid entity.ProjectID, session string) ([]entity.UserID, error) {
+		if pid != 1 {
+			return nil, fmt.Errorf("project %d: %w", pid, store.ErrNotFound)
+		}
+		return []entity.UserID{1, 2}, nil
+	}
+	presenceMoq.LeaveFunc = func(ctx context.Context, pid entity.ProjectID, session string) error {
+		mu.Lock()
+		defer mu.Unlock()
+		left = append(left, pid)
+		return nil
+	}
+	srv := httptest.NewServer(&Collab{
+		Auth: authMoq, Events: eventMoq, Presence: presenceMoq, Interval: time.Hour,
+	})
+	t.Cleanup(srv.Close)
+	url := "ws" + strings.TrimPrefix(srv.URL, "http")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+	dial := func(t *testing.T, query string) *websocket.Conn {
+		t.Helper()
+		conn, _, err := websocket.Dial(ctx, url+query, nil)
+		if err != nil {
+			t.Fatalf("failed to dial: %v", err)
+		}
+		t.Cleanup(func() { _ = conn.CloseNow() })
+		return conn
+	}
+	expect := func(t *testing.T, conn *websocket.Conn, want collabMessage) {
+		t.Helper()
+		var got collabMessage
+		if err := wsjson.Read(ctx, conn, &got); err != nil {
+			t.Fatalf("failed to read: %v", err)
+		}
+		if d := cmp.Diff(want, got); d != "" {
+			t.Errorf("differs: (-want +got)\n%s", d)
+		}
+	}
+	write := func(t *testing.T, conn *websocket.Conn, req collabRequest) {
+		t.Helper()
+		if err := wsjson.Write(ctx, conn, req); err != nil {
+			t.Fatalf("failed to write: %v", err)
+		}
+	}
+
+	t.Run("unauthorized", func(t *testing.T) {
+		conn := dial(t, "?token=bad")
+		var got collabMessage
+		err := wsjson.Read(ctx, conn, &got)
+		if s := websocket.CloseStatus(err); s != websocket.StatusPolicyViolation {
+			t.Errorf("want close status %v, but got %v", websocket.StatusPolicyViolation, err)
+		}
+	})
+
+	t.Run("subscribe", func(t *testing.T) {
+		// 쿼리 파라미터 대신 첫 번째 메시지로 인증한다.
+		conn := dial(t, "")
+		write(t, conn, collabRequest{Type: "auth", Token: "good"})
+		expect(t, conn, collabMessage{Type: "authenticated"})
+
+		// 멤버가 아닌 프로젝트는 구독할 수 없다.
+		write(t, conn, collabRequest{Type: "subscribe", ProjectID: 3})
+		expect(t, conn, collabMessage{Type: "error", ProjectID: 3, Message: "project 3 not found"})
+		write(t, conn, collabRequest{Type: "subscribe", ProjectID: 1})
+		expect(t, conn, collabMessage{Type: "subscribed", ProjectID: 1, Viewers: []entity.UserID{1, 2}})
+
+		// 프로젝트의 모든 Task 이벤트를 전달한다.
+		pid := entity.ProjectID(1)
+		events <- &entity.StreamEvent{
+			ID: 11, ProjectID: pid, Type: entity.EventTaskCreated,
+			Task: &entity.Task{ID: 4, UserID: 2, ProjectID: &pid, Title: "shared", Status: entity.TaskStatusTodo},
+		}
+		expect(t, conn, collabMessage{
+			Type: "event", ProjectID: 1, ID: 11, Event: entity.EventTaskCreated,
+			Task: &task{ID: 4, ProjectID: &pid, Title: "shared", Status: entity.TaskStatusTodo},
+		})
+
+		write(t, conn, collabRequest{Type: "unsubscribe", ProjectID: 1})
+		expect(t, conn, collabMessage{Type: "unsubscribed", ProjectID: 1})
+		write(t, conn, collabRequest{Type: "ping"})
+		expect(t, conn, collabMessage{Type: "error", Message: `unknown message type "ping"`})
+
+		mu.Lock()
+		defer mu.Unlock()
+		if d := cmp.Diff([]entity.ProjectID{1}, left); d != "" {
+			t.Errorf("differs: (-want +got)\n%s", d)
+		}
+		if unsubscribed != 1 {
+			t.Errorf("want project events unsubscribed once, but got %d", unsubscribed)
+		}
+	})
+}
@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+)
+
+// DeleteTask는 Task를 하위 Task와 함께 삭제하는 핸들러이다.
+type DeleteTask struct {
+	Service DeleteTaskService
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, DeleteTask 핸들러의 엔트리 포인트이다. (DELETE /tasks/{id})
+// 삭제한 Task의 ID를 삭제한 순서(하위 Task부터)로 반환한다.
+func (dt *DeleteTask) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id, err := taskIDParam(r)
+	if err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+
+	ts, err := dt.Service.DeleteTask(ctx, id)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, store.ErrNotFound) {
+			status = http.StatusNotFound
+		}
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, status)
+		return
+	}
+	rsp := struct {
+		DeletedIDs []entity.TaskID `json:"deleted_ids"`
+	}{DeletedIDs: []entity.TaskID{}}
+	for _, t := range ts {
+		rsp.DeletedIDs = append(rsp.DeletedIDs, t.ID)
+	}
+	Respond(w, r, rsp, http.StatusOK)
+}
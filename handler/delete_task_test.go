@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/gitwub5/go_todo_app/testutil"
+	"github.com/go-chi/chi/v5"
+)
+
+func TestDeleteTask(t *testing.T) {
+	type want struct {
+		status  int
+		rspFile string
+	}
+	tests := map[string]struct {
+		id   string
+		err  error
+		want want
+	}{
+		"ok": {
+			id: "1",
+			want: want{
+				status:  http.StatusOK,
+				rspFile: "testdata/delete_task/ok_rsp.json.golden",
+			},
+		},
+		"badID": {
+			id: "abc",
+			want: want{
+				status:  http.StatusBadRequest,
+				rspFile: "testdata/delete_task/bad_id_rsp.json.golden",
+			},
+		},
+		"notFound": {
+			id:  "1",
+			err: fmt.Errorf("failed to get: %w", store.ErrNotFound),
+			want: want{
+				status:  http.StatusNotFound,
+				rspFile: "testdata/delete_task/not_found_rsp.json.golden",
+			},
+		},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodDelete, "/tasks/"+tt.id, nil)
+			// chi의 URL 파라미터를 직접 설정한다.
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("id", tt.id)
+			r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+
+			moq := &DeleteTaskServiceMock{}
+			moq.DeleteTaskFunc = func(ctx context.Context, id entity.TaskID) (entity.Tasks, error) {
+				if tt.err != nil {
+					return nil, tt.err
+				}
+				// 하위 Task부터 삭제한다.
+				return entity.Tasks{{ID: 2, ParentID: &id}, {ID: id}}, nil
+			}
+			sut := DeleteTask{Service: moq}
+			sut.ServeHTTP(w, r)
+
+			resp := w.Result()
+			testutil.AssertResponse(t,
+				resp, tt.want.status, testutil.LoadFile(t, tt.want.rspFile),
+			)
+		})
+	}
+}
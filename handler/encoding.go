@@ -0,0 +1,286 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+/*
+응답은 먼저 JSON으로 인코딩한 뒤, Accept 헤더에 따라 MessagePack이나 CSV로 변환한다.
+따라서 어느 형식이든 필드 이름과 생략 규칙(omitempty)은 JSON 응답과 같다.
+요청 바디도 Content-Type이 MessagePack이면 JSON으로 변환한 뒤 디코딩한다.
+*/
+
+const (
+	MIMEJSON    = "application/json"
+	MIMEMsgpack = "application/msgpack"
+	MIMECSV     = "text/csv"
+)
+
+// ErrUnsupportedMediaType은 요청 바디의 Content-Type을 디코딩할 수 없을 때 반환한다.
+var ErrUnsupportedMediaType = errors.New("unsupported media type")
+
+// msgpackAliases는 MessagePack으로 취급하는 Content-Type이다.
+var msgpackAliases = map[string]bool{
+	MIMEMsgpack:                 true,
+	"application/x-msgpack":     true,
+	"application/vnd.msgpack":   true,
+	"application/x-messagepack": true,
+}
+
+// encoder는 JSON으로 인코딩된 응답을 하나의 형식으로 변환한다.
+type encoder struct {
+	mediaType   string
+	contentType string
+	// accepts는 JSON 응답을 이 형식으로 나타낼 수 있는지 확인한다. nil이면 항상 나타낼 수 있다.
+	accepts func(b []byte) bool
+	convert func(b []byte) ([]byte, error)
+}
+
+// encoders는 서버가 선호하는 순서로 나열한 응답 형식이다.
+var encoders = []*encoder{
+	{
+		mediaType:   MIMEJSON,
+		contentType: "application/json; charset=utf-8",
+		convert:     func(b []byte) ([]byte, error) { return b, nil },
+	},
+	{
+		mediaType:   MIMEMsgpack,
+		contentType: MIMEMsgpack,
+		convert:     jsonToMsgpack,
+	},
+	{
+		// CSV는 목록을 반환하는 엔드포인트에서만 사용할 수 있다.
+		mediaType:   MIMECSV,
+		contentType: "text/csv; charset=utf-8",
+		accepts:     isObjectList,
+		convert:     jsonToCSV,
+	},
+}
+
+// mediaRange는 Accept 헤더의 항목 하나이다.
+type mediaRange struct {
+	typ string
+	q   float64
+}
+
+// parseAccept 함수는 Accept 헤더를 q 값이 큰 순서로 나열한다.
+func parseAccept(accept string) []mediaRange {
+	var rs []mediaRange
+	for _, part := range strings.Split(accept, ",") {
+		mt, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		q := 1.0
+		if v, ok := params["q"]; ok {
+			if q, err = strconv.ParseFloat(v, 64); err != nil {
+				continue
+			}
+		}
+		rs = append(rs, mediaRange{typ: mt, q: q})
+	}
+	sort.SliceStable(rs, func(i, j int) bool { return rs[i].q > rs[j].q })
+	return rs
+}
+
+// matches 메서드는 인코더의 형식이 media range에 포함되는지 확인한다.
+func (e *encoder) matches(typ string) bool {
+	if typ == "*/*" || e.is(typ) {
+		return true
+	}
+	major, _, _ := strings.Cut(e.mediaType, "/")
+	return typ == major+"/*"
+}
+
+// is 메서드는 typ이 인코더의 형식을 가리키는지 확인한다.
+func (e *encoder) is(typ string) bool {
+	return typ == e.mediaType || (e.mediaType == MIMEMsgpack && msgpackAliases[typ])
+}
+
+// negotiate 함수는 Accept 헤더와 JSON으로 인코딩된 응답 b에 맞는 인코더를 고른다.
+// Accept 헤더가 없으면 JSON을 사용하고, 맞는 인코더가 없으면 false를 반환한다.
+// q=0으로 명시한 형식은 와일드카드에 포함되더라도 사용하지 않는다.
+func negotiate(accept string, b []byte) (*encoder, bool) {
+	if strings.TrimSpace(accept) == "" {
+		return encoders[0], true
+	}
+	rs := parseAccept(accept)
+	refused := func(e *encoder) bool {
+		for _, r := range rs {
+			if r.q <= 0 && e.is(r.typ) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, r := range rs {
+		if r.q <= 0 {
+			break
+		}
+		for _, e := range encoders {
+			if e.matches(r.typ) && !refused(e) && (e.accepts == nil || e.accepts(b)) {
+				return e, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// jsonToMsgpack 함수는 JSON을 같은 구조의 MessagePack으로 변환한다.
+func jsonToMsgpack(b []byte) ([]byte, error) {
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	var v any
+	if err := d.Decode(&v); err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	enc := msgpack.NewEncoder(&buf)
+	enc.SetSortMapKeys(true)
+	if err := enc.Encode(fromJSONNumber(v)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// fromJSONNumber 함수는 json.Number를 정수는 int64, 그 외에는 float64로 바꾼다.
+func fromJSONNumber(v any) any {
+	switch v := v.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	case map[string]any:
+		for k, e := range v {
+			v[k] = fromJSONNumber(e)
+		}
+	case []any:
+		for i, e := range v {
+			v[i] = fromJSONNumber(e)
+		}
+	}
+	return v
+}
+
+// isObjectList 함수는 JSON이 객체의 배열인지 확인한다.
+func isObjectList(b []byte) bool {
+	var rows []map[string]json.RawMessage
+	return json.Unmarshal(b, &rows) == nil && rows != nil
+}
+
+// jsonToCSV 함수는 객체의 배열인 JSON을 헤더가 있는 CSV로 변환한다.
+// 열은 객체의 키가 처음 나온 순서이며, 객체나 배열인 값은 JSON 문자열로, null은 빈 문자열로 쓴다.
+func jsonToCSV(b []byte) ([]byte, error) {
+	d := json.NewDecoder(bytes.NewReader(b))
+	if _, err := d.Token(); err != nil { // [
+		return nil, err
+	}
+	var cols []string
+	index := map[string]int{}
+	var rows []map[string]string
+	for d.More() {
+		if _, err := d.Token(); err != nil { // {
+			return nil, err
+		}
+		row := map[string]string{}
+		for d.More() {
+			t, err := d.Token()
+			if err != nil {
+				return nil, err
+			}
+			k := t.(string)
+			var raw json.RawMessage
+			if err := d.Decode(&raw); err != nil {
+				return nil, err
+			}
+			if _, ok := index[k]; !ok {
+				index[k] = len(cols)
+				cols = append(cols, k)
+			}
+			row[k] = csvValue(raw)
+		}
+		if _, err := d.Token(); err != nil { // }
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+
+	var buf bytes.Buffer
+	if len(rows) == 0 {
+		return buf.Bytes(), nil
+	}
+	cw := csv.NewWriter(&buf)
+	if err := cw.Write(cols); err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		rec := make([]string, len(cols))
+		for k, v := range row {
+			rec[index[k]] = v
+		}
+		if err := cw.Write(rec); err != nil {
+			return nil, err
+		}
+	}
+	cw.Flush()
+	return buf.Bytes(), cw.Error()
+}
+
+func csvValue(raw json.RawMessage) string {
+	var s string
+	switch {
+	case string(raw) == "null":
+		return ""
+	case json.Unmarshal(raw, &s) == nil:
+		return s
+	}
+	return string(raw)
+}
+
+// decodeBody 함수는 요청 바디를 Content-Type에 따라 v로 디코딩한다.
+// Content-Type이 없으면 JSON으로 간주하고, 지원하지 않는 형식이면 ErrUnsupportedMediaType을 반환한다.
+func decodeBody(r *http.Request, v any) error {
+	mt := MIMEJSON
+	if ct := r.Header.Get("Content-Type"); ct != "" {
+		var err error
+		if mt, _, err = mime.ParseMediaType(ct); err != nil {
+			return fmt.Errorf("%w: %q", ErrUnsupportedMediaType, ct)
+		}
+	}
+	switch {
+	case mt == MIMEJSON:
+		return json.NewDecoder(r.Body).Decode(v)
+	case msgpackAliases[mt]:
+		var m any
+		if err := msgpack.NewDecoder(r.Body).Decode(&m); err != nil {
+			return err
+		}
+		b, err := json.Marshal(m)
+		if err != nil {
+			return err
+		}
+		return json.Unmarshal(b, v)
+	}
+	return fmt.Errorf("%w: %q", ErrUnsupportedMediaType, mt)
+}
+
+// decodeStatus 함수는 decodeBody의 에러에 해당하는 상태 코드를 반환한다.
+func decodeStatus(err error) int {
+	if errors.Is(err, ErrUnsupportedMediaType) {
+		return http.StatusUnsupportedMediaType
+	}
+	return http.StatusInternalServerError
+}
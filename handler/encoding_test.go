@@ -0,0 +1,43 @@
+package handler
+
+import "testing"
+
+func TestNegotiate(t *testing.T) {
+	t.Parallel()
+
+	list := []byte(`[{"id": 1}]`)
+	object := []byte(`{"id": 1}`)
+	tests := map[string]struct {
+		accept string
+		body   []byte
+		want   string // 빈 문자열이면 응답할 수 있는 형식이 없다.
+	}{
+		"none":          {accept: "", body: object, want: MIMEJSON},
+		"any":           {accept: "*/*", body: list, want: MIMEJSON},
+		"quality":       {accept: "application/json;q=0.5, application/msgpack", body: object, want: MIMEMsgpack},
+		"alias":         {accept: "application/vnd.msgpack", body: object, want: MIMEMsgpack},
+		"csvList":       {accept: "text/csv, application/json;q=0.1", body: list, want: MIMECSV},
+		"csvObject":     {accept: "text/csv, application/json;q=0.1", body: object, want: MIMEJSON},
+		"csvOnly":       {accept: "text/*", body: object, want: ""},
+		"refused":       {accept: "application/json;q=0, */*;q=0.1", body: object, want: MIMEMsgpack},
+		"unsupported":   {accept: "text/html, application/xml", body: list, want: ""},
+		"invalidAccept": {accept: "not a media type", body: list, want: ""},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := negotiate(tt.accept, tt.body)
+			if tt.want == "" {
+				if ok {
+					t.Errorf("want no encoder, but got %q", got.mediaType)
+				}
+				return
+			}
+			if !ok || got.mediaType != tt.want {
+				t.Errorf("want %q, but got %v", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/entity"
+)
+
+// EventStream은 Task 변경 이벤트를 Server-Sent Events로 전달하는 핸들러이다.
+type EventStream struct {
+	Service EventStreamService
+	// Heartbeat는 연결을 유지하기 위해 주석 행을 보내는 주기이다.
+	Heartbeat time.Duration
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, EventStream 핸들러의 엔트리 포인트이다. (GET /events)
+// Last-Event-ID 헤더를 지정하면 재전송 버퍼에 남아 있는 그 이후의 이벤트부터 전달한다.
+func (es *EventStream) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		Respond(w, r, &ErrResponse{
+			Message: "streaming unsupported",
+		}, http.StatusInternalServerError)
+		return
+	}
+	var lastID int64
+	if v := r.Header.Get("Last-Event-ID"); v != "" {
+		id, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || id < 0 {
+			Respond(w, r, &ErrResponse{
+				Message: fmt.Sprintf("invalid Last-Event-ID %q", v),
+			}, http.StatusBadRequest)
+			return
+		}
+		lastID = id
+	}
+	replay, events, cancel, err := es.Service.Subscribe(ctx, lastID)
+	if err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusInternalServerError)
+		return
+	}
+	defer cancel()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no") // 리버스 프록시의 버퍼링을 끈다.
+	w.WriteHeader(http.StatusOK)
+
+	// 재전송한 이벤트가 구독 채널로 다시 오면 건너뛴다.
+	var replayed int64
+	for _, e := range replay {
+		if err := writeEvent(w, e); err != nil {
+			return
+		}
+		replayed = e.ID
+	}
+	flusher.Flush()
+
+	heartbeat := es.Heartbeat
+	if heartbeat <= 0 {
+		heartbeat = 15 * time.Second
+	}
+	ticker := time.NewTicker(heartbeat)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := io.WriteString(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+		case e, ok := <-events:
+			// 채널이 닫히면 응답을 끝낸다. 클라이언트는 Last-Event-ID로 다시 연결한다.
+			if !ok {
+				return
+			}
+			if e.ID <= replayed {
+				continue
+			}
+			if err := writeEvent(w, e); err != nil {
+				return
+			}
+		}
+		flusher.Flush()
+	}
+}
+
+// writeEvent 함수는 이벤트를 SSE 형식으로 쓴다.
+func writeEvent(w io.Writer, e *entity.StreamEvent) error {
+	data, err := json.Marshal(struct {
+		Task     task      `json:"task"`
+		Occurred time.Time `json:"occurred"`
+	}{Task: newTask(e.Task), Occurred: e.Occurred})
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", e.ID, e.Type, data)
+	return err
+}
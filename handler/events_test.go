@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gitwub5/go_todo_app/clock"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/testutil"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEventStream(t *testing.T) {
+	type want struct {
+		status  int
+		lastID  int64
+		rspFile string
+	}
+	tests := map[string]struct {
+		lastEventID string
+		want        want
+	}{
+		"ok": {
+			want: want{
+				status:  http.StatusOK,
+				rspFile: "testdata/event_stream/ok_rsp.txt.golden",
+			},
+		},
+		"resume": {
+			lastEventID: "1",
+			want: want{
+				status:  http.StatusOK,
+				lastID:  1,
+				rspFile: "testdata/event_stream/resume_rsp.txt.golden",
+			},
+		},
+		"badLastEventID": {
+			lastEventID: "abc",
+			want: want{
+				status:  http.StatusBadRequest,
+				rspFile: "testdata/event_stream/bad_rsp.json.golden",
+			},
+		},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodGet, "/events", nil)
+			if tt.lastEventID != "" {
+				r.Header.Set("Last-Event-ID", tt.lastEventID)
+			}
+
+			now := clock.FixedClocker{}.Now()
+			newEvent := func(id int64, typ entity.EventType, status entity.TaskStatus) *entity.StreamEvent {
+				return &entity.StreamEvent{
+					ID: id, UserID: 1, Type: typ, Occurred: now,
+					Task: &entity.Task{ID: 1, UserID: 1, Title: "test1", Status: status},
+				}
+			}
+			moq := &EventStreamServiceMock{}
+			moq.SubscribeFunc = func(ctx context.Context, lastID int64) ([]*entity.StreamEvent, <-chan *entity.StreamEvent, func(), error) {
+				if lastID != tt.want.lastID {
+					t.Errorf("want last id %d, but got %d", tt.want.lastID, lastID)
+				}
+				replay := []*entity.StreamEvent{}
+				ch := make(chan *entity.StreamEvent, 2)
+				if lastID > 0 {
+					replay = append(replay, newEvent(2, entity.EventTaskUpdated, entity.TaskStatusDoing))
+					// 재전송한 이벤트가 구독 채널로 다시 와도 한 번만 전달한다.
+					ch <- newEvent(2, entity.EventTaskUpdated, entity.TaskStatusDoing)
+				}
+				ch <- newEvent(3, entity.EventTaskCompleted, entity.TaskStatusDone)
+				close(ch)
+				return replay, ch, func() {}, nil
+			}
+			sut := EventStream{Service: moq}
+			sut.ServeHTTP(w, r)
+
+			resp := w.Result()
+			if tt.want.status != http.StatusOK {
+				testutil.AssertResponse(t,
+					resp, tt.want.status, testutil.LoadFile(t, tt.want.rspFile),
+				)
+				return
+			}
+			t.Cleanup(func() { _ = resp.Body.Close() })
+			if resp.StatusCode != tt.want.status {
+				t.Fatalf("want status %d, but got %d", tt.want.status, resp.StatusCode)
+			}
+			if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+				t.Errorf("want text/event-stream, but got %q", ct)
+			}
+			got, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if d := cmp.Diff(string(testutil.LoadFile(t, tt.want.rspFile)), string(got)); d != "" {
+				t.Errorf("differs: (-want +got)\n%s", d)
+			}
+		})
+	}
+}
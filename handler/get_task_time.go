@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+)
+
+// GetTaskTime은 Task에 기록된 작업 시간의 합계를 반환하는 핸들러이다.
+type GetTaskTime struct {
+	Service GetTaskTimeService
+}
+
+type dailyTime struct {
+	Date    string `json:"date"`
+	Seconds int64  `json:"seconds"`
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, GetTaskTime 핸들러의 엔트리 포인트이다. (GET /tasks/{id}/time)
+func (gt *GetTaskTime) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id, err := taskIDParam(r)
+	if err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+	loc, err := locationParam(r)
+	if err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+	tt, err := gt.Service.GetTaskTime(ctx, id, loc)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, store.ErrNotFound) {
+			status = http.StatusNotFound
+		}
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, status)
+		return
+	}
+
+	rsp := struct {
+		TaskID       entity.TaskID `json:"task_id"`
+		TotalSeconds int64         `json:"total_seconds"`
+		Running      bool          `json:"running"`
+		Days         []dailyTime   `json:"days"`
+		Entries      []timeEntry   `json:"entries"`
+	}{
+		TaskID:       tt.TaskID,
+		TotalSeconds: int64(tt.Total.Seconds()),
+		Running:      tt.Running,
+		Days:         []dailyTime{},
+		Entries:      []timeEntry{},
+	}
+	for _, d := range tt.Days {
+		rsp.Days = append(rsp.Days, dailyTime{Date: d.Date, Seconds: int64(d.Duration.Seconds())})
+	}
+	for _, e := range tt.Entries {
+		rsp.Entries = append(rsp.Entries, newTimeEntry(e.TimeEntry, e.Duration))
+	}
+	Respond(w, r, rsp, http.StatusOK)
+}
@@ -0,0 +1,103 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/service"
+)
+
+// GetTimesheet은 기간 내의 작업 시간을 날짜별, Task별로 집계한 보고서를 반환하는 핸들러이다.
+type GetTimesheet struct {
+	Service GetTimesheetService
+}
+
+// maxTimesheetDays는 한 번에 조회할 수 있는 최대 일수이다.
+const maxTimesheetDays = 92
+
+type taskSeconds struct {
+	TaskID  entity.TaskID `json:"task_id"`
+	Seconds int64         `json:"seconds"`
+}
+
+type timesheetDay struct {
+	Date         string        `json:"date"`
+	TotalSeconds int64         `json:"total_seconds"`
+	Tasks        []taskSeconds `json:"tasks"`
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, GetTimesheet 핸들러의 엔트리 포인트이다.
+// (GET /timesheet?from=2006-01-02&to=2006-01-02&tz=Asia/Seoul)
+func (gt *GetTimesheet) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	loc, err := locationParam(r)
+	if err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+	q := r.URL.Query()
+	from, err := time.ParseInLocation(time.DateOnly, q.Get("from"), loc)
+	if err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: "invalid from",
+			Details: []string{err.Error()},
+		}, http.StatusBadRequest)
+		return
+	}
+	to, err := time.ParseInLocation(time.DateOnly, q.Get("to"), loc)
+	if err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: "invalid to",
+			Details: []string{err.Error()},
+		}, http.StatusBadRequest)
+		return
+	}
+	// to는 해당 날짜를 포함하므로 다음 날 0시까지 집계한다.
+	to = to.AddDate(0, 0, 1)
+	if to.Sub(from) > maxTimesheetDays*24*time.Hour {
+		Respond(w, r, &ErrResponse{
+			Message: "period is too long",
+		}, http.StatusBadRequest)
+		return
+	}
+
+	ts, err := gt.Service.GetTimesheet(ctx, from, to)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, service.ErrInvalidPeriod) {
+			status = http.StatusBadRequest
+		}
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, status)
+		return
+	}
+
+	rsp := struct {
+		From         string         `json:"from"`
+		To           string         `json:"to"`
+		TotalSeconds int64          `json:"total_seconds"`
+		Days         []timesheetDay `json:"days"`
+	}{
+		From:         ts.From,
+		To:           ts.To,
+		TotalSeconds: int64(ts.Total.Seconds()),
+		Days:         []timesheetDay{},
+	}
+	for _, d := range ts.Days {
+		day := timesheetDay{
+			Date:         d.Date,
+			TotalSeconds: int64(d.Total.Seconds()),
+			Tasks:        []taskSeconds{},
+		}
+		for _, t := range d.Tasks {
+			day.Tasks = append(day.Tasks, taskSeconds{TaskID: t.TaskID, Seconds: int64(t.Duration.Seconds())})
+		}
+		rsp.Days = append(rsp.Days, day)
+	}
+	Respond(w, r, rsp, http.StatusOK)
+}
@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/testutil"
+)
+
+func TestGetTimesheet(t *testing.T) {
+	type want struct {
+		status  int
+		rspFile string
+	}
+	tests := map[string]struct {
+		query string
+		want  want
+	}{
+		"ok": {
+			query: "from=2022-05-09&to=2022-05-10&tz=Asia/Seoul",
+			want: want{
+				status:  http.StatusOK,
+				rspFile: "testdata/get_timesheet/ok_rsp.json.golden",
+			},
+		},
+		"badRequest": {
+			query: "from=2022/05/09&to=2022-05-10",
+			want: want{
+				status:  http.StatusBadRequest,
+				rspFile: "testdata/get_timesheet/bad_rsp.json.golden",
+			},
+		},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodGet, "/timesheet?"+tt.query, nil)
+
+			moq := &GetTimesheetServiceMock{}
+			moq.GetTimesheetFunc = func(ctx context.Context, from, to time.Time) (*entity.Timesheet, error) {
+				// to는 마지막 날짜의 다음 날 0시여야 한다.
+				if got := to.Sub(from); got != 48*time.Hour {
+					t.Errorf("want 48h period, but got %v", got)
+				}
+				if from.Location().String() != "Asia/Seoul" {
+					t.Errorf("want Asia/Seoul, but got %v", from.Location())
+				}
+				return &entity.Timesheet{
+					From:  "2022-05-09",
+					To:    "2022-05-10",
+					Total: 90 * time.Minute,
+					Days: []entity.TimesheetDay{
+						{
+							Date:  "2022-05-10",
+							Total: 90 * time.Minute,
+							Tasks: []entity.TaskDuration{
+								{TaskID: 1, Duration: time.Hour},
+								{TaskID: 2, Duration: 30 * time.Minute},
+							},
+						},
+					},
+				}, nil
+			}
+			sut := GetTimesheet{Service: moq}
+			sut.ServeHTTP(w, r)
+
+			resp := w.Result()
+			testutil.AssertResponse(t,
+				resp, tt.want.status, testutil.LoadFile(t, tt.want.rspFile),
+			)
+		})
+	}
+}
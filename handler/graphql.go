@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// GraphQL은 GraphQL 요청을 실행하는 핸들러이다.
+type GraphQL struct {
+	Executor  GraphQLExecutor
+	Validator *validator.Validate
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, GraphQL 핸들러의 엔트리 포인트이다. (POST /graphql)
+// GraphQL의 관례에 따라 실행 결과의 에러는 상태 코드 200과 함께 errors 필드로 반환한다.
+func (g *GraphQL) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var b struct {
+		Query         string                 `json:"query" validate:"required"`
+		OperationName string                 `json:"operationName"`
+		Variables     map[string]interface{} `json:"variables"`
+	}
+	if err := decodeBody(r, &b); err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, decodeStatus(err))
+		return
+	}
+	if err := g.Validator.Struct(b); err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+	Respond(w, r, g.Executor.Execute(ctx, b.Query, b.OperationName, b.Variables), http.StatusOK)
+}
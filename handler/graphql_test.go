@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gitwub5/go_todo_app/testutil"
+	"github.com/go-playground/validator/v10"
+	"github.com/graphql-go/graphql"
+)
+
+func TestGraphQL(t *testing.T) {
+	type want struct {
+		status  int
+		rspFile string
+	}
+	tests := map[string]struct {
+		reqFile string
+		want    want
+	}{
+		"ok": {
+			reqFile: "testdata/graphql/ok_req.json.golden",
+			want: want{
+				status:  http.StatusOK,
+				rspFile: "testdata/graphql/ok_rsp.json.golden",
+			},
+		},
+		"badRequest": {
+			reqFile: "testdata/graphql/bad_req.json.golden",
+			want: want{
+				status:  http.StatusBadRequest,
+				rspFile: "testdata/graphql/bad_rsp.json.golden",
+			},
+		},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(
+				http.MethodPost,
+				"/graphql",
+				bytes.NewReader(testutil.LoadFile(t, tt.reqFile)),
+			)
+
+			moq := &GraphQLExecutorMock{}
+			moq.ExecuteFunc = func(
+				ctx context.Context, query, operationName string, variables map[string]interface{},
+			) *graphql.Result {
+				if variables["id"] != "1" {
+					t.Fatalf("unexpected variables: %v", variables)
+				}
+				return &graphql.Result{Data: map[string]interface{}{
+					"task": map[string]interface{}{"title": "release"},
+				}}
+			}
+			sut := GraphQL{Executor: moq, Validator: validator.New()}
+			sut.ServeHTTP(w, r)
+
+			resp := w.Result()
+			testutil.AssertResponse(t,
+				resp, tt.want.status, testutil.LoadFile(t, tt.want.rspFile),
+			)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+)
+
+// InstantiateTemplate은 템플릿으로부터 Task 트리를 생성하는 핸들러이다.
+type InstantiateTemplate struct {
+	Service   InstantiateTemplateService
+	Validator *validator.Validate
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, InstantiateTemplate 핸들러의 엔트리 포인트이다. (POST /templates/{id}/instantiate)
+func (it *InstantiateTemplate) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+	// anchor는 템플릿의 마감 시간 오프셋을 계산하는 기준 시각이다. (RFC3339)
+	var b struct {
+		Anchor time.Time `json:"anchor" validate:"required"`
+	}
+	if err := decodeBody(r, &b); err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, decodeStatus(err))
+		return
+	}
+	if err := it.Validator.Struct(b); err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+	tasks, err := it.Service.InstantiateTemplate(ctx, entity.TemplateID(id), b.Anchor)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, store.ErrNotFound) {
+			status = http.StatusNotFound
+		}
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, status)
+		return
+	}
+	Respond(w, r, newTasks(tasks), http.StatusOK)
+}
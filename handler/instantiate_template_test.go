@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/gitwub5/go_todo_app/testutil"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+)
+
+func TestInstantiateTemplate(t *testing.T) {
+	type want struct {
+		status  int
+		rspFile string
+	}
+	tests := map[string]struct {
+		reqFile string
+		err     error
+		want    want
+	}{
+		"ok": {
+			reqFile: "testdata/instantiate_template/ok_req.json.golden",
+			want: want{
+				status:  http.StatusOK,
+				rspFile: "testdata/instantiate_template/ok_rsp.json.golden",
+			},
+		},
+		"badRequest": {
+			reqFile: "testdata/instantiate_template/empty_req.json.golden",
+			want: want{
+				status:  http.StatusBadRequest,
+				rspFile: "testdata/instantiate_template/bad_rsp.json.golden",
+			},
+		},
+		"notFound": {
+			reqFile: "testdata/instantiate_template/ok_req.json.golden",
+			err:     fmt.Errorf("failed to get: template 1: %w", store.ErrNotFound),
+			want: want{
+				status:  http.StatusNotFound,
+				rspFile: "testdata/instantiate_template/not_found_rsp.json.golden",
+			},
+		},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(
+				http.MethodPost,
+				"/templates/1/instantiate",
+				bytes.NewReader(testutil.LoadFile(t, tt.reqFile)),
+			)
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("id", "1")
+			r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+
+			moq := &InstantiateTemplateServiceMock{}
+			moq.InstantiateTemplateFunc = func(
+				ctx context.Context, id entity.TemplateID, anchor time.Time,
+			) (entity.Tasks, error) {
+				if tt.err != nil {
+					return nil, tt.err
+				}
+				parent := entity.TaskID(1)
+				due := anchor.Add(24 * time.Hour)
+				return entity.Tasks{
+					{ID: 1, Title: "release", Status: entity.TaskStatusTodo},
+					{ID: 2, ParentID: &parent, Title: "changelog", Status: entity.TaskStatusTodo, Due: &due},
+				}, nil
+			}
+
+			sut := InstantiateTemplate{
+				Service:   moq,
+				Validator: validator.New(),
+			}
+			sut.ServeHTTP(w, r)
+
+			resp := w.Result()
+			testutil.AssertResponse(t,
+				resp, tt.want.status, testutil.LoadFile(t, tt.want.rspFile),
+			)
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// JWKS는 액세스 토큰을 검증하는 공개 키를 JWK Set(RFC 7517)으로 응답하는 핸들러이다.
+type JWKS struct {
+	Service KeySetService
+	// MaxAge는 클라이언트가 응답을 캐시할 시간이다. 0이면 캐시하지 않는다.
+	MaxAge time.Duration
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, JWKS 핸들러의 엔트리 포인트이다. (GET /.well-known/jwks.json)
+// 서명 키를 교체하는 동안에는 이전 키와 새 키를 모두 포함한다.
+func (j *JWKS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if j.MaxAge > 0 {
+		w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(j.MaxAge.Seconds())))
+	} else {
+		w.Header().Set("Cache-Control", "no-cache")
+	}
+	Respond(w, r, j.Service.PublicKeys(), http.StatusOK)
+}
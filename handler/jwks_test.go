@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/testutil"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+)
+
+func TestJWKS(t *testing.T) {
+	t.Parallel()
+
+	golden := testutil.LoadFile(t, "testdata/jwks/ok_rsp.json.golden")
+	set, err := jwk.Parse(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := map[string]struct {
+		maxAge time.Duration
+		want   string
+	}{
+		"cache":   {maxAge: 5 * time.Minute, want: "public, max-age=300"},
+		"noCache": {want: "no-cache"},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil)
+			moq := &KeySetServiceMock{PublicKeysFunc: func() jwk.Set { return set }}
+			sut := &JWKS{Service: moq, MaxAge: tt.maxAge}
+			sut.ServeHTTP(w, r)
+
+			rsp := w.Result()
+			if got := rsp.Header.Get("Cache-Control"); got != tt.want {
+				t.Errorf("want Cache-Control %q, but got %q", tt.want, got)
+			}
+			testutil.AssertResponse(t, rsp, http.StatusOK, golden)
+		})
+	}
+}
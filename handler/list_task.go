@@ -1,7 +1,9 @@
 package handler
 
 import (
+	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gitwub5/go_todo_app/entity"
 )
@@ -9,32 +11,74 @@ import (
 // ListTask는 Task 목록을 반환하는 핸들러이다.
 type ListTask struct {
 	Service ListTasksService
+	// Envelope가 true이면 목록을 {"data": [...]}로 감싸 응답한다. (/v2)
+	Envelope bool
 }
 
 type task struct {
-	ID     entity.TaskID     `json:"id"`
-	Title  string            `json:"title"`
-	Status entity.TaskStatus `json:"status"`
+	ID         entity.TaskID     `json:"id"`
+	ProjectID  *entity.ProjectID `json:"project_id,omitempty"`
+	ParentID   *entity.TaskID    `json:"parent_id,omitempty"`
+	AssigneeID *entity.UserID    `json:"assignee_id,omitempty"`
+	Title      string            `json:"title"`
+	Status     entity.TaskStatus `json:"status"`
+	Due        *time.Time        `json:"due,omitempty"`
+	entity.TaskAttributes
+}
+
+// newTask 함수는 entity.Task를 JSON 응답용 구조체로 변환한다.
+func newTask(t *entity.Task) task {
+	return task{
+		ID:         t.ID,
+		ProjectID:  t.ProjectID,
+		ParentID:   t.ParentID,
+		AssigneeID: t.AssigneeID,
+		Title:      t.Title,
+		Status:     t.Status,
+		Due:        t.Due,
+
+		TaskAttributes: t.TaskAttributes,
+	}
+}
+
+// newTasks 함수는 entity.Tasks를 JSON 응답용 슬라이스로 변환한다.
+func newTasks(ts entity.Tasks) []task {
+	rsp := []task{}
+	for _, t := range ts {
+		rsp = append(rsp, newTask(t))
+	}
+	return rsp
 }
 
 // ServeHTTP는 HTTP 요청을 처리하는 메서드로, ListTask 핸들러의 엔트리 포인트이다. (GET /tasks)
+// ?assignee=me를 지정하면 자신이 담당자로 지정된 Task 목록을 반환한다.
 func (lt *ListTask) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
-	tasks, err := lt.Service.ListTasks(ctx)
+	var (
+		tasks entity.Tasks
+		err   error
+	)
+	switch a := r.URL.Query().Get("assignee"); a {
+	case "":
+		tasks, err = lt.Service.ListTasks(ctx)
+	case "me":
+		tasks, err = lt.Service.ListAssignedTasks(ctx)
+	default:
+		Respond(w, r, &ErrResponse{
+			Message: fmt.Sprintf("unsupported assignee %q", a),
+		}, http.StatusBadRequest)
+		return
+	}
 	if err != nil {
-		RespondJSON(ctx, w, &ErrResponse{
+		Respond(w, r, &ErrResponse{
 			Message: err.Error(),
 		}, http.StatusInternalServerError)
 		return
 	}
 	// 등록이 끝난 모든 Task 목록을 JSON 응답으로 변환한다.
-	rsp := []task{}
-	for _, t := range tasks {
-		rsp = append(rsp, task{
-			ID:     t.ID,
-			Title:  t.Title,
-			Status: t.Status,
-		})
+	if lt.Envelope {
+		Respond(w, r, listResponse{Data: newTasks(tasks)}, http.StatusOK)
+		return
 	}
-	RespondJSON(ctx, w, rsp, http.StatusOK)
+	Respond(w, r, newTasks(tasks), http.StatusOK)
 }
@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gitwub5/go_todo_app/entity"
+)
+
+// ListTaskStatus는 사용자가 사용할 수 있는 Task 상태 목록을 반환하는 핸들러이다.
+type ListTaskStatus struct {
+	Service ListTaskStatusesService
+}
+
+type taskStatus struct {
+	Name     entity.TaskStatus         `json:"name"`
+	Category entity.TaskStatusCategory `json:"category"`
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, ListTaskStatus 핸들러의 엔트리 포인트이다. (GET /statuses)
+func (ls *ListTaskStatus) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defs, err := ls.Service.ListTaskStatuses(ctx)
+	if err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusInternalServerError)
+		return
+	}
+	rsp := []taskStatus{}
+	for _, d := range defs {
+		rsp = append(rsp, taskStatus{
+			Name:     d.Name,
+			Category: d.Category,
+		})
+	}
+	Respond(w, r, rsp, http.StatusOK)
+}
@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/testutil"
+)
+
+func TestListTaskStatus(t *testing.T) {
+	t.Parallel()
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/statuses", nil)
+
+	moq := &ListTaskStatusesServiceMock{}
+	moq.ListTaskStatusesFunc = func(ctx context.Context) (entity.TaskStatusDefs, error) {
+		return entity.TaskStatusDefs{
+			{Name: entity.TaskStatusTodo, Category: entity.TaskStatusCategoryOpen},
+			{Name: "review", Category: entity.TaskStatusCategoryInProgress},
+			{Name: entity.TaskStatusDone, Category: entity.TaskStatusCategoryClosed},
+		}, nil
+	}
+	sut := ListTaskStatus{Service: moq}
+	sut.ServeHTTP(w, r)
+
+	resp := w.Result()
+	testutil.AssertResponse(t,
+		resp, http.StatusOK, testutil.LoadFile(t, "testdata/list_task_status/ok_rsp.json.golden"),
+	)
+}
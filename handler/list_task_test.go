@@ -17,8 +17,11 @@ func TestListTask(t *testing.T) {
 		rspFile string
 	}
 	tests := map[string]struct {
-		tasks []*entity.Task
-		want  want
+		query    string
+		accept   string
+		envelope bool
+		tasks    []*entity.Task
+		want     want
 	}{
 		"ok": {
 			tasks: []*entity.Task{
@@ -38,6 +41,68 @@ func TestListTask(t *testing.T) {
 				rspFile: "testdata/list_task/ok_rsp.json.golden",
 			},
 		},
+		"assignedToMe": {
+			query: "?assignee=me",
+			tasks: []*entity.Task{
+				{
+					ID:         3,
+					AssigneeID: func() *entity.UserID { id := entity.UserID(10); return &id }(),
+					Title:      "assigned",
+					Status:     entity.TaskStatusDoing,
+				},
+			},
+			want: want{
+				status:  http.StatusOK,
+				rspFile: "testdata/list_task/assigned_rsp.json.golden",
+			},
+		},
+		"badAssignee": {
+			query: "?assignee=someone",
+			tasks: []*entity.Task{},
+			want: want{
+				status:  http.StatusBadRequest,
+				rspFile: "testdata/list_task/bad_assignee_rsp.json.golden",
+			},
+		},
+		"csv": {
+			accept: "text/csv, application/json;q=0.5",
+			tasks: []*entity.Task{
+				{ID: 1, Title: "test1", Status: entity.TaskStatusTodo},
+				{
+					ID:         2,
+					ParentID:   func() *entity.TaskID { id := entity.TaskID(1); return &id }(),
+					AssigneeID: func() *entity.UserID { id := entity.UserID(10); return &id }(),
+					Title:      "test2, with comma",
+					Status:     entity.TaskStatusDone,
+				},
+			},
+			want: want{
+				status:  http.StatusOK,
+				rspFile: "testdata/list_task/ok_rsp.csv.golden",
+			},
+		},
+		"msgpack": {
+			accept: "application/x-msgpack",
+			tasks: []*entity.Task{
+				{ID: 1, Title: "test1", Status: entity.TaskStatusTodo},
+				{ID: 2, Title: "test2", Status: entity.TaskStatusDone},
+			},
+			want: want{
+				status:  http.StatusOK,
+				rspFile: "testdata/list_task/ok_rsp.json.golden",
+			},
+		},
+		"envelope": {
+			envelope: true,
+			tasks: []*entity.Task{
+				{ID: 1, Title: "test1", Status: entity.TaskStatusTodo},
+				{ID: 2, Title: "test2", Status: entity.TaskStatusDone},
+			},
+			want: want{
+				status:  http.StatusOK,
+				rspFile: "testdata/list_task/envelope_rsp.json.golden",
+			},
+		},
 		"empty": {
 			tasks: []*entity.Task{},
 			want: want{
@@ -52,7 +117,8 @@ func TestListTask(t *testing.T) {
 			t.Parallel()
 
 			w := httptest.NewRecorder()
-			r := httptest.NewRequest(http.MethodGet, "/tasks", nil)
+			r := httptest.NewRequest(http.MethodGet, "/tasks"+tt.query, nil)
+			r.Header.Set("Accept", tt.accept)
 
 			moq := &ListTasksServiceMock{}
 			moq.ListTasksFunc = func(ctx context.Context) (entity.Tasks, error) {
@@ -61,7 +127,10 @@ func TestListTask(t *testing.T) {
 				}
 				return nil, errors.New("error from mock")
 			}
-			sut := ListTask{Service: moq}
+			moq.ListAssignedTasksFunc = func(ctx context.Context) (entity.Tasks, error) {
+				return tt.tasks, nil
+			}
+			sut := ListTask{Service: moq, Envelope: tt.envelope}
 			sut.ServeHTTP(w, r)
 
 			resp := w.Result()
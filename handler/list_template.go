@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"net/http"
+)
+
+// ListTemplate은 사용자가 만들었거나 사용자가 멤버인 프로젝트에 공유된 템플릿 목록을 반환하는 핸들러이다.
+type ListTemplate struct {
+	Service ListTemplatesService
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, ListTemplate 핸들러의 엔트리 포인트이다. (GET /templates)
+func (lt *ListTemplate) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	ts, err := lt.Service.ListTemplates(ctx)
+	if err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusInternalServerError)
+		return
+	}
+	rsp := []template{}
+	for _, t := range ts {
+		rsp = append(rsp, newTemplate(t))
+	}
+	Respond(w, r, rsp, http.StatusOK)
+}
@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"net/http"
+)
+
+// ListWork는 사용자가 소유하거나 담당하는 Task 목록("my work")을 반환하는 핸들러이다.
+type ListWork struct {
+	Service ListWorkService
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, ListWork 핸들러의 엔트리 포인트이다. (GET /mywork)
+func (lw *ListWork) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tasks, err := lw.Service.ListWorkTasks(ctx)
+	if err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusInternalServerError)
+		return
+	}
+	Respond(w, r, newTasks(tasks), http.StatusOK)
+}
@@ -1,12 +1,16 @@
 package handler
 
 import (
-	"encoding/json"
+	"net"
 	"net/http"
 
+	"github.com/gitwub5/go_todo_app/entity"
 	"github.com/go-playground/validator/v10"
 )
 
+// maxDeviceLength는 세션 목록에 보관하는 User-Agent의 최대 길이이다.
+const maxDeviceLength = 256
+
 type Login struct {
 	Service   LoginService
 	Validator *validator.Validate
@@ -19,33 +23,42 @@ func (l *Login) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		Password string `json:"password" validate:"required"`
 	}
 	// 요청 본문에서 데이터를 읽어와서 구조체에 디코딩한다.
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		RespondJSON(ctx, w, &ErrResponse{
+	if err := decodeBody(r, &body); err != nil {
+		Respond(w, r, &ErrResponse{
 			Message: err.Error(),
-		}, http.StatusInternalServerError)
+		}, decodeStatus(err))
 		return
 	}
 	// 유효성 검사 수행
 	err := l.Validator.Struct(body)
 	if err != nil {
-		RespondJSON(ctx, w, &ErrResponse{
+		Respond(w, r, &ErrResponse{
 			Message: err.Error(),
 		}, http.StatusBadRequest)
 		return
 	}
 	// 로그인 서비스 호출
-	jwt, err := l.Service.Login(ctx, body.UserName, body.Password)
+	tokens, err := l.Service.Login(ctx, body.UserName, body.Password, clientOf(r))
 	if err != nil {
-		RespondJSON(ctx, w, &ErrResponse{
+		Respond(w, r, &ErrResponse{
 			Message: err.Error(),
 		}, http.StatusInternalServerError)
 		return
 	}
-	rsp := struct {
-		AccessToken string `json:"access_token"`
-	}{
-		AccessToken: jwt,
-	}
 
-	RespondJSON(ctx, w, rsp, http.StatusOK)
+	Respond(w, r, tokens, http.StatusOK)
+}
+
+// clientOf 함수는 로그인 요청을 보낸 기기의 정보를 만든다.
+// 프록시가 보낸 X-Forwarded-For는 위조할 수 있으므로 사용하지 않는다.
+func clientOf(r *http.Request) entity.Client {
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+	device := r.UserAgent()
+	if len(device) > maxDeviceLength {
+		device = device[:maxDeviceLength]
+	}
+	return entity.Client{Device: device, IP: ip}
 }
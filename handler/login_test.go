@@ -8,14 +8,15 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/gitwub5/go_todo_app/entity"
 	"github.com/gitwub5/go_todo_app/testutil"
 	"github.com/go-playground/validator/v10"
 )
 
 func TestLogin_ServeHTTP(t *testing.T) {
 	type moq struct {
-		token string
-		err   error
+		tokens *entity.Tokens
+		err    error
 	}
 	type want struct {
 		status  int
@@ -29,7 +30,7 @@ func TestLogin_ServeHTTP(t *testing.T) {
 		"ok": {
 			reqFile: "testdata/login/ok_req.json.golden",
 			moq: moq{
-				token: "from_moq",
+				tokens: &entity.Tokens{AccessToken: "from_moq", RefreshToken: "refresh_from_moq"},
 			},
 			want: want{
 				status:  http.StatusOK,
@@ -67,8 +68,14 @@ func TestLogin_ServeHTTP(t *testing.T) {
 			)
 
 			moq := &LoginServiceMock{}
-			moq.LoginFunc = func(ctx context.Context, name, pw string) (string, error) {
-				return tt.moq.token, tt.moq.err
+			r.Header.Set("User-Agent", "todo-test")
+			moq.LoginFunc = func(ctx context.Context, name, pw string, c entity.Client) (*entity.Tokens, error) {
+				// 세션에는 요청을 보낸 기기의 정보를 기록한다.
+				want := entity.Client{Device: "todo-test", IP: "192.0.2.1"}
+				if c != want {
+					t.Errorf("want client %+v, but got %+v", want, c)
+				}
+				return tt.moq.tokens, tt.moq.err
 			}
 			sut := Login{
 				Service:   moq,
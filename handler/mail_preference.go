@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/service"
+	"github.com/go-playground/validator/v10"
+)
+
+// mailPreference는 메일 주소와 종류별 수신 여부를 나타내는 응답이다.
+type mailPreference struct {
+	Email   *string                  `json:"email"`
+	Enabled map[entity.MailKind]bool `json:"enabled"`
+}
+
+func newMailPreference(p *entity.MailPreference) mailPreference {
+	rsp := mailPreference{Email: p.Email, Enabled: map[entity.MailKind]bool{}}
+	for _, k := range entity.OptOutMailKinds {
+		rsp.Enabled[k] = true
+	}
+	for _, k := range p.OptOuts {
+		rsp.Enabled[k] = false
+	}
+	return rsp
+}
+
+// GetMailPreference는 사용자의 메일 수신 설정을 반환하는 핸들러이다.
+type GetMailPreference struct {
+	Service MailPreferenceService
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, GetMailPreference 핸들러의 엔트리 포인트이다. (GET /mail/preferences)
+func (gp *GetMailPreference) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	p, err := gp.Service.GetMailPreference(ctx)
+	if err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusInternalServerError)
+		return
+	}
+	Respond(w, r, newMailPreference(p), http.StatusOK)
+}
+
+// UpdateMailPreference는 메일 주소와 종류별 수신 여부를 변경하는 핸들러이다.
+type UpdateMailPreference struct {
+	Service   MailPreferenceService
+	Validator *validator.Validate
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, UpdateMailPreference 핸들러의 엔트리 포인트이다. (PUT /mail/preferences)
+func (up *UpdateMailPreference) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	// email을 빈 문자열로 보내면 메일 주소를 삭제한다.
+	var b struct {
+		Email   *string                  `json:"email" validate:"omitempty,max=255"`
+		Enabled map[entity.MailKind]bool `json:"enabled"`
+	}
+	if err := decodeBody(r, &b); err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, decodeStatus(err))
+		return
+	}
+	if err := up.Validator.Struct(b); err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+	if b.Email != nil && *b.Email != "" {
+		if err := up.Validator.Var(*b.Email, "email"); err != nil {
+			Respond(w, r, &ErrResponse{
+				Message: err.Error(),
+			}, http.StatusBadRequest)
+			return
+		}
+	}
+	p, err := up.Service.UpdateMailPreference(ctx, b.Email, b.Enabled)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, service.ErrUnknownMailKind) {
+			status = http.StatusBadRequest
+		}
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, status)
+		return
+	}
+	Respond(w, r, newMailPreference(p), http.StatusOK)
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/entity"
 )
 
 /*
@@ -12,14 +13,20 @@ import (
 따라서 미들웨어를 사용해 JWT에 포함된 인증 및 권한 정보를 추출하고, 이를 context에 저장해 다른 패키지에서 사용할 수 있도록 한다.
 */
 
+// RequestAuthenticator는 요청의 토큰을 검증하고, 사용자 ID와 권한을 설정한 요청을 반환한다.
+// JWT만 받으면 auth.JWTer, 개인 액세스 토큰과 OAuth 클라이언트의 토큰도 받으면 auth.Authenticator를 사용한다.
+type RequestAuthenticator interface {
+	FillContext(r *http.Request) (*http.Request, error)
+}
+
 // 액세스 토큰을 검증하고, 사용자 ID와 권한을 포함시킨 context를 반환하는 미들웨어를 반환한다.
-func AuthMiddleware(j *auth.JWTer) func(next http.Handler) http.Handler {
+func AuthMiddleware(a RequestAuthenticator) func(next http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			// JWTer의 FillContext 메서드를 사용해 context에 사용자 ID와 권한을 저장한다.
-			req, err := j.FillContext(r)
+			// FillContext 메서드를 사용해 context에 사용자 ID와 권한을 저장한다.
+			req, err := a.FillContext(r)
 			if err != nil {
-				RespondJSON(r.Context(), w, ErrResponse{
+				Respond(w, r, ErrResponse{
 					Message: "not find auth info",
 					Details: []string{err.Error()},
 				}, http.StatusUnauthorized)
@@ -35,7 +42,7 @@ func AdminMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		log.Print("AdminMiddleware")
 		if !auth.IsAdmin(r.Context()) {
-			RespondJSON(r.Context(), w, ErrResponse{
+			Respond(w, r, ErrResponse{
 				Message: "not admin",
 			}, http.StatusUnauthorized)
 			return
@@ -43,3 +50,22 @@ func AdminMiddleware(next http.Handler) http.Handler {
 		next.ServeHTTP(w, r)
 	})
 }
+
+// 개인 액세스 토큰이나 OAuth 클라이언트의 토큰으로 인증한 요청이 scopes를 모두 허용하는지 확인하는 미들웨어를 반환한다.
+// 로그인해서 발급한 JWT로 인증한 요청은 범위의 제한이 없다. AuthMiddleware 다음에 사용한다.
+func RequireScope(scopes ...entity.Scope) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			for _, s := range scopes {
+				if !auth.HasScope(r.Context(), s) {
+					Respond(w, r, ErrResponse{
+						Message: "insufficient scope",
+						Details: []string{"token requires scope " + string(s)},
+					}, http.StatusForbidden)
+					return
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
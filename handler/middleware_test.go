@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/testutil"
+)
+
+func TestRequireScope(t *testing.T) {
+	type want struct {
+		status  int
+		rspFile string
+	}
+	tests := map[string]struct {
+		scopes *entity.Scopes // nil이면 JWT로 인증한 요청
+		want   want
+	}{
+		"jwt": {
+			want: want{status: http.StatusNoContent},
+		},
+		"granted": {
+			scopes: &entity.Scopes{entity.ScopeTasksRead, entity.ScopeTasksWrite},
+			want:   want{status: http.StatusNoContent},
+		},
+		"insufficient": {
+			scopes: &entity.Scopes{entity.ScopeTasksRead},
+			want: want{
+				status:  http.StatusForbidden,
+				rspFile: "testdata/personal_access_token/forbidden_rsp.json.golden",
+			},
+		},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodPost, "/tasks", nil)
+			ctx := auth.SetUserID(r.Context(), 10)
+			if tt.scopes != nil {
+				ctx = auth.SetScopes(ctx, *tt.scopes)
+			}
+			r = r.WithContext(ctx)
+
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			})
+			RequireScope(entity.ScopeTasksRead, entity.ScopeTasksWrite)(next).ServeHTTP(w, r)
+
+			var body []byte
+			if tt.want.rspFile != "" {
+				body = testutil.LoadFile(t, tt.want.rspFile)
+			}
+			testutil.AssertResponse(t, w.Result(), tt.want.status, body)
+		})
+	}
+}
+
+func TestAuthMiddleware(t *testing.T) {
+	t.Parallel()
+
+	moq := &RequestAuthenticatorMock{}
+	moq.FillContextFunc = func(r *http.Request) (*http.Request, error) {
+		if r.Header.Get("Authorization") != "Bearer todo_pat_valid" {
+			return nil, auth.ErrInvalidPersonalAccessToken
+		}
+		return r.WithContext(auth.SetUserID(r.Context(), 10)), nil
+	}
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if id, ok := auth.GetUserID(r.Context()); !ok || id != 10 {
+			t.Errorf("want user ID 10, but got %d", id)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	sut := AuthMiddleware(moq)(next)
+
+	for token, want := range map[string]int{
+		"todo_pat_valid":   http.StatusNoContent,
+		"todo_pat_revoked": http.StatusUnauthorized,
+	} {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/tasks", nil)
+		r.Header.Set("Authorization", "Bearer "+token)
+		sut.ServeHTTP(w, r)
+		if got := w.Result().StatusCode; got != want {
+			t.Errorf("%s: want status %d, but got %d", token, want, got)
+		}
+	}
+}
@@ -6,7 +6,12 @@ package handler
 import (
 	"context"
 	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/quickadd"
+	"github.com/graphql-go/graphql"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"net/http"
 	"sync"
+	"time"
 )
 
 // Ensure, that ListTasksServiceMock does implement ListTasksService.
@@ -19,6 +24,9 @@ var _ ListTasksService = &ListTasksServiceMock{}
 //
 //		// make and configure a mocked ListTasksService
 //		mockedListTasksService := &ListTasksServiceMock{
+//			ListAssignedTasksFunc: func(ctx context.Context) (entity.Tasks, error) {
+//				panic("mock out the ListAssignedTasks method")
+//			},
 //			ListTasksFunc: func(ctx context.Context) (entity.Tasks, error) {
 //				panic("mock out the ListTasks method")
 //			},
@@ -29,18 +37,59 @@ var _ ListTasksService = &ListTasksServiceMock{}
 //
 //	}
 type ListTasksServiceMock struct {
+	// ListAssignedTasksFunc mocks the ListAssignedTasks method.
+	ListAssignedTasksFunc func(ctx context.Context) (entity.Tasks, error)
+
 	// ListTasksFunc mocks the ListTasks method.
 	ListTasksFunc func(ctx context.Context) (entity.Tasks, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// ListAssignedTasks holds details about calls to the ListAssignedTasks method.
+		ListAssignedTasks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
 		// ListTasks holds details about calls to the ListTasks method.
 		ListTasks []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 		}
 	}
-	lockListTasks sync.RWMutex
+	lockListAssignedTasks sync.RWMutex
+	lockListTasks         sync.RWMutex
+}
+
+// ListAssignedTasks calls ListAssignedTasksFunc.
+func (mock *ListTasksServiceMock) ListAssignedTasks(ctx context.Context) (entity.Tasks, error) {
+	if mock.ListAssignedTasksFunc == nil {
+		panic("ListTasksServiceMock.ListAssignedTasksFunc: method is nil but ListTasksService.ListAssignedTasks was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListAssignedTasks.Lock()
+	mock.calls.ListAssignedTasks = append(mock.calls.ListAssignedTasks, callInfo)
+	mock.lockListAssignedTasks.Unlock()
+	return mock.ListAssignedTasksFunc(ctx)
+}
+
+// ListAssignedTasksCalls gets all the calls that were made to ListAssignedTasks.
+// Check the length with:
+//
+//	len(mockedListTasksService.ListAssignedTasksCalls())
+func (mock *ListTasksServiceMock) ListAssignedTasksCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockListAssignedTasks.RLock()
+	calls = mock.calls.ListAssignedTasks
+	mock.lockListAssignedTasks.RUnlock()
+	return calls
 }
 
 // ListTasks calls ListTasksFunc.
@@ -75,6 +124,72 @@ func (mock *ListTasksServiceMock) ListTasksCalls() []struct {
 	return calls
 }
 
+// Ensure, that ListWorkServiceMock does implement ListWorkService.
+// If this is not the case, regenerate this file with moq.
+var _ ListWorkService = &ListWorkServiceMock{}
+
+// ListWorkServiceMock is a mock implementation of ListWorkService.
+//
+//	func TestSomethingThatUsesListWorkService(t *testing.T) {
+//
+//		// make and configure a mocked ListWorkService
+//		mockedListWorkService := &ListWorkServiceMock{
+//			ListWorkTasksFunc: func(ctx context.Context) (entity.Tasks, error) {
+//				panic("mock out the ListWorkTasks method")
+//			},
+//		}
+//
+//		// use mockedListWorkService in code that requires ListWorkService
+//		// and then make assertions.
+//
+//	}
+type ListWorkServiceMock struct {
+	// ListWorkTasksFunc mocks the ListWorkTasks method.
+	ListWorkTasksFunc func(ctx context.Context) (entity.Tasks, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ListWorkTasks holds details about calls to the ListWorkTasks method.
+		ListWorkTasks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+	}
+	lockListWorkTasks sync.RWMutex
+}
+
+// ListWorkTasks calls ListWorkTasksFunc.
+func (mock *ListWorkServiceMock) ListWorkTasks(ctx context.Context) (entity.Tasks, error) {
+	if mock.ListWorkTasksFunc == nil {
+		panic("ListWorkServiceMock.ListWorkTasksFunc: method is nil but ListWorkService.ListWorkTasks was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListWorkTasks.Lock()
+	mock.calls.ListWorkTasks = append(mock.calls.ListWorkTasks, callInfo)
+	mock.lockListWorkTasks.Unlock()
+	return mock.ListWorkTasksFunc(ctx)
+}
+
+// ListWorkTasksCalls gets all the calls that were made to ListWorkTasks.
+// Check the length with:
+//
+//	len(mockedListWorkService.ListWorkTasksCalls())
+func (mock *ListWorkServiceMock) ListWorkTasksCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockListWorkTasks.RLock()
+	calls = mock.calls.ListWorkTasks
+	mock.lockListWorkTasks.RUnlock()
+	return calls
+}
+
 // Ensure, that AddTaskServiceMock does implement AddTaskService.
 // If this is not the case, regenerate this file with moq.
 var _ AddTaskService = &AddTaskServiceMock{}
@@ -85,7 +200,7 @@ var _ AddTaskService = &AddTaskServiceMock{}
 //
 //		// make and configure a mocked AddTaskService
 //		mockedAddTaskService := &AddTaskServiceMock{
-//			AddTaskFunc: func(ctx context.Context, title string) (*entity.Task, error) {
+//			AddTaskFunc: func(ctx context.Context, title string, due *time.Time, parent *entity.TaskID, attrs entity.TaskAttributes) (*entity.Task, error) {
 //				panic("mock out the AddTask method")
 //			},
 //		}
@@ -96,7 +211,7 @@ var _ AddTaskService = &AddTaskServiceMock{}
 //	}
 type AddTaskServiceMock struct {
 	// AddTaskFunc mocks the AddTask method.
-	AddTaskFunc func(ctx context.Context, title string) (*entity.Task, error)
+	AddTaskFunc func(ctx context.Context, title string, due *time.Time, parent *entity.TaskID, attrs entity.TaskAttributes) (*entity.Task, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
@@ -106,27 +221,39 @@ type AddTaskServiceMock struct {
 			Ctx context.Context
 			// Title is the title argument value.
 			Title string
+			// Due is the due argument value.
+			Due *time.Time
+			// Parent is the parent argument value.
+			Parent *entity.TaskID
+			// Attrs is the attrs argument value.
+			Attrs entity.TaskAttributes
 		}
 	}
 	lockAddTask sync.RWMutex
 }
 
 // AddTask calls AddTaskFunc.
-func (mock *AddTaskServiceMock) AddTask(ctx context.Context, title string) (*entity.Task, error) {
+func (mock *AddTaskServiceMock) AddTask(ctx context.Context, title string, due *time.Time, parent *entity.TaskID, attrs entity.TaskAttributes) (*entity.Task, error) {
 	if mock.AddTaskFunc == nil {
 		panic("AddTaskServiceMock.AddTaskFunc: method is nil but AddTaskService.AddTask was just called")
 	}
 	callInfo := struct {
-		Ctx   context.Context
-		Title string
+		Ctx    context.Context
+		Title  string
+		Due    *time.Time
+		Parent *entity.TaskID
+		Attrs  entity.TaskAttributes
 	}{
-		Ctx:   ctx,
-		Title: title,
+		Ctx:    ctx,
+		Title:  title,
+		Due:    due,
+		Parent: parent,
+		Attrs:  attrs,
 	}
 	mock.lockAddTask.Lock()
 	mock.calls.AddTask = append(mock.calls.AddTask, callInfo)
 	mock.lockAddTask.Unlock()
-	return mock.AddTaskFunc(ctx, title)
+	return mock.AddTaskFunc(ctx, title, due, parent, attrs)
 }
 
 // AddTaskCalls gets all the calls that were made to AddTask.
@@ -134,12 +261,18 @@ func (mock *AddTaskServiceMock) AddTask(ctx context.Context, title string) (*ent
 //
 //	len(mockedAddTaskService.AddTaskCalls())
 func (mock *AddTaskServiceMock) AddTaskCalls() []struct {
-	Ctx   context.Context
-	Title string
+	Ctx    context.Context
+	Title  string
+	Due    *time.Time
+	Parent *entity.TaskID
+	Attrs  entity.TaskAttributes
 } {
 	var calls []struct {
-		Ctx   context.Context
-		Title string
+		Ctx    context.Context
+		Title  string
+		Due    *time.Time
+		Parent *entity.TaskID
+		Attrs  entity.TaskAttributes
 	}
 	mock.lockAddTask.RLock()
 	calls = mock.calls.AddTask
@@ -147,164 +280,4211 @@ func (mock *AddTaskServiceMock) AddTaskCalls() []struct {
 	return calls
 }
 
-// Ensure, that RegisterUserServiceMock does implement RegisterUserService.
+// Ensure, that UpdateTaskServiceMock does implement UpdateTaskService.
 // If this is not the case, regenerate this file with moq.
-var _ RegisterUserService = &RegisterUserServiceMock{}
+var _ UpdateTaskService = &UpdateTaskServiceMock{}
 
-// RegisterUserServiceMock is a mock implementation of RegisterUserService.
+// UpdateTaskServiceMock is a mock implementation of UpdateTaskService.
 //
-//	func TestSomethingThatUsesRegisterUserService(t *testing.T) {
+//	func TestSomethingThatUsesUpdateTaskService(t *testing.T) {
 //
-//		// make and configure a mocked RegisterUserService
-//		mockedRegisterUserService := &RegisterUserServiceMock{
-//			RegisterUserFunc: func(ctx context.Context, name string, password string, role string) (*entity.User, error) {
-//				panic("mock out the RegisterUser method")
+//		// make and configure a mocked UpdateTaskService
+//		mockedUpdateTaskService := &UpdateTaskServiceMock{
+//			UpdateTaskFunc: func(ctx context.Context, id entity.TaskID, title *string, status *entity.TaskStatus) (*entity.Task, error) {
+//				panic("mock out the UpdateTask method")
 //			},
 //		}
 //
-//		// use mockedRegisterUserService in code that requires RegisterUserService
+//		// use mockedUpdateTaskService in code that requires UpdateTaskService
 //		// and then make assertions.
 //
 //	}
-type RegisterUserServiceMock struct {
-	// RegisterUserFunc mocks the RegisterUser method.
-	RegisterUserFunc func(ctx context.Context, name string, password string, role string) (*entity.User, error)
+type UpdateTaskServiceMock struct {
+	// UpdateTaskFunc mocks the UpdateTask method.
+	UpdateTaskFunc func(ctx context.Context, id entity.TaskID, title *string, status *entity.TaskStatus) (*entity.Task, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
-		// RegisterUser holds details about calls to the RegisterUser method.
-		RegisterUser []struct {
+		// UpdateTask holds details about calls to the UpdateTask method.
+		UpdateTask []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
-			// Name is the name argument value.
-			Name string
-			// Password is the password argument value.
-			Password string
-			// Role is the role argument value.
-			Role string
+			// ID is the id argument value.
+			ID entity.TaskID
+			// Title is the title argument value.
+			Title *string
+			// Status is the status argument value.
+			Status *entity.TaskStatus
 		}
 	}
-	lockRegisterUser sync.RWMutex
+	lockUpdateTask sync.RWMutex
 }
 
-// RegisterUser calls RegisterUserFunc.
-func (mock *RegisterUserServiceMock) RegisterUser(ctx context.Context, name string, password string, role string) (*entity.User, error) {
-	if mock.RegisterUserFunc == nil {
-		panic("RegisterUserServiceMock.RegisterUserFunc: method is nil but RegisterUserService.RegisterUser was just called")
+// UpdateTask calls UpdateTaskFunc.
+func (mock *UpdateTaskServiceMock) UpdateTask(ctx context.Context, id entity.TaskID, title *string, status *entity.TaskStatus) (*entity.Task, error) {
+	if mock.UpdateTaskFunc == nil {
+		panic("UpdateTaskServiceMock.UpdateTaskFunc: method is nil but UpdateTaskService.UpdateTask was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		ID     entity.TaskID
+		Title  *string
+		Status *entity.TaskStatus
+	}{
+		Ctx:    ctx,
+		ID:     id,
+		Title:  title,
+		Status: status,
+	}
+	mock.lockUpdateTask.Lock()
+	mock.calls.UpdateTask = append(mock.calls.UpdateTask, callInfo)
+	mock.lockUpdateTask.Unlock()
+	return mock.UpdateTaskFunc(ctx, id, title, status)
+}
+
+// UpdateTaskCalls gets all the calls that were made to UpdateTask.
+// Check the length with:
+//
+//	len(mockedUpdateTaskService.UpdateTaskCalls())
+func (mock *UpdateTaskServiceMock) UpdateTaskCalls() []struct {
+	Ctx    context.Context
+	ID     entity.TaskID
+	Title  *string
+	Status *entity.TaskStatus
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ID     entity.TaskID
+		Title  *string
+		Status *entity.TaskStatus
+	}
+	mock.lockUpdateTask.RLock()
+	calls = mock.calls.UpdateTask
+	mock.lockUpdateTask.RUnlock()
+	return calls
+}
+
+// Ensure, that DeleteTaskServiceMock does implement DeleteTaskService.
+// If this is not the case, regenerate this file with moq.
+var _ DeleteTaskService = &DeleteTaskServiceMock{}
+
+// DeleteTaskServiceMock is a mock implementation of DeleteTaskService.
+//
+//	func TestSomethingThatUsesDeleteTaskService(t *testing.T) {
+//
+//		// make and configure a mocked DeleteTaskService
+//		mockedDeleteTaskService := &DeleteTaskServiceMock{
+//			DeleteTaskFunc: func(ctx context.Context, id entity.TaskID) (entity.Tasks, error) {
+//				panic("mock out the DeleteTask method")
+//			},
+//		}
+//
+//		// use mockedDeleteTaskService in code that requires DeleteTaskService
+//		// and then make assertions.
+//
+//	}
+type DeleteTaskServiceMock struct {
+	// DeleteTaskFunc mocks the DeleteTask method.
+	DeleteTaskFunc func(ctx context.Context, id entity.TaskID) (entity.Tasks, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// DeleteTask holds details about calls to the DeleteTask method.
+		DeleteTask []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID entity.TaskID
+		}
+	}
+	lockDeleteTask sync.RWMutex
+}
+
+// DeleteTask calls DeleteTaskFunc.
+func (mock *DeleteTaskServiceMock) DeleteTask(ctx context.Context, id entity.TaskID) (entity.Tasks, error) {
+	if mock.DeleteTaskFunc == nil {
+		panic("DeleteTaskServiceMock.DeleteTaskFunc: method is nil but DeleteTaskService.DeleteTask was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  entity.TaskID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockDeleteTask.Lock()
+	mock.calls.DeleteTask = append(mock.calls.DeleteTask, callInfo)
+	mock.lockDeleteTask.Unlock()
+	return mock.DeleteTaskFunc(ctx, id)
+}
+
+// DeleteTaskCalls gets all the calls that were made to DeleteTask.
+// Check the length with:
+//
+//	len(mockedDeleteTaskService.DeleteTaskCalls())
+func (mock *DeleteTaskServiceMock) DeleteTaskCalls() []struct {
+	Ctx context.Context
+	ID  entity.TaskID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  entity.TaskID
+	}
+	mock.lockDeleteTask.RLock()
+	calls = mock.calls.DeleteTask
+	mock.lockDeleteTask.RUnlock()
+	return calls
+}
+
+// Ensure, that AssignTaskServiceMock does implement AssignTaskService.
+// If this is not the case, regenerate this file with moq.
+var _ AssignTaskService = &AssignTaskServiceMock{}
+
+// AssignTaskServiceMock is a mock implementation of AssignTaskService.
+//
+//	func TestSomethingThatUsesAssignTaskService(t *testing.T) {
+//
+//		// make and configure a mocked AssignTaskService
+//		mockedAssignTaskService := &AssignTaskServiceMock{
+//			AssignTaskFunc: func(ctx context.Context, id entity.TaskID, assignee entity.UserID) (*entity.Task, error) {
+//				panic("mock out the AssignTask method")
+//			},
+//			UnassignTaskFunc: func(ctx context.Context, id entity.TaskID) (*entity.Task, error) {
+//				panic("mock out the UnassignTask method")
+//			},
+//		}
+//
+//		// use mockedAssignTaskService in code that requires AssignTaskService
+//		// and then make assertions.
+//
+//	}
+type AssignTaskServiceMock struct {
+	// AssignTaskFunc mocks the AssignTask method.
+	AssignTaskFunc func(ctx context.Context, id entity.TaskID, assignee entity.UserID) (*entity.Task, error)
+
+	// UnassignTaskFunc mocks the UnassignTask method.
+	UnassignTaskFunc func(ctx context.Context, id entity.TaskID) (*entity.Task, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AssignTask holds details about calls to the AssignTask method.
+		AssignTask []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID entity.TaskID
+			// Assignee is the assignee argument value.
+			Assignee entity.UserID
+		}
+		// UnassignTask holds details about calls to the UnassignTask method.
+		UnassignTask []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID entity.TaskID
+		}
+	}
+	lockAssignTask   sync.RWMutex
+	lockUnassignTask sync.RWMutex
+}
+
+// AssignTask calls AssignTaskFunc.
+func (mock *AssignTaskServiceMock) AssignTask(ctx context.Context, id entity.TaskID, assignee entity.UserID) (*entity.Task, error) {
+	if mock.AssignTaskFunc == nil {
+		panic("AssignTaskServiceMock.AssignTaskFunc: method is nil but AssignTaskService.AssignTask was just called")
 	}
 	callInfo := struct {
 		Ctx      context.Context
-		Name     string
-		Password string
-		Role     string
+		ID       entity.TaskID
+		Assignee entity.UserID
 	}{
 		Ctx:      ctx,
-		Name:     name,
-		Password: password,
-		Role:     role,
+		ID:       id,
+		Assignee: assignee,
 	}
-	mock.lockRegisterUser.Lock()
-	mock.calls.RegisterUser = append(mock.calls.RegisterUser, callInfo)
-	mock.lockRegisterUser.Unlock()
-	return mock.RegisterUserFunc(ctx, name, password, role)
+	mock.lockAssignTask.Lock()
+	mock.calls.AssignTask = append(mock.calls.AssignTask, callInfo)
+	mock.lockAssignTask.Unlock()
+	return mock.AssignTaskFunc(ctx, id, assignee)
 }
 
-// RegisterUserCalls gets all the calls that were made to RegisterUser.
+// AssignTaskCalls gets all the calls that were made to AssignTask.
 // Check the length with:
 //
-//	len(mockedRegisterUserService.RegisterUserCalls())
-func (mock *RegisterUserServiceMock) RegisterUserCalls() []struct {
+//	len(mockedAssignTaskService.AssignTaskCalls())
+func (mock *AssignTaskServiceMock) AssignTaskCalls() []struct {
 	Ctx      context.Context
-	Name     string
-	Password string
-	Role     string
+	ID       entity.TaskID
+	Assignee entity.UserID
 } {
 	var calls []struct {
 		Ctx      context.Context
-		Name     string
-		Password string
-		Role     string
+		ID       entity.TaskID
+		Assignee entity.UserID
 	}
-	mock.lockRegisterUser.RLock()
-	calls = mock.calls.RegisterUser
-	mock.lockRegisterUser.RUnlock()
+	mock.lockAssignTask.RLock()
+	calls = mock.calls.AssignTask
+	mock.lockAssignTask.RUnlock()
 	return calls
 }
 
-// Ensure, that LoginServiceMock does implement LoginService.
+// UnassignTask calls UnassignTaskFunc.
+func (mock *AssignTaskServiceMock) UnassignTask(ctx context.Context, id entity.TaskID) (*entity.Task, error) {
+	if mock.UnassignTaskFunc == nil {
+		panic("AssignTaskServiceMock.UnassignTaskFunc: method is nil but AssignTaskService.UnassignTask was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  entity.TaskID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockUnassignTask.Lock()
+	mock.calls.UnassignTask = append(mock.calls.UnassignTask, callInfo)
+	mock.lockUnassignTask.Unlock()
+	return mock.UnassignTaskFunc(ctx, id)
+}
+
+// UnassignTaskCalls gets all the calls that were made to UnassignTask.
+// Check the length with:
+//
+//	len(mockedAssignTaskService.UnassignTaskCalls())
+func (mock *AssignTaskServiceMock) UnassignTaskCalls() []struct {
+	Ctx context.Context
+	ID  entity.TaskID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  entity.TaskID
+	}
+	mock.lockUnassignTask.RLock()
+	calls = mock.calls.UnassignTask
+	mock.lockUnassignTask.RUnlock()
+	return calls
+}
+
+// Ensure, that ProjectServiceMock does implement ProjectService.
 // If this is not the case, regenerate this file with moq.
-var _ LoginService = &LoginServiceMock{}
+var _ ProjectService = &ProjectServiceMock{}
 
-// LoginServiceMock is a mock implementation of LoginService.
+// ProjectServiceMock is a mock implementation of ProjectService.
 //
-//	func TestSomethingThatUsesLoginService(t *testing.T) {
+//	func TestSomethingThatUsesProjectService(t *testing.T) {
 //
-//		// make and configure a mocked LoginService
-//		mockedLoginService := &LoginServiceMock{
-//			LoginFunc: func(ctx context.Context, name string, pw string) (string, error) {
-//				panic("mock out the Login method")
+//		// make and configure a mocked ProjectService
+//		mockedProjectService := &ProjectServiceMock{
+//			AddProjectFunc: func(ctx context.Context, name string) (*entity.Project, error) {
+//				panic("mock out the AddProject method")
+//			},
+//			AddProjectMemberFunc: func(ctx context.Context, id entity.ProjectID, uid entity.UserID) error {
+//				panic("mock out the AddProjectMember method")
+//			},
+//			ListProjectMembersFunc: func(ctx context.Context, id entity.ProjectID) ([]entity.UserID, error) {
+//				panic("mock out the ListProjectMembers method")
+//			},
+//			ListProjectsFunc: func(ctx context.Context) (entity.Projects, error) {
+//				panic("mock out the ListProjects method")
+//			},
+//			RemoveProjectMemberFunc: func(ctx context.Context, id entity.ProjectID, uid entity.UserID) error {
+//				panic("mock out the RemoveProjectMember method")
 //			},
 //		}
 //
-//		// use mockedLoginService in code that requires LoginService
+//		// use mockedProjectService in code that requires ProjectService
 //		// and then make assertions.
 //
 //	}
-type LoginServiceMock struct {
-	// LoginFunc mocks the Login method.
-	LoginFunc func(ctx context.Context, name string, pw string) (string, error)
+type ProjectServiceMock struct {
+	// AddProjectFunc mocks the AddProject method.
+	AddProjectFunc func(ctx context.Context, name string) (*entity.Project, error)
+
+	// AddProjectMemberFunc mocks the AddProjectMember method.
+	AddProjectMemberFunc func(ctx context.Context, id entity.ProjectID, uid entity.UserID) error
+
+	// ListProjectMembersFunc mocks the ListProjectMembers method.
+	ListProjectMembersFunc func(ctx context.Context, id entity.ProjectID) ([]entity.UserID, error)
+
+	// ListProjectsFunc mocks the ListProjects method.
+	ListProjectsFunc func(ctx context.Context) (entity.Projects, error)
+
+	// RemoveProjectMemberFunc mocks the RemoveProjectMember method.
+	RemoveProjectMemberFunc func(ctx context.Context, id entity.ProjectID, uid entity.UserID) error
 
 	// calls tracks calls to the methods.
 	calls struct {
-		// Login holds details about calls to the Login method.
-		Login []struct {
+		// AddProject holds details about calls to the AddProject method.
+		AddProject []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 			// Name is the name argument value.
 			Name string
-			// Pw is the pw argument value.
-			Pw string
+		}
+		// AddProjectMember holds details about calls to the AddProjectMember method.
+		AddProjectMember []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID entity.ProjectID
+			// UID is the uid argument value.
+			UID entity.UserID
+		}
+		// ListProjectMembers holds details about calls to the ListProjectMembers method.
+		ListProjectMembers []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID entity.ProjectID
+		}
+		// ListProjects holds details about calls to the ListProjects method.
+		ListProjects []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// RemoveProjectMember holds details about calls to the RemoveProjectMember method.
+		RemoveProjectMember []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID entity.ProjectID
+			// UID is the uid argument value.
+			UID entity.UserID
 		}
 	}
-	lockLogin sync.RWMutex
+	lockAddProject          sync.RWMutex
+	lockAddProjectMember    sync.RWMutex
+	lockListProjectMembers  sync.RWMutex
+	lockListProjects        sync.RWMutex
+	lockRemoveProjectMember sync.RWMutex
 }
 
-// Login calls LoginFunc.
-func (mock *LoginServiceMock) Login(ctx context.Context, name string, pw string) (string, error) {
-	if mock.LoginFunc == nil {
-		panic("LoginServiceMock.LoginFunc: method is nil but LoginService.Login was just called")
+// AddProject calls AddProjectFunc.
+func (mock *ProjectServiceMock) AddProject(ctx context.Context, name string) (*entity.Project, error) {
+	if mock.AddProjectFunc == nil {
+		panic("ProjectServiceMock.AddProjectFunc: method is nil but ProjectService.AddProject was just called")
 	}
 	callInfo := struct {
 		Ctx  context.Context
 		Name string
-		Pw   string
 	}{
 		Ctx:  ctx,
 		Name: name,
-		Pw:   pw,
 	}
-	mock.lockLogin.Lock()
-	mock.calls.Login = append(mock.calls.Login, callInfo)
-	mock.lockLogin.Unlock()
-	return mock.LoginFunc(ctx, name, pw)
+	mock.lockAddProject.Lock()
+	mock.calls.AddProject = append(mock.calls.AddProject, callInfo)
+	mock.lockAddProject.Unlock()
+	return mock.AddProjectFunc(ctx, name)
 }
 
-// LoginCalls gets all the calls that were made to Login.
+// AddProjectCalls gets all the calls that were made to AddProject.
 // Check the length with:
 //
-//	len(mockedLoginService.LoginCalls())
-func (mock *LoginServiceMock) LoginCalls() []struct {
+//	len(mockedProjectService.AddProjectCalls())
+func (mock *ProjectServiceMock) AddProjectCalls() []struct {
 	Ctx  context.Context
 	Name string
-	Pw   string
 } {
 	var calls []struct {
 		Ctx  context.Context
 		Name string
-		Pw   string
 	}
-	mock.lockLogin.RLock()
-	calls = mock.calls.Login
-	mock.lockLogin.RUnlock()
+	mock.lockAddProject.RLock()
+	calls = mock.calls.AddProject
+	mock.lockAddProject.RUnlock()
+	return calls
+}
+
+// AddProjectMember calls AddProjectMemberFunc.
+func (mock *ProjectServiceMock) AddProjectMember(ctx context.Context, id entity.ProjectID, uid entity.UserID) error {
+	if mock.AddProjectMemberFunc == nil {
+		panic("ProjectServiceMock.AddProjectMemberFunc: method is nil but ProjectService.AddProjectMember was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  entity.ProjectID
+		UID entity.UserID
+	}{
+		Ctx: ctx,
+		ID:  id,
+		UID: uid,
+	}
+	mock.lockAddProjectMember.Lock()
+	mock.calls.AddProjectMember = append(mock.calls.AddProjectMember, callInfo)
+	mock.lockAddProjectMember.Unlock()
+	return mock.AddProjectMemberFunc(ctx, id, uid)
+}
+
+// AddProjectMemberCalls gets all the calls that were made to AddProjectMember.
+// Check the length with:
+//
+//	len(mockedProjectService.AddProjectMemberCalls())
+func (mock *ProjectServiceMock) AddProjectMemberCalls() []struct {
+	Ctx context.Context
+	ID  entity.ProjectID
+	UID entity.UserID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  entity.ProjectID
+		UID entity.UserID
+	}
+	mock.lockAddProjectMember.RLock()
+	calls = mock.calls.AddProjectMember
+	mock.lockAddProjectMember.RUnlock()
+	return calls
+}
+
+// ListProjectMembers calls ListProjectMembersFunc.
+func (mock *ProjectServiceMock) ListProjectMembers(ctx context.Context, id entity.ProjectID) ([]entity.UserID, error) {
+	if mock.ListProjectMembersFunc == nil {
+		panic("ProjectServiceMock.ListProjectMembersFunc: method is nil but ProjectService.ListProjectMembers was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  entity.ProjectID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockListProjectMembers.Lock()
+	mock.calls.ListProjectMembers = append(mock.calls.ListProjectMembers, callInfo)
+	mock.lockListProjectMembers.Unlock()
+	return mock.ListProjectMembersFunc(ctx, id)
+}
+
+// ListProjectMembersCalls gets all the calls that were made to ListProjectMembers.
+// Check the length with:
+//
+//	len(mockedProjectService.ListProjectMembersCalls())
+func (mock *ProjectServiceMock) ListProjectMembersCalls() []struct {
+	Ctx context.Context
+	ID  entity.ProjectID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  entity.ProjectID
+	}
+	mock.lockListProjectMembers.RLock()
+	calls = mock.calls.ListProjectMembers
+	mock.lockListProjectMembers.RUnlock()
+	return calls
+}
+
+// ListProjects calls ListProjectsFunc.
+func (mock *ProjectServiceMock) ListProjects(ctx context.Context) (entity.Projects, error) {
+	if mock.ListProjectsFunc == nil {
+		panic("ProjectServiceMock.ListProjectsFunc: method is nil but ProjectService.ListProjects was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListProjects.Lock()
+	mock.calls.ListProjects = append(mock.calls.ListProjects, callInfo)
+	mock.lockListProjects.Unlock()
+	return mock.ListProjectsFunc(ctx)
+}
+
+// ListProjectsCalls gets all the calls that were made to ListProjects.
+// Check the length with:
+//
+//	len(mockedProjectService.ListProjectsCalls())
+func (mock *ProjectServiceMock) ListProjectsCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockListProjects.RLock()
+	calls = mock.calls.ListProjects
+	mock.lockListProjects.RUnlock()
+	return calls
+}
+
+// RemoveProjectMember calls RemoveProjectMemberFunc.
+func (mock *ProjectServiceMock) RemoveProjectMember(ctx context.Context, id entity.ProjectID, uid entity.UserID) error {
+	if mock.RemoveProjectMemberFunc == nil {
+		panic("ProjectServiceMock.RemoveProjectMemberFunc: method is nil but ProjectService.RemoveProjectMember was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  entity.ProjectID
+		UID entity.UserID
+	}{
+		Ctx: ctx,
+		ID:  id,
+		UID: uid,
+	}
+	mock.lockRemoveProjectMember.Lock()
+	mock.calls.RemoveProjectMember = append(mock.calls.RemoveProjectMember, callInfo)
+	mock.lockRemoveProjectMember.Unlock()
+	return mock.RemoveProjectMemberFunc(ctx, id, uid)
+}
+
+// RemoveProjectMemberCalls gets all the calls that were made to RemoveProjectMember.
+// Check the length with:
+//
+//	len(mockedProjectService.RemoveProjectMemberCalls())
+func (mock *ProjectServiceMock) RemoveProjectMemberCalls() []struct {
+	Ctx context.Context
+	ID  entity.ProjectID
+	UID entity.UserID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  entity.ProjectID
+		UID entity.UserID
+	}
+	mock.lockRemoveProjectMember.RLock()
+	calls = mock.calls.RemoveProjectMember
+	mock.lockRemoveProjectMember.RUnlock()
+	return calls
+}
+
+// Ensure, that TaskProjectServiceMock does implement TaskProjectService.
+// If this is not the case, regenerate this file with moq.
+var _ TaskProjectService = &TaskProjectServiceMock{}
+
+// TaskProjectServiceMock is a mock implementation of TaskProjectService.
+//
+//	func TestSomethingThatUsesTaskProjectService(t *testing.T) {
+//
+//		// make and configure a mocked TaskProjectService
+//		mockedTaskProjectService := &TaskProjectServiceMock{
+//			SetTaskProjectFunc: func(ctx context.Context, id entity.TaskID, project *entity.ProjectID) (*entity.Task, error) {
+//				panic("mock out the SetTaskProject method")
+//			},
+//		}
+//
+//		// use mockedTaskProjectService in code that requires TaskProjectService
+//		// and then make assertions.
+//
+//	}
+type TaskProjectServiceMock struct {
+	// SetTaskProjectFunc mocks the SetTaskProject method.
+	SetTaskProjectFunc func(ctx context.Context, id entity.TaskID, project *entity.ProjectID) (*entity.Task, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// SetTaskProject holds details about calls to the SetTaskProject method.
+		SetTaskProject []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID entity.TaskID
+			// Project is the project argument value.
+			Project *entity.ProjectID
+		}
+	}
+	lockSetTaskProject sync.RWMutex
+}
+
+// SetTaskProject calls SetTaskProjectFunc.
+func (mock *TaskProjectServiceMock) SetTaskProject(ctx context.Context, id entity.TaskID, project *entity.ProjectID) (*entity.Task, error) {
+	if mock.SetTaskProjectFunc == nil {
+		panic("TaskProjectServiceMock.SetTaskProjectFunc: method is nil but TaskProjectService.SetTaskProject was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		ID      entity.TaskID
+		Project *entity.ProjectID
+	}{
+		Ctx:     ctx,
+		ID:      id,
+		Project: project,
+	}
+	mock.lockSetTaskProject.Lock()
+	mock.calls.SetTaskProject = append(mock.calls.SetTaskProject, callInfo)
+	mock.lockSetTaskProject.Unlock()
+	return mock.SetTaskProjectFunc(ctx, id, project)
+}
+
+// SetTaskProjectCalls gets all the calls that were made to SetTaskProject.
+// Check the length with:
+//
+//	len(mockedTaskProjectService.SetTaskProjectCalls())
+func (mock *TaskProjectServiceMock) SetTaskProjectCalls() []struct {
+	Ctx     context.Context
+	ID      entity.TaskID
+	Project *entity.ProjectID
+} {
+	var calls []struct {
+		Ctx     context.Context
+		ID      entity.TaskID
+		Project *entity.ProjectID
+	}
+	mock.lockSetTaskProject.RLock()
+	calls = mock.calls.SetTaskProject
+	mock.lockSetTaskProject.RUnlock()
+	return calls
+}
+
+// Ensure, that ListTaskStatusesServiceMock does implement ListTaskStatusesService.
+// If this is not the case, regenerate this file with moq.
+var _ ListTaskStatusesService = &ListTaskStatusesServiceMock{}
+
+// ListTaskStatusesServiceMock is a mock implementation of ListTaskStatusesService.
+//
+//	func TestSomethingThatUsesListTaskStatusesService(t *testing.T) {
+//
+//		// make and configure a mocked ListTaskStatusesService
+//		mockedListTaskStatusesService := &ListTaskStatusesServiceMock{
+//			ListTaskStatusesFunc: func(ctx context.Context) (entity.TaskStatusDefs, error) {
+//				panic("mock out the ListTaskStatuses method")
+//			},
+//		}
+//
+//		// use mockedListTaskStatusesService in code that requires ListTaskStatusesService
+//		// and then make assertions.
+//
+//	}
+type ListTaskStatusesServiceMock struct {
+	// ListTaskStatusesFunc mocks the ListTaskStatuses method.
+	ListTaskStatusesFunc func(ctx context.Context) (entity.TaskStatusDefs, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ListTaskStatuses holds details about calls to the ListTaskStatuses method.
+		ListTaskStatuses []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+	}
+	lockListTaskStatuses sync.RWMutex
+}
+
+// ListTaskStatuses calls ListTaskStatusesFunc.
+func (mock *ListTaskStatusesServiceMock) ListTaskStatuses(ctx context.Context) (entity.TaskStatusDefs, error) {
+	if mock.ListTaskStatusesFunc == nil {
+		panic("ListTaskStatusesServiceMock.ListTaskStatusesFunc: method is nil but ListTaskStatusesService.ListTaskStatuses was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListTaskStatuses.Lock()
+	mock.calls.ListTaskStatuses = append(mock.calls.ListTaskStatuses, callInfo)
+	mock.lockListTaskStatuses.Unlock()
+	return mock.ListTaskStatusesFunc(ctx)
+}
+
+// ListTaskStatusesCalls gets all the calls that were made to ListTaskStatuses.
+// Check the length with:
+//
+//	len(mockedListTaskStatusesService.ListTaskStatusesCalls())
+func (mock *ListTaskStatusesServiceMock) ListTaskStatusesCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockListTaskStatuses.RLock()
+	calls = mock.calls.ListTaskStatuses
+	mock.lockListTaskStatuses.RUnlock()
+	return calls
+}
+
+// Ensure, that AddTaskStatusServiceMock does implement AddTaskStatusService.
+// If this is not the case, regenerate this file with moq.
+var _ AddTaskStatusService = &AddTaskStatusServiceMock{}
+
+// AddTaskStatusServiceMock is a mock implementation of AddTaskStatusService.
+//
+//	func TestSomethingThatUsesAddTaskStatusService(t *testing.T) {
+//
+//		// make and configure a mocked AddTaskStatusService
+//		mockedAddTaskStatusService := &AddTaskStatusServiceMock{
+//			AddTaskStatusFunc: func(ctx context.Context, name entity.TaskStatus, category entity.TaskStatusCategory) (*entity.TaskStatusDef, error) {
+//				panic("mock out the AddTaskStatus method")
+//			},
+//		}
+//
+//		// use mockedAddTaskStatusService in code that requires AddTaskStatusService
+//		// and then make assertions.
+//
+//	}
+type AddTaskStatusServiceMock struct {
+	// AddTaskStatusFunc mocks the AddTaskStatus method.
+	AddTaskStatusFunc func(ctx context.Context, name entity.TaskStatus, category entity.TaskStatusCategory) (*entity.TaskStatusDef, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AddTaskStatus holds details about calls to the AddTaskStatus method.
+		AddTaskStatus []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name entity.TaskStatus
+			// Category is the category argument value.
+			Category entity.TaskStatusCategory
+		}
+	}
+	lockAddTaskStatus sync.RWMutex
+}
+
+// AddTaskStatus calls AddTaskStatusFunc.
+func (mock *AddTaskStatusServiceMock) AddTaskStatus(ctx context.Context, name entity.TaskStatus, category entity.TaskStatusCategory) (*entity.TaskStatusDef, error) {
+	if mock.AddTaskStatusFunc == nil {
+		panic("AddTaskStatusServiceMock.AddTaskStatusFunc: method is nil but AddTaskStatusService.AddTaskStatus was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Name     entity.TaskStatus
+		Category entity.TaskStatusCategory
+	}{
+		Ctx:      ctx,
+		Name:     name,
+		Category: category,
+	}
+	mock.lockAddTaskStatus.Lock()
+	mock.calls.AddTaskStatus = append(mock.calls.AddTaskStatus, callInfo)
+	mock.lockAddTaskStatus.Unlock()
+	return mock.AddTaskStatusFunc(ctx, name, category)
+}
+
+// AddTaskStatusCalls gets all the calls that were made to AddTaskStatus.
+// Check the length with:
+//
+//	len(mockedAddTaskStatusService.AddTaskStatusCalls())
+func (mock *AddTaskStatusServiceMock) AddTaskStatusCalls() []struct {
+	Ctx      context.Context
+	Name     entity.TaskStatus
+	Category entity.TaskStatusCategory
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Name     entity.TaskStatus
+		Category entity.TaskStatusCategory
+	}
+	mock.lockAddTaskStatus.RLock()
+	calls = mock.calls.AddTaskStatus
+	mock.lockAddTaskStatus.RUnlock()
+	return calls
+}
+
+// Ensure, that StartTimerServiceMock does implement StartTimerService.
+// If this is not the case, regenerate this file with moq.
+var _ StartTimerService = &StartTimerServiceMock{}
+
+// StartTimerServiceMock is a mock implementation of StartTimerService.
+//
+//	func TestSomethingThatUsesStartTimerService(t *testing.T) {
+//
+//		// make and configure a mocked StartTimerService
+//		mockedStartTimerService := &StartTimerServiceMock{
+//			StartTimerFunc: func(ctx context.Context, id entity.TaskID) (*entity.TimeEntry, error) {
+//				panic("mock out the StartTimer method")
+//			},
+//		}
+//
+//		// use mockedStartTimerService in code that requires StartTimerService
+//		// and then make assertions.
+//
+//	}
+type StartTimerServiceMock struct {
+	// StartTimerFunc mocks the StartTimer method.
+	StartTimerFunc func(ctx context.Context, id entity.TaskID) (*entity.TimeEntry, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// StartTimer holds details about calls to the StartTimer method.
+		StartTimer []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID entity.TaskID
+		}
+	}
+	lockStartTimer sync.RWMutex
+}
+
+// StartTimer calls StartTimerFunc.
+func (mock *StartTimerServiceMock) StartTimer(ctx context.Context, id entity.TaskID) (*entity.TimeEntry, error) {
+	if mock.StartTimerFunc == nil {
+		panic("StartTimerServiceMock.StartTimerFunc: method is nil but StartTimerService.StartTimer was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  entity.TaskID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockStartTimer.Lock()
+	mock.calls.StartTimer = append(mock.calls.StartTimer, callInfo)
+	mock.lockStartTimer.Unlock()
+	return mock.StartTimerFunc(ctx, id)
+}
+
+// StartTimerCalls gets all the calls that were made to StartTimer.
+// Check the length with:
+//
+//	len(mockedStartTimerService.StartTimerCalls())
+func (mock *StartTimerServiceMock) StartTimerCalls() []struct {
+	Ctx context.Context
+	ID  entity.TaskID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  entity.TaskID
+	}
+	mock.lockStartTimer.RLock()
+	calls = mock.calls.StartTimer
+	mock.lockStartTimer.RUnlock()
+	return calls
+}
+
+// Ensure, that StopTimerServiceMock does implement StopTimerService.
+// If this is not the case, regenerate this file with moq.
+var _ StopTimerService = &StopTimerServiceMock{}
+
+// StopTimerServiceMock is a mock implementation of StopTimerService.
+//
+//	func TestSomethingThatUsesStopTimerService(t *testing.T) {
+//
+//		// make and configure a mocked StopTimerService
+//		mockedStopTimerService := &StopTimerServiceMock{
+//			StopTimerFunc: func(ctx context.Context, id entity.TaskID) (*entity.TimeEntry, error) {
+//				panic("mock out the StopTimer method")
+//			},
+//		}
+//
+//		// use mockedStopTimerService in code that requires StopTimerService
+//		// and then make assertions.
+//
+//	}
+type StopTimerServiceMock struct {
+	// StopTimerFunc mocks the StopTimer method.
+	StopTimerFunc func(ctx context.Context, id entity.TaskID) (*entity.TimeEntry, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// StopTimer holds details about calls to the StopTimer method.
+		StopTimer []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID entity.TaskID
+		}
+	}
+	lockStopTimer sync.RWMutex
+}
+
+// StopTimer calls StopTimerFunc.
+func (mock *StopTimerServiceMock) StopTimer(ctx context.Context, id entity.TaskID) (*entity.TimeEntry, error) {
+	if mock.StopTimerFunc == nil {
+		panic("StopTimerServiceMock.StopTimerFunc: method is nil but StopTimerService.StopTimer was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  entity.TaskID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockStopTimer.Lock()
+	mock.calls.StopTimer = append(mock.calls.StopTimer, callInfo)
+	mock.lockStopTimer.Unlock()
+	return mock.StopTimerFunc(ctx, id)
+}
+
+// StopTimerCalls gets all the calls that were made to StopTimer.
+// Check the length with:
+//
+//	len(mockedStopTimerService.StopTimerCalls())
+func (mock *StopTimerServiceMock) StopTimerCalls() []struct {
+	Ctx context.Context
+	ID  entity.TaskID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  entity.TaskID
+	}
+	mock.lockStopTimer.RLock()
+	calls = mock.calls.StopTimer
+	mock.lockStopTimer.RUnlock()
+	return calls
+}
+
+// Ensure, that AddTimeEntryServiceMock does implement AddTimeEntryService.
+// If this is not the case, regenerate this file with moq.
+var _ AddTimeEntryService = &AddTimeEntryServiceMock{}
+
+// AddTimeEntryServiceMock is a mock implementation of AddTimeEntryService.
+//
+//	func TestSomethingThatUsesAddTimeEntryService(t *testing.T) {
+//
+//		// make and configure a mocked AddTimeEntryService
+//		mockedAddTimeEntryService := &AddTimeEntryServiceMock{
+//			AddTimeEntryFunc: func(ctx context.Context, id entity.TaskID, started time.Time, stopped time.Time) (*entity.TimeEntry, error) {
+//				panic("mock out the AddTimeEntry method")
+//			},
+//		}
+//
+//		// use mockedAddTimeEntryService in code that requires AddTimeEntryService
+//		// and then make assertions.
+//
+//	}
+type AddTimeEntryServiceMock struct {
+	// AddTimeEntryFunc mocks the AddTimeEntry method.
+	AddTimeEntryFunc func(ctx context.Context, id entity.TaskID, started time.Time, stopped time.Time) (*entity.TimeEntry, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AddTimeEntry holds details about calls to the AddTimeEntry method.
+		AddTimeEntry []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID entity.TaskID
+			// Started is the started argument value.
+			Started time.Time
+			// Stopped is the stopped argument value.
+			Stopped time.Time
+		}
+	}
+	lockAddTimeEntry sync.RWMutex
+}
+
+// AddTimeEntry calls AddTimeEntryFunc.
+func (mock *AddTimeEntryServiceMock) AddTimeEntry(ctx context.Context, id entity.TaskID, started time.Time, stopped time.Time) (*entity.TimeEntry, error) {
+	if mock.AddTimeEntryFunc == nil {
+		panic("AddTimeEntryServiceMock.AddTimeEntryFunc: method is nil but AddTimeEntryService.AddTimeEntry was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		ID      entity.TaskID
+		Started time.Time
+		Stopped time.Time
+	}{
+		Ctx:     ctx,
+		ID:      id,
+		Started: started,
+		Stopped: stopped,
+	}
+	mock.lockAddTimeEntry.Lock()
+	mock.calls.AddTimeEntry = append(mock.calls.AddTimeEntry, callInfo)
+	mock.lockAddTimeEntry.Unlock()
+	return mock.AddTimeEntryFunc(ctx, id, started, stopped)
+}
+
+// AddTimeEntryCalls gets all the calls that were made to AddTimeEntry.
+// Check the length with:
+//
+//	len(mockedAddTimeEntryService.AddTimeEntryCalls())
+func (mock *AddTimeEntryServiceMock) AddTimeEntryCalls() []struct {
+	Ctx     context.Context
+	ID      entity.TaskID
+	Started time.Time
+	Stopped time.Time
+} {
+	var calls []struct {
+		Ctx     context.Context
+		ID      entity.TaskID
+		Started time.Time
+		Stopped time.Time
+	}
+	mock.lockAddTimeEntry.RLock()
+	calls = mock.calls.AddTimeEntry
+	mock.lockAddTimeEntry.RUnlock()
+	return calls
+}
+
+// Ensure, that GetTaskTimeServiceMock does implement GetTaskTimeService.
+// If this is not the case, regenerate this file with moq.
+var _ GetTaskTimeService = &GetTaskTimeServiceMock{}
+
+// GetTaskTimeServiceMock is a mock implementation of GetTaskTimeService.
+//
+//	func TestSomethingThatUsesGetTaskTimeService(t *testing.T) {
+//
+//		// make and configure a mocked GetTaskTimeService
+//		mockedGetTaskTimeService := &GetTaskTimeServiceMock{
+//			GetTaskTimeFunc: func(ctx context.Context, id entity.TaskID, loc *time.Location) (*entity.TaskTime, error) {
+//				panic("mock out the GetTaskTime method")
+//			},
+//		}
+//
+//		// use mockedGetTaskTimeService in code that requires GetTaskTimeService
+//		// and then make assertions.
+//
+//	}
+type GetTaskTimeServiceMock struct {
+	// GetTaskTimeFunc mocks the GetTaskTime method.
+	GetTaskTimeFunc func(ctx context.Context, id entity.TaskID, loc *time.Location) (*entity.TaskTime, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetTaskTime holds details about calls to the GetTaskTime method.
+		GetTaskTime []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID entity.TaskID
+			// Loc is the loc argument value.
+			Loc *time.Location
+		}
+	}
+	lockGetTaskTime sync.RWMutex
+}
+
+// GetTaskTime calls GetTaskTimeFunc.
+func (mock *GetTaskTimeServiceMock) GetTaskTime(ctx context.Context, id entity.TaskID, loc *time.Location) (*entity.TaskTime, error) {
+	if mock.GetTaskTimeFunc == nil {
+		panic("GetTaskTimeServiceMock.GetTaskTimeFunc: method is nil but GetTaskTimeService.GetTaskTime was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  entity.TaskID
+		Loc *time.Location
+	}{
+		Ctx: ctx,
+		ID:  id,
+		Loc: loc,
+	}
+	mock.lockGetTaskTime.Lock()
+	mock.calls.GetTaskTime = append(mock.calls.GetTaskTime, callInfo)
+	mock.lockGetTaskTime.Unlock()
+	return mock.GetTaskTimeFunc(ctx, id, loc)
+}
+
+// GetTaskTimeCalls gets all the calls that were made to GetTaskTime.
+// Check the length with:
+//
+//	len(mockedGetTaskTimeService.GetTaskTimeCalls())
+func (mock *GetTaskTimeServiceMock) GetTaskTimeCalls() []struct {
+	Ctx context.Context
+	ID  entity.TaskID
+	Loc *time.Location
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  entity.TaskID
+		Loc *time.Location
+	}
+	mock.lockGetTaskTime.RLock()
+	calls = mock.calls.GetTaskTime
+	mock.lockGetTaskTime.RUnlock()
+	return calls
+}
+
+// Ensure, that GetTimesheetServiceMock does implement GetTimesheetService.
+// If this is not the case, regenerate this file with moq.
+var _ GetTimesheetService = &GetTimesheetServiceMock{}
+
+// GetTimesheetServiceMock is a mock implementation of GetTimesheetService.
+//
+//	func TestSomethingThatUsesGetTimesheetService(t *testing.T) {
+//
+//		// make and configure a mocked GetTimesheetService
+//		mockedGetTimesheetService := &GetTimesheetServiceMock{
+//			GetTimesheetFunc: func(ctx context.Context, from time.Time, to time.Time) (*entity.Timesheet, error) {
+//				panic("mock out the GetTimesheet method")
+//			},
+//		}
+//
+//		// use mockedGetTimesheetService in code that requires GetTimesheetService
+//		// and then make assertions.
+//
+//	}
+type GetTimesheetServiceMock struct {
+	// GetTimesheetFunc mocks the GetTimesheet method.
+	GetTimesheetFunc func(ctx context.Context, from time.Time, to time.Time) (*entity.Timesheet, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetTimesheet holds details about calls to the GetTimesheet method.
+		GetTimesheet []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// From is the from argument value.
+			From time.Time
+			// To is the to argument value.
+			To time.Time
+		}
+	}
+	lockGetTimesheet sync.RWMutex
+}
+
+// GetTimesheet calls GetTimesheetFunc.
+func (mock *GetTimesheetServiceMock) GetTimesheet(ctx context.Context, from time.Time, to time.Time) (*entity.Timesheet, error) {
+	if mock.GetTimesheetFunc == nil {
+		panic("GetTimesheetServiceMock.GetTimesheetFunc: method is nil but GetTimesheetService.GetTimesheet was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		From time.Time
+		To   time.Time
+	}{
+		Ctx:  ctx,
+		From: from,
+		To:   to,
+	}
+	mock.lockGetTimesheet.Lock()
+	mock.calls.GetTimesheet = append(mock.calls.GetTimesheet, callInfo)
+	mock.lockGetTimesheet.Unlock()
+	return mock.GetTimesheetFunc(ctx, from, to)
+}
+
+// GetTimesheetCalls gets all the calls that were made to GetTimesheet.
+// Check the length with:
+//
+//	len(mockedGetTimesheetService.GetTimesheetCalls())
+func (mock *GetTimesheetServiceMock) GetTimesheetCalls() []struct {
+	Ctx  context.Context
+	From time.Time
+	To   time.Time
+} {
+	var calls []struct {
+		Ctx  context.Context
+		From time.Time
+		To   time.Time
+	}
+	mock.lockGetTimesheet.RLock()
+	calls = mock.calls.GetTimesheet
+	mock.lockGetTimesheet.RUnlock()
+	return calls
+}
+
+// Ensure, that QuickAddParserMock does implement QuickAddParser.
+// If this is not the case, regenerate this file with moq.
+var _ QuickAddParser = &QuickAddParserMock{}
+
+// QuickAddParserMock is a mock implementation of QuickAddParser.
+//
+//	func TestSomethingThatUsesQuickAddParser(t *testing.T) {
+//
+//		// make and configure a mocked QuickAddParser
+//		mockedQuickAddParser := &QuickAddParserMock{
+//			ParseFunc: func(text string, loc *time.Location) (*quickadd.Result, error) {
+//				panic("mock out the Parse method")
+//			},
+//		}
+//
+//		// use mockedQuickAddParser in code that requires QuickAddParser
+//		// and then make assertions.
+//
+//	}
+type QuickAddParserMock struct {
+	// ParseFunc mocks the Parse method.
+	ParseFunc func(text string, loc *time.Location) (*quickadd.Result, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Parse holds details about calls to the Parse method.
+		Parse []struct {
+			// Text is the text argument value.
+			Text string
+			// Loc is the loc argument value.
+			Loc *time.Location
+		}
+	}
+	lockParse sync.RWMutex
+}
+
+// Parse calls ParseFunc.
+func (mock *QuickAddParserMock) Parse(text string, loc *time.Location) (*quickadd.Result, error) {
+	if mock.ParseFunc == nil {
+		panic("QuickAddParserMock.ParseFunc: method is nil but QuickAddParser.Parse was just called")
+	}
+	callInfo := struct {
+		Text string
+		Loc  *time.Location
+	}{
+		Text: text,
+		Loc:  loc,
+	}
+	mock.lockParse.Lock()
+	mock.calls.Parse = append(mock.calls.Parse, callInfo)
+	mock.lockParse.Unlock()
+	return mock.ParseFunc(text, loc)
+}
+
+// ParseCalls gets all the calls that were made to Parse.
+// Check the length with:
+//
+//	len(mockedQuickAddParser.ParseCalls())
+func (mock *QuickAddParserMock) ParseCalls() []struct {
+	Text string
+	Loc  *time.Location
+} {
+	var calls []struct {
+		Text string
+		Loc  *time.Location
+	}
+	mock.lockParse.RLock()
+	calls = mock.calls.Parse
+	mock.lockParse.RUnlock()
+	return calls
+}
+
+// Ensure, that AddTemplateServiceMock does implement AddTemplateService.
+// If this is not the case, regenerate this file with moq.
+var _ AddTemplateService = &AddTemplateServiceMock{}
+
+// AddTemplateServiceMock is a mock implementation of AddTemplateService.
+//
+//	func TestSomethingThatUsesAddTemplateService(t *testing.T) {
+//
+//		// make and configure a mocked AddTemplateService
+//		mockedAddTemplateService := &AddTemplateServiceMock{
+//			AddTemplateFunc: func(ctx context.Context, name string, id entity.TaskID, project *entity.ProjectID) (*entity.Template, error) {
+//				panic("mock out the AddTemplate method")
+//			},
+//		}
+//
+//		// use mockedAddTemplateService in code that requires AddTemplateService
+//		// and then make assertions.
+//
+//	}
+type AddTemplateServiceMock struct {
+	// AddTemplateFunc mocks the AddTemplate method.
+	AddTemplateFunc func(ctx context.Context, name string, id entity.TaskID, project *entity.ProjectID) (*entity.Template, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AddTemplate holds details about calls to the AddTemplate method.
+		AddTemplate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
+			// ID is the id argument value.
+			ID entity.TaskID
+			// Project is the project argument value.
+			Project *entity.ProjectID
+		}
+	}
+	lockAddTemplate sync.RWMutex
+}
+
+// AddTemplate calls AddTemplateFunc.
+func (mock *AddTemplateServiceMock) AddTemplate(ctx context.Context, name string, id entity.TaskID, project *entity.ProjectID) (*entity.Template, error) {
+	if mock.AddTemplateFunc == nil {
+		panic("AddTemplateServiceMock.AddTemplateFunc: method is nil but AddTemplateService.AddTemplate was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Name    string
+		ID      entity.TaskID
+		Project *entity.ProjectID
+	}{
+		Ctx:     ctx,
+		Name:    name,
+		ID:      id,
+		Project: project,
+	}
+	mock.lockAddTemplate.Lock()
+	mock.calls.AddTemplate = append(mock.calls.AddTemplate, callInfo)
+	mock.lockAddTemplate.Unlock()
+	return mock.AddTemplateFunc(ctx, name, id, project)
+}
+
+// AddTemplateCalls gets all the calls that were made to AddTemplate.
+// Check the length with:
+//
+//	len(mockedAddTemplateService.AddTemplateCalls())
+func (mock *AddTemplateServiceMock) AddTemplateCalls() []struct {
+	Ctx     context.Context
+	Name    string
+	ID      entity.TaskID
+	Project *entity.ProjectID
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Name    string
+		ID      entity.TaskID
+		Project *entity.ProjectID
+	}
+	mock.lockAddTemplate.RLock()
+	calls = mock.calls.AddTemplate
+	mock.lockAddTemplate.RUnlock()
+	return calls
+}
+
+// Ensure, that ListTemplatesServiceMock does implement ListTemplatesService.
+// If this is not the case, regenerate this file with moq.
+var _ ListTemplatesService = &ListTemplatesServiceMock{}
+
+// ListTemplatesServiceMock is a mock implementation of ListTemplatesService.
+//
+//	func TestSomethingThatUsesListTemplatesService(t *testing.T) {
+//
+//		// make and configure a mocked ListTemplatesService
+//		mockedListTemplatesService := &ListTemplatesServiceMock{
+//			ListTemplatesFunc: func(ctx context.Context) (entity.Templates, error) {
+//				panic("mock out the ListTemplates method")
+//			},
+//		}
+//
+//		// use mockedListTemplatesService in code that requires ListTemplatesService
+//		// and then make assertions.
+//
+//	}
+type ListTemplatesServiceMock struct {
+	// ListTemplatesFunc mocks the ListTemplates method.
+	ListTemplatesFunc func(ctx context.Context) (entity.Templates, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ListTemplates holds details about calls to the ListTemplates method.
+		ListTemplates []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+	}
+	lockListTemplates sync.RWMutex
+}
+
+// ListTemplates calls ListTemplatesFunc.
+func (mock *ListTemplatesServiceMock) ListTemplates(ctx context.Context) (entity.Templates, error) {
+	if mock.ListTemplatesFunc == nil {
+		panic("ListTemplatesServiceMock.ListTemplatesFunc: method is nil but ListTemplatesService.ListTemplates was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListTemplates.Lock()
+	mock.calls.ListTemplates = append(mock.calls.ListTemplates, callInfo)
+	mock.lockListTemplates.Unlock()
+	return mock.ListTemplatesFunc(ctx)
+}
+
+// ListTemplatesCalls gets all the calls that were made to ListTemplates.
+// Check the length with:
+//
+//	len(mockedListTemplatesService.ListTemplatesCalls())
+func (mock *ListTemplatesServiceMock) ListTemplatesCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockListTemplates.RLock()
+	calls = mock.calls.ListTemplates
+	mock.lockListTemplates.RUnlock()
+	return calls
+}
+
+// Ensure, that InstantiateTemplateServiceMock does implement InstantiateTemplateService.
+// If this is not the case, regenerate this file with moq.
+var _ InstantiateTemplateService = &InstantiateTemplateServiceMock{}
+
+// InstantiateTemplateServiceMock is a mock implementation of InstantiateTemplateService.
+//
+//	func TestSomethingThatUsesInstantiateTemplateService(t *testing.T) {
+//
+//		// make and configure a mocked InstantiateTemplateService
+//		mockedInstantiateTemplateService := &InstantiateTemplateServiceMock{
+//			InstantiateTemplateFunc: func(ctx context.Context, id entity.TemplateID, anchor time.Time) (entity.Tasks, error) {
+//				panic("mock out the InstantiateTemplate method")
+//			},
+//		}
+//
+//		// use mockedInstantiateTemplateService in code that requires InstantiateTemplateService
+//		// and then make assertions.
+//
+//	}
+type InstantiateTemplateServiceMock struct {
+	// InstantiateTemplateFunc mocks the InstantiateTemplate method.
+	InstantiateTemplateFunc func(ctx context.Context, id entity.TemplateID, anchor time.Time) (entity.Tasks, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// InstantiateTemplate holds details about calls to the InstantiateTemplate method.
+		InstantiateTemplate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID entity.TemplateID
+			// Anchor is the anchor argument value.
+			Anchor time.Time
+		}
+	}
+	lockInstantiateTemplate sync.RWMutex
+}
+
+// InstantiateTemplate calls InstantiateTemplateFunc.
+func (mock *InstantiateTemplateServiceMock) InstantiateTemplate(ctx context.Context, id entity.TemplateID, anchor time.Time) (entity.Tasks, error) {
+	if mock.InstantiateTemplateFunc == nil {
+		panic("InstantiateTemplateServiceMock.InstantiateTemplateFunc: method is nil but InstantiateTemplateService.InstantiateTemplate was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		ID     entity.TemplateID
+		Anchor time.Time
+	}{
+		Ctx:    ctx,
+		ID:     id,
+		Anchor: anchor,
+	}
+	mock.lockInstantiateTemplate.Lock()
+	mock.calls.InstantiateTemplate = append(mock.calls.InstantiateTemplate, callInfo)
+	mock.lockInstantiateTemplate.Unlock()
+	return mock.InstantiateTemplateFunc(ctx, id, anchor)
+}
+
+// InstantiateTemplateCalls gets all the calls that were made to InstantiateTemplate.
+// Check the length with:
+//
+//	len(mockedInstantiateTemplateService.InstantiateTemplateCalls())
+func (mock *InstantiateTemplateServiceMock) InstantiateTemplateCalls() []struct {
+	Ctx    context.Context
+	ID     entity.TemplateID
+	Anchor time.Time
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ID     entity.TemplateID
+		Anchor time.Time
+	}
+	mock.lockInstantiateTemplate.RLock()
+	calls = mock.calls.InstantiateTemplate
+	mock.lockInstantiateTemplate.RUnlock()
+	return calls
+}
+
+// Ensure, that ListNotificationsServiceMock does implement ListNotificationsService.
+// If this is not the case, regenerate this file with moq.
+var _ ListNotificationsService = &ListNotificationsServiceMock{}
+
+// ListNotificationsServiceMock is a mock implementation of ListNotificationsService.
+//
+//	func TestSomethingThatUsesListNotificationsService(t *testing.T) {
+//
+//		// make and configure a mocked ListNotificationsService
+//		mockedListNotificationsService := &ListNotificationsServiceMock{
+//			ListNotificationsFunc: func(ctx context.Context, unreadOnly bool) (entity.Notifications, int, error) {
+//				panic("mock out the ListNotifications method")
+//			},
+//		}
+//
+//		// use mockedListNotificationsService in code that requires ListNotificationsService
+//		// and then make assertions.
+//
+//	}
+type ListNotificationsServiceMock struct {
+	// ListNotificationsFunc mocks the ListNotifications method.
+	ListNotificationsFunc func(ctx context.Context, unreadOnly bool) (entity.Notifications, int, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ListNotifications holds details about calls to the ListNotifications method.
+		ListNotifications []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UnreadOnly is the unreadOnly argument value.
+			UnreadOnly bool
+		}
+	}
+	lockListNotifications sync.RWMutex
+}
+
+// ListNotifications calls ListNotificationsFunc.
+func (mock *ListNotificationsServiceMock) ListNotifications(ctx context.Context, unreadOnly bool) (entity.Notifications, int, error) {
+	if mock.ListNotificationsFunc == nil {
+		panic("ListNotificationsServiceMock.ListNotificationsFunc: method is nil but ListNotificationsService.ListNotifications was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		UnreadOnly bool
+	}{
+		Ctx:        ctx,
+		UnreadOnly: unreadOnly,
+	}
+	mock.lockListNotifications.Lock()
+	mock.calls.ListNotifications = append(mock.calls.ListNotifications, callInfo)
+	mock.lockListNotifications.Unlock()
+	return mock.ListNotificationsFunc(ctx, unreadOnly)
+}
+
+// ListNotificationsCalls gets all the calls that were made to ListNotifications.
+// Check the length with:
+//
+//	len(mockedListNotificationsService.ListNotificationsCalls())
+func (mock *ListNotificationsServiceMock) ListNotificationsCalls() []struct {
+	Ctx        context.Context
+	UnreadOnly bool
+} {
+	var calls []struct {
+		Ctx        context.Context
+		UnreadOnly bool
+	}
+	mock.lockListNotifications.RLock()
+	calls = mock.calls.ListNotifications
+	mock.lockListNotifications.RUnlock()
+	return calls
+}
+
+// Ensure, that MarkNotificationServiceMock does implement MarkNotificationService.
+// If this is not the case, regenerate this file with moq.
+var _ MarkNotificationService = &MarkNotificationServiceMock{}
+
+// MarkNotificationServiceMock is a mock implementation of MarkNotificationService.
+//
+//	func TestSomethingThatUsesMarkNotificationService(t *testing.T) {
+//
+//		// make and configure a mocked MarkNotificationService
+//		mockedMarkNotificationService := &MarkNotificationServiceMock{
+//			MarkAllReadFunc: func(ctx context.Context) (int64, error) {
+//				panic("mock out the MarkAllRead method")
+//			},
+//			MarkReadFunc: func(ctx context.Context, id entity.NotificationID) (int, error) {
+//				panic("mock out the MarkRead method")
+//			},
+//		}
+//
+//		// use mockedMarkNotificationService in code that requires MarkNotificationService
+//		// and then make assertions.
+//
+//	}
+type MarkNotificationServiceMock struct {
+	// MarkAllReadFunc mocks the MarkAllRead method.
+	MarkAllReadFunc func(ctx context.Context) (int64, error)
+
+	// MarkReadFunc mocks the MarkRead method.
+	MarkReadFunc func(ctx context.Context, id entity.NotificationID) (int, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// MarkAllRead holds details about calls to the MarkAllRead method.
+		MarkAllRead []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// MarkRead holds details about calls to the MarkRead method.
+		MarkRead []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID entity.NotificationID
+		}
+	}
+	lockMarkAllRead sync.RWMutex
+	lockMarkRead    sync.RWMutex
+}
+
+// MarkAllRead calls MarkAllReadFunc.
+func (mock *MarkNotificationServiceMock) MarkAllRead(ctx context.Context) (int64, error) {
+	if mock.MarkAllReadFunc == nil {
+		panic("MarkNotificationServiceMock.MarkAllReadFunc: method is nil but MarkNotificationService.MarkAllRead was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockMarkAllRead.Lock()
+	mock.calls.MarkAllRead = append(mock.calls.MarkAllRead, callInfo)
+	mock.lockMarkAllRead.Unlock()
+	return mock.MarkAllReadFunc(ctx)
+}
+
+// MarkAllReadCalls gets all the calls that were made to MarkAllRead.
+// Check the length with:
+//
+//	len(mockedMarkNotificationService.MarkAllReadCalls())
+func (mock *MarkNotificationServiceMock) MarkAllReadCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockMarkAllRead.RLock()
+	calls = mock.calls.MarkAllRead
+	mock.lockMarkAllRead.RUnlock()
+	return calls
+}
+
+// MarkRead calls MarkReadFunc.
+func (mock *MarkNotificationServiceMock) MarkRead(ctx context.Context, id entity.NotificationID) (int, error) {
+	if mock.MarkReadFunc == nil {
+		panic("MarkNotificationServiceMock.MarkReadFunc: method is nil but MarkNotificationService.MarkRead was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  entity.NotificationID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockMarkRead.Lock()
+	mock.calls.MarkRead = append(mock.calls.MarkRead, callInfo)
+	mock.lockMarkRead.Unlock()
+	return mock.MarkReadFunc(ctx, id)
+}
+
+// MarkReadCalls gets all the calls that were made to MarkRead.
+// Check the length with:
+//
+//	len(mockedMarkNotificationService.MarkReadCalls())
+func (mock *MarkNotificationServiceMock) MarkReadCalls() []struct {
+	Ctx context.Context
+	ID  entity.NotificationID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  entity.NotificationID
+	}
+	mock.lockMarkRead.RLock()
+	calls = mock.calls.MarkRead
+	mock.lockMarkRead.RUnlock()
+	return calls
+}
+
+// Ensure, that AddWebhookServiceMock does implement AddWebhookService.
+// If this is not the case, regenerate this file with moq.
+var _ AddWebhookService = &AddWebhookServiceMock{}
+
+// AddWebhookServiceMock is a mock implementation of AddWebhookService.
+//
+//	func TestSomethingThatUsesAddWebhookService(t *testing.T) {
+//
+//		// make and configure a mocked AddWebhookService
+//		mockedAddWebhookService := &AddWebhookServiceMock{
+//			AddWebhookFunc: func(ctx context.Context, url string, events []entity.EventType) (*entity.Webhook, error) {
+//				panic("mock out the AddWebhook method")
+//			},
+//		}
+//
+//		// use mockedAddWebhookService in code that requires AddWebhookService
+//		// and then make assertions.
+//
+//	}
+type AddWebhookServiceMock struct {
+	// AddWebhookFunc mocks the AddWebhook method.
+	AddWebhookFunc func(ctx context.Context, url string, events []entity.EventType) (*entity.Webhook, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AddWebhook holds details about calls to the AddWebhook method.
+		AddWebhook []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// URL is the url argument value.
+			URL string
+			// Events is the events argument value.
+			Events []entity.EventType
+		}
+	}
+	lockAddWebhook sync.RWMutex
+}
+
+// AddWebhook calls AddWebhookFunc.
+func (mock *AddWebhookServiceMock) AddWebhook(ctx context.Context, url string, events []entity.EventType) (*entity.Webhook, error) {
+	if mock.AddWebhookFunc == nil {
+		panic("AddWebhookServiceMock.AddWebhookFunc: method is nil but AddWebhookService.AddWebhook was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		URL    string
+		Events []entity.EventType
+	}{
+		Ctx:    ctx,
+		URL:    url,
+		Events: events,
+	}
+	mock.lockAddWebhook.Lock()
+	mock.calls.AddWebhook = append(mock.calls.AddWebhook, callInfo)
+	mock.lockAddWebhook.Unlock()
+	return mock.AddWebhookFunc(ctx, url, events)
+}
+
+// AddWebhookCalls gets all the calls that were made to AddWebhook.
+// Check the length with:
+//
+//	len(mockedAddWebhookService.AddWebhookCalls())
+func (mock *AddWebhookServiceMock) AddWebhookCalls() []struct {
+	Ctx    context.Context
+	URL    string
+	Events []entity.EventType
+} {
+	var calls []struct {
+		Ctx    context.Context
+		URL    string
+		Events []entity.EventType
+	}
+	mock.lockAddWebhook.RLock()
+	calls = mock.calls.AddWebhook
+	mock.lockAddWebhook.RUnlock()
+	return calls
+}
+
+// Ensure, that ListWebhooksServiceMock does implement ListWebhooksService.
+// If this is not the case, regenerate this file with moq.
+var _ ListWebhooksService = &ListWebhooksServiceMock{}
+
+// ListWebhooksServiceMock is a mock implementation of ListWebhooksService.
+//
+//	func TestSomethingThatUsesListWebhooksService(t *testing.T) {
+//
+//		// make and configure a mocked ListWebhooksService
+//		mockedListWebhooksService := &ListWebhooksServiceMock{
+//			ListWebhookDeliveriesFunc: func(ctx context.Context, id entity.WebhookID) (entity.WebhookDeliveries, error) {
+//				panic("mock out the ListWebhookDeliveries method")
+//			},
+//			ListWebhooksFunc: func(ctx context.Context) (entity.Webhooks, error) {
+//				panic("mock out the ListWebhooks method")
+//			},
+//		}
+//
+//		// use mockedListWebhooksService in code that requires ListWebhooksService
+//		// and then make assertions.
+//
+//	}
+type ListWebhooksServiceMock struct {
+	// ListWebhookDeliveriesFunc mocks the ListWebhookDeliveries method.
+	ListWebhookDeliveriesFunc func(ctx context.Context, id entity.WebhookID) (entity.WebhookDeliveries, error)
+
+	// ListWebhooksFunc mocks the ListWebhooks method.
+	ListWebhooksFunc func(ctx context.Context) (entity.Webhooks, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ListWebhookDeliveries holds details about calls to the ListWebhookDeliveries method.
+		ListWebhookDeliveries []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID entity.WebhookID
+		}
+		// ListWebhooks holds details about calls to the ListWebhooks method.
+		ListWebhooks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+	}
+	lockListWebhookDeliveries sync.RWMutex
+	lockListWebhooks          sync.RWMutex
+}
+
+// ListWebhookDeliveries calls ListWebhookDeliveriesFunc.
+func (mock *ListWebhooksServiceMock) ListWebhookDeliveries(ctx context.Context, id entity.WebhookID) (entity.WebhookDeliveries, error) {
+	if mock.ListWebhookDeliveriesFunc == nil {
+		panic("ListWebhooksServiceMock.ListWebhookDeliveriesFunc: method is nil but ListWebhooksService.ListWebhookDeliveries was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  entity.WebhookID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockListWebhookDeliveries.Lock()
+	mock.calls.ListWebhookDeliveries = append(mock.calls.ListWebhookDeliveries, callInfo)
+	mock.lockListWebhookDeliveries.Unlock()
+	return mock.ListWebhookDeliveriesFunc(ctx, id)
+}
+
+// ListWebhookDeliveriesCalls gets all the calls that were made to ListWebhookDeliveries.
+// Check the length with:
+//
+//	len(mockedListWebhooksService.ListWebhookDeliveriesCalls())
+func (mock *ListWebhooksServiceMock) ListWebhookDeliveriesCalls() []struct {
+	Ctx context.Context
+	ID  entity.WebhookID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  entity.WebhookID
+	}
+	mock.lockListWebhookDeliveries.RLock()
+	calls = mock.calls.ListWebhookDeliveries
+	mock.lockListWebhookDeliveries.RUnlock()
+	return calls
+}
+
+// ListWebhooks calls ListWebhooksFunc.
+func (mock *ListWebhooksServiceMock) ListWebhooks(ctx context.Context) (entity.Webhooks, error) {
+	if mock.ListWebhooksFunc == nil {
+		panic("ListWebhooksServiceMock.ListWebhooksFunc: method is nil but ListWebhooksService.ListWebhooks was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListWebhooks.Lock()
+	mock.calls.ListWebhooks = append(mock.calls.ListWebhooks, callInfo)
+	mock.lockListWebhooks.Unlock()
+	return mock.ListWebhooksFunc(ctx)
+}
+
+// ListWebhooksCalls gets all the calls that were made to ListWebhooks.
+// Check the length with:
+//
+//	len(mockedListWebhooksService.ListWebhooksCalls())
+func (mock *ListWebhooksServiceMock) ListWebhooksCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockListWebhooks.RLock()
+	calls = mock.calls.ListWebhooks
+	mock.lockListWebhooks.RUnlock()
+	return calls
+}
+
+// Ensure, that EditWebhookServiceMock does implement EditWebhookService.
+// If this is not the case, regenerate this file with moq.
+var _ EditWebhookService = &EditWebhookServiceMock{}
+
+// EditWebhookServiceMock is a mock implementation of EditWebhookService.
+//
+//	func TestSomethingThatUsesEditWebhookService(t *testing.T) {
+//
+//		// make and configure a mocked EditWebhookService
+//		mockedEditWebhookService := &EditWebhookServiceMock{
+//			DeleteWebhookFunc: func(ctx context.Context, id entity.WebhookID) error {
+//				panic("mock out the DeleteWebhook method")
+//			},
+//			EnableWebhookFunc: func(ctx context.Context, id entity.WebhookID) (*entity.Webhook, error) {
+//				panic("mock out the EnableWebhook method")
+//			},
+//		}
+//
+//		// use mockedEditWebhookService in code that requires EditWebhookService
+//		// and then make assertions.
+//
+//	}
+type EditWebhookServiceMock struct {
+	// DeleteWebhookFunc mocks the DeleteWebhook method.
+	DeleteWebhookFunc func(ctx context.Context, id entity.WebhookID) error
+
+	// EnableWebhookFunc mocks the EnableWebhook method.
+	EnableWebhookFunc func(ctx context.Context, id entity.WebhookID) (*entity.Webhook, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// DeleteWebhook holds details about calls to the DeleteWebhook method.
+		DeleteWebhook []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID entity.WebhookID
+		}
+		// EnableWebhook holds details about calls to the EnableWebhook method.
+		EnableWebhook []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID entity.WebhookID
+		}
+	}
+	lockDeleteWebhook sync.RWMutex
+	lockEnableWebhook sync.RWMutex
+}
+
+// DeleteWebhook calls DeleteWebhookFunc.
+func (mock *EditWebhookServiceMock) DeleteWebhook(ctx context.Context, id entity.WebhookID) error {
+	if mock.DeleteWebhookFunc == nil {
+		panic("EditWebhookServiceMock.DeleteWebhookFunc: method is nil but EditWebhookService.DeleteWebhook was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  entity.WebhookID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockDeleteWebhook.Lock()
+	mock.calls.DeleteWebhook = append(mock.calls.DeleteWebhook, callInfo)
+	mock.lockDeleteWebhook.Unlock()
+	return mock.DeleteWebhookFunc(ctx, id)
+}
+
+// DeleteWebhookCalls gets all the calls that were made to DeleteWebhook.
+// Check the length with:
+//
+//	len(mockedEditWebhookService.DeleteWebhookCalls())
+func (mock *EditWebhookServiceMock) DeleteWebhookCalls() []struct {
+	Ctx context.Context
+	ID  entity.WebhookID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  entity.WebhookID
+	}
+	mock.lockDeleteWebhook.RLock()
+	calls = mock.calls.DeleteWebhook
+	mock.lockDeleteWebhook.RUnlock()
+	return calls
+}
+
+// EnableWebhook calls EnableWebhookFunc.
+func (mock *EditWebhookServiceMock) EnableWebhook(ctx context.Context, id entity.WebhookID) (*entity.Webhook, error) {
+	if mock.EnableWebhookFunc == nil {
+		panic("EditWebhookServiceMock.EnableWebhookFunc: method is nil but EditWebhookService.EnableWebhook was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  entity.WebhookID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockEnableWebhook.Lock()
+	mock.calls.EnableWebhook = append(mock.calls.EnableWebhook, callInfo)
+	mock.lockEnableWebhook.Unlock()
+	return mock.EnableWebhookFunc(ctx, id)
+}
+
+// EnableWebhookCalls gets all the calls that were made to EnableWebhook.
+// Check the length with:
+//
+//	len(mockedEditWebhookService.EnableWebhookCalls())
+func (mock *EditWebhookServiceMock) EnableWebhookCalls() []struct {
+	Ctx context.Context
+	ID  entity.WebhookID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  entity.WebhookID
+	}
+	mock.lockEnableWebhook.RLock()
+	calls = mock.calls.EnableWebhook
+	mock.lockEnableWebhook.RUnlock()
+	return calls
+}
+
+// Ensure, that SyncServiceMock does implement SyncService.
+// If this is not the case, regenerate this file with moq.
+var _ SyncService = &SyncServiceMock{}
+
+// SyncServiceMock is a mock implementation of SyncService.
+//
+//	func TestSomethingThatUsesSyncService(t *testing.T) {
+//
+//		// make and configure a mocked SyncService
+//		mockedSyncService := &SyncServiceMock{
+//			PullFunc: func(ctx context.Context, since string) (*entity.SyncDelta, error) {
+//				panic("mock out the Pull method")
+//			},
+//			PushFunc: func(ctx context.Context, since string, ms []*entity.SyncMutation) ([]*entity.SyncResult, *entity.SyncDelta, error) {
+//				panic("mock out the Push method")
+//			},
+//		}
+//
+//		// use mockedSyncService in code that requires SyncService
+//		// and then make assertions.
+//
+//	}
+type SyncServiceMock struct {
+	// PullFunc mocks the Pull method.
+	PullFunc func(ctx context.Context, since string) (*entity.SyncDelta, error)
+
+	// PushFunc mocks the Push method.
+	PushFunc func(ctx context.Context, since string, ms []*entity.SyncMutation) ([]*entity.SyncResult, *entity.SyncDelta, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Pull holds details about calls to the Pull method.
+		Pull []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Since is the since argument value.
+			Since string
+		}
+		// Push holds details about calls to the Push method.
+		Push []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Since is the since argument value.
+			Since string
+			// Ms is the ms argument value.
+			Ms []*entity.SyncMutation
+		}
+	}
+	lockPull sync.RWMutex
+	lockPush sync.RWMutex
+}
+
+// Pull calls PullFunc.
+func (mock *SyncServiceMock) Pull(ctx context.Context, since string) (*entity.SyncDelta, error) {
+	if mock.PullFunc == nil {
+		panic("SyncServiceMock.PullFunc: method is nil but SyncService.Pull was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Since string
+	}{
+		Ctx:   ctx,
+		Since: since,
+	}
+	mock.lockPull.Lock()
+	mock.calls.Pull = append(mock.calls.Pull, callInfo)
+	mock.lockPull.Unlock()
+	return mock.PullFunc(ctx, since)
+}
+
+// PullCalls gets all the calls that were made to Pull.
+// Check the length with:
+//
+//	len(mockedSyncService.PullCalls())
+func (mock *SyncServiceMock) PullCalls() []struct {
+	Ctx   context.Context
+	Since string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Since string
+	}
+	mock.lockPull.RLock()
+	calls = mock.calls.Pull
+	mock.lockPull.RUnlock()
+	return calls
+}
+
+// Push calls PushFunc.
+func (mock *SyncServiceMock) Push(ctx context.Context, since string, ms []*entity.SyncMutation) ([]*entity.SyncResult, *entity.SyncDelta, error) {
+	if mock.PushFunc == nil {
+		panic("SyncServiceMock.PushFunc: method is nil but SyncService.Push was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Since string
+		Ms    []*entity.SyncMutation
+	}{
+		Ctx:   ctx,
+		Since: since,
+		Ms:    ms,
+	}
+	mock.lockPush.Lock()
+	mock.calls.Push = append(mock.calls.Push, callInfo)
+	mock.lockPush.Unlock()
+	return mock.PushFunc(ctx, since, ms)
+}
+
+// PushCalls gets all the calls that were made to Push.
+// Check the length with:
+//
+//	len(mockedSyncService.PushCalls())
+func (mock *SyncServiceMock) PushCalls() []struct {
+	Ctx   context.Context
+	Since string
+	Ms    []*entity.SyncMutation
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Since string
+		Ms    []*entity.SyncMutation
+	}
+	mock.lockPush.RLock()
+	calls = mock.calls.Push
+	mock.lockPush.RUnlock()
+	return calls
+}
+
+// Ensure, that EventStreamServiceMock does implement EventStreamService.
+// If this is not the case, regenerate this file with moq.
+var _ EventStreamService = &EventStreamServiceMock{}
+
+// EventStreamServiceMock is a mock implementation of EventStreamService.
+//
+//	func TestSomethingThatUsesEventStreamService(t *testing.T) {
+//
+//		// make and configure a mocked EventStreamService
+//		mockedEventStreamService := &EventStreamServiceMock{
+//			SubscribeFunc: func(ctx context.Context, lastID int64) ([]*entity.StreamEvent, <-chan *entity.StreamEvent, func(), error) {
+//				panic("mock out the Subscribe method")
+//			},
+//		}
+//
+//		// use mockedEventStreamService in code that requires EventStreamService
+//		// and then make assertions.
+//
+//	}
+type EventStreamServiceMock struct {
+	// SubscribeFunc mocks the Subscribe method.
+	SubscribeFunc func(ctx context.Context, lastID int64) ([]*entity.StreamEvent, <-chan *entity.StreamEvent, func(), error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Subscribe holds details about calls to the Subscribe method.
+		Subscribe []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// LastID is the lastID argument value.
+			LastID int64
+		}
+	}
+	lockSubscribe sync.RWMutex
+}
+
+// Subscribe calls SubscribeFunc.
+func (mock *EventStreamServiceMock) Subscribe(ctx context.Context, lastID int64) ([]*entity.StreamEvent, <-chan *entity.StreamEvent, func(), error) {
+	if mock.SubscribeFunc == nil {
+		panic("EventStreamServiceMock.SubscribeFunc: method is nil but EventStreamService.Subscribe was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		LastID int64
+	}{
+		Ctx:    ctx,
+		LastID: lastID,
+	}
+	mock.lockSubscribe.Lock()
+	mock.calls.Subscribe = append(mock.calls.Subscribe, callInfo)
+	mock.lockSubscribe.Unlock()
+	return mock.SubscribeFunc(ctx, lastID)
+}
+
+// SubscribeCalls gets all the calls that were made to Subscribe.
+// Check the length with:
+//
+//	len(mockedEventStreamService.SubscribeCalls())
+func (mock *EventStreamServiceMock) SubscribeCalls() []struct {
+	Ctx    context.Context
+	LastID int64
+} {
+	var calls []struct {
+		Ctx    context.Context
+		LastID int64
+	}
+	mock.lockSubscribe.RLock()
+	calls = mock.calls.Subscribe
+	mock.lockSubscribe.RUnlock()
+	return calls
+}
+
+// Ensure, that ProjectEventServiceMock does implement ProjectEventService.
+// If this is not the case, regenerate this file with moq.
+var _ ProjectEventService = &ProjectEventServiceMock{}
+
+// ProjectEventServiceMock is a mock implementation of ProjectEventService.
+//
+//	func TestSomethingThatUsesProjectEventService(t *testing.T) {
+//
+//		// make and configure a mocked ProjectEventService
+//		mockedProjectEventService := &ProjectEventServiceMock{
+//			SubscribeProjectFunc: func(ctx context.Context, pid entity.ProjectID) (<-chan *entity.StreamEvent, func(), error) {
+//				panic("mock out the SubscribeProject method")
+//			},
+//		}
+//
+//		// use mockedProjectEventService in code that requires ProjectEventService
+//		// and then make assertions.
+//
+//	}
+type ProjectEventServiceMock struct {
+	// SubscribeProjectFunc mocks the SubscribeProject method.
+	SubscribeProjectFunc func(ctx context.Context, pid entity.ProjectID) (<-chan *entity.StreamEvent, func(), error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// SubscribeProject holds details about calls to the SubscribeProject method.
+		SubscribeProject []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Pid is the pid argument value.
+			Pid entity.ProjectID
+		}
+	}
+	lockSubscribeProject sync.RWMutex
+}
+
+// SubscribeProject calls SubscribeProjectFunc.
+func (mock *ProjectEventServiceMock) SubscribeProject(ctx context.Context, pid entity.ProjectID) (<-chan *entity.StreamEvent, func(), error) {
+	if mock.SubscribeProjectFunc == nil {
+		panic("ProjectEventServiceMock.SubscribeProjectFunc: method is nil but ProjectEventService.SubscribeProject was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Pid entity.ProjectID
+	}{
+		Ctx: ctx,
+		Pid: pid,
+	}
+	mock.lockSubscribeProject.Lock()
+	mock.calls.SubscribeProject = append(mock.calls.SubscribeProject, callInfo)
+	mock.lockSubscribeProject.Unlock()
+	return mock.SubscribeProjectFunc(ctx, pid)
+}
+
+// SubscribeProjectCalls gets all the calls that were made to SubscribeProject.
+// Check the length with:
+//
+//	len(mockedProjectEventService.SubscribeProjectCalls())
+func (mock *ProjectEventServiceMock) SubscribeProjectCalls() []struct {
+	Ctx context.Context
+	Pid entity.ProjectID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Pid entity.ProjectID
+	}
+	mock.lockSubscribeProject.RLock()
+	calls = mock.calls.SubscribeProject
+	mock.lockSubscribeProject.RUnlock()
+	return calls
+}
+
+// Ensure, that AuthenticatorMock does implement Authenticator.
+// If this is not the case, regenerate this file with moq.
+var _ Authenticator = &AuthenticatorMock{}
+
+// AuthenticatorMock is a mock implementation of Authenticator.
+//
+//	func TestSomethingThatUsesAuthenticator(t *testing.T) {
+//
+//		// make and configure a mocked Authenticator
+//		mockedAuthenticator := &AuthenticatorMock{
+//			AuthenticateFunc: func(ctx context.Context, token string) (context.Context, error) {
+//				panic("mock out the Authenticate method")
+//			},
+//		}
+//
+//		// use mockedAuthenticator in code that requires Authenticator
+//		// and then make assertions.
+//
+//	}
+type AuthenticatorMock struct {
+	// AuthenticateFunc mocks the Authenticate method.
+	AuthenticateFunc func(ctx context.Context, token string) (context.Context, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Authenticate holds details about calls to the Authenticate method.
+		Authenticate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Token is the token argument value.
+			Token string
+		}
+	}
+	lockAuthenticate sync.RWMutex
+}
+
+// Authenticate calls AuthenticateFunc.
+func (mock *AuthenticatorMock) Authenticate(ctx context.Context, token string) (context.Context, error) {
+	if mock.AuthenticateFunc == nil {
+		panic("AuthenticatorMock.AuthenticateFunc: method is nil but Authenticator.Authenticate was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Token string
+	}{
+		Ctx:   ctx,
+		Token: token,
+	}
+	mock.lockAuthenticate.Lock()
+	mock.calls.Authenticate = append(mock.calls.Authenticate, callInfo)
+	mock.lockAuthenticate.Unlock()
+	return mock.AuthenticateFunc(ctx, token)
+}
+
+// AuthenticateCalls gets all the calls that were made to Authenticate.
+// Check the length with:
+//
+//	len(mockedAuthenticator.AuthenticateCalls())
+func (mock *AuthenticatorMock) AuthenticateCalls() []struct {
+	Ctx   context.Context
+	Token string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Token string
+	}
+	mock.lockAuthenticate.RLock()
+	calls = mock.calls.Authenticate
+	mock.lockAuthenticate.RUnlock()
+	return calls
+}
+
+// Ensure, that PresenceServiceMock does implement PresenceService.
+// If this is not the case, regenerate this file with moq.
+var _ PresenceService = &PresenceServiceMock{}
+
+// PresenceServiceMock is a mock implementation of PresenceService.
+//
+//	func TestSomethingThatUsesPresenceService(t *testing.T) {
+//
+//		// make and configure a mocked PresenceService
+//		mockedPresenceService := &PresenceServiceMock{
+//			JoinFunc: func(ctx context.Context, pid entity.ProjectID, session string) ([]entity.UserID, error) {
+//				panic("mock out the Join method")
+//			},
+//			LeaveFunc: func(ctx context.Context, pid entity.ProjectID, session string) error {
+//				panic("mock out the Leave method")
+//			},
+//		}
+//
+//		// use mockedPresenceService in code that requires PresenceService
+//		// and then make assertions.
+//
+//	}
+type PresenceServiceMock struct {
+	// JoinFunc mocks the Join method.
+	JoinFunc func(ctx context.Context, pid entity.ProjectID, session string) ([]entity.UserID, error)
+
+	// LeaveFunc mocks the Leave method.
+	LeaveFunc func(ctx context.Context, pid entity.ProjectID, session string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Join holds details about calls to the Join method.
+		Join []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Pid is the pid argument value.
+			Pid entity.ProjectID
+			// Session is the session argument value.
+			Session string
+		}
+		// Leave holds details about calls to the Leave method.
+		Leave []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Pid is the pid argument value.
+			Pid entity.ProjectID
+			// Session is the session argument value.
+			Session string
+		}
+	}
+	lockJoin  sync.RWMutex
+	lockLeave sync.RWMutex
+}
+
+// Join calls JoinFunc.
+func (mock *PresenceServiceMock) Join(ctx context.Context, pid entity.ProjectID, session string) ([]entity.UserID, error) {
+	if mock.JoinFunc == nil {
+		panic("PresenceServiceMock.JoinFunc: method is nil but PresenceService.Join was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Pid     entity.ProjectID
+		Session string
+	}{
+		Ctx:     ctx,
+		Pid:     pid,
+		Session: session,
+	}
+	mock.lockJoin.Lock()
+	mock.calls.Join = append(mock.calls.Join, callInfo)
+	mock.lockJoin.Unlock()
+	return mock.JoinFunc(ctx, pid, session)
+}
+
+// JoinCalls gets all the calls that were made to Join.
+// Check the length with:
+//
+//	len(mockedPresenceService.JoinCalls())
+func (mock *PresenceServiceMock) JoinCalls() []struct {
+	Ctx     context.Context
+	Pid     entity.ProjectID
+	Session string
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Pid     entity.ProjectID
+		Session string
+	}
+	mock.lockJoin.RLock()
+	calls = mock.calls.Join
+	mock.lockJoin.RUnlock()
+	return calls
+}
+
+// Leave calls LeaveFunc.
+func (mock *PresenceServiceMock) Leave(ctx context.Context, pid entity.ProjectID, session string) error {
+	if mock.LeaveFunc == nil {
+		panic("PresenceServiceMock.LeaveFunc: method is nil but PresenceService.Leave was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Pid     entity.ProjectID
+		Session string
+	}{
+		Ctx:     ctx,
+		Pid:     pid,
+		Session: session,
+	}
+	mock.lockLeave.Lock()
+	mock.calls.Leave = append(mock.calls.Leave, callInfo)
+	mock.lockLeave.Unlock()
+	return mock.LeaveFunc(ctx, pid, session)
+}
+
+// LeaveCalls gets all the calls that were made to Leave.
+// Check the length with:
+//
+//	len(mockedPresenceService.LeaveCalls())
+func (mock *PresenceServiceMock) LeaveCalls() []struct {
+	Ctx     context.Context
+	Pid     entity.ProjectID
+	Session string
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Pid     entity.ProjectID
+		Session string
+	}
+	mock.lockLeave.RLock()
+	calls = mock.calls.Leave
+	mock.lockLeave.RUnlock()
+	return calls
+}
+
+// Ensure, that MailPreferenceServiceMock does implement MailPreferenceService.
+// If this is not the case, regenerate this file with moq.
+var _ MailPreferenceService = &MailPreferenceServiceMock{}
+
+// MailPreferenceServiceMock is a mock implementation of MailPreferenceService.
+//
+//	func TestSomethingThatUsesMailPreferenceService(t *testing.T) {
+//
+//		// make and configure a mocked MailPreferenceService
+//		mockedMailPreferenceService := &MailPreferenceServiceMock{
+//			GetMailPreferenceFunc: func(ctx context.Context) (*entity.MailPreference, error) {
+//				panic("mock out the GetMailPreference method")
+//			},
+//			UpdateMailPreferenceFunc: func(ctx context.Context, email *string, enabled map[entity.MailKind]bool) (*entity.MailPreference, error) {
+//				panic("mock out the UpdateMailPreference method")
+//			},
+//		}
+//
+//		// use mockedMailPreferenceService in code that requires MailPreferenceService
+//		// and then make assertions.
+//
+//	}
+type MailPreferenceServiceMock struct {
+	// GetMailPreferenceFunc mocks the GetMailPreference method.
+	GetMailPreferenceFunc func(ctx context.Context) (*entity.MailPreference, error)
+
+	// UpdateMailPreferenceFunc mocks the UpdateMailPreference method.
+	UpdateMailPreferenceFunc func(ctx context.Context, email *string, enabled map[entity.MailKind]bool) (*entity.MailPreference, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetMailPreference holds details about calls to the GetMailPreference method.
+		GetMailPreference []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// UpdateMailPreference holds details about calls to the UpdateMailPreference method.
+		UpdateMailPreference []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Email is the email argument value.
+			Email *string
+			// Enabled is the enabled argument value.
+			Enabled map[entity.MailKind]bool
+		}
+	}
+	lockGetMailPreference    sync.RWMutex
+	lockUpdateMailPreference sync.RWMutex
+}
+
+// GetMailPreference calls GetMailPreferenceFunc.
+func (mock *MailPreferenceServiceMock) GetMailPreference(ctx context.Context) (*entity.MailPreference, error) {
+	if mock.GetMailPreferenceFunc == nil {
+		panic("MailPreferenceServiceMock.GetMailPreferenceFunc: method is nil but MailPreferenceService.GetMailPreference was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetMailPreference.Lock()
+	mock.calls.GetMailPreference = append(mock.calls.GetMailPreference, callInfo)
+	mock.lockGetMailPreference.Unlock()
+	return mock.GetMailPreferenceFunc(ctx)
+}
+
+// GetMailPreferenceCalls gets all the calls that were made to GetMailPreference.
+// Check the length with:
+//
+//	len(mockedMailPreferenceService.GetMailPreferenceCalls())
+func (mock *MailPreferenceServiceMock) GetMailPreferenceCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetMailPreference.RLock()
+	calls = mock.calls.GetMailPreference
+	mock.lockGetMailPreference.RUnlock()
+	return calls
+}
+
+// UpdateMailPreference calls UpdateMailPreferenceFunc.
+func (mock *MailPreferenceServiceMock) UpdateMailPreference(ctx context.Context, email *string, enabled map[entity.MailKind]bool) (*entity.MailPreference, error) {
+	if mock.UpdateMailPreferenceFunc == nil {
+		panic("MailPreferenceServiceMock.UpdateMailPreferenceFunc: method is nil but MailPreferenceService.UpdateMailPreference was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Email   *string
+		Enabled map[entity.MailKind]bool
+	}{
+		Ctx:     ctx,
+		Email:   email,
+		Enabled: enabled,
+	}
+	mock.lockUpdateMailPreference.Lock()
+	mock.calls.UpdateMailPreference = append(mock.calls.UpdateMailPreference, callInfo)
+	mock.lockUpdateMailPreference.Unlock()
+	return mock.UpdateMailPreferenceFunc(ctx, email, enabled)
+}
+
+// UpdateMailPreferenceCalls gets all the calls that were made to UpdateMailPreference.
+// Check the length with:
+//
+//	len(mockedMailPreferenceService.UpdateMailPreferenceCalls())
+func (mock *MailPreferenceServiceMock) UpdateMailPreferenceCalls() []struct {
+	Ctx     context.Context
+	Email   *string
+	Enabled map[entity.MailKind]bool
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Email   *string
+		Enabled map[entity.MailKind]bool
+	}
+	mock.lockUpdateMailPreference.RLock()
+	calls = mock.calls.UpdateMailPreference
+	mock.lockUpdateMailPreference.RUnlock()
+	return calls
+}
+
+// Ensure, that PasswordResetServiceMock does implement PasswordResetService.
+// If this is not the case, regenerate this file with moq.
+var _ PasswordResetService = &PasswordResetServiceMock{}
+
+// PasswordResetServiceMock is a mock implementation of PasswordResetService.
+//
+//	func TestSomethingThatUsesPasswordResetService(t *testing.T) {
+//
+//		// make and configure a mocked PasswordResetService
+//		mockedPasswordResetService := &PasswordResetServiceMock{
+//			RequestResetFunc: func(ctx context.Context, name string) error {
+//				panic("mock out the RequestReset method")
+//			},
+//			ResetPasswordFunc: func(ctx context.Context, token string, password string) error {
+//				panic("mock out the ResetPassword method")
+//			},
+//		}
+//
+//		// use mockedPasswordResetService in code that requires PasswordResetService
+//		// and then make assertions.
+//
+//	}
+type PasswordResetServiceMock struct {
+	// RequestResetFunc mocks the RequestReset method.
+	RequestResetFunc func(ctx context.Context, name string) error
+
+	// ResetPasswordFunc mocks the ResetPassword method.
+	ResetPasswordFunc func(ctx context.Context, token string, password string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// RequestReset holds details about calls to the RequestReset method.
+		RequestReset []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
+		}
+		// ResetPassword holds details about calls to the ResetPassword method.
+		ResetPassword []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Token is the token argument value.
+			Token string
+			// Password is the password argument value.
+			Password string
+		}
+	}
+	lockRequestReset  sync.RWMutex
+	lockResetPassword sync.RWMutex
+}
+
+// RequestReset calls RequestResetFunc.
+func (mock *PasswordResetServiceMock) RequestReset(ctx context.Context, name string) error {
+	if mock.RequestResetFunc == nil {
+		panic("PasswordResetServiceMock.RequestResetFunc: method is nil but PasswordResetService.RequestReset was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Name string
+	}{
+		Ctx:  ctx,
+		Name: name,
+	}
+	mock.lockRequestReset.Lock()
+	mock.calls.RequestReset = append(mock.calls.RequestReset, callInfo)
+	mock.lockRequestReset.Unlock()
+	return mock.RequestResetFunc(ctx, name)
+}
+
+// RequestResetCalls gets all the calls that were made to RequestReset.
+// Check the length with:
+//
+//	len(mockedPasswordResetService.RequestResetCalls())
+func (mock *PasswordResetServiceMock) RequestResetCalls() []struct {
+	Ctx  context.Context
+	Name string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Name string
+	}
+	mock.lockRequestReset.RLock()
+	calls = mock.calls.RequestReset
+	mock.lockRequestReset.RUnlock()
+	return calls
+}
+
+// ResetPassword calls ResetPasswordFunc.
+func (mock *PasswordResetServiceMock) ResetPassword(ctx context.Context, token string, password string) error {
+	if mock.ResetPasswordFunc == nil {
+		panic("PasswordResetServiceMock.ResetPasswordFunc: method is nil but PasswordResetService.ResetPassword was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Token    string
+		Password string
+	}{
+		Ctx:      ctx,
+		Token:    token,
+		Password: password,
+	}
+	mock.lockResetPassword.Lock()
+	mock.calls.ResetPassword = append(mock.calls.ResetPassword, callInfo)
+	mock.lockResetPassword.Unlock()
+	return mock.ResetPasswordFunc(ctx, token, password)
+}
+
+// ResetPasswordCalls gets all the calls that were made to ResetPassword.
+// Check the length with:
+//
+//	len(mockedPasswordResetService.ResetPasswordCalls())
+func (mock *PasswordResetServiceMock) ResetPasswordCalls() []struct {
+	Ctx      context.Context
+	Token    string
+	Password string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Token    string
+		Password string
+	}
+	mock.lockResetPassword.RLock()
+	calls = mock.calls.ResetPassword
+	mock.lockResetPassword.RUnlock()
+	return calls
+}
+
+// Ensure, that RequestAuthenticatorMock does implement RequestAuthenticator.
+// If this is not the case, regenerate this file with moq.
+var _ RequestAuthenticator = &RequestAuthenticatorMock{}
+
+// RequestAuthenticatorMock is a mock implementation of RequestAuthenticator.
+//
+//	func TestSomethingThatUsesRequestAuthenticator(t *testing.T) {
+//
+//		// make and configure a mocked RequestAuthenticator
+//		mockedRequestAuthenticator := &RequestAuthenticatorMock{
+//			FillContextFunc: func(r *http.Request) (*http.Request, error) {
+//				panic("mock out the FillContext method")
+//			},
+//		}
+//
+//		// use mockedRequestAuthenticator in code that requires RequestAuthenticator
+//		// and then make assertions.
+//
+//	}
+type RequestAuthenticatorMock struct {
+	// FillContextFunc mocks the FillContext method.
+	FillContextFunc func(r *http.Request) (*http.Request, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// FillContext holds details about calls to the FillContext method.
+		FillContext []struct {
+			// R is the r argument value.
+			R *http.Request
+		}
+	}
+	lockFillContext sync.RWMutex
+}
+
+// FillContext calls FillContextFunc.
+func (mock *RequestAuthenticatorMock) FillContext(r *http.Request) (*http.Request, error) {
+	if mock.FillContextFunc == nil {
+		panic("RequestAuthenticatorMock.FillContextFunc: method is nil but RequestAuthenticator.FillContext was just called")
+	}
+	callInfo := struct {
+		R *http.Request
+	}{
+		R: r,
+	}
+	mock.lockFillContext.Lock()
+	mock.calls.FillContext = append(mock.calls.FillContext, callInfo)
+	mock.lockFillContext.Unlock()
+	return mock.FillContextFunc(r)
+}
+
+// FillContextCalls gets all the calls that were made to FillContext.
+// Check the length with:
+//
+//	len(mockedRequestAuthenticator.FillContextCalls())
+func (mock *RequestAuthenticatorMock) FillContextCalls() []struct {
+	R *http.Request
+} {
+	var calls []struct {
+		R *http.Request
+	}
+	mock.lockFillContext.RLock()
+	calls = mock.calls.FillContext
+	mock.lockFillContext.RUnlock()
+	return calls
+}
+
+// Ensure, that RegisterUserServiceMock does implement RegisterUserService.
+// If this is not the case, regenerate this file with moq.
+var _ RegisterUserService = &RegisterUserServiceMock{}
+
+// RegisterUserServiceMock is a mock implementation of RegisterUserService.
+//
+//	func TestSomethingThatUsesRegisterUserService(t *testing.T) {
+//
+//		// make and configure a mocked RegisterUserService
+//		mockedRegisterUserService := &RegisterUserServiceMock{
+//			RegisterUserFunc: func(ctx context.Context, name string, password string, role string, email *string) (*entity.User, error) {
+//				panic("mock out the RegisterUser method")
+//			},
+//		}
+//
+//		// use mockedRegisterUserService in code that requires RegisterUserService
+//		// and then make assertions.
+//
+//	}
+type RegisterUserServiceMock struct {
+	// RegisterUserFunc mocks the RegisterUser method.
+	RegisterUserFunc func(ctx context.Context, name string, password string, role string, email *string) (*entity.User, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// RegisterUser holds details about calls to the RegisterUser method.
+		RegisterUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
+			// Password is the password argument value.
+			Password string
+			// Role is the role argument value.
+			Role string
+			// Email is the email argument value.
+			Email *string
+		}
+	}
+	lockRegisterUser sync.RWMutex
+}
+
+// RegisterUser calls RegisterUserFunc.
+func (mock *RegisterUserServiceMock) RegisterUser(ctx context.Context, name string, password string, role string, email *string) (*entity.User, error) {
+	if mock.RegisterUserFunc == nil {
+		panic("RegisterUserServiceMock.RegisterUserFunc: method is nil but RegisterUserService.RegisterUser was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Name     string
+		Password string
+		Role     string
+		Email    *string
+	}{
+		Ctx:      ctx,
+		Name:     name,
+		Password: password,
+		Role:     role,
+		Email:    email,
+	}
+	mock.lockRegisterUser.Lock()
+	mock.calls.RegisterUser = append(mock.calls.RegisterUser, callInfo)
+	mock.lockRegisterUser.Unlock()
+	return mock.RegisterUserFunc(ctx, name, password, role, email)
+}
+
+// RegisterUserCalls gets all the calls that were made to RegisterUser.
+// Check the length with:
+//
+//	len(mockedRegisterUserService.RegisterUserCalls())
+func (mock *RegisterUserServiceMock) RegisterUserCalls() []struct {
+	Ctx      context.Context
+	Name     string
+	Password string
+	Role     string
+	Email    *string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Name     string
+		Password string
+		Role     string
+		Email    *string
+	}
+	mock.lockRegisterUser.RLock()
+	calls = mock.calls.RegisterUser
+	mock.lockRegisterUser.RUnlock()
+	return calls
+}
+
+// Ensure, that LoginServiceMock does implement LoginService.
+// If this is not the case, regenerate this file with moq.
+var _ LoginService = &LoginServiceMock{}
+
+// LoginServiceMock is a mock implementation of LoginService.
+//
+//	func TestSomethingThatUsesLoginService(t *testing.T) {
+//
+//		// make and configure a mocked LoginService
+//		mockedLoginService := &LoginServiceMock{
+//			LoginFunc: func(ctx context.Context, name string, pw string, c entity.Client) (*entity.Tokens, error) {
+//				panic("mock out the Login method")
+//			},
+//		}
+//
+//		// use mockedLoginService in code that requires LoginService
+//		// and then make assertions.
+//
+//	}
+type LoginServiceMock struct {
+	// LoginFunc mocks the Login method.
+	LoginFunc func(ctx context.Context, name string, pw string, c entity.Client) (*entity.Tokens, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Login holds details about calls to the Login method.
+		Login []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
+			// Pw is the pw argument value.
+			Pw string
+			// C is the c argument value.
+			C entity.Client
+		}
+	}
+	lockLogin sync.RWMutex
+}
+
+// Login calls LoginFunc.
+func (mock *LoginServiceMock) Login(ctx context.Context, name string, pw string, c entity.Client) (*entity.Tokens, error) {
+	if mock.LoginFunc == nil {
+		panic("LoginServiceMock.LoginFunc: method is nil but LoginService.Login was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Name string
+		Pw   string
+		C    entity.Client
+	}{
+		Ctx:  ctx,
+		Name: name,
+		Pw:   pw,
+		C:    c,
+	}
+	mock.lockLogin.Lock()
+	mock.calls.Login = append(mock.calls.Login, callInfo)
+	mock.lockLogin.Unlock()
+	return mock.LoginFunc(ctx, name, pw, c)
+}
+
+// LoginCalls gets all the calls that were made to Login.
+// Check the length with:
+//
+//	len(mockedLoginService.LoginCalls())
+func (mock *LoginServiceMock) LoginCalls() []struct {
+	Ctx  context.Context
+	Name string
+	Pw   string
+	C    entity.Client
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Name string
+		Pw   string
+		C    entity.Client
+	}
+	mock.lockLogin.RLock()
+	calls = mock.calls.Login
+	mock.lockLogin.RUnlock()
+	return calls
+}
+
+// Ensure, that LogoutServiceMock does implement LogoutService.
+// If this is not the case, regenerate this file with moq.
+var _ LogoutService = &LogoutServiceMock{}
+
+// LogoutServiceMock is a mock implementation of LogoutService.
+//
+//	func TestSomethingThatUsesLogoutService(t *testing.T) {
+//
+//		// make and configure a mocked LogoutService
+//		mockedLogoutService := &LogoutServiceMock{
+//			LogoutFunc: func(ctx context.Context) error {
+//				panic("mock out the Logout method")
+//			},
+//		}
+//
+//		// use mockedLogoutService in code that requires LogoutService
+//		// and then make assertions.
+//
+//	}
+type LogoutServiceMock struct {
+	// LogoutFunc mocks the Logout method.
+	LogoutFunc func(ctx context.Context) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Logout holds details about calls to the Logout method.
+		Logout []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+	}
+	lockLogout sync.RWMutex
+}
+
+// Logout calls LogoutFunc.
+func (mock *LogoutServiceMock) Logout(ctx context.Context) error {
+	if mock.LogoutFunc == nil {
+		panic("LogoutServiceMock.LogoutFunc: method is nil but LogoutService.Logout was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockLogout.Lock()
+	mock.calls.Logout = append(mock.calls.Logout, callInfo)
+	mock.lockLogout.Unlock()
+	return mock.LogoutFunc(ctx)
+}
+
+// LogoutCalls gets all the calls that were made to Logout.
+// Check the length with:
+//
+//	len(mockedLogoutService.LogoutCalls())
+func (mock *LogoutServiceMock) LogoutCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockLogout.RLock()
+	calls = mock.calls.Logout
+	mock.lockLogout.RUnlock()
+	return calls
+}
+
+// Ensure, that SessionServiceMock does implement SessionService.
+// If this is not the case, regenerate this file with moq.
+var _ SessionService = &SessionServiceMock{}
+
+// SessionServiceMock is a mock implementation of SessionService.
+//
+//	func TestSomethingThatUsesSessionService(t *testing.T) {
+//
+//		// make and configure a mocked SessionService
+//		mockedSessionService := &SessionServiceMock{
+//			DeleteSessionFunc: func(ctx context.Context, id entity.SessionID) error {
+//				panic("mock out the DeleteSession method")
+//			},
+//			DeleteSessionsFunc: func(ctx context.Context) (int, error) {
+//				panic("mock out the DeleteSessions method")
+//			},
+//			ListSessionsFunc: func(ctx context.Context) ([]*entity.Session, error) {
+//				panic("mock out the ListSessions method")
+//			},
+//		}
+//
+//		// use mockedSessionService in code that requires SessionService
+//		// and then make assertions.
+//
+//	}
+type SessionServiceMock struct {
+	// DeleteSessionFunc mocks the DeleteSession method.
+	DeleteSessionFunc func(ctx context.Context, id entity.SessionID) error
+
+	// DeleteSessionsFunc mocks the DeleteSessions method.
+	DeleteSessionsFunc func(ctx context.Context) (int, error)
+
+	// ListSessionsFunc mocks the ListSessions method.
+	ListSessionsFunc func(ctx context.Context) ([]*entity.Session, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// DeleteSession holds details about calls to the DeleteSession method.
+		DeleteSession []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID entity.SessionID
+		}
+		// DeleteSessions holds details about calls to the DeleteSessions method.
+		DeleteSessions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// ListSessions holds details about calls to the ListSessions method.
+		ListSessions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+	}
+	lockDeleteSession  sync.RWMutex
+	lockDeleteSessions sync.RWMutex
+	lockListSessions   sync.RWMutex
+}
+
+// DeleteSession calls DeleteSessionFunc.
+func (mock *SessionServiceMock) DeleteSession(ctx context.Context, id entity.SessionID) error {
+	if mock.DeleteSessionFunc == nil {
+		panic("SessionServiceMock.DeleteSessionFunc: method is nil but SessionService.DeleteSession was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  entity.SessionID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockDeleteSession.Lock()
+	mock.calls.DeleteSession = append(mock.calls.DeleteSession, callInfo)
+	mock.lockDeleteSession.Unlock()
+	return mock.DeleteSessionFunc(ctx, id)
+}
+
+// DeleteSessionCalls gets all the calls that were made to DeleteSession.
+// Check the length with:
+//
+//	len(mockedSessionService.DeleteSessionCalls())
+func (mock *SessionServiceMock) DeleteSessionCalls() []struct {
+	Ctx context.Context
+	ID  entity.SessionID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  entity.SessionID
+	}
+	mock.lockDeleteSession.RLock()
+	calls = mock.calls.DeleteSession
+	mock.lockDeleteSession.RUnlock()
+	return calls
+}
+
+// DeleteSessions calls DeleteSessionsFunc.
+func (mock *SessionServiceMock) DeleteSessions(ctx context.Context) (int, error) {
+	if mock.DeleteSessionsFunc == nil {
+		panic("SessionServiceMock.DeleteSessionsFunc: method is nil but SessionService.DeleteSessions was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockDeleteSessions.Lock()
+	mock.calls.DeleteSessions = append(mock.calls.DeleteSessions, callInfo)
+	mock.lockDeleteSessions.Unlock()
+	return mock.DeleteSessionsFunc(ctx)
+}
+
+// DeleteSessionsCalls gets all the calls that were made to DeleteSessions.
+// Check the length with:
+//
+//	len(mockedSessionService.DeleteSessionsCalls())
+func (mock *SessionServiceMock) DeleteSessionsCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockDeleteSessions.RLock()
+	calls = mock.calls.DeleteSessions
+	mock.lockDeleteSessions.RUnlock()
+	return calls
+}
+
+// ListSessions calls ListSessionsFunc.
+func (mock *SessionServiceMock) ListSessions(ctx context.Context) ([]*entity.Session, error) {
+	if mock.ListSessionsFunc == nil {
+		panic("SessionServiceMock.ListSessionsFunc: method is nil but SessionService.ListSessions was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListSessions.Lock()
+	mock.calls.ListSessions = append(mock.calls.ListSessions, callInfo)
+	mock.lockListSessions.Unlock()
+	return mock.ListSessionsFunc(ctx)
+}
+
+// ListSessionsCalls gets all the calls that were made to ListSessions.
+// Check the length with:
+//
+//	len(mockedSessionService.ListSessionsCalls())
+func (mock *SessionServiceMock) ListSessionsCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockListSessions.RLock()
+	calls = mock.calls.ListSessions
+	mock.lockListSessions.RUnlock()
+	return calls
+}
+
+// Ensure, that PersonalAccessTokenServiceMock does implement PersonalAccessTokenService.
+// If this is not the case, regenerate this file with moq.
+var _ PersonalAccessTokenService = &PersonalAccessTokenServiceMock{}
+
+// PersonalAccessTokenServiceMock is a mock implementation of PersonalAccessTokenService.
+//
+//	func TestSomethingThatUsesPersonalAccessTokenService(t *testing.T) {
+//
+//		// make and configure a mocked PersonalAccessTokenService
+//		mockedPersonalAccessTokenService := &PersonalAccessTokenServiceMock{
+//			CreatePersonalAccessTokenFunc: func(ctx context.Context, name string, scopes entity.Scopes, expires *time.Time) (*entity.PersonalAccessToken, string, error) {
+//				panic("mock out the CreatePersonalAccessToken method")
+//			},
+//			DeletePersonalAccessTokenFunc: func(ctx context.Context, id entity.PersonalAccessTokenID) error {
+//				panic("mock out the DeletePersonalAccessToken method")
+//			},
+//			ListPersonalAccessTokensFunc: func(ctx context.Context) (entity.PersonalAccessTokens, error) {
+//				panic("mock out the ListPersonalAccessTokens method")
+//			},
+//		}
+//
+//		// use mockedPersonalAccessTokenService in code that requires PersonalAccessTokenService
+//		// and then make assertions.
+//
+//	}
+type PersonalAccessTokenServiceMock struct {
+	// CreatePersonalAccessTokenFunc mocks the CreatePersonalAccessToken method.
+	CreatePersonalAccessTokenFunc func(ctx context.Context, name string, scopes entity.Scopes, expires *time.Time) (*entity.PersonalAccessToken, string, error)
+
+	// DeletePersonalAccessTokenFunc mocks the DeletePersonalAccessToken method.
+	DeletePersonalAccessTokenFunc func(ctx context.Context, id entity.PersonalAccessTokenID) error
+
+	// ListPersonalAccessTokensFunc mocks the ListPersonalAccessTokens method.
+	ListPersonalAccessTokensFunc func(ctx context.Context) (entity.PersonalAccessTokens, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreatePersonalAccessToken holds details about calls to the CreatePersonalAccessToken method.
+		CreatePersonalAccessToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
+			// Scopes is the scopes argument value.
+			Scopes entity.Scopes
+			// Expires is the expires argument value.
+			Expires *time.Time
+		}
+		// DeletePersonalAccessToken holds details about calls to the DeletePersonalAccessToken method.
+		DeletePersonalAccessToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID entity.PersonalAccessTokenID
+		}
+		// ListPersonalAccessTokens holds details about calls to the ListPersonalAccessTokens method.
+		ListPersonalAccessTokens []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+	}
+	lockCreatePersonalAccessToken sync.RWMutex
+	lockDeletePersonalAccessToken sync.RWMutex
+	lockListPersonalAccessTokens  sync.RWMutex
+}
+
+// CreatePersonalAccessToken calls CreatePersonalAccessTokenFunc.
+func (mock *PersonalAccessTokenServiceMock) CreatePersonalAccessToken(ctx context.Context, name string, scopes entity.Scopes, expires *time.Time) (*entity.PersonalAccessToken, string, error) {
+	if mock.CreatePersonalAccessTokenFunc == nil {
+		panic("PersonalAccessTokenServiceMock.CreatePersonalAccessTokenFunc: method is nil but PersonalAccessTokenService.CreatePersonalAccessToken was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Name    string
+		Scopes  entity.Scopes
+		Expires *time.Time
+	}{
+		Ctx:     ctx,
+		Name:    name,
+		Scopes:  scopes,
+		Expires: expires,
+	}
+	mock.lockCreatePersonalAccessToken.Lock()
+	mock.calls.CreatePersonalAccessToken = append(mock.calls.CreatePersonalAccessToken, callInfo)
+	mock.lockCreatePersonalAccessToken.Unlock()
+	return mock.CreatePersonalAccessTokenFunc(ctx, name, scopes, expires)
+}
+
+// CreatePersonalAccessTokenCalls gets all the calls that were made to CreatePersonalAccessToken.
+// Check the length with:
+//
+//	len(mockedPersonalAccessTokenService.CreatePersonalAccessTokenCalls())
+func (mock *PersonalAccessTokenServiceMock) CreatePersonalAccessTokenCalls() []struct {
+	Ctx     context.Context
+	Name    string
+	Scopes  entity.Scopes
+	Expires *time.Time
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Name    string
+		Scopes  entity.Scopes
+		Expires *time.Time
+	}
+	mock.lockCreatePersonalAccessToken.RLock()
+	calls = mock.calls.CreatePersonalAccessToken
+	mock.lockCreatePersonalAccessToken.RUnlock()
+	return calls
+}
+
+// DeletePersonalAccessToken calls DeletePersonalAccessTokenFunc.
+func (mock *PersonalAccessTokenServiceMock) DeletePersonalAccessToken(ctx context.Context, id entity.PersonalAccessTokenID) error {
+	if mock.DeletePersonalAccessTokenFunc == nil {
+		panic("PersonalAccessTokenServiceMock.DeletePersonalAccessTokenFunc: method is nil but PersonalAccessTokenService.DeletePersonalAccessToken was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  entity.PersonalAccessTokenID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockDeletePersonalAccessToken.Lock()
+	mock.calls.DeletePersonalAccessToken = append(mock.calls.DeletePersonalAccessToken, callInfo)
+	mock.lockDeletePersonalAccessToken.Unlock()
+	return mock.DeletePersonalAccessTokenFunc(ctx, id)
+}
+
+// DeletePersonalAccessTokenCalls gets all the calls that were made to DeletePersonalAccessToken.
+// Check the length with:
+//
+//	len(mockedPersonalAccessTokenService.DeletePersonalAccessTokenCalls())
+func (mock *PersonalAccessTokenServiceMock) DeletePersonalAccessTokenCalls() []struct {
+	Ctx context.Context
+	ID  entity.PersonalAccessTokenID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  entity.PersonalAccessTokenID
+	}
+	mock.lockDeletePersonalAccessToken.RLock()
+	calls = mock.calls.DeletePersonalAccessToken
+	mock.lockDeletePersonalAccessToken.RUnlock()
+	return calls
+}
+
+// ListPersonalAccessTokens calls ListPersonalAccessTokensFunc.
+func (mock *PersonalAccessTokenServiceMock) ListPersonalAccessTokens(ctx context.Context) (entity.PersonalAccessTokens, error) {
+	if mock.ListPersonalAccessTokensFunc == nil {
+		panic("PersonalAccessTokenServiceMock.ListPersonalAccessTokensFunc: method is nil but PersonalAccessTokenService.ListPersonalAccessTokens was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListPersonalAccessTokens.Lock()
+	mock.calls.ListPersonalAccessTokens = append(mock.calls.ListPersonalAccessTokens, callInfo)
+	mock.lockListPersonalAccessTokens.Unlock()
+	return mock.ListPersonalAccessTokensFunc(ctx)
+}
+
+// ListPersonalAccessTokensCalls gets all the calls that were made to ListPersonalAccessTokens.
+// Check the length with:
+//
+//	len(mockedPersonalAccessTokenService.ListPersonalAccessTokensCalls())
+func (mock *PersonalAccessTokenServiceMock) ListPersonalAccessTokensCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockListPersonalAccessTokens.RLock()
+	calls = mock.calls.ListPersonalAccessTokens
+	mock.lockListPersonalAccessTokens.RUnlock()
+	return calls
+}
+
+// Ensure, that OAuthClientServiceMock does implement OAuthClientService.
+// If this is not the case, regenerate this file with moq.
+var _ OAuthClientService = &OAuthClientServiceMock{}
+
+// OAuthClientServiceMock is a mock implementation of OAuthClientService.
+//
+//	func TestSomethingThatUsesOAuthClientService(t *testing.T) {
+//
+//		// make and configure a mocked OAuthClientService
+//		mockedOAuthClientService := &OAuthClientServiceMock{
+//			DeleteOAuthClientFunc: func(ctx context.Context, id entity.OAuthClientID) error {
+//				panic("mock out the DeleteOAuthClient method")
+//			},
+//			ListOAuthClientsFunc: func(ctx context.Context) (entity.OAuthClients, error) {
+//				panic("mock out the ListOAuthClients method")
+//			},
+//			RegisterOAuthClientFunc: func(ctx context.Context, name string, redirectURIs []string, scopes entity.Scopes, confidential bool) (*entity.OAuthClient, string, error) {
+//				panic("mock out the RegisterOAuthClient method")
+//			},
+//		}
+//
+//		// use mockedOAuthClientService in code that requires OAuthClientService
+//		// and then make assertions.
+//
+//	}
+type OAuthClientServiceMock struct {
+	// DeleteOAuthClientFunc mocks the DeleteOAuthClient method.
+	DeleteOAuthClientFunc func(ctx context.Context, id entity.OAuthClientID) error
+
+	// ListOAuthClientsFunc mocks the ListOAuthClients method.
+	ListOAuthClientsFunc func(ctx context.Context) (entity.OAuthClients, error)
+
+	// RegisterOAuthClientFunc mocks the RegisterOAuthClient method.
+	RegisterOAuthClientFunc func(ctx context.Context, name string, redirectURIs []string, scopes entity.Scopes, confidential bool) (*entity.OAuthClient, string, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// DeleteOAuthClient holds details about calls to the DeleteOAuthClient method.
+		DeleteOAuthClient []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID entity.OAuthClientID
+		}
+		// ListOAuthClients holds details about calls to the ListOAuthClients method.
+		ListOAuthClients []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// RegisterOAuthClient holds details about calls to the RegisterOAuthClient method.
+		RegisterOAuthClient []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
+			// RedirectURIs is the redirectURIs argument value.
+			RedirectURIs []string
+			// Scopes is the scopes argument value.
+			Scopes entity.Scopes
+			// Confidential is the confidential argument value.
+			Confidential bool
+		}
+	}
+	lockDeleteOAuthClient   sync.RWMutex
+	lockListOAuthClients    sync.RWMutex
+	lockRegisterOAuthClient sync.RWMutex
+}
+
+// DeleteOAuthClient calls DeleteOAuthClientFunc.
+func (mock *OAuthClientServiceMock) DeleteOAuthClient(ctx context.Context, id entity.OAuthClientID) error {
+	if mock.DeleteOAuthClientFunc == nil {
+		panic("OAuthClientServiceMock.DeleteOAuthClientFunc: method is nil but OAuthClientService.DeleteOAuthClient was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  entity.OAuthClientID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockDeleteOAuthClient.Lock()
+	mock.calls.DeleteOAuthClient = append(mock.calls.DeleteOAuthClient, callInfo)
+	mock.lockDeleteOAuthClient.Unlock()
+	return mock.DeleteOAuthClientFunc(ctx, id)
+}
+
+// DeleteOAuthClientCalls gets all the calls that were made to DeleteOAuthClient.
+// Check the length with:
+//
+//	len(mockedOAuthClientService.DeleteOAuthClientCalls())
+func (mock *OAuthClientServiceMock) DeleteOAuthClientCalls() []struct {
+	Ctx context.Context
+	ID  entity.OAuthClientID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  entity.OAuthClientID
+	}
+	mock.lockDeleteOAuthClient.RLock()
+	calls = mock.calls.DeleteOAuthClient
+	mock.lockDeleteOAuthClient.RUnlock()
+	return calls
+}
+
+// ListOAuthClients calls ListOAuthClientsFunc.
+func (mock *OAuthClientServiceMock) ListOAuthClients(ctx context.Context) (entity.OAuthClients, error) {
+	if mock.ListOAuthClientsFunc == nil {
+		panic("OAuthClientServiceMock.ListOAuthClientsFunc: method is nil but OAuthClientService.ListOAuthClients was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListOAuthClients.Lock()
+	mock.calls.ListOAuthClients = append(mock.calls.ListOAuthClients, callInfo)
+	mock.lockListOAuthClients.Unlock()
+	return mock.ListOAuthClientsFunc(ctx)
+}
+
+// ListOAuthClientsCalls gets all the calls that were made to ListOAuthClients.
+// Check the length with:
+//
+//	len(mockedOAuthClientService.ListOAuthClientsCalls())
+func (mock *OAuthClientServiceMock) ListOAuthClientsCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockListOAuthClients.RLock()
+	calls = mock.calls.ListOAuthClients
+	mock.lockListOAuthClients.RUnlock()
+	return calls
+}
+
+// RegisterOAuthClient calls RegisterOAuthClientFunc.
+func (mock *OAuthClientServiceMock) RegisterOAuthClient(ctx context.Context, name string, redirectURIs []string, scopes entity.Scopes, confidential bool) (*entity.OAuthClient, string, error) {
+	if mock.RegisterOAuthClientFunc == nil {
+		panic("OAuthClientServiceMock.RegisterOAuthClientFunc: method is nil but OAuthClientService.RegisterOAuthClient was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		Name         string
+		RedirectURIs []string
+		Scopes       entity.Scopes
+		Confidential bool
+	}{
+		Ctx:          ctx,
+		Name:         name,
+		RedirectURIs: redirectURIs,
+		Scopes:       scopes,
+		Confidential: confidential,
+	}
+	mock.lockRegisterOAuthClient.Lock()
+	mock.calls.RegisterOAuthClient = append(mock.calls.RegisterOAuthClient, callInfo)
+	mock.lockRegisterOAuthClient.Unlock()
+	return mock.RegisterOAuthClientFunc(ctx, name, redirectURIs, scopes, confidential)
+}
+
+// RegisterOAuthClientCalls gets all the calls that were made to RegisterOAuthClient.
+// Check the length with:
+//
+//	len(mockedOAuthClientService.RegisterOAuthClientCalls())
+func (mock *OAuthClientServiceMock) RegisterOAuthClientCalls() []struct {
+	Ctx          context.Context
+	Name         string
+	RedirectURIs []string
+	Scopes       entity.Scopes
+	Confidential bool
+} {
+	var calls []struct {
+		Ctx          context.Context
+		Name         string
+		RedirectURIs []string
+		Scopes       entity.Scopes
+		Confidential bool
+	}
+	mock.lockRegisterOAuthClient.RLock()
+	calls = mock.calls.RegisterOAuthClient
+	mock.lockRegisterOAuthClient.RUnlock()
+	return calls
+}
+
+// Ensure, that AuthorizeServiceMock does implement AuthorizeService.
+// If this is not the case, regenerate this file with moq.
+var _ AuthorizeService = &AuthorizeServiceMock{}
+
+// AuthorizeServiceMock is a mock implementation of AuthorizeService.
+//
+//	func TestSomethingThatUsesAuthorizeService(t *testing.T) {
+//
+//		// make and configure a mocked AuthorizeService
+//		mockedAuthorizeService := &AuthorizeServiceMock{
+//			ApproveFunc: func(ctx context.Context, req *entity.AuthorizationRequest, name string, pw string) (string, error) {
+//				panic("mock out the Approve method")
+//			},
+//			AuthorizeFunc: func(ctx context.Context, req *entity.AuthorizationRequest) (*entity.OAuthClient, entity.Scopes, error) {
+//				panic("mock out the Authorize method")
+//			},
+//			DenyFunc: func(ctx context.Context, req *entity.AuthorizationRequest) (string, error) {
+//				panic("mock out the Deny method")
+//			},
+//		}
+//
+//		// use mockedAuthorizeService in code that requires AuthorizeService
+//		// and then make assertions.
+//
+//	}
+type AuthorizeServiceMock struct {
+	// ApproveFunc mocks the Approve method.
+	ApproveFunc func(ctx context.Context, req *entity.AuthorizationRequest, name string, pw string) (string, error)
+
+	// AuthorizeFunc mocks the Authorize method.
+	AuthorizeFunc func(ctx context.Context, req *entity.AuthorizationRequest) (*entity.OAuthClient, entity.Scopes, error)
+
+	// DenyFunc mocks the Deny method.
+	DenyFunc func(ctx context.Context, req *entity.AuthorizationRequest) (string, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Approve holds details about calls to the Approve method.
+		Approve []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req *entity.AuthorizationRequest
+			// Name is the name argument value.
+			Name string
+			// Pw is the pw argument value.
+			Pw string
+		}
+		// Authorize holds details about calls to the Authorize method.
+		Authorize []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req *entity.AuthorizationRequest
+		}
+		// Deny holds details about calls to the Deny method.
+		Deny []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req *entity.AuthorizationRequest
+		}
+	}
+	lockApprove   sync.RWMutex
+	lockAuthorize sync.RWMutex
+	lockDeny      sync.RWMutex
+}
+
+// Approve calls ApproveFunc.
+func (mock *AuthorizeServiceMock) Approve(ctx context.Context, req *entity.AuthorizationRequest, name string, pw string) (string, error) {
+	if mock.ApproveFunc == nil {
+		panic("AuthorizeServiceMock.ApproveFunc: method is nil but AuthorizeService.Approve was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Req  *entity.AuthorizationRequest
+		Name string
+		Pw   string
+	}{
+		Ctx:  ctx,
+		Req:  req,
+		Name: name,
+		Pw:   pw,
+	}
+	mock.lockApprove.Lock()
+	mock.calls.Approve = append(mock.calls.Approve, callInfo)
+	mock.lockApprove.Unlock()
+	return mock.ApproveFunc(ctx, req, name, pw)
+}
+
+// ApproveCalls gets all the calls that were made to Approve.
+// Check the length with:
+//
+//	len(mockedAuthorizeService.ApproveCalls())
+func (mock *AuthorizeServiceMock) ApproveCalls() []struct {
+	Ctx  context.Context
+	Req  *entity.AuthorizationRequest
+	Name string
+	Pw   string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Req  *entity.AuthorizationRequest
+		Name string
+		Pw   string
+	}
+	mock.lockApprove.RLock()
+	calls = mock.calls.Approve
+	mock.lockApprove.RUnlock()
+	return calls
+}
+
+// Authorize calls AuthorizeFunc.
+func (mock *AuthorizeServiceMock) Authorize(ctx context.Context, req *entity.AuthorizationRequest) (*entity.OAuthClient, entity.Scopes, error) {
+	if mock.AuthorizeFunc == nil {
+		panic("AuthorizeServiceMock.AuthorizeFunc: method is nil but AuthorizeService.Authorize was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Req *entity.AuthorizationRequest
+	}{
+		Ctx: ctx,
+		Req: req,
+	}
+	mock.lockAuthorize.Lock()
+	mock.calls.Authorize = append(mock.calls.Authorize, callInfo)
+	mock.lockAuthorize.Unlock()
+	return mock.AuthorizeFunc(ctx, req)
+}
+
+// AuthorizeCalls gets all the calls that were made to Authorize.
+// Check the length with:
+//
+//	len(mockedAuthorizeService.AuthorizeCalls())
+func (mock *AuthorizeServiceMock) AuthorizeCalls() []struct {
+	Ctx context.Context
+	Req *entity.AuthorizationRequest
+} {
+	var calls []struct {
+		Ctx context.Context
+		Req *entity.AuthorizationRequest
+	}
+	mock.lockAuthorize.RLock()
+	calls = mock.calls.Authorize
+	mock.lockAuthorize.RUnlock()
+	return calls
+}
+
+// Deny calls DenyFunc.
+func (mock *AuthorizeServiceMock) Deny(ctx context.Context, req *entity.AuthorizationRequest) (string, error) {
+	if mock.DenyFunc == nil {
+		panic("AuthorizeServiceMock.DenyFunc: method is nil but AuthorizeService.Deny was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Req *entity.AuthorizationRequest
+	}{
+		Ctx: ctx,
+		Req: req,
+	}
+	mock.lockDeny.Lock()
+	mock.calls.Deny = append(mock.calls.Deny, callInfo)
+	mock.lockDeny.Unlock()
+	return mock.DenyFunc(ctx, req)
+}
+
+// DenyCalls gets all the calls that were made to Deny.
+// Check the length with:
+//
+//	len(mockedAuthorizeService.DenyCalls())
+func (mock *AuthorizeServiceMock) DenyCalls() []struct {
+	Ctx context.Context
+	Req *entity.AuthorizationRequest
+} {
+	var calls []struct {
+		Ctx context.Context
+		Req *entity.AuthorizationRequest
+	}
+	mock.lockDeny.RLock()
+	calls = mock.calls.Deny
+	mock.lockDeny.RUnlock()
+	return calls
+}
+
+// Ensure, that OAuthTokenServiceMock does implement OAuthTokenService.
+// If this is not the case, regenerate this file with moq.
+var _ OAuthTokenService = &OAuthTokenServiceMock{}
+
+// OAuthTokenServiceMock is a mock implementation of OAuthTokenService.
+//
+//	func TestSomethingThatUsesOAuthTokenService(t *testing.T) {
+//
+//		// make and configure a mocked OAuthTokenService
+//		mockedOAuthTokenService := &OAuthTokenServiceMock{
+//			ClientCredentialsGrantFunc: func(ctx context.Context, cid entity.OAuthClientID, secret string, scope string) (*entity.OAuthToken, error) {
+//				panic("mock out the ClientCredentialsGrant method")
+//			},
+//			ExchangeAuthorizationCodeFunc: func(ctx context.Context, cid entity.OAuthClientID, secret string, code string, redirectURI string, verifier string) (*entity.OAuthToken, error) {
+//				panic("mock out the ExchangeAuthorizationCode method")
+//			},
+//			IntrospectTokenFunc: func(ctx context.Context, cid entity.OAuthClientID, secret string, token string) (*entity.TokenIntrospection, error) {
+//				panic("mock out the IntrospectToken method")
+//			},
+//			RevokeTokenFunc: func(ctx context.Context, cid entity.OAuthClientID, secret string, token string) error {
+//				panic("mock out the RevokeToken method")
+//			},
+//		}
+//
+//		// use mockedOAuthTokenService in code that requires OAuthTokenService
+//		// and then make assertions.
+//
+//	}
+type OAuthTokenServiceMock struct {
+	// ClientCredentialsGrantFunc mocks the ClientCredentialsGrant method.
+	ClientCredentialsGrantFunc func(ctx context.Context, cid entity.OAuthClientID, secret string, scope string) (*entity.OAuthToken, error)
+
+	// ExchangeAuthorizationCodeFunc mocks the ExchangeAuthorizationCode method.
+	ExchangeAuthorizationCodeFunc func(ctx context.Context, cid entity.OAuthClientID, secret string, code string, redirectURI string, verifier string) (*entity.OAuthToken, error)
+
+	// IntrospectTokenFunc mocks the IntrospectToken method.
+	IntrospectTokenFunc func(ctx context.Context, cid entity.OAuthClientID, secret string, token string) (*entity.TokenIntrospection, error)
+
+	// RevokeTokenFunc mocks the RevokeToken method.
+	RevokeTokenFunc func(ctx context.Context, cid entity.OAuthClientID, secret string, token string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ClientCredentialsGrant holds details about calls to the ClientCredentialsGrant method.
+		ClientCredentialsGrant []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Cid is the cid argument value.
+			Cid entity.OAuthClientID
+			// Secret is the secret argument value.
+			Secret string
+			// Scope is the scope argument value.
+			Scope string
+		}
+		// ExchangeAuthorizationCode holds details about calls to the ExchangeAuthorizationCode method.
+		ExchangeAuthorizationCode []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Cid is the cid argument value.
+			Cid entity.OAuthClientID
+			// Secret is the secret argument value.
+			Secret string
+			// Code is the code argument value.
+			Code string
+			// RedirectURI is the redirectURI argument value.
+			RedirectURI string
+			// Verifier is the verifier argument value.
+			Verifier string
+		}
+		// IntrospectToken holds details about calls to the IntrospectToken method.
+		IntrospectToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Cid is the cid argument value.
+			Cid entity.OAuthClientID
+			// Secret is the secret argument value.
+			Secret string
+			// Token is the token argument value.
+			Token string
+		}
+		// RevokeToken holds details about calls to the RevokeToken method.
+		RevokeToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Cid is the cid argument value.
+			Cid entity.OAuthClientID
+			// Secret is the secret argument value.
+			Secret string
+			// Token is the token argument value.
+			Token string
+		}
+	}
+	lockClientCredentialsGrant    sync.RWMutex
+	lockExchangeAuthorizationCode sync.RWMutex
+	lockIntrospectToken           sync.RWMutex
+	lockRevokeToken               sync.RWMutex
+}
+
+// ClientCredentialsGrant calls ClientCredentialsGrantFunc.
+func (mock *OAuthTokenServiceMock) ClientCredentialsGrant(ctx context.Context, cid entity.OAuthClientID, secret string, scope string) (*entity.OAuthToken, error) {
+	if mock.ClientCredentialsGrantFunc == nil {
+		panic("OAuthTokenServiceMock.ClientCredentialsGrantFunc: method is nil but OAuthTokenService.ClientCredentialsGrant was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Cid    entity.OAuthClientID
+		Secret string
+		Scope  string
+	}{
+		Ctx:    ctx,
+		Cid:    cid,
+		Secret: secret,
+		Scope:  scope,
+	}
+	mock.lockClientCredentialsGrant.Lock()
+	mock.calls.ClientCredentialsGrant = append(mock.calls.ClientCredentialsGrant, callInfo)
+	mock.lockClientCredentialsGrant.Unlock()
+	return mock.ClientCredentialsGrantFunc(ctx, cid, secret, scope)
+}
+
+// ClientCredentialsGrantCalls gets all the calls that were made to ClientCredentialsGrant.
+// Check the length with:
+//
+//	len(mockedOAuthTokenService.ClientCredentialsGrantCalls())
+func (mock *OAuthTokenServiceMock) ClientCredentialsGrantCalls() []struct {
+	Ctx    context.Context
+	Cid    entity.OAuthClientID
+	Secret string
+	Scope  string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Cid    entity.OAuthClientID
+		Secret string
+		Scope  string
+	}
+	mock.lockClientCredentialsGrant.RLock()
+	calls = mock.calls.ClientCredentialsGrant
+	mock.lockClientCredentialsGrant.RUnlock()
+	return calls
+}
+
+// ExchangeAuthorizationCode calls ExchangeAuthorizationCodeFunc.
+func (mock *OAuthTokenServiceMock) ExchangeAuthorizationCode(ctx context.Context, cid entity.OAuthClientID, secret string, code string, redirectURI string, verifier string) (*entity.OAuthToken, error) {
+	if mock.ExchangeAuthorizationCodeFunc == nil {
+		panic("OAuthTokenServiceMock.ExchangeAuthorizationCodeFunc: method is nil but OAuthTokenService.ExchangeAuthorizationCode was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		Cid         entity.OAuthClientID
+		Secret      string
+		Code        string
+		RedirectURI string
+		Verifier    string
+	}{
+		Ctx:         ctx,
+		Cid:         cid,
+		Secret:      secret,
+		Code:        code,
+		RedirectURI: redirectURI,
+		Verifier:    verifier,
+	}
+	mock.lockExchangeAuthorizationCode.Lock()
+	mock.calls.ExchangeAuthorizationCode = append(mock.calls.ExchangeAuthorizationCode, callInfo)
+	mock.lockExchangeAuthorizationCode.Unlock()
+	return mock.ExchangeAuthorizationCodeFunc(ctx, cid, secret, code, redirectURI, verifier)
+}
+
+// ExchangeAuthorizationCodeCalls gets all the calls that were made to ExchangeAuthorizationCode.
+// Check the length with:
+//
+//	len(mockedOAuthTokenService.ExchangeAuthorizationCodeCalls())
+func (mock *OAuthTokenServiceMock) ExchangeAuthorizationCodeCalls() []struct {
+	Ctx         context.Context
+	Cid         entity.OAuthClientID
+	Secret      string
+	Code        string
+	RedirectURI string
+	Verifier    string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		Cid         entity.OAuthClientID
+		Secret      string
+		Code        string
+		RedirectURI string
+		Verifier    string
+	}
+	mock.lockExchangeAuthorizationCode.RLock()
+	calls = mock.calls.ExchangeAuthorizationCode
+	mock.lockExchangeAuthorizationCode.RUnlock()
+	return calls
+}
+
+// IntrospectToken calls IntrospectTokenFunc.
+func (mock *OAuthTokenServiceMock) IntrospectToken(ctx context.Context, cid entity.OAuthClientID, secret string, token string) (*entity.TokenIntrospection, error) {
+	if mock.IntrospectTokenFunc == nil {
+		panic("OAuthTokenServiceMock.IntrospectTokenFunc: method is nil but OAuthTokenService.IntrospectToken was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Cid    entity.OAuthClientID
+		Secret string
+		Token  string
+	}{
+		Ctx:    ctx,
+		Cid:    cid,
+		Secret: secret,
+		Token:  token,
+	}
+	mock.lockIntrospectToken.Lock()
+	mock.calls.IntrospectToken = append(mock.calls.IntrospectToken, callInfo)
+	mock.lockIntrospectToken.Unlock()
+	return mock.IntrospectTokenFunc(ctx, cid, secret, token)
+}
+
+// IntrospectTokenCalls gets all the calls that were made to IntrospectToken.
+// Check the length with:
+//
+//	len(mockedOAuthTokenService.IntrospectTokenCalls())
+func (mock *OAuthTokenServiceMock) IntrospectTokenCalls() []struct {
+	Ctx    context.Context
+	Cid    entity.OAuthClientID
+	Secret string
+	Token  string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Cid    entity.OAuthClientID
+		Secret string
+		Token  string
+	}
+	mock.lockIntrospectToken.RLock()
+	calls = mock.calls.IntrospectToken
+	mock.lockIntrospectToken.RUnlock()
+	return calls
+}
+
+// RevokeToken calls RevokeTokenFunc.
+func (mock *OAuthTokenServiceMock) RevokeToken(ctx context.Context, cid entity.OAuthClientID, secret string, token string) error {
+	if mock.RevokeTokenFunc == nil {
+		panic("OAuthTokenServiceMock.RevokeTokenFunc: method is nil but OAuthTokenService.RevokeToken was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Cid    entity.OAuthClientID
+		Secret string
+		Token  string
+	}{
+		Ctx:    ctx,
+		Cid:    cid,
+		Secret: secret,
+		Token:  token,
+	}
+	mock.lockRevokeToken.Lock()
+	mock.calls.RevokeToken = append(mock.calls.RevokeToken, callInfo)
+	mock.lockRevokeToken.Unlock()
+	return mock.RevokeTokenFunc(ctx, cid, secret, token)
+}
+
+// RevokeTokenCalls gets all the calls that were made to RevokeToken.
+// Check the length with:
+//
+//	len(mockedOAuthTokenService.RevokeTokenCalls())
+func (mock *OAuthTokenServiceMock) RevokeTokenCalls() []struct {
+	Ctx    context.Context
+	Cid    entity.OAuthClientID
+	Secret string
+	Token  string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Cid    entity.OAuthClientID
+		Secret string
+		Token  string
+	}
+	mock.lockRevokeToken.RLock()
+	calls = mock.calls.RevokeToken
+	mock.lockRevokeToken.RUnlock()
+	return calls
+}
+
+// Ensure, that RefreshTokenServiceMock does implement RefreshTokenService.
+// If this is not the case, regenerate this file with moq.
+var _ RefreshTokenService = &RefreshTokenServiceMock{}
+
+// RefreshTokenServiceMock is a mock implementation of RefreshTokenService.
+//
+//	func TestSomethingThatUsesRefreshTokenService(t *testing.T) {
+//
+//		// make and configure a mocked RefreshTokenService
+//		mockedRefreshTokenService := &RefreshTokenServiceMock{
+//			RefreshFunc: func(ctx context.Context, token string) (*entity.Tokens, error) {
+//				panic("mock out the Refresh method")
+//			},
+//		}
+//
+//		// use mockedRefreshTokenService in code that requires RefreshTokenService
+//		// and then make assertions.
+//
+//	}
+type RefreshTokenServiceMock struct {
+	// RefreshFunc mocks the Refresh method.
+	RefreshFunc func(ctx context.Context, token string) (*entity.Tokens, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Refresh holds details about calls to the Refresh method.
+		Refresh []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Token is the token argument value.
+			Token string
+		}
+	}
+	lockRefresh sync.RWMutex
+}
+
+// Refresh calls RefreshFunc.
+func (mock *RefreshTokenServiceMock) Refresh(ctx context.Context, token string) (*entity.Tokens, error) {
+	if mock.RefreshFunc == nil {
+		panic("RefreshTokenServiceMock.RefreshFunc: method is nil but RefreshTokenService.Refresh was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Token string
+	}{
+		Ctx:   ctx,
+		Token: token,
+	}
+	mock.lockRefresh.Lock()
+	mock.calls.Refresh = append(mock.calls.Refresh, callInfo)
+	mock.lockRefresh.Unlock()
+	return mock.RefreshFunc(ctx, token)
+}
+
+// RefreshCalls gets all the calls that were made to Refresh.
+// Check the length with:
+//
+//	len(mockedRefreshTokenService.RefreshCalls())
+func (mock *RefreshTokenServiceMock) RefreshCalls() []struct {
+	Ctx   context.Context
+	Token string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Token string
+	}
+	mock.lockRefresh.RLock()
+	calls = mock.calls.Refresh
+	mock.lockRefresh.RUnlock()
+	return calls
+}
+
+// Ensure, that KeySetServiceMock does implement KeySetService.
+// If this is not the case, regenerate this file with moq.
+var _ KeySetService = &KeySetServiceMock{}
+
+// KeySetServiceMock is a mock implementation of KeySetService.
+//
+//	func TestSomethingThatUsesKeySetService(t *testing.T) {
+//
+//		// make and configure a mocked KeySetService
+//		mockedKeySetService := &KeySetServiceMock{
+//			PublicKeysFunc: func() jwk.Set {
+//				panic("mock out the PublicKeys method")
+//			},
+//		}
+//
+//		// use mockedKeySetService in code that requires KeySetService
+//		// and then make assertions.
+//
+//	}
+type KeySetServiceMock struct {
+	// PublicKeysFunc mocks the PublicKeys method.
+	PublicKeysFunc func() jwk.Set
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// PublicKeys holds details about calls to the PublicKeys method.
+		PublicKeys []struct {
+		}
+	}
+	lockPublicKeys sync.RWMutex
+}
+
+// PublicKeys calls PublicKeysFunc.
+func (mock *KeySetServiceMock) PublicKeys() jwk.Set {
+	if mock.PublicKeysFunc == nil {
+		panic("KeySetServiceMock.PublicKeysFunc: method is nil but KeySetService.PublicKeys was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockPublicKeys.Lock()
+	mock.calls.PublicKeys = append(mock.calls.PublicKeys, callInfo)
+	mock.lockPublicKeys.Unlock()
+	return mock.PublicKeysFunc()
+}
+
+// PublicKeysCalls gets all the calls that were made to PublicKeys.
+// Check the length with:
+//
+//	len(mockedKeySetService.PublicKeysCalls())
+func (mock *KeySetServiceMock) PublicKeysCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockPublicKeys.RLock()
+	calls = mock.calls.PublicKeys
+	mock.lockPublicKeys.RUnlock()
+	return calls
+}
+
+// Ensure, that GraphQLExecutorMock does implement GraphQLExecutor.
+// If this is not the case, regenerate this file with moq.
+var _ GraphQLExecutor = &GraphQLExecutorMock{}
+
+// GraphQLExecutorMock is a mock implementation of GraphQLExecutor.
+//
+//	func TestSomethingThatUsesGraphQLExecutor(t *testing.T) {
+//
+//		// make and configure a mocked GraphQLExecutor
+//		mockedGraphQLExecutor := &GraphQLExecutorMock{
+//			ExecuteFunc: func(ctx context.Context, query string, operationName string, variables map[string]interface{}) *graphql.Result {
+//				panic("mock out the Execute method")
+//			},
+//		}
+//
+//		// use mockedGraphQLExecutor in code that requires GraphQLExecutor
+//		// and then make assertions.
+//
+//	}
+type GraphQLExecutorMock struct {
+	// ExecuteFunc mocks the Execute method.
+	ExecuteFunc func(ctx context.Context, query string, operationName string, variables map[string]interface{}) *graphql.Result
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Execute holds details about calls to the Execute method.
+		Execute []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Query is the query argument value.
+			Query string
+			// OperationName is the operationName argument value.
+			OperationName string
+			// Variables is the variables argument value.
+			Variables map[string]interface{}
+		}
+	}
+	lockExecute sync.RWMutex
+}
+
+// Execute calls ExecuteFunc.
+func (mock *GraphQLExecutorMock) Execute(ctx context.Context, query string, operationName string, variables map[string]interface{}) *graphql.Result {
+	if mock.ExecuteFunc == nil {
+		panic("GraphQLExecutorMock.ExecuteFunc: method is nil but GraphQLExecutor.Execute was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		Query         string
+		OperationName string
+		Variables     map[string]interface{}
+	}{
+		Ctx:           ctx,
+		Query:         query,
+		OperationName: operationName,
+		Variables:     variables,
+	}
+	mock.lockExecute.Lock()
+	mock.calls.Execute = append(mock.calls.Execute, callInfo)
+	mock.lockExecute.Unlock()
+	return mock.ExecuteFunc(ctx, query, operationName, variables)
+}
+
+// ExecuteCalls gets all the calls that were made to Execute.
+// Check the length with:
+//
+//	len(mockedGraphQLExecutor.ExecuteCalls())
+func (mock *GraphQLExecutorMock) ExecuteCalls() []struct {
+	Ctx           context.Context
+	Query         string
+	OperationName string
+	Variables     map[string]interface{}
+} {
+	var calls []struct {
+		Ctx           context.Context
+		Query         string
+		OperationName string
+		Variables     map[string]interface{}
+	}
+	mock.lockExecute.RLock()
+	calls = mock.calls.Execute
+	mock.lockExecute.RUnlock()
 	return calls
 }
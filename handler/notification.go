@@ -0,0 +1,118 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/go-chi/chi/v5"
+)
+
+type notification struct {
+	ID      entity.NotificationID   `json:"id"`
+	Type    entity.NotificationType `json:"type"`
+	TaskID  *entity.TaskID          `json:"task_id,omitempty"`
+	Message string                  `json:"message"`
+	Read    bool                    `json:"read"`
+	Created time.Time               `json:"created"`
+}
+
+// ListNotification은 사용자의 알림 목록과 읽지 않은 알림 수를 반환하는 핸들러이다.
+type ListNotification struct {
+	Service ListNotificationsService
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, ListNotification 핸들러의 엔트리 포인트이다. (GET /notifications)
+// ?unread=true를 지정하면 읽지 않은 알림만 반환한다.
+func (ln *ListNotification) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	unreadOnly := false
+	if v := r.URL.Query().Get("unread"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			Respond(w, r, &ErrResponse{
+				Message: err.Error(),
+			}, http.StatusBadRequest)
+			return
+		}
+		unreadOnly = b
+	}
+	ns, unread, err := ln.Service.ListNotifications(ctx, unreadOnly)
+	if err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusInternalServerError)
+		return
+	}
+	rsp := struct {
+		Unread        int            `json:"unread"`
+		Notifications []notification `json:"notifications"`
+	}{Unread: unread, Notifications: []notification{}}
+	for _, n := range ns {
+		rsp.Notifications = append(rsp.Notifications, notification{
+			ID:      n.ID,
+			Type:    n.Type,
+			TaskID:  n.TaskID,
+			Message: n.Message,
+			Read:    n.ReadAt != nil,
+			Created: n.Created,
+		})
+	}
+	Respond(w, r, rsp, http.StatusOK)
+}
+
+// MarkNotificationRead는 알림 하나를 읽음으로 표시하는 핸들러이다.
+type MarkNotificationRead struct {
+	Service MarkNotificationService
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, MarkNotificationRead 핸들러의 엔트리 포인트이다. (POST /notifications/{id}/read)
+func (mn *MarkNotificationRead) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+	unread, err := mn.Service.MarkRead(ctx, entity.NotificationID(id))
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, store.ErrNotFound) {
+			status = http.StatusNotFound
+		}
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, status)
+		return
+	}
+	rsp := struct {
+		Unread int `json:"unread"`
+	}{Unread: unread}
+	Respond(w, r, rsp, http.StatusOK)
+}
+
+// MarkAllNotificationsRead는 사용자의 모든 알림을 읽음으로 표시하는 핸들러이다.
+type MarkAllNotificationsRead struct {
+	Service MarkNotificationService
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, MarkAllNotificationsRead 핸들러의 엔트리 포인트이다. (POST /notifications/read-all)
+func (ma *MarkAllNotificationsRead) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	n, err := ma.Service.MarkAllRead(ctx)
+	if err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusInternalServerError)
+		return
+	}
+	rsp := struct {
+		Marked int64 `json:"marked"`
+	}{Marked: n}
+	Respond(w, r, rsp, http.StatusOK)
+}
@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gitwub5/go_todo_app/clock"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/testutil"
+)
+
+func TestListNotification(t *testing.T) {
+	type want struct {
+		status  int
+		rspFile string
+	}
+	tests := map[string]struct {
+		query string
+		want  want
+	}{
+		"ok": {
+			want: want{
+				status:  http.StatusOK,
+				rspFile: "testdata/list_notification/ok_rsp.json.golden",
+			},
+		},
+		"badUnread": {
+			query: "?unread=yes",
+			want: want{
+				status:  http.StatusBadRequest,
+				rspFile: "testdata/list_notification/bad_rsp.json.golden",
+			},
+		},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodGet, "/notifications"+tt.query, nil)
+
+			now := clock.FixedClocker{}.Now()
+			tid := entity.TaskID(1)
+			moq := &ListNotificationsServiceMock{}
+			moq.ListNotificationsFunc = func(ctx context.Context, unreadOnly bool) (entity.Notifications, int, error) {
+				return entity.Notifications{
+					{ID: 2, Type: entity.NotificationTaskOverdue, TaskID: &tid, Message: `task "test1" is overdue`, Created: now},
+					{ID: 1, Type: entity.NotificationTaskAssigned, TaskID: &tid, Message: `task "test1" was assigned to you`, ReadAt: &now, Created: now},
+				}, 1, nil
+			}
+			sut := ListNotification{Service: moq}
+			sut.ServeHTTP(w, r)
+
+			resp := w.Result()
+			testutil.AssertResponse(t,
+				resp, tt.want.status, testutil.LoadFile(t, tt.want.rspFile),
+			)
+		})
+	}
+}
@@ -0,0 +1,405 @@
+package handler
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"log"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/service"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+)
+
+// 동의 화면과 인가 요청의 에러 화면
+//
+//go:embed templates/*.html
+var templateFS embed.FS
+
+// scopeDescriptions는 동의 화면에 보여줄 범위의 설명이다.
+var scopeDescriptions = map[entity.Scope]string{
+	entity.ScopeTasksRead:  "Read your tasks and time entries",
+	entity.ScopeTasksWrite: "Create, update and delete your tasks and time entries",
+}
+
+var pages = htmltemplate.Must(htmltemplate.New("").Funcs(htmltemplate.FuncMap{
+	"scopeDescription": func(s entity.Scope) string { return scopeDescriptions[s] },
+}).ParseFS(templateFS, "templates/*.html"))
+
+type oauthClient struct {
+	ID           entity.OAuthClientID `json:"id"`
+	Name         string               `json:"name"`
+	RedirectURIs entity.RedirectURIs  `json:"redirect_uris"`
+	Scopes       entity.Scopes        `json:"scopes"`
+	Confidential bool                 `json:"confidential"`
+	Created      time.Time            `json:"created"`
+}
+
+func newOAuthClient(c *entity.OAuthClient) oauthClient {
+	return oauthClient{
+		ID:           c.ID,
+		Name:         c.Name,
+		RedirectURIs: c.RedirectURIs,
+		Scopes:       c.Scopes,
+		Confidential: c.Confidential(),
+		Created:      c.Created,
+	}
+}
+
+// CreateOAuthClient는 사용자가 서드파티 애플리케이션을 OAuth 클라이언트로 등록하는 핸들러이다.
+type CreateOAuthClient struct {
+	Service   OAuthClientService
+	Validator *validator.Validate
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, CreateOAuthClient 핸들러의 엔트리 포인트이다. (POST /oauth/clients)
+func (cc *CreateOAuthClient) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var b struct {
+		Name         string         `json:"name" validate:"required,max=80"`
+		RedirectURIs []string       `json:"redirect_uris" validate:"required,min=1,dive,required"`
+		Scopes       []entity.Scope `json:"scopes" validate:"required,min=1,dive,required"`
+		Confidential bool           `json:"confidential"`
+	}
+	if err := decodeBody(r, &b); err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, decodeStatus(err))
+		return
+	}
+	if err := cc.Validator.Struct(b); err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+	c, secret, err := cc.Service.RegisterOAuthClient(ctx, b.Name, b.RedirectURIs, b.Scopes, b.Confidential)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, service.ErrUnknownScope) || errors.Is(err, service.ErrInvalidRedirectURI) {
+			status = http.StatusBadRequest
+		}
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, status)
+		return
+	}
+	// 시크릿은 등록할 때만 응답한다. 공개 클라이언트에는 시크릿이 없다.
+	rsp := struct {
+		oauthClient
+		Secret string `json:"client_secret,omitempty"`
+	}{oauthClient: newOAuthClient(c), Secret: secret}
+	Respond(w, r, rsp, http.StatusOK)
+}
+
+// ListOAuthClients는 사용자가 등록한 OAuth 클라이언트 목록을 반환하는 핸들러이다.
+type ListOAuthClients struct {
+	Service OAuthClientService
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, ListOAuthClients 핸들러의 엔트리 포인트이다. (GET /oauth/clients)
+func (lc *ListOAuthClients) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	cs, err := lc.Service.ListOAuthClients(r.Context())
+	if err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusInternalServerError)
+		return
+	}
+	rsp := []oauthClient{}
+	for _, c := range cs {
+		rsp = append(rsp, newOAuthClient(c))
+	}
+	Respond(w, r, rsp, http.StatusOK)
+}
+
+// DeleteOAuthClient는 사용자가 등록한 OAuth 클라이언트를 삭제하는 핸들러이다.
+type DeleteOAuthClient struct {
+	Service OAuthClientService
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, DeleteOAuthClient 핸들러의 엔트리 포인트이다. (DELETE /oauth/clients/{id})
+func (dc *DeleteOAuthClient) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id := entity.OAuthClientID(chi.URLParam(r, "id"))
+	if err := dc.Service.DeleteOAuthClient(r.Context(), id); err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, store.ErrNotFound) {
+			status = http.StatusNotFound
+		}
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, status)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Authorize는 인가 요청을 검증하고 동의 화면을 보여주는 핸들러이다.
+type Authorize struct {
+	Service AuthorizeService
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, Authorize 핸들러의 엔트리 포인트이다. (GET /oauth/authorize)
+func (a *Authorize) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req := authorizationRequest(r.URL.Query())
+	c, scopes, err := a.Service.Authorize(r.Context(), req)
+	if err != nil {
+		respondAuthorizeError(w, r, err)
+		return
+	}
+	renderConsent(w, http.StatusOK, consent{Client: c, Scopes: scopes, Request: req})
+}
+
+// ApproveAuthorization은 사용자가 동의 화면에서 승인하거나 거부한 결과를 클라이언트로 돌려보내는 핸들러이다.
+// 승인하려면 사용자 이름과 비밀번호를 입력해야 하므로, 다른 사이트가 사용자 모르게 폼을 제출해도 승인되지 않는다.
+type ApproveAuthorization struct {
+	Service AuthorizeService
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, ApproveAuthorization 핸들러의 엔트리 포인트이다. (POST /oauth/authorize)
+func (aa *ApproveAuthorization) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if err := r.ParseForm(); err != nil {
+		respondAuthorizeError(w, r, &service.OAuthError{Code: service.OAuthInvalidRequest, Description: err.Error()})
+		return
+	}
+	req := authorizationRequest(r.PostForm)
+	if r.PostForm.Get("action") != "approve" {
+		loc, err := aa.Service.Deny(ctx, req)
+		if err != nil {
+			respondAuthorizeError(w, r, err)
+			return
+		}
+		http.Redirect(w, r, loc, http.StatusFound)
+		return
+	}
+	loc, err := aa.Service.Approve(ctx, req, r.PostForm.Get("user_name"), r.PostForm.Get("password"))
+	if errors.Is(err, service.ErrInvalidCredentials) || errors.Is(err, service.ErrUserDisabled) {
+		// 동의 화면을 다시 보여준다. 요청은 Approve가 이미 검증했다.
+		c, scopes, aerr := aa.Service.Authorize(ctx, req)
+		if aerr != nil {
+			respondAuthorizeError(w, r, aerr)
+			return
+		}
+		renderConsent(w, http.StatusUnauthorized, consent{Client: c, Scopes: scopes, Request: req, Error: err.Error()})
+		return
+	}
+	if err != nil {
+		respondAuthorizeError(w, r, err)
+		return
+	}
+	http.Redirect(w, r, loc, http.StatusFound)
+}
+
+// consent는 동의 화면의 데이터이다.
+type consent struct {
+	Client  *entity.OAuthClient
+	Scopes  entity.Scopes
+	Request *entity.AuthorizationRequest
+	Error   string
+}
+
+func authorizationRequest(v url.Values) *entity.AuthorizationRequest {
+	return &entity.AuthorizationRequest{
+		ResponseType:        v.Get("response_type"),
+		ClientID:            entity.OAuthClientID(v.Get("client_id")),
+		RedirectURI:         v.Get("redirect_uri"),
+		Scope:               v.Get("scope"),
+		State:               v.Get("state"),
+		CodeChallenge:       v.Get("code_challenge"),
+		CodeChallengeMethod: v.Get("code_challenge_method"),
+	}
+}
+
+// respondAuthorizeError 함수는 인가 요청의 에러를 클라이언트의 리디렉션 URI로 돌려보내고,
+// 돌려보낼 수 없으면 사용자에게 에러 화면을 보여준다.
+func respondAuthorizeError(w http.ResponseWriter, r *http.Request, err error) {
+	var oe *service.OAuthError
+	if !errors.As(err, &oe) {
+		log.Printf("failed to authorize: %v", err)
+		renderHTML(w, "oauth_error.html", http.StatusInternalServerError, &service.OAuthError{
+			Code: "server_error", Description: http.StatusText(http.StatusInternalServerError),
+		})
+		return
+	}
+	if oe.RedirectURI != "" {
+		http.Redirect(w, r, oe.RedirectURI, http.StatusFound)
+		return
+	}
+	renderHTML(w, "oauth_error.html", http.StatusBadRequest, oe)
+}
+
+func renderConsent(w http.ResponseWriter, status int, c consent) {
+	renderHTML(w, "authorize.html", status, c)
+}
+
+// renderHTML 함수는 HTML 화면을 응답한다. 다른 사이트가 화면을 프레임에 넣어 사용자를 속이지 못하도록 한다.
+func renderHTML(w http.ResponseWriter, name string, status int, data any) {
+	var b bytes.Buffer
+	if err := pages.ExecuteTemplate(&b, name, data); err != nil {
+		fmt.Printf("render %s error: %v", name, err)
+		respondInternalError(w)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("X-Frame-Options", "DENY")
+	w.Header().Set("Content-Security-Policy", "default-src 'none'; style-src 'unsafe-inline'; frame-ancestors 'none'")
+	w.WriteHeader(status)
+	if _, err := w.Write(b.Bytes()); err != nil {
+		fmt.Printf("write response error: %v", err)
+	}
+}
+
+// oauthErrResponse는 OAuth 2.0 토큰 엔드포인트의 에러 응답이다. (RFC 6749 5.2)
+type oauthErrResponse struct {
+	Error       string `json:"error"`
+	Description string `json:"error_description,omitempty"`
+}
+
+// OAuthToken은 OAuth 클라이언트에 액세스 토큰을 발급하는 토큰 엔드포인트이다.
+// 클라이언트는 HTTP Basic 인증이나 폼의 client_id, client_secret으로 인증한다.
+type OAuthToken struct {
+	Service OAuthTokenService
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, OAuthToken 핸들러의 엔트리 포인트이다. (POST /oauth/token)
+func (ot *OAuthToken) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if err := r.ParseForm(); err != nil {
+		respondOAuthError(w, r, &service.OAuthError{Code: service.OAuthInvalidRequest, Description: err.Error()})
+		return
+	}
+	cid, secret := clientCredentials(r)
+	var (
+		t   *entity.OAuthToken
+		err error
+	)
+	switch gt := r.PostForm.Get("grant_type"); gt {
+	case "authorization_code":
+		t, err = ot.Service.ExchangeAuthorizationCode(ctx, cid, secret,
+			r.PostForm.Get("code"), r.PostForm.Get("redirect_uri"), r.PostForm.Get("code_verifier"))
+	case "client_credentials":
+		t, err = ot.Service.ClientCredentialsGrant(ctx, cid, secret, r.PostForm.Get("scope"))
+	default:
+		err = &service.OAuthError{Code: service.OAuthUnsupportedGrantType, Description: fmt.Sprintf("grant_type %q is not supported", gt)}
+	}
+	if err != nil {
+		respondOAuthError(w, r, err)
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+	Respond(w, r, t, http.StatusOK)
+}
+
+// RevokeOAuthToken은 OAuth 클라이언트가 발급받은 액세스 토큰을 폐기하는 핸들러이다. (RFC 7009)
+type RevokeOAuthToken struct {
+	Service OAuthTokenService
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, RevokeOAuthToken 핸들러의 엔트리 포인트이다. (POST /oauth/revoke)
+func (rt *RevokeOAuthToken) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil || r.PostForm.Get("token") == "" {
+		respondOAuthError(w, r, &service.OAuthError{Code: service.OAuthInvalidRequest, Description: "token is required"})
+		return
+	}
+	cid, secret := clientCredentials(r)
+	if err := rt.Service.RevokeToken(r.Context(), cid, secret, r.PostForm.Get("token")); err != nil {
+		respondOAuthError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// IntrospectOAuthToken은 OAuth 클라이언트가 발급받은 액세스 토큰의 상태를 반환하는 핸들러이다. (RFC 7662)
+type IntrospectOAuthToken struct {
+	Service OAuthTokenService
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, IntrospectOAuthToken 핸들러의 엔트리 포인트이다. (POST /oauth/introspect)
+func (it *IntrospectOAuthToken) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil || r.PostForm.Get("token") == "" {
+		respondOAuthError(w, r, &service.OAuthError{Code: service.OAuthInvalidRequest, Description: "token is required"})
+		return
+	}
+	cid, secret := clientCredentials(r)
+	ti, err := it.Service.IntrospectToken(r.Context(), cid, secret, r.PostForm.Get("token"))
+	if err != nil {
+		respondOAuthError(w, r, err)
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	Respond(w, r, ti, http.StatusOK)
+}
+
+// clientCredentials 함수는 요청의 HTTP Basic 인증이나 폼에서 클라이언트 ID와 시크릿을 가져온다. (RFC 6749 2.3.1)
+func clientCredentials(r *http.Request) (entity.OAuthClientID, string) {
+	if id, secret, ok := r.BasicAuth(); ok {
+		// Basic 인증의 ID와 시크릿은 폼 인코딩한 값이다.
+		if v, err := url.QueryUnescape(id); err == nil {
+			id = v
+		}
+		if v, err := url.QueryUnescape(secret); err == nil {
+			secret = v
+		}
+		return entity.OAuthClientID(id), secret
+	}
+	return entity.OAuthClientID(r.PostForm.Get("client_id")), r.PostForm.Get("client_secret")
+}
+
+// respondOAuthError 함수는 OAuth 2.0 에러를 응답한다. 클라이언트 인증에 실패하면 401을 응답한다.
+func respondOAuthError(w http.ResponseWriter, r *http.Request, err error) {
+	var oe *service.OAuthError
+	if !errors.As(err, &oe) {
+		log.Printf("oauth token endpoint: %v", err)
+		Respond(w, r, &oauthErrResponse{Error: "server_error"}, http.StatusInternalServerError)
+		return
+	}
+	status := http.StatusBadRequest
+	if oe.Code == service.OAuthInvalidClient {
+		w.Header().Set("WWW-Authenticate", `Basic realm="oauth"`)
+		status = http.StatusUnauthorized
+	}
+	Respond(w, r, &oauthErrResponse{Error: oe.Code, Description: oe.Description}, status)
+}
+
+// OAuthMetadata는 인가 서버의 엔드포인트와 지원하는 기능을 알리는 핸들러이다. (RFC 8414)
+type OAuthMetadata struct {
+	// Issuer는 인가 서버의 URL이며, 엔드포인트의 URL도 Issuer를 기준으로 만든다.
+	Issuer string
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, OAuthMetadata 핸들러의 엔트리 포인트이다. (GET /.well-known/oauth-authorization-server)
+func (om *OAuthMetadata) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	Respond(w, r, struct {
+		Issuer                string        `json:"issuer"`
+		AuthorizationEndpoint string        `json:"authorization_endpoint"`
+		TokenEndpoint         string        `json:"token_endpoint"`
+		RevocationEndpoint    string        `json:"revocation_endpoint"`
+		IntrospectionEndpoint string        `json:"introspection_endpoint"`
+		ResponseTypes         []string      `json:"response_types_supported"`
+		GrantTypes            []string      `json:"grant_types_supported"`
+		CodeChallengeMethods  []string      `json:"code_challenge_methods_supported"`
+		Scopes                entity.Scopes `json:"scopes_supported"`
+		TokenEndpointAuth     []string      `json:"token_endpoint_auth_methods_supported"`
+	}{
+		Issuer:                om.Issuer,
+		AuthorizationEndpoint: om.Issuer + "/oauth/authorize",
+		TokenEndpoint:         om.Issuer + "/oauth/token",
+		RevocationEndpoint:    om.Issuer + "/oauth/revoke",
+		IntrospectionEndpoint: om.Issuer + "/oauth/introspect",
+		ResponseTypes:         []string{"code"},
+		GrantTypes:            []string{"authorization_code", "client_credentials"},
+		CodeChallengeMethods:  []string{"S256"},
+		Scopes:                entity.AllScopes,
+		TokenEndpointAuth:     []string{"client_secret_basic", "client_secret_post", "none"},
+	}, http.StatusOK)
+}
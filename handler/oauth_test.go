@@ -0,0 +1,403 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/clock"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/service"
+	"github.com/gitwub5/go_todo_app/testutil"
+	"github.com/go-playground/validator/v10"
+)
+
+func TestCreateOAuthClient(t *testing.T) {
+	type want struct {
+		status  int
+		rspFile string
+	}
+	tests := map[string]struct {
+		reqFile string
+		want    want
+	}{
+		"ok": {
+			reqFile: "testdata/oauth/create_client_ok_req.json.golden",
+			want: want{
+				status:  http.StatusOK,
+				rspFile: "testdata/oauth/create_client_ok_rsp.json.golden",
+			},
+		},
+		"badRedirectURI": {
+			reqFile: "testdata/oauth/create_client_bad_redirect_req.json.golden",
+			want: want{
+				status:  http.StatusBadRequest,
+				rspFile: "testdata/oauth/create_client_bad_redirect_rsp.json.golden",
+			},
+		},
+		"badRequest": {
+			reqFile: "testdata/oauth/create_client_bad_req.json.golden",
+			want: want{
+				status:  http.StatusBadRequest,
+				rspFile: "testdata/oauth/create_client_bad_rsp.json.golden",
+			},
+		},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(
+				http.MethodPost,
+				"/oauth/clients",
+				bytes.NewReader(testutil.LoadFile(t, tt.reqFile)),
+			)
+
+			moq := &OAuthClientServiceMock{}
+			moq.RegisterOAuthClientFunc = func(
+				ctx context.Context, name string, redirectURIs []string, scopes entity.Scopes, confidential bool,
+			) (*entity.OAuthClient, string, error) {
+				for _, u := range redirectURIs {
+					if !strings.HasPrefix(u, "https://") {
+						return nil, "", fmt.Errorf("%q: %w", u, service.ErrInvalidRedirectURI)
+					}
+				}
+				hash := "hash"
+				return &entity.OAuthClient{
+					ID:           "client1",
+					UserID:       10,
+					Name:         name,
+					SecretHash:   &hash,
+					RedirectURIs: redirectURIs,
+					Scopes:       scopes,
+					Created:      clock.FixedClocker{}.Now(),
+				}, "todo_cs_secret", nil
+			}
+			sut := CreateOAuthClient{Service: moq, Validator: validator.New()}
+			sut.ServeHTTP(w, r)
+
+			testutil.AssertResponse(t,
+				w.Result(), tt.want.status, testutil.LoadFile(t, tt.want.rspFile),
+			)
+		})
+	}
+}
+
+func TestListOAuthClients(t *testing.T) {
+	t.Parallel()
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/oauth/clients", nil)
+
+	now := clock.FixedClocker{}.Now()
+	hash := "hash"
+	moq := &OAuthClientServiceMock{}
+	moq.ListOAuthClientsFunc = func(ctx context.Context) (entity.OAuthClients, error) {
+		return entity.OAuthClients{
+			{
+				ID: "client1", UserID: 10, Name: "report", SecretHash: &hash,
+				RedirectURIs: entity.RedirectURIs{"https://app.example.com/callback"},
+				Scopes:       entity.Scopes{entity.ScopeTasksRead},
+				Created:      now,
+			},
+			{
+				ID: "client2", UserID: 10, Name: "mobile",
+				RedirectURIs: entity.RedirectURIs{"http://127.0.0.1:53682/"},
+				Scopes:       entity.Scopes{entity.ScopeTasksRead, entity.ScopeTasksWrite},
+				Created:      now,
+			},
+		}, nil
+	}
+	sut := ListOAuthClients{Service: moq}
+	sut.ServeHTTP(w, r)
+
+	testutil.AssertResponse(t,
+		w.Result(), http.StatusOK, testutil.LoadFile(t, "testdata/oauth/list_clients_rsp.json.golden"),
+	)
+}
+
+// newAuthorizeServiceMock 함수는 클라이언트 "client1"의 인가 요청만 받고, 사용자 alice의 비밀번호가 "test12345"인 AuthorizeService를 만든다.
+func newAuthorizeServiceMock() *AuthorizeServiceMock {
+	authorize := func(ctx context.Context, req *entity.AuthorizationRequest) (*entity.OAuthClient, entity.Scopes, error) {
+		if req.ClientID != "client1" {
+			return nil, nil, &service.OAuthError{Code: service.OAuthInvalidRequest, Description: "unknown client_id"}
+		}
+		if req.ResponseType != "code" {
+			return nil, nil, &service.OAuthError{
+				Code: service.OAuthUnsupportedResponseType, Description: "response_type must be code",
+				RedirectURI: req.RedirectURI + "?error=unsupported_response_type&state=" + req.State,
+			}
+		}
+		return &entity.OAuthClient{ID: "client1", Name: "<b>report</b>"}, entity.Scopes{entity.ScopeTasksRead}, nil
+	}
+	return &AuthorizeServiceMock{
+		AuthorizeFunc: authorize,
+		ApproveFunc: func(ctx context.Context, req *entity.AuthorizationRequest, name, pw string) (string, error) {
+			if _, _, err := authorize(ctx, req); err != nil {
+				return "", err
+			}
+			if name != "alice" || pw != "test12345" {
+				return "", service.ErrInvalidCredentials
+			}
+			return req.RedirectURI + "?code=code&state=" + req.State, nil
+		},
+		DenyFunc: func(ctx context.Context, req *entity.AuthorizationRequest) (string, error) {
+			return req.RedirectURI + "?error=access_denied&state=" + req.State, nil
+		},
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		query        string
+		wantStatus   int
+		wantLocation string
+		wantBody     []string
+	}{
+		// 클라이언트 이름은 이스케이프하고, 요청을 폼에 그대로 담는다.
+		"consent": {
+			query:      "response_type=code&client_id=client1&redirect_uri=https://app.example.com/cb&state=xyz",
+			wantStatus: http.StatusOK,
+			wantBody: []string{
+				"&lt;b&gt;report&lt;/b&gt;",
+				`name="state" value="xyz"`,
+				"Read your tasks and time entries",
+			},
+		},
+		"unknownClient": {
+			query:      "response_type=code&client_id=unknown&redirect_uri=https://app.example.com/cb",
+			wantStatus: http.StatusBadRequest,
+			wantBody:   []string{"unknown client_id"},
+		},
+		"redirectError": {
+			query:        "response_type=token&client_id=client1&redirect_uri=https://app.example.com/cb&state=xyz",
+			wantStatus:   http.StatusFound,
+			wantLocation: "https://app.example.com/cb?error=unsupported_response_type&state=xyz",
+		},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodGet, "/oauth/authorize?"+tt.query, nil)
+			sut := Authorize{Service: newAuthorizeServiceMock()}
+			sut.ServeHTTP(w, r)
+
+			assertAuthorizeResponse(t, w.Result(), tt.wantStatus, tt.wantLocation, tt.wantBody)
+		})
+	}
+}
+
+func TestApproveAuthorization(t *testing.T) {
+	t.Parallel()
+
+	req := url.Values{
+		"response_type": {"code"},
+		"client_id":     {"client1"},
+		"redirect_uri":  {"https://app.example.com/cb"},
+		"state":         {"xyz"},
+	}
+	tests := map[string]struct {
+		form         url.Values
+		wantStatus   int
+		wantLocation string
+		wantBody     []string
+	}{
+		"approve": {
+			form:         url.Values{"action": {"approve"}, "user_name": {"alice"}, "password": {"test12345"}},
+			wantStatus:   http.StatusFound,
+			wantLocation: "https://app.example.com/cb?code=code&state=xyz",
+		},
+		// 비밀번호가 틀리면 동의 화면을 다시 보여준다.
+		"wrongPassword": {
+			form:       url.Values{"action": {"approve"}, "user_name": {"alice"}, "password": {"wrong"}},
+			wantStatus: http.StatusUnauthorized,
+			wantBody:   []string{"wrong user name or password", `name="state" value="xyz"`},
+		},
+		"deny": {
+			form:         url.Values{"action": {"deny"}},
+			wantStatus:   http.StatusFound,
+			wantLocation: "https://app.example.com/cb?error=access_denied&state=xyz",
+		},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			form := url.Values{}
+			for k, v := range req {
+				form[k] = v
+			}
+			for k, v := range tt.form {
+				form[k] = v
+			}
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodPost, "/oauth/authorize", strings.NewReader(form.Encode()))
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			sut := ApproveAuthorization{Service: newAuthorizeServiceMock()}
+			sut.ServeHTTP(w, r)
+
+			assertAuthorizeResponse(t, w.Result(), tt.wantStatus, tt.wantLocation, tt.wantBody)
+		})
+	}
+}
+
+func assertAuthorizeResponse(t *testing.T, got *http.Response, status int, location string, body []string) {
+	t.Helper()
+	t.Cleanup(func() { _ = got.Body.Close() })
+
+	if got.StatusCode != status {
+		t.Fatalf("want status %d, but got %d", status, got.StatusCode)
+	}
+	if location != "" {
+		if l := got.Header.Get("Location"); l != location {
+			t.Errorf("want location %q, but got %q", location, l)
+		}
+		return
+	}
+	// 동의 화면을 다른 사이트의 프레임에 넣을 수 없다.
+	if got.Header.Get("X-Frame-Options") != "DENY" {
+		t.Errorf("want X-Frame-Options DENY, but got %q", got.Header.Get("X-Frame-Options"))
+	}
+	b, err := io.ReadAll(got.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range body {
+		if !strings.Contains(string(b), s) {
+			t.Errorf("want body to contain %q, but got %s", s, b)
+		}
+	}
+}
+
+func TestOAuthToken(t *testing.T) {
+	type want struct {
+		status  int
+		rspFile string
+	}
+	tests := map[string]struct {
+		form      url.Values
+		basicAuth []string
+		want      want
+	}{
+		"authorizationCode": {
+			form: url.Values{
+				"grant_type": {"authorization_code"}, "client_id": {"client1"},
+				"code": {"code"}, "redirect_uri": {"https://app.example.com/cb"}, "code_verifier": {"verifier"},
+			},
+			want: want{status: http.StatusOK, rspFile: "testdata/oauth/token_ok_rsp.json.golden"},
+		},
+		"clientCredentials": {
+			form:      url.Values{"grant_type": {"client_credentials"}},
+			basicAuth: []string{"client1", "todo_cs_secret"},
+			want:      want{status: http.StatusOK, rspFile: "testdata/oauth/token_ok_rsp.json.golden"},
+		},
+		"invalidClient": {
+			form:      url.Values{"grant_type": {"client_credentials"}},
+			basicAuth: []string{"client1", "wrong"},
+			want:      want{status: http.StatusUnauthorized, rspFile: "testdata/oauth/token_invalid_client_rsp.json.golden"},
+		},
+		"unsupportedGrantType": {
+			form: url.Values{"grant_type": {"password"}, "client_id": {"client1"}},
+			want: want{status: http.StatusBadRequest, rspFile: "testdata/oauth/token_unsupported_grant_type_rsp.json.golden"},
+		},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodPost, "/oauth/token", strings.NewReader(tt.form.Encode()))
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			if tt.basicAuth != nil {
+				r.SetBasicAuth(tt.basicAuth[0], tt.basicAuth[1])
+			}
+
+			token := &entity.OAuthToken{AccessToken: "token", TokenType: "Bearer", ExpiresIn: 1800, Scope: "tasks:read"}
+			moq := &OAuthTokenServiceMock{
+				ExchangeAuthorizationCodeFunc: func(
+					ctx context.Context, cid entity.OAuthClientID, secret, code, redirectURI, verifier string,
+				) (*entity.OAuthToken, error) {
+					if cid != "client1" || code != "code" || redirectURI != "https://app.example.com/cb" || verifier != "verifier" {
+						t.Errorf("unexpected request: %q, %q, %q, %q", cid, code, redirectURI, verifier)
+					}
+					return token, nil
+				},
+				ClientCredentialsGrantFunc: func(
+					ctx context.Context, cid entity.OAuthClientID, secret, scope string,
+				) (*entity.OAuthToken, error) {
+					if cid != "client1" || secret != "todo_cs_secret" {
+						return nil, &service.OAuthError{Code: service.OAuthInvalidClient, Description: "client authentication failed"}
+					}
+					return token, nil
+				},
+			}
+			sut := OAuthToken{Service: moq}
+			sut.ServeHTTP(w, r)
+
+			rsp := w.Result()
+			if tt.want.status == http.StatusOK && rsp.Header.Get("Cache-Control") != "no-store" {
+				t.Errorf("want token response not to be stored, but got Cache-Control %q", rsp.Header.Get("Cache-Control"))
+			}
+			if tt.want.status == http.StatusUnauthorized && rsp.Header.Get("WWW-Authenticate") == "" {
+				t.Error("want WWW-Authenticate header")
+			}
+			testutil.AssertResponse(t, rsp, tt.want.status, testutil.LoadFile(t, tt.want.rspFile))
+		})
+	}
+}
+
+func TestIntrospectOAuthToken(t *testing.T) {
+	tests := map[string]struct {
+		token   string
+		rspFile string
+	}{
+		"active":   {token: "valid", rspFile: "testdata/oauth/introspect_rsp.json.golden"},
+		"inactive": {token: "revoked", rspFile: "testdata/oauth/introspect_inactive_rsp.json.golden"},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			form := url.Values{"token": {tt.token}, "client_id": {"client1"}}
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodPost, "/oauth/introspect", strings.NewReader(form.Encode()))
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+			now := clock.FixedClocker{}.Now()
+			moq := &OAuthTokenServiceMock{
+				IntrospectTokenFunc: func(
+					ctx context.Context, cid entity.OAuthClientID, secret, token string,
+				) (*entity.TokenIntrospection, error) {
+					if token != "valid" {
+						return &entity.TokenIntrospection{Active: false}, nil
+					}
+					return &entity.TokenIntrospection{
+						Active: true, Scope: "tasks:read", ClientID: cid, Username: "alice", Subject: "10",
+						TokenType: "Bearer", Expires: now.Unix(), IssuedAt: now.Add(-30 * time.Minute).Unix(),
+					}, nil
+				},
+			}
+			sut := IntrospectOAuthToken{Service: moq}
+			sut.ServeHTTP(w, r)
+
+			testutil.AssertResponse(t, w.Result(), http.StatusOK, testutil.LoadFile(t, tt.rspFile))
+		})
+	}
+}
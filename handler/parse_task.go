@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/quickadd"
+	"github.com/go-playground/validator/v10"
+)
+
+// ParseTask는 자연어 문자열을 해석한 결과를 미리보기로 반환하는 핸들러이다. Task는 등록하지 않는다.
+type ParseTask struct {
+	Parser    QuickAddParser
+	Validator *validator.Validate
+}
+
+// parsedTask는 quickadd 해석 결과의 응답 형식이다.
+type parsedTask struct {
+	Title      string             `json:"title"`
+	Labels     []string           `json:"labels"`
+	Priority   quickadd.Priority  `json:"priority,omitempty"`
+	Due        *time.Time         `json:"due,omitempty"`
+	AllDay     bool               `json:"all_day"`
+	Recurrence *entity.Recurrence `json:"recurrence,omitempty"`
+}
+
+func newParsedTask(res *quickadd.Result) *parsedTask {
+	return &parsedTask{
+		Title:      res.Title,
+		Labels:     res.Labels,
+		Priority:   res.Priority,
+		Due:        res.Due,
+		AllDay:     res.AllDay,
+		Recurrence: newRecurrence(res.Recurrence),
+	}
+}
+
+// newTaskAttributes 함수는 quickadd 해석 결과에서 Task에 저장할 라벨, 우선순위, 반복 규칙을 꺼낸다.
+func newTaskAttributes(res *quickadd.Result) entity.TaskAttributes {
+	return entity.TaskAttributes{
+		Labels:     entity.Labels(res.Labels),
+		Priority:   entity.TaskPriority(res.Priority),
+		Recurrence: newRecurrence(res.Recurrence),
+	}
+}
+
+func newRecurrence(r *quickadd.Recurrence) *entity.Recurrence {
+	if r == nil {
+		return nil
+	}
+	rec := &entity.Recurrence{
+		Frequency: string(r.Frequency),
+		Interval:  r.Interval,
+		MonthDay:  r.MonthDay,
+	}
+	if r.Weekday != nil {
+		rec.Weekday = strings.ToLower(r.Weekday.String())
+	}
+	return rec
+}
+
+// parseQuick 함수는 timezone으로 지정된 타임존 기준으로 text를 해석한다.
+func parseQuick(p QuickAddParser, text, timezone string) (*quickadd.Result, int, error) {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	res, err := p.Parse(text, loc)
+	if err != nil {
+		if errors.Is(err, quickadd.ErrEmptyTitle) {
+			return nil, http.StatusBadRequest, err
+		}
+		return nil, http.StatusInternalServerError, err
+	}
+	return res, http.StatusOK, nil
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, ParseTask 핸들러의 엔트리 포인트이다. (POST /tasks/parse)
+func (pt *ParseTask) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var b struct {
+		Text     string `json:"text" validate:"required"`
+		Timezone string `json:"timezone"` // IANA 타임존 이름 (예: Asia/Seoul). 비어 있으면 UTC
+	}
+	if err := decodeBody(r, &b); err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, decodeStatus(err))
+		return
+	}
+	if err := pt.Validator.Struct(b); err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+	res, status, err := parseQuick(pt.Parser, b.Text, b.Timezone)
+	if err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, status)
+		return
+	}
+	Respond(w, r, newParsedTask(res), http.StatusOK)
+}
@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gitwub5/go_todo_app/clock"
+	"github.com/gitwub5/go_todo_app/quickadd"
+	"github.com/gitwub5/go_todo_app/testutil"
+	"github.com/go-playground/validator/v10"
+)
+
+func TestParseTask(t *testing.T) {
+	type want struct {
+		status  int
+		rspFile string
+	}
+	tests := map[string]struct {
+		reqFile string
+		want    want
+	}{
+		"ok": {
+			reqFile: "testdata/parse_task/ok_req.json.golden",
+			want: want{
+				status:  http.StatusOK,
+				rspFile: "testdata/parse_task/ok_rsp.json.golden",
+			},
+		},
+		"badTimezone": {
+			reqFile: "testdata/parse_task/bad_timezone_req.json.golden",
+			want: want{
+				status:  http.StatusBadRequest,
+				rspFile: "testdata/parse_task/bad_timezone_rsp.json.golden",
+			},
+		},
+		"emptyTitle": {
+			reqFile: "testdata/parse_task/empty_title_req.json.golden",
+			want: want{
+				status:  http.StatusBadRequest,
+				rspFile: "testdata/parse_task/empty_title_rsp.json.golden",
+			},
+		},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(
+				http.MethodPost,
+				"/tasks/parse",
+				bytes.NewReader(testutil.LoadFile(t, tt.reqFile)),
+			)
+			sut := ParseTask{
+				Parser:    &quickadd.Parser{Clocker: clock.FixedClocker{}},
+				Validator: validator.New(),
+			}
+			sut.ServeHTTP(w, r)
+
+			resp := w.Result()
+			testutil.AssertResponse(t,
+				resp, tt.want.status, testutil.LoadFile(t, tt.want.rspFile),
+			)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gitwub5/go_todo_app/service"
+	"github.com/go-playground/validator/v10"
+)
+
+// ForgotPassword는 패스워드 재설정 토큰을 메일로 요청하는 핸들러이다.
+type ForgotPassword struct {
+	Service   PasswordResetService
+	Validator *validator.Validate
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, ForgotPassword 핸들러의 엔트리 포인트이다. (POST /password/forgot)
+// 사용자 존재 여부가 드러나지 않도록 항상 202 Accepted를 응답한다.
+func (fp *ForgotPassword) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var b struct {
+		Name string `json:"name" validate:"required"`
+	}
+	if err := decodeBody(r, &b); err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, decodeStatus(err))
+		return
+	}
+	if err := fp.Validator.Struct(b); err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+	if err := fp.Service.RequestReset(ctx, b.Name); err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// ResetPassword는 메일로 받은 토큰으로 패스워드를 변경하는 핸들러이다.
+type ResetPassword struct {
+	Service   PasswordResetService
+	Validator *validator.Validate
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, ResetPassword 핸들러의 엔트리 포인트이다. (POST /password/reset)
+func (rp *ResetPassword) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var b struct {
+		Token    string `json:"token" validate:"required"`
+		Password string `json:"password" validate:"required"`
+	}
+	if err := decodeBody(r, &b); err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, decodeStatus(err))
+		return
+	}
+	if err := rp.Validator.Struct(b); err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+	if err := rp.Service.ResetPassword(ctx, b.Token, b.Password); err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, service.ErrInvalidResetToken) {
+			status = http.StatusBadRequest
+		}
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, status)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
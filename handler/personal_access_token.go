@@ -0,0 +1,127 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/service"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+)
+
+type personalAccessToken struct {
+	ID       entity.PersonalAccessTokenID `json:"id"`
+	Name     string                       `json:"name"`
+	Scopes   entity.Scopes                `json:"scopes"`
+	Expires  *time.Time                   `json:"expires"`
+	LastUsed *time.Time                   `json:"last_used"`
+	Created  time.Time                    `json:"created"`
+}
+
+func newPersonalAccessToken(t *entity.PersonalAccessToken) personalAccessToken {
+	return personalAccessToken{
+		ID:       t.ID,
+		Name:     t.Name,
+		Scopes:   t.Scopes,
+		Expires:  t.Expires,
+		LastUsed: t.LastUsed,
+		Created:  t.Created,
+	}
+}
+
+// CreatePersonalAccessToken은 개인 액세스 토큰을 발급하는 핸들러이다.
+type CreatePersonalAccessToken struct {
+	Service   PersonalAccessTokenService
+	Validator *validator.Validate
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, CreatePersonalAccessToken 핸들러의 엔트리 포인트이다. (POST /tokens)
+func (ct *CreatePersonalAccessToken) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var b struct {
+		Name    string         `json:"name" validate:"required,max=80"`
+		Scopes  []entity.Scope `json:"scopes" validate:"required,min=1,dive,required"`
+		Expires *time.Time     `json:"expires"`
+	}
+	if err := decodeBody(r, &b); err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, decodeStatus(err))
+		return
+	}
+	if err := ct.Validator.Struct(b); err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+	t, token, err := ct.Service.CreatePersonalAccessToken(ctx, b.Name, b.Scopes, b.Expires)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, service.ErrUnknownScope) || errors.Is(err, service.ErrInvalidExpiry) {
+			status = http.StatusBadRequest
+		}
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, status)
+		return
+	}
+	// 토큰은 발급할 때만 응답한다.
+	rsp := struct {
+		personalAccessToken
+		Token string `json:"token"`
+	}{personalAccessToken: newPersonalAccessToken(t), Token: token}
+	Respond(w, r, rsp, http.StatusOK)
+}
+
+// ListPersonalAccessTokens는 사용자의 개인 액세스 토큰 목록을 반환하는 핸들러이다.
+type ListPersonalAccessTokens struct {
+	Service PersonalAccessTokenService
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, ListPersonalAccessTokens 핸들러의 엔트리 포인트이다. (GET /tokens)
+func (lt *ListPersonalAccessTokens) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ts, err := lt.Service.ListPersonalAccessTokens(r.Context())
+	if err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusInternalServerError)
+		return
+	}
+	rsp := []personalAccessToken{}
+	for _, t := range ts {
+		rsp = append(rsp, newPersonalAccessToken(t))
+	}
+	Respond(w, r, rsp, http.StatusOK)
+}
+
+// DeletePersonalAccessToken은 개인 액세스 토큰을 폐기하는 핸들러이다.
+type DeletePersonalAccessToken struct {
+	Service PersonalAccessTokenService
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, DeletePersonalAccessToken 핸들러의 엔트리 포인트이다. (DELETE /tokens/{id})
+func (dt *DeletePersonalAccessToken) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+	if err := dt.Service.DeletePersonalAccessToken(r.Context(), entity.PersonalAccessTokenID(id)); err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, store.ErrNotFound) {
+			status = http.StatusNotFound
+		}
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, status)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
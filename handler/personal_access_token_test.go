@@ -0,0 +1,172 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/clock"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/service"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/gitwub5/go_todo_app/testutil"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+)
+
+func TestCreatePersonalAccessToken(t *testing.T) {
+	type want struct {
+		status  int
+		rspFile string
+	}
+	tests := map[string]struct {
+		reqFile string
+		want    want
+	}{
+		"ok": {
+			reqFile: "testdata/personal_access_token/create_ok_req.json.golden",
+			want: want{
+				status:  http.StatusOK,
+				rspFile: "testdata/personal_access_token/create_ok_rsp.json.golden",
+			},
+		},
+		"unknownScope": {
+			reqFile: "testdata/personal_access_token/create_unknown_scope_req.json.golden",
+			want: want{
+				status:  http.StatusBadRequest,
+				rspFile: "testdata/personal_access_token/create_unknown_scope_rsp.json.golden",
+			},
+		},
+		"badRequest": {
+			reqFile: "testdata/personal_access_token/create_bad_req.json.golden",
+			want: want{
+				status:  http.StatusBadRequest,
+				rspFile: "testdata/personal_access_token/create_bad_rsp.json.golden",
+			},
+		},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(
+				http.MethodPost,
+				"/tokens",
+				bytes.NewReader(testutil.LoadFile(t, tt.reqFile)),
+			)
+
+			moq := &PersonalAccessTokenServiceMock{}
+			moq.CreatePersonalAccessTokenFunc = func(
+				ctx context.Context, name string, scopes entity.Scopes, expires *time.Time,
+			) (*entity.PersonalAccessToken, string, error) {
+				for _, s := range scopes {
+					if !entity.AllScopes.Has(s) {
+						return nil, "", fmt.Errorf("%q: %w", s, service.ErrUnknownScope)
+					}
+				}
+				return &entity.PersonalAccessToken{
+					ID:      1,
+					UserID:  10,
+					Name:    name,
+					Scopes:  scopes,
+					Expires: expires,
+					Created: clock.FixedClocker{}.Now(),
+				}, "todo_pat_secret", nil
+			}
+			sut := CreatePersonalAccessToken{Service: moq, Validator: validator.New()}
+			sut.ServeHTTP(w, r)
+
+			testutil.AssertResponse(t,
+				w.Result(), tt.want.status, testutil.LoadFile(t, tt.want.rspFile),
+			)
+		})
+	}
+}
+
+func TestListPersonalAccessTokens(t *testing.T) {
+	t.Parallel()
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/tokens", nil)
+
+	now := clock.FixedClocker{}.Now()
+	expires := now.AddDate(0, 1, 0)
+	moq := &PersonalAccessTokenServiceMock{}
+	moq.ListPersonalAccessTokensFunc = func(ctx context.Context) (entity.PersonalAccessTokens, error) {
+		return entity.PersonalAccessTokens{
+			{
+				ID: 1, UserID: 10, Name: "ci", TokenHash: "hash1",
+				Scopes:   entity.Scopes{entity.ScopeTasksRead, entity.ScopeTasksWrite},
+				LastUsed: &now, Created: now,
+			},
+			{
+				ID: 2, UserID: 10, Name: "report", TokenHash: "hash2",
+				Scopes:  entity.Scopes{entity.ScopeTasksRead},
+				Expires: &expires, Created: now,
+			},
+		}, nil
+	}
+	sut := ListPersonalAccessTokens{Service: moq}
+	sut.ServeHTTP(w, r)
+
+	testutil.AssertResponse(t,
+		w.Result(), http.StatusOK, testutil.LoadFile(t, "testdata/personal_access_token/list_rsp.json.golden"),
+	)
+}
+
+func TestDeletePersonalAccessToken(t *testing.T) {
+	type want struct {
+		status  int
+		rspFile string
+	}
+	tests := map[string]struct {
+		id   string
+		want want
+	}{
+		"ok": {
+			id:   "1",
+			want: want{status: http.StatusNoContent},
+		},
+		"notFound": {
+			id: "3",
+			want: want{
+				status:  http.StatusNotFound,
+				rspFile: "testdata/personal_access_token/not_found_rsp.json.golden",
+			},
+		},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodDelete, "/tokens/"+tt.id, nil)
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("id", tt.id)
+			r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+
+			moq := &PersonalAccessTokenServiceMock{}
+			moq.DeletePersonalAccessTokenFunc = func(ctx context.Context, id entity.PersonalAccessTokenID) error {
+				if id != 1 {
+					return fmt.Errorf("personal access token %d: %w", id, store.ErrNotFound)
+				}
+				return nil
+			}
+			sut := DeletePersonalAccessToken{Service: moq}
+			sut.ServeHTTP(w, r)
+
+			var body []byte
+			if tt.want.rspFile != "" {
+				body = testutil.LoadFile(t, tt.want.rspFile)
+			}
+			testutil.AssertResponse(t, w.Result(), tt.want.status, body)
+		})
+	}
+}
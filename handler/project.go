@@ -0,0 +1,265 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/service"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+)
+
+type project struct {
+	ID      entity.ProjectID `json:"id"`
+	OwnerID entity.UserID    `json:"owner_id"`
+	Name    string           `json:"name"`
+	Created time.Time        `json:"created"`
+}
+
+type projectMember struct {
+	UserID entity.UserID `json:"user_id"`
+}
+
+func newProject(p *entity.Project) project {
+	return project{
+		ID:      p.ID,
+		OwnerID: p.OwnerID,
+		Name:    p.Name,
+		Created: p.Created,
+	}
+}
+
+// AddProject는 프로젝트를 만드는 핸들러이다.
+type AddProject struct {
+	Service   ProjectService
+	Validator *validator.Validate
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, AddProject 핸들러의 엔트리 포인트이다. (POST /projects)
+func (ap *AddProject) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var b struct {
+		Name string `json:"name" validate:"required,max=128"`
+	}
+	if err := decodeBody(r, &b); err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, decodeStatus(err))
+		return
+	}
+	if err := ap.Validator.Struct(b); err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+	p, err := ap.Service.AddProject(ctx, b.Name)
+	if err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusInternalServerError)
+		return
+	}
+	Respond(w, r, newProject(p), http.StatusOK)
+}
+
+// ListProjects는 사용자가 멤버인 프로젝트 목록을 반환하는 핸들러이다.
+type ListProjects struct {
+	Service ProjectService
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, ListProjects 핸들러의 엔트리 포인트이다. (GET /projects)
+func (lp *ListProjects) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ps, err := lp.Service.ListProjects(r.Context())
+	if err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusInternalServerError)
+		return
+	}
+	rsp := []project{}
+	for _, p := range ps {
+		rsp = append(rsp, newProject(p))
+	}
+	Respond(w, r, rsp, http.StatusOK)
+}
+
+// ListProjectMembers는 프로젝트 멤버의 ID 목록을 반환하는 핸들러이다.
+type ListProjectMembers struct {
+	Service ProjectService
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, ListProjectMembers 핸들러의 엔트리 포인트이다. (GET /projects/{id}/members)
+func (lm *ListProjectMembers) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id, err := projectIDParam(r)
+	if err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+	uids, err := lm.Service.ListProjectMembers(r.Context(), id)
+	if err != nil {
+		respondProjectError(w, r, err)
+		return
+	}
+	rsp := []projectMember{}
+	for _, uid := range uids {
+		rsp = append(rsp, projectMember{UserID: uid})
+	}
+	Respond(w, r, rsp, http.StatusOK)
+}
+
+// AddProjectMember는 프로젝트에 멤버를 추가하는 핸들러이다.
+type AddProjectMember struct {
+	Service   ProjectService
+	Validator *validator.Validate
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, AddProjectMember 핸들러의 엔트리 포인트이다. (POST /projects/{id}/members)
+func (am *AddProjectMember) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id, err := projectIDParam(r)
+	if err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+	var b struct {
+		UserID entity.UserID `json:"user_id" validate:"required"`
+	}
+	if err := decodeBody(r, &b); err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, decodeStatus(err))
+		return
+	}
+	if err := am.Validator.Struct(b); err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+	if err := am.Service.AddProjectMember(r.Context(), id, b.UserID); err != nil {
+		respondProjectError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DeleteProjectMember는 프로젝트에서 멤버를 제외하는 핸들러이다.
+type DeleteProjectMember struct {
+	Service ProjectService
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, DeleteProjectMember 핸들러의 엔트리 포인트이다. (DELETE /projects/{id}/members/{user_id})
+func (dm *DeleteProjectMember) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id, err := projectIDParam(r)
+	if err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+	uid, err := strconv.ParseInt(chi.URLParam(r, "user_id"), 10, 64)
+	if err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+	if err := dm.Service.RemoveProjectMember(r.Context(), id, entity.UserID(uid)); err != nil {
+		respondProjectError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// SetTaskProject는 Task를 프로젝트에 넣는 핸들러이다.
+type SetTaskProject struct {
+	Service   TaskProjectService
+	Validator *validator.Validate
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, SetTaskProject 핸들러의 엔트리 포인트이다. (PUT /tasks/{id}/project)
+func (sp *SetTaskProject) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id, err := taskIDParam(r)
+	if err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+	var b struct {
+		ProjectID entity.ProjectID `json:"project_id" validate:"required"`
+	}
+	if err := decodeBody(r, &b); err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, decodeStatus(err))
+		return
+	}
+	if err := sp.Validator.Struct(b); err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+	t, err := sp.Service.SetTaskProject(r.Context(), id, &b.ProjectID)
+	if err != nil {
+		respondProjectError(w, r, err)
+		return
+	}
+	Respond(w, r, newTask(t), http.StatusOK)
+}
+
+// UnsetTaskProject는 Task를 프로젝트에서 빼는 핸들러이다.
+type UnsetTaskProject struct {
+	Service TaskProjectService
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, UnsetTaskProject 핸들러의 엔트리 포인트이다. (DELETE /tasks/{id}/project)
+func (up *UnsetTaskProject) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id, err := taskIDParam(r)
+	if err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+	t, err := up.Service.SetTaskProject(r.Context(), id, nil)
+	if err != nil {
+		respondProjectError(w, r, err)
+		return
+	}
+	Respond(w, r, newTask(t), http.StatusOK)
+}
+
+func projectIDParam(r *http.Request) (entity.ProjectID, error) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return entity.ProjectID(id), nil
+}
+
+func respondProjectError(w http.ResponseWriter, r *http.Request, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, store.ErrNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, store.ErrAlreadyEntry):
+		status = http.StatusConflict
+	case errors.Is(err, service.ErrNotProjectOwner):
+		status = http.StatusForbidden
+	case errors.Is(err, service.ErrUnknownMember), errors.Is(err, service.ErrRemoveProjectOwner):
+		status = http.StatusBadRequest
+	}
+	Respond(w, r, &ErrResponse{
+		Message: err.Error(),
+	}, status)
+}
@@ -0,0 +1,157 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/service"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/gitwub5/go_todo_app/testutil"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+)
+
+func TestAddProjectMember(t *testing.T) {
+	type want struct {
+		status  int
+		rspFile string
+	}
+	tests := map[string]struct {
+		reqFile string
+		err     error
+		want    want
+	}{
+		"ok": {
+			reqFile: "testdata/project/member_req.json.golden",
+			want:    want{status: http.StatusNoContent},
+		},
+		"badRequest": {
+			reqFile: "testdata/project/bad_req.json.golden",
+			want: want{
+				status:  http.StatusBadRequest,
+				rspFile: "testdata/project/member_bad_rsp.json.golden",
+			},
+		},
+		"notOwner": {
+			reqFile: "testdata/project/member_req.json.golden",
+			err:     fmt.Errorf("project 5: %w", service.ErrNotProjectOwner),
+			want: want{
+				status:  http.StatusForbidden,
+				rspFile: "testdata/project/not_owner_rsp.json.golden",
+			},
+		},
+		"unknownMember": {
+			reqFile: "testdata/project/member_req.json.golden",
+			err:     fmt.Errorf("user 20: %w", service.ErrUnknownMember),
+			want: want{
+				status:  http.StatusBadRequest,
+				rspFile: "testdata/project/unknown_member_rsp.json.golden",
+			},
+		},
+		"alreadyMember": {
+			reqFile: "testdata/project/member_req.json.golden",
+			err:     fmt.Errorf("failed to add member: user 20 is already a member: %w", store.ErrAlreadyEntry),
+			want: want{
+				status:  http.StatusConflict,
+				rspFile: "testdata/project/already_member_rsp.json.golden",
+			},
+		},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(
+				http.MethodPost,
+				"/projects/5/members",
+				bytes.NewReader(testutil.LoadFile(t, tt.reqFile)),
+			)
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("id", "5")
+			r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+
+			moq := &ProjectServiceMock{}
+			moq.AddProjectMemberFunc = func(ctx context.Context, id entity.ProjectID, uid entity.UserID) error {
+				if id != 5 || uid != 20 {
+					t.Errorf("unexpected member: project %d, user %d", id, uid)
+				}
+				return tt.err
+			}
+			sut := AddProjectMember{Service: moq, Validator: validator.New()}
+			sut.ServeHTTP(w, r)
+
+			var body []byte
+			if tt.want.rspFile != "" {
+				body = testutil.LoadFile(t, tt.want.rspFile)
+			}
+			testutil.AssertResponse(t, w.Result(), tt.want.status, body)
+		})
+	}
+}
+
+func TestSetTaskProject(t *testing.T) {
+	type want struct {
+		status  int
+		rspFile string
+	}
+	tests := map[string]struct {
+		err  error
+		want want
+	}{
+		"ok": {
+			want: want{
+				status:  http.StatusOK,
+				rspFile: "testdata/project/set_task_rsp.json.golden",
+			},
+		},
+		// 멤버가 아닌 프로젝트에는 넣을 수 없다.
+		"notFound": {
+			err: fmt.Errorf("failed to get project: project 5: %w", store.ErrNotFound),
+			want: want{
+				status:  http.StatusNotFound,
+				rspFile: "testdata/project/project_not_found_rsp.json.golden",
+			},
+		},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(
+				http.MethodPut,
+				"/tasks/1/project",
+				bytes.NewReader(testutil.LoadFile(t, "testdata/project/set_task_req.json.golden")),
+			)
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("id", "1")
+			r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+
+			moq := &TaskProjectServiceMock{}
+			moq.SetTaskProjectFunc = func(
+				ctx context.Context, id entity.TaskID, project *entity.ProjectID,
+			) (*entity.Task, error) {
+				if tt.err != nil {
+					return nil, tt.err
+				}
+				return &entity.Task{
+					ID: id, ProjectID: project, Title: "test1", Status: entity.TaskStatusTodo,
+				}, nil
+			}
+			sut := SetTaskProject{Service: moq, Validator: validator.New()}
+			sut.ServeHTTP(w, r)
+
+			testutil.AssertResponse(t,
+				w.Result(), tt.want.status, testutil.LoadFile(t, tt.want.rspFile),
+			)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/service"
+	"github.com/go-playground/validator/v10"
+)
+
+// RefreshToken은 리프레시 토큰으로 새 액세스 토큰과 리프레시 토큰을 발급하는 핸들러이다.
+type RefreshToken struct {
+	Service   RefreshTokenService
+	Validator *validator.Validate
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, RefreshToken 핸들러의 엔트리 포인트이다. (POST /token/refresh)
+// 요청한 리프레시 토큰은 더 이상 사용할 수 없으므로, 클라이언트는 응답의 리프레시 토큰을 저장해야 한다.
+func (rt *RefreshToken) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var b struct {
+		RefreshToken string `json:"refresh_token" validate:"required"`
+	}
+	if err := decodeBody(r, &b); err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, decodeStatus(err))
+		return
+	}
+	if err := rt.Validator.Struct(b); err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+	tokens, err := rt.Service.Refresh(ctx, b.RefreshToken)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, auth.ErrInvalidRefreshToken) ||
+			errors.Is(err, auth.ErrRefreshTokenReused) ||
+			errors.Is(err, service.ErrUserDisabled) {
+			status = http.StatusUnauthorized
+		}
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, status)
+		return
+	}
+	Respond(w, r, tokens, http.StatusOK)
+}
@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/testutil"
+	"github.com/go-playground/validator/v10"
+)
+
+func TestRefreshToken_ServeHTTP(t *testing.T) {
+	type moq struct {
+		tokens *entity.Tokens
+		err    error
+	}
+	type want struct {
+		status  int
+		rspFile string
+	}
+	tests := map[string]struct {
+		reqFile string
+		moq     moq
+		want    want
+	}{
+		"ok": {
+			reqFile: "testdata/refresh_token/ok_req.json.golden",
+			moq: moq{
+				tokens: &entity.Tokens{AccessToken: "new_access", RefreshToken: "next"},
+			},
+			want: want{
+				status:  http.StatusOK,
+				rspFile: "testdata/refresh_token/ok_rsp.json.golden",
+			},
+		},
+		"badRequest": {
+			reqFile: "testdata/refresh_token/bad_req.json.golden",
+			want: want{
+				status:  http.StatusBadRequest,
+				rspFile: "testdata/refresh_token/bad_rsp.json.golden",
+			},
+		},
+		"reused": {
+			reqFile: "testdata/refresh_token/ok_req.json.golden",
+			moq: moq{
+				err: auth.ErrRefreshTokenReused,
+			},
+			want: want{
+				status:  http.StatusUnauthorized,
+				rspFile: "testdata/refresh_token/reused_rsp.json.golden",
+			},
+		},
+		"internalServerError": {
+			reqFile: "testdata/refresh_token/ok_req.json.golden",
+			moq: moq{
+				err: errors.New("error from mock"),
+			},
+			want: want{
+				status:  http.StatusInternalServerError,
+				rspFile: "testdata/refresh_token/internal_server_error_rsp.json.golden",
+			},
+		},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(
+				http.MethodPost,
+				"/token/refresh",
+				bytes.NewReader(testutil.LoadFile(t, tt.reqFile)),
+			)
+
+			moq := &RefreshTokenServiceMock{}
+			moq.RefreshFunc = func(ctx context.Context, token string) (*entity.Tokens, error) {
+				if token != "current" {
+					t.Errorf("want token %q, but got %q", "current", token)
+				}
+				return tt.moq.tokens, tt.moq.err
+			}
+			sut := RefreshToken{
+				Service:   moq,
+				Validator: validator.New(),
+			}
+			sut.ServeHTTP(w, r)
+
+			testutil.AssertResponse(t,
+				w.Result(), tt.want.status, testutil.LoadFile(t, tt.want.rspFile),
+			)
+		})
+	}
+}
@@ -1,7 +1,6 @@
 package handler
 
 import (
-	"encoding/json"
 	"net/http"
 
 	"github.com/gitwub5/go_todo_app/entity"
@@ -16,31 +15,32 @@ type RegisterUser struct {
 func (ru *RegisterUser) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	var b struct {
-		Name     string `json:"name" validate:"required"`
-		Password string `json:"password" validate:"required"`
-		Role     string `json:"role" validate:"required"`
+		Name     string  `json:"name" validate:"required"`
+		Password string  `json:"password" validate:"required"`
+		Role     string  `json:"role" validate:"required"`
+		Email    *string `json:"email" validate:"omitempty,email,max=255"` // 메일 알림을 받을 주소 (선택)
 	}
 
 	// 요청 본문에서 데이터를 읽어와서 구조체에 디코딩한다.
-	if err := json.NewDecoder(r.Body).Decode(&b); err != nil {
-		RespondJSON(ctx, w, &ErrResponse{
+	if err := decodeBody(r, &b); err != nil {
+		Respond(w, r, &ErrResponse{
 			Message: err.Error(),
-		}, http.StatusInternalServerError)
+		}, decodeStatus(err))
 		return
 	}
 
 	// 유효성 검사를 수행. 필수 값이 비어 있는 경우 에러가 반환된다.
 	if err := ru.Validator.Struct(b); err != nil {
-		RespondJSON(ctx, w, &ErrResponse{
+		Respond(w, r, &ErrResponse{
 			Message: err.Error(),
 		}, http.StatusBadRequest)
 		return
 	}
 
 	// 사용자 등록
-	u, err := ru.Service.RegisterUser(ctx, b.Name, b.Password, b.Role)
+	u, err := ru.Service.RegisterUser(ctx, b.Name, b.Password, b.Role, b.Email)
 	if err != nil {
-		RespondJSON(ctx, w, &ErrResponse{
+		Respond(w, r, &ErrResponse{
 			Message: err.Error(),
 		}, http.StatusInternalServerError)
 		return
@@ -48,5 +48,5 @@ func (ru *RegisterUser) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	rsp := struct {
 		ID entity.UserID `json:"id"`
 	}{ID: u.ID}
-	RespondJSON(ctx, w, rsp, http.StatusOK)
+	Respond(w, r, rsp, http.StatusOK)
 }
@@ -1,10 +1,10 @@
 package handler
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 )
 
 type ErrResponse struct {
@@ -12,23 +12,58 @@ type ErrResponse struct {
 	Details []string `json:"details,omitempty"`
 }
 
-func RespondJSON(ctx context.Context, w http.ResponseWriter, body any, status int) {
-	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+// Error 메서드는 클라이언트가 에러 응답을 error로 다룰 수 있도록 한다. (client.Error가 감싼다)
+func (e *ErrResponse) Error() string {
+	if len(e.Details) == 0 {
+		return e.Message
+	}
+	return e.Message + " (" + strings.Join(e.Details, "; ") + ")"
+}
+
+// Respond 함수는 요청의 Accept 헤더에 따라 body를 JSON, MessagePack, CSV(목록만) 중 하나로 인코딩해 응답한다.
+// 응답할 수 있는 형식이 없으면 406 에러를 반환한다. 단, 에러 응답은 원래의 상태 코드를 유지하고 JSON으로 응답한다.
+func Respond(w http.ResponseWriter, r *http.Request, body any, status int) {
 	bodyBytes, err := json.Marshal(body) // body를 JSON으로 인코딩
 	if err != nil {
 		fmt.Printf("encode response error: %v", err)
-		w.WriteHeader(http.StatusInternalServerError) // 인코딩에 실패하면 500 에러를 반환
-		rsp := ErrResponse{                           // ErrResponse 구조체를 사용하여 에러 응답을 생성
-			Message: http.StatusText(http.StatusInternalServerError),
-		}
-		if err := json.NewEncoder(w).Encode(rsp); err != nil { // JSON으로 인코딩한 rsp를 응답으로 반환
-			fmt.Printf("write error response error: %v", err)
+		respondInternalError(w)
+		return
+	}
+	enc, ok := negotiate(r.Header.Get("Accept"), bodyBytes)
+	if !ok {
+		enc = encoders[0]
+		if status < http.StatusBadRequest {
+			status = http.StatusNotAcceptable
+			bodyBytes, _ = json.Marshal(ErrResponse{
+				Message: http.StatusText(http.StatusNotAcceptable),
+				Details: []string{"supported media types: " + strings.Join(
+					[]string{MIMEJSON, MIMEMsgpack, MIMECSV + " (lists only)"}, ", ",
+				)},
+			})
 		}
+	}
+	if bodyBytes, err = enc.convert(bodyBytes); err != nil {
+		fmt.Printf("encode response error: %v", err)
+		respondInternalError(w)
 		return
 	}
 
+	w.Header().Set("Content-Type", enc.contentType)
+	w.Header().Add("Vary", "Accept")
 	w.WriteHeader(status) // 상태 코드를 설정
-	if _, err := fmt.Fprintf(w, "%s", bodyBytes); err != nil {
+	if _, err := w.Write(bodyBytes); err != nil {
 		fmt.Printf("write response error: %v", err)
 	}
 }
+
+// respondInternalError 함수는 인코딩에 실패했을 때 500 에러를 JSON으로 응답한다.
+func respondInternalError(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusInternalServerError) // 인코딩에 실패하면 500 에러를 반환
+	rsp := ErrResponse{                           // ErrResponse 구조체를 사용하여 에러 응답을 생성
+		Message: http.StatusText(http.StatusInternalServerError),
+	}
+	if err := json.NewEncoder(w).Encode(rsp); err != nil { // JSON으로 인코딩한 rsp를 응답으로 반환
+		fmt.Printf("write error response error: %v", err)
+	}
+}
@@ -2,25 +2,211 @@ package handler
 
 import (
 	"context"
+	"time"
 
 	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/quickadd"
+	"github.com/graphql-go/graphql"
+	"github.com/lestrrat-go/jwx/v2/jwk"
 )
 
 // handler 패키지로부터 비즈니스 로직과 데이터베이스 처리를 제외시키기 위해 서비스 인터페이스를 정의한다.
 
-//go:generate go run github.com/matryer/moq -out moq_test.go . ListTasksService AddTaskService RegisterUserService LoginService
+//go:generate go run github.com/matryer/moq -out moq_test.go . ListTasksService ListWorkService AddTaskService UpdateTaskService DeleteTaskService AssignTaskService ProjectService TaskProjectService ListTaskStatusesService AddTaskStatusService StartTimerService StopTimerService AddTimeEntryService GetTaskTimeService GetTimesheetService QuickAddParser AddTemplateService ListTemplatesService InstantiateTemplateService ListNotificationsService MarkNotificationService AddWebhookService ListWebhooksService EditWebhookService SyncService EventStreamService ProjectEventService Authenticator PresenceService MailPreferenceService PasswordResetService RequestAuthenticator RegisterUserService LoginService LogoutService SessionService PersonalAccessTokenService OAuthClientService AuthorizeService OAuthTokenService RefreshTokenService KeySetService GraphQLExecutor
 type ListTasksService interface {
 	ListTasks(ctx context.Context) (entity.Tasks, error)
+	ListAssignedTasks(ctx context.Context) (entity.Tasks, error)
+}
+
+type ListWorkService interface {
+	ListWorkTasks(ctx context.Context) (entity.Tasks, error)
 }
 
 type AddTaskService interface {
-	AddTask(ctx context.Context, title string) (*entity.Task, error)
+	AddTask(ctx context.Context, title string, due *time.Time, parent *entity.TaskID, attrs entity.TaskAttributes) (*entity.Task, error)
+}
+
+type UpdateTaskService interface {
+	UpdateTask(ctx context.Context, id entity.TaskID, title *string, status *entity.TaskStatus) (*entity.Task, error)
+}
+
+type DeleteTaskService interface {
+	DeleteTask(ctx context.Context, id entity.TaskID) (entity.Tasks, error)
+}
+
+type AssignTaskService interface {
+	AssignTask(ctx context.Context, id entity.TaskID, assignee entity.UserID) (*entity.Task, error)
+	UnassignTask(ctx context.Context, id entity.TaskID) (*entity.Task, error)
+}
+
+type ProjectService interface {
+	AddProject(ctx context.Context, name string) (*entity.Project, error)
+	ListProjects(ctx context.Context) (entity.Projects, error)
+	ListProjectMembers(ctx context.Context, id entity.ProjectID) ([]entity.UserID, error)
+	AddProjectMember(ctx context.Context, id entity.ProjectID, uid entity.UserID) error
+	RemoveProjectMember(ctx context.Context, id entity.ProjectID, uid entity.UserID) error
+}
+
+type TaskProjectService interface {
+	SetTaskProject(ctx context.Context, id entity.TaskID, project *entity.ProjectID) (*entity.Task, error)
+}
+
+type ListTaskStatusesService interface {
+	ListTaskStatuses(ctx context.Context) (entity.TaskStatusDefs, error)
+}
+
+type AddTaskStatusService interface {
+	AddTaskStatus(ctx context.Context, name entity.TaskStatus, category entity.TaskStatusCategory) (*entity.TaskStatusDef, error)
+}
+
+type StartTimerService interface {
+	StartTimer(ctx context.Context, id entity.TaskID) (*entity.TimeEntry, error)
+}
+
+type StopTimerService interface {
+	StopTimer(ctx context.Context, id entity.TaskID) (*entity.TimeEntry, error)
+}
+
+type AddTimeEntryService interface {
+	AddTimeEntry(ctx context.Context, id entity.TaskID, started, stopped time.Time) (*entity.TimeEntry, error)
+}
+
+type GetTaskTimeService interface {
+	GetTaskTime(ctx context.Context, id entity.TaskID, loc *time.Location) (*entity.TaskTime, error)
+}
+
+type GetTimesheetService interface {
+	GetTimesheet(ctx context.Context, from, to time.Time) (*entity.Timesheet, error)
+}
+
+type QuickAddParser interface {
+	Parse(text string, loc *time.Location) (*quickadd.Result, error)
+}
+
+type AddTemplateService interface {
+	AddTemplate(ctx context.Context, name string, id entity.TaskID, project *entity.ProjectID) (*entity.Template, error)
+}
+
+type ListTemplatesService interface {
+	ListTemplates(ctx context.Context) (entity.Templates, error)
+}
+
+type InstantiateTemplateService interface {
+	InstantiateTemplate(ctx context.Context, id entity.TemplateID, anchor time.Time) (entity.Tasks, error)
+}
+
+type ListNotificationsService interface {
+	ListNotifications(ctx context.Context, unreadOnly bool) (entity.Notifications, int, error)
+}
+
+type MarkNotificationService interface {
+	MarkRead(ctx context.Context, id entity.NotificationID) (int, error)
+	MarkAllRead(ctx context.Context) (int64, error)
+}
+
+type AddWebhookService interface {
+	AddWebhook(ctx context.Context, url string, events []entity.EventType) (*entity.Webhook, error)
+}
+
+type ListWebhooksService interface {
+	ListWebhooks(ctx context.Context) (entity.Webhooks, error)
+	ListWebhookDeliveries(ctx context.Context, id entity.WebhookID) (entity.WebhookDeliveries, error)
+}
+
+type EditWebhookService interface {
+	DeleteWebhook(ctx context.Context, id entity.WebhookID) error
+	EnableWebhook(ctx context.Context, id entity.WebhookID) (*entity.Webhook, error)
+}
+
+type SyncService interface {
+	Pull(ctx context.Context, since string) (*entity.SyncDelta, error)
+	Push(ctx context.Context, since string, ms []*entity.SyncMutation) ([]*entity.SyncResult, *entity.SyncDelta, error)
+}
+
+type EventStreamService interface {
+	Subscribe(ctx context.Context, lastID int64) ([]*entity.StreamEvent, <-chan *entity.StreamEvent, func(), error)
+}
+
+// Authenticator는 문자열로 전달된 액세스 토큰을 검증하고, 사용자 ID를 설정한 context를 반환한다.
+type Authenticator interface {
+	Authenticate(ctx context.Context, token string) (context.Context, error)
+}
+
+// ProjectEventService는 프로젝트에 속한 Task의 변경 이벤트를 구독한다. 멤버인지는 확인하지 않는다.
+type ProjectEventService interface {
+	SubscribeProject(ctx context.Context, pid entity.ProjectID) (<-chan *entity.StreamEvent, func(), error)
+}
+
+type PresenceService interface {
+	Join(ctx context.Context, pid entity.ProjectID, session string) ([]entity.UserID, error)
+	Leave(ctx context.Context, pid entity.ProjectID, session string) error
+}
+
+type MailPreferenceService interface {
+	GetMailPreference(ctx context.Context) (*entity.MailPreference, error)
+	UpdateMailPreference(ctx context.Context, email *string, enabled map[entity.MailKind]bool) (*entity.MailPreference, error)
+}
+
+type PasswordResetService interface {
+	RequestReset(ctx context.Context, name string) error
+	ResetPassword(ctx context.Context, token, password string) error
 }
 
 type RegisterUserService interface {
-	RegisterUser(ctx context.Context, name, password, role string) (*entity.User, error)
+	RegisterUser(ctx context.Context, name, password, role string, email *string) (*entity.User, error)
 }
 
 type LoginService interface {
-	Login(ctx context.Context, name, pw string) (string, error)
+	Login(ctx context.Context, name, pw string, c entity.Client) (*entity.Tokens, error)
+}
+
+// LogoutService는 요청에 사용한 액세스 토큰의 세션을 끝낸다. auth.JWTer가 구현한다.
+type LogoutService interface {
+	Logout(ctx context.Context) error
+}
+
+type SessionService interface {
+	ListSessions(ctx context.Context) ([]*entity.Session, error)
+	DeleteSession(ctx context.Context, id entity.SessionID) error
+	DeleteSessions(ctx context.Context) (int, error)
+}
+
+type PersonalAccessTokenService interface {
+	CreatePersonalAccessToken(ctx context.Context, name string, scopes entity.Scopes, expires *time.Time) (*entity.PersonalAccessToken, string, error)
+	ListPersonalAccessTokens(ctx context.Context) (entity.PersonalAccessTokens, error)
+	DeletePersonalAccessToken(ctx context.Context, id entity.PersonalAccessTokenID) error
+}
+
+type OAuthClientService interface {
+	RegisterOAuthClient(ctx context.Context, name string, redirectURIs []string, scopes entity.Scopes, confidential bool) (*entity.OAuthClient, string, error)
+	ListOAuthClients(ctx context.Context) (entity.OAuthClients, error)
+	DeleteOAuthClient(ctx context.Context, id entity.OAuthClientID) error
+}
+
+// AuthorizeService는 인가 요청을 검증하고, 사용자가 동의 화면에서 승인하거나 거부한 결과를 클라이언트로 돌려보낼 URI를 만든다.
+type AuthorizeService interface {
+	Authorize(ctx context.Context, req *entity.AuthorizationRequest) (*entity.OAuthClient, entity.Scopes, error)
+	Approve(ctx context.Context, req *entity.AuthorizationRequest, name, pw string) (string, error)
+	Deny(ctx context.Context, req *entity.AuthorizationRequest) (string, error)
+}
+
+// OAuthTokenService는 인증한 OAuth 클라이언트에 액세스 토큰을 발급하고, 발급한 토큰을 확인하거나 폐기한다.
+type OAuthTokenService interface {
+	ExchangeAuthorizationCode(ctx context.Context, cid entity.OAuthClientID, secret, code, redirectURI, verifier string) (*entity.OAuthToken, error)
+	ClientCredentialsGrant(ctx context.Context, cid entity.OAuthClientID, secret, scope string) (*entity.OAuthToken, error)
+	RevokeToken(ctx context.Context, cid entity.OAuthClientID, secret, token string) error
+	IntrospectToken(ctx context.Context, cid entity.OAuthClientID, secret, token string) (*entity.TokenIntrospection, error)
+}
+
+type RefreshTokenService interface {
+	Refresh(ctx context.Context, token string) (*entity.Tokens, error)
+}
+
+type KeySetService interface {
+	PublicKeys() jwk.Set
+}
+
+// GraphQLExecutor는 GraphQL 요청을 실행한다.
+type GraphQLExecutor interface {
+	Execute(ctx context.Context, query, operationName string, variables map[string]interface{}) *graphql.Result
 }
@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/go-chi/chi/v5"
+)
+
+// Logout은 요청에 사용한 액세스 토큰의 세션을 끝내는 핸들러이다.
+type Logout struct {
+	Service LogoutService
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, Logout 핸들러의 엔트리 포인트이다. (POST /logout)
+// 액세스 토큰과 같은 세션의 리프레시 토큰도 폐기된다.
+func (l *Logout) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := l.Service.Logout(r.Context()); err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListSessions는 사용자의 세션 목록을 반환하는 핸들러이다.
+type ListSessions struct {
+	Service SessionService
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, ListSessions 핸들러의 엔트리 포인트이다. (GET /sessions)
+func (ls *ListSessions) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ss, err := ls.Service.ListSessions(r.Context())
+	if err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusInternalServerError)
+		return
+	}
+	Respond(w, r, ss, http.StatusOK)
+}
+
+// DeleteSession은 사용자의 세션을 삭제해 그 세션의 토큰을 폐기하는 핸들러이다.
+type DeleteSession struct {
+	Service SessionService
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, DeleteSession 핸들러의 엔트리 포인트이다. (DELETE /sessions/{id})
+func (ds *DeleteSession) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id := entity.SessionID(chi.URLParam(r, "id"))
+	if err := ds.Service.DeleteSession(r.Context(), id); err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, store.ErrNotFound) {
+			status = http.StatusNotFound
+		}
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, status)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DeleteSessions는 사용자의 모든 세션을 삭제해 모든 기기에서 로그아웃하는 핸들러이다.
+type DeleteSessions struct {
+	Service SessionService
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, DeleteSessions 핸들러의 엔트리 포인트이다. (DELETE /sessions)
+// 요청에 사용한 액세스 토큰도 폐기되므로, 계속 사용하려면 다시 로그인해야 한다.
+func (ds *DeleteSessions) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	n, err := ds.Service.DeleteSessions(r.Context())
+	if err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusInternalServerError)
+		return
+	}
+	rsp := struct {
+		Deleted int `json:"deleted"`
+	}{Deleted: n}
+	Respond(w, r, rsp, http.StatusOK)
+}
@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gitwub5/go_todo_app/clock"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/gitwub5/go_todo_app/testutil"
+	"github.com/go-chi/chi/v5"
+)
+
+func TestListSessions(t *testing.T) {
+	t.Parallel()
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/sessions", nil)
+
+	now := clock.FixedClocker{}.Now()
+	moq := &SessionServiceMock{}
+	moq.ListSessionsFunc = func(ctx context.Context) ([]*entity.Session, error) {
+		return []*entity.Session{
+			{
+				ID:       "0f8fad5b-d9cb-469f-a165-70867728950e",
+				UserID:   1,
+				Client:   entity.Client{Device: "todo-cli/1.0", IP: "192.0.2.1"},
+				IssuedAt: now,
+				Current:  true,
+			},
+			{
+				ID:       "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+				UserID:   1,
+				Client:   entity.Client{Device: "Mozilla/5.0", IP: "2001:db8::1"},
+				IssuedAt: now,
+			},
+		}, nil
+	}
+	sut := ListSessions{Service: moq}
+	sut.ServeHTTP(w, r)
+
+	testutil.AssertResponse(t,
+		w.Result(), http.StatusOK, testutil.LoadFile(t, "testdata/session/list_rsp.json.golden"),
+	)
+}
+
+func TestDeleteSession(t *testing.T) {
+	type want struct {
+		status  int
+		rspFile string
+	}
+	tests := map[string]struct {
+		id   entity.SessionID
+		want want
+	}{
+		"ok": {
+			id:   "0f8fad5b-d9cb-469f-a165-70867728950e",
+			want: want{status: http.StatusNoContent},
+		},
+		"notFound": {
+			id: "unknown",
+			want: want{
+				status:  http.StatusNotFound,
+				rspFile: "testdata/session/not_found_rsp.json.golden",
+			},
+		},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodDelete, "/sessions/"+string(tt.id), nil)
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("id", string(tt.id))
+			r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+
+			moq := &SessionServiceMock{}
+			moq.DeleteSessionFunc = func(ctx context.Context, id entity.SessionID) error {
+				if id == "unknown" {
+					return fmt.Errorf("session %q: %w", id, store.ErrNotFound)
+				}
+				return nil
+			}
+			sut := DeleteSession{Service: moq}
+			sut.ServeHTTP(w, r)
+
+			var body []byte
+			if tt.want.rspFile != "" {
+				body = testutil.LoadFile(t, tt.want.rspFile)
+			}
+			testutil.AssertResponse(t, w.Result(), tt.want.status, body)
+		})
+	}
+}
+
+func TestDeleteSessions(t *testing.T) {
+	t.Parallel()
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodDelete, "/sessions", nil)
+
+	moq := &SessionServiceMock{}
+	moq.DeleteSessionsFunc = func(ctx context.Context) (int, error) {
+		return 2, nil
+	}
+	sut := DeleteSessions{Service: moq}
+	sut.ServeHTTP(w, r)
+
+	testutil.AssertResponse(t,
+		w.Result(), http.StatusOK, testutil.LoadFile(t, "testdata/session/delete_all_rsp.json.golden"),
+	)
+}
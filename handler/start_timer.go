@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gitwub5/go_todo_app/service"
+	"github.com/gitwub5/go_todo_app/store"
+)
+
+// StartTimer는 Task의 작업 시간 타이머를 시작하는 핸들러이다.
+type StartTimer struct {
+	Service StartTimerService
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, StartTimer 핸들러의 엔트리 포인트이다. (POST /tasks/{id}/timer/start)
+func (st *StartTimer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id, err := taskIDParam(r)
+	if err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+	e, err := st.Service.StartTimer(ctx, id)
+	if err != nil {
+		status := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, store.ErrNotFound):
+			status = http.StatusNotFound
+		case errors.Is(err, service.ErrTimerRunning):
+			status = http.StatusConflict
+		}
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, status)
+		return
+	}
+	Respond(w, r, newTimeEntry(e, 0), http.StatusOK)
+}
@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gitwub5/go_todo_app/clock"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/service"
+	"github.com/gitwub5/go_todo_app/testutil"
+	"github.com/go-chi/chi/v5"
+)
+
+func TestStartTimer(t *testing.T) {
+	type want struct {
+		status  int
+		rspFile string
+	}
+	tests := map[string]struct {
+		err  error
+		want want
+	}{
+		"ok": {
+			want: want{
+				status:  http.StatusOK,
+				rspFile: "testdata/start_timer/ok_rsp.json.golden",
+			},
+		},
+		"conflict": {
+			err: fmt.Errorf("task 2: %w", service.ErrTimerRunning),
+			want: want{
+				status:  http.StatusConflict,
+				rspFile: "testdata/start_timer/conflict_rsp.json.golden",
+			},
+		},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodPost, "/tasks/1/timer/start", nil)
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("id", "1")
+			r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+
+			moq := &StartTimerServiceMock{}
+			moq.StartTimerFunc = func(ctx context.Context, id entity.TaskID) (*entity.TimeEntry, error) {
+				if tt.err != nil {
+					return nil, tt.err
+				}
+				return &entity.TimeEntry{ID: 5, TaskID: id, Started: clock.FixedClocker{}.Now()}, nil
+			}
+			sut := StartTimer{Service: moq}
+			sut.ServeHTTP(w, r)
+
+			resp := w.Result()
+			testutil.AssertResponse(t,
+				resp, tt.want.status, testutil.LoadFile(t, tt.want.rspFile),
+			)
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gitwub5/go_todo_app/service"
+)
+
+// StopTimer는 실행 중인 Task의 작업 시간 타이머를 멈추는 핸들러이다.
+type StopTimer struct {
+	Service StopTimerService
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, StopTimer 핸들러의 엔트리 포인트이다. (POST /tasks/{id}/timer/stop)
+func (st *StopTimer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id, err := taskIDParam(r)
+	if err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+	e, err := st.Service.StopTimer(ctx, id)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, service.ErrTimerNotRunning) {
+			status = http.StatusConflict
+		}
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, status)
+		return
+	}
+	// 멈춘 타이머는 Stopped가 설정되어 있으므로 시각에 의존하지 않는다.
+	Respond(w, r, newTimeEntry(e, e.Duration(*e.Stopped)), http.StatusOK)
+}
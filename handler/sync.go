@@ -0,0 +1,167 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/service"
+	"github.com/go-playground/validator/v10"
+)
+
+// syncTask는 동기화 응답에 포함하는 Task이다.
+// 클라이언트가 충돌을 감지할 수 있도록 client_id와 modified를 함께 반환한다.
+type syncTask struct {
+	ID         entity.TaskID     `json:"id"`
+	ClientID   *string           `json:"client_id,omitempty"`
+	ParentID   *entity.TaskID    `json:"parent_id,omitempty"`
+	AssigneeID *entity.UserID    `json:"assignee_id,omitempty"`
+	Title      string            `json:"title"`
+	Status     entity.TaskStatus `json:"status"`
+	Due        *time.Time        `json:"due,omitempty"`
+	Modified   time.Time         `json:"modified"`
+}
+
+func newSyncTask(t *entity.Task) *syncTask {
+	if t == nil {
+		return nil
+	}
+	return &syncTask{
+		ID:         t.ID,
+		ClientID:   t.ClientID,
+		ParentID:   t.ParentID,
+		AssigneeID: t.AssigneeID,
+		Title:      t.Title,
+		Status:     t.Status,
+		Due:        t.Due,
+		Modified:   t.Modified,
+	}
+}
+
+type syncChange struct {
+	Op     entity.TaskChangeOp `json:"op"`
+	TaskID entity.TaskID       `json:"task_id"`
+	Task   *syncTask           `json:"task,omitempty"`
+}
+
+type syncDelta struct {
+	Token   string       `json:"token"`
+	HasMore bool         `json:"has_more"`
+	Changes []syncChange `json:"changes"`
+}
+
+func newSyncDelta(d *entity.SyncDelta) syncDelta {
+	rsp := syncDelta{Token: d.Token, HasMore: d.HasMore, Changes: []syncChange{}}
+	for _, c := range d.Changes {
+		rsp.Changes = append(rsp.Changes, syncChange{Op: c.Op, TaskID: c.TaskID, Task: newSyncTask(c.Task)})
+	}
+	return rsp
+}
+
+// syncStatus 함수는 동기화 서비스의 에러에 해당하는 상태 코드를 반환한다.
+func syncStatus(err error) int {
+	if errors.Is(err, service.ErrInvalidSyncToken) {
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
+
+// PullSync는 동기화 토큰 이후의 Task 변경 내역을 반환하는 핸들러이다.
+type PullSync struct {
+	Service SyncService
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, PullSync 핸들러의 엔트리 포인트이다. (GET /sync)
+// ?since=를 생략하면 볼 수 있는 모든 Task를 반환한다.
+func (ps *PullSync) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	d, err := ps.Service.Pull(ctx, r.URL.Query().Get("since"))
+	if err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, syncStatus(err))
+		return
+	}
+	Respond(w, r, newSyncDelta(d), http.StatusOK)
+}
+
+// PushSync는 오프라인 클라이언트의 변경 요청을 적용하는 핸들러이다.
+type PushSync struct {
+	Service   SyncService
+	Validator *validator.Validate
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, PushSync 핸들러의 엔트리 포인트이다. (POST /sync)
+// 요청별 처리 결과와 함께, since 이후의 변경 내역과 새로운 동기화 토큰을 반환한다.
+func (ps *PushSync) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var b struct {
+		Since     string `json:"since"`
+		Mutations []struct {
+			Op             entity.SyncMutationOp `json:"op" validate:"required,oneof=create update delete"`
+			ID             *entity.TaskID        `json:"id"`
+			ClientID       string                `json:"client_id" validate:"max=64"`
+			ParentID       *entity.TaskID        `json:"parent_id"`
+			ParentClientID string                `json:"parent_client_id" validate:"max=64"`
+			Title          *string               `json:"title" validate:"omitempty,max=128"`
+			Status         *entity.TaskStatus    `json:"status"`
+			Due            *time.Time            `json:"due"`
+			BaseModified   *time.Time            `json:"base_modified"`
+		} `json:"mutations" validate:"max=500,dive"`
+	}
+	if err := decodeBody(r, &b); err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, decodeStatus(err))
+		return
+	}
+	if err := ps.Validator.Struct(b); err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+	ms := make([]*entity.SyncMutation, 0, len(b.Mutations))
+	for _, m := range b.Mutations {
+		ms = append(ms, &entity.SyncMutation{
+			Op:             m.Op,
+			ID:             m.ID,
+			ClientID:       m.ClientID,
+			ParentID:       m.ParentID,
+			ParentClientID: m.ParentClientID,
+			Title:          m.Title,
+			Status:         m.Status,
+			Due:            m.Due,
+			BaseModified:   m.BaseModified,
+		})
+	}
+	results, d, err := ps.Service.Push(ctx, b.Since, ms)
+	if err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, syncStatus(err))
+		return
+	}
+	type result struct {
+		ClientID string                  `json:"client_id,omitempty"`
+		ID       *entity.TaskID          `json:"id,omitempty"`
+		Status   entity.SyncResultStatus `json:"status"`
+		Reason   string                  `json:"reason,omitempty"`
+		Task     *syncTask               `json:"task,omitempty"`
+	}
+	rsp := struct {
+		Results []result `json:"results"`
+		syncDelta
+	}{Results: []result{}, syncDelta: newSyncDelta(d)}
+	for _, r := range results {
+		rsp.Results = append(rsp.Results, result{
+			ClientID: r.ClientID,
+			ID:       r.ID,
+			Status:   r.Status,
+			Reason:   r.Reason,
+			Task:     newSyncTask(r.Task),
+		})
+	}
+	Respond(w, r, rsp, http.StatusOK)
+}
@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/clock"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/service"
+	"github.com/gitwub5/go_todo_app/testutil"
+	"github.com/go-playground/validator/v10"
+)
+
+func TestPushSync(t *testing.T) {
+	type want struct {
+		status  int
+		rspFile string
+	}
+	tests := map[string]struct {
+		reqFile string
+		err     error
+		want    want
+	}{
+		"ok": {
+			reqFile: "testdata/sync/ok_req.json.golden",
+			want: want{
+				status:  http.StatusOK,
+				rspFile: "testdata/sync/ok_rsp.json.golden",
+			},
+		},
+		"badRequest": {
+			reqFile: "testdata/sync/bad_req.json.golden",
+			want: want{
+				status:  http.StatusBadRequest,
+				rspFile: "testdata/sync/bad_rsp.json.golden",
+			},
+		},
+		"badToken": {
+			reqFile: "testdata/sync/ok_req.json.golden",
+			err:     fmt.Errorf("%q: %w", "abc", service.ErrInvalidSyncToken),
+			want: want{
+				status:  http.StatusBadRequest,
+				rspFile: "testdata/sync/bad_token_rsp.json.golden",
+			},
+		},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(
+				http.MethodPost,
+				"/sync",
+				bytes.NewReader(testutil.LoadFile(t, tt.reqFile)),
+			)
+
+			moq := &SyncServiceMock{}
+			moq.PushFunc = func(
+				ctx context.Context, since string, ms []*entity.SyncMutation,
+			) ([]*entity.SyncResult, *entity.SyncDelta, error) {
+				if tt.err != nil {
+					return nil, nil, tt.err
+				}
+				if since != "10" || len(ms) != 2 {
+					t.Fatalf("unexpected request: %q, %d mutations", since, len(ms))
+				}
+				now := clock.FixedClocker{}.Now()
+				clientID := ms[0].ClientID
+				created := &entity.Task{
+					ID: 3, ClientID: &clientID, Title: *ms[0].Title,
+					Status: entity.TaskStatusTodo, Modified: now,
+				}
+				server := &entity.Task{
+					ID: 2, Title: "edited elsewhere",
+					Status: entity.TaskStatusDoing, Modified: now.Add(time.Hour),
+				}
+				return []*entity.SyncResult{
+					{ClientID: clientID, ID: &created.ID, Status: entity.SyncApplied, Task: created},
+					{ID: &server.ID, Status: entity.SyncConflict, Reason: "task was modified on the server", Task: server},
+				}, &entity.SyncDelta{
+					Token: "12",
+					Changes: []*entity.SyncChange{
+						{Op: entity.TaskChangeUpsert, TaskID: created.ID, Task: created},
+						{Op: entity.TaskChangeDelete, TaskID: 1},
+					},
+				}, nil
+			}
+
+			sut := PushSync{
+				Service:   moq,
+				Validator: validator.New(),
+			}
+			sut.ServeHTTP(w, r)
+
+			resp := w.Result()
+			testutil.AssertResponse(t,
+				resp, tt.want.status, testutil.LoadFile(t, tt.want.rspFile),
+			)
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/entity"
+)
+
+// timeEntry는 작업 시간 기록의 응답 형식이다. 작업 시간은 초 단위로 반환한다.
+type timeEntry struct {
+	ID      entity.TimeEntryID `json:"id"`
+	TaskID  entity.TaskID      `json:"task_id"`
+	Started time.Time          `json:"started"`
+	Stopped *time.Time         `json:"stopped"`
+	Seconds int64              `json:"seconds"`
+	Manual  bool               `json:"manual"`
+}
+
+func newTimeEntry(e *entity.TimeEntry, d time.Duration) timeEntry {
+	return timeEntry{
+		ID:      e.ID,
+		TaskID:  e.TaskID,
+		Started: e.Started,
+		Stopped: e.Stopped,
+		Seconds: int64(d.Seconds()),
+		Manual:  e.Manual,
+	}
+}
+
+// locationParam 함수는 쿼리 파라미터 tz로 지정된 타임존을 반환한다. 지정하지 않으면 UTC를 사용한다.
+func locationParam(r *http.Request) (*time.Location, error) {
+	return time.LoadLocation(r.URL.Query().Get("tz"))
+}
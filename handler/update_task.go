@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/service"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+)
+
+// UpdateTask는 Task의 제목이나 상태를 변경하는 핸들러이다.
+type UpdateTask struct {
+	Service   UpdateTaskService
+	Validator *validator.Validate
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, UpdateTask 핸들러의 엔트리 포인트이다. (PATCH /tasks/{id})
+func (ut *UpdateTask) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id, err := taskIDParam(r)
+	if err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+
+	// 전달된 항목만 변경하기 위해 포인터로 받는다.
+	var b struct {
+		Title  *string            `json:"title" validate:"omitempty,min=1,max=128"`
+		Status *entity.TaskStatus `json:"status" validate:"omitempty,min=1"`
+	}
+	if err := decodeBody(r, &b); err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, decodeStatus(err))
+		return
+	}
+	if err := ut.Validator.Struct(b); err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+
+	t, err := ut.Service.UpdateTask(ctx, id, b.Title, b.Status)
+	if err != nil {
+		status := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, store.ErrNotFound):
+			status = http.StatusNotFound
+		case errors.Is(err, service.ErrUnknownStatus):
+			status = http.StatusBadRequest
+		}
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, status)
+		return
+	}
+	Respond(w, r, newTask(t), http.StatusOK)
+}
+
+// taskIDParam 함수는 URL 경로의 {id}를 TaskID로 변환한다.
+func taskIDParam(r *http.Request) (entity.TaskID, error) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return entity.TaskID(id), nil
+}
@@ -0,0 +1,98 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/service"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/gitwub5/go_todo_app/testutil"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+)
+
+func TestUpdateTask(t *testing.T) {
+	type want struct {
+		status  int
+		rspFile string
+	}
+	tests := map[string]struct {
+		reqFile string
+		err     error
+		want    want
+	}{
+		"ok": {
+			reqFile: "testdata/update_task/ok_req.json.golden",
+			want: want{
+				status:  http.StatusOK,
+				rspFile: "testdata/update_task/ok_rsp.json.golden",
+			},
+		},
+		"badRequest": {
+			reqFile: "testdata/update_task/bad_req.json.golden",
+			want: want{
+				status:  http.StatusBadRequest,
+				rspFile: "testdata/update_task/bad_rsp.json.golden",
+			},
+		},
+		"notFound": {
+			reqFile: "testdata/update_task/ok_req.json.golden",
+			err:     fmt.Errorf("task 1: %w", store.ErrNotFound),
+			want: want{
+				status:  http.StatusNotFound,
+				rspFile: "testdata/update_task/not_found_rsp.json.golden",
+			},
+		},
+		"unknownStatus": {
+			reqFile: "testdata/update_task/ok_req.json.golden",
+			err:     fmt.Errorf("%q: %w", "review", service.ErrUnknownStatus),
+			want: want{
+				status:  http.StatusBadRequest,
+				rspFile: "testdata/update_task/unknown_status_rsp.json.golden",
+			},
+		},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(
+				http.MethodPatch,
+				"/tasks/1",
+				bytes.NewReader(testutil.LoadFile(t, tt.reqFile)),
+			)
+			// chi의 URL 파라미터를 직접 설정한다.
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("id", "1")
+			r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+
+			moq := &UpdateTaskServiceMock{}
+			moq.UpdateTaskFunc = func(
+				ctx context.Context, id entity.TaskID, title *string, status *entity.TaskStatus,
+			) (*entity.Task, error) {
+				if tt.err != nil {
+					return nil, tt.err
+				}
+				return &entity.Task{ID: id, Title: "test1", Status: *status}, nil
+			}
+
+			sut := UpdateTask{
+				Service:   moq,
+				Validator: validator.New(),
+			}
+			sut.ServeHTTP(w, r)
+
+			resp := w.Result()
+			testutil.AssertResponse(t,
+				resp, tt.want.status, testutil.LoadFile(t, tt.want.rspFile),
+			)
+		})
+	}
+}
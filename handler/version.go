@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"expvar"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+/*
+API는 /v1, /v2처럼 버전 접두사를 붙여 제공한다.
+/v2는 /v1과 같은 경로를 제공하되, 응답 형식을 바꿔야 하는 엔드포인트만 다르게 응답한다.
+접두사가 없는 기존 경로는 전환 기간 동안 /v1과 같게 응답하면서, Deprecated 미들웨어로 폐기 예정임을 알린다.
+*/
+
+// legacyRequests는 접두사가 없는 경로로 들어온 요청 수를 "메서드 경로 패턴"별로 센다.
+// GET /admin/metrics의 legacy_requests로 조회할 수 있다.
+var legacyRequests = expvar.NewMap("legacy_requests")
+
+// listResponse는 /v2에서 목록을 감싸는 응답이다. 이후 페이지 정보 등을 추가할 수 있도록 목록을 객체로 감싼다.
+type listResponse struct {
+	Data any `json:"data"`
+}
+
+// Deprecated 함수는 폐기 예정인 경로의 응답에 Deprecation(RFC 9745), Sunset(RFC 8594) 헤더와
+// 경로 앞에 successor를 붙인 후속 버전의 Link 헤더를 추가하고, 사용량을 세는 미들웨어를 반환한다.
+func Deprecated(deprecatedAt, sunset time.Time, successor string) func(next http.Handler) http.Handler {
+	deprecation := fmt.Sprintf("@%d", deprecatedAt.Unix())
+	sunsetAt := sunset.UTC().Format(http.TimeFormat)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Deprecation", deprecation)
+			w.Header().Set("Sunset", sunsetAt)
+			w.Header().Add("Link", fmt.Sprintf(`<%s%s>; rel="successor-version"`, successor, r.URL.Path))
+			next.ServeHTTP(w, r)
+			// 경로 패턴은 라우팅이 끝난 뒤에 알 수 있다.
+			if rctx := chi.RouteContext(r.Context()); rctx != nil {
+				legacyRequests.Add(r.Method+" "+rctx.RoutePattern(), 1)
+			}
+		})
+	}
+}
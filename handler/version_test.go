@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"expvar"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func TestDeprecated(t *testing.T) {
+	deprecatedAt := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+	sunset := time.Date(2027, 5, 1, 9, 0, 0, 0, time.FixedZone("KST", 9*60*60))
+
+	mux := chi.NewRouter()
+	mux.Use(Deprecated(deprecatedAt, sunset, "/v1"))
+	mux.Get("/tasks/{id}/time", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	key := "GET /tasks/{id}/time"
+	before := counter(legacyRequests, key)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/tasks/1/time", nil))
+
+	if w.Code != http.StatusNoContent {
+		t.Errorf("want status %d, but got %d", http.StatusNoContent, w.Code)
+	}
+	for k, want := range map[string]string{
+		"Deprecation": "@1793491200",
+		"Sunset":      "Sat, 01 May 2027 00:00:00 GMT",
+		"Link":        `</v1/tasks/1/time>; rel="successor-version"`,
+	} {
+		if got := w.Header().Get(k); got != want {
+			t.Errorf("want %s %q, but got %q", k, want, got)
+		}
+	}
+	if got := counter(legacyRequests, key); got != before+1 {
+		t.Errorf("want %q count %d, but got %d", key, before+1, got)
+	}
+}
+
+func counter(m *expvar.Map, key string) int64 {
+	if v, ok := m.Get(key).(*expvar.Int); ok {
+		return v.Value()
+	}
+	return 0
+}
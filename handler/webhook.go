@@ -0,0 +1,187 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/service"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+)
+
+type webhook struct {
+	ID           entity.WebhookID   `json:"id"`
+	URL          string             `json:"url"`
+	Events       []entity.EventType `json:"events"`
+	Active       bool               `json:"active"`
+	FailureCount int                `json:"failure_count"`
+	Created      time.Time          `json:"created"`
+}
+
+func newWebhook(w *entity.Webhook) webhook {
+	return webhook{
+		ID:           w.ID,
+		URL:          w.URL,
+		Events:       w.Events,
+		Active:       w.Active,
+		FailureCount: w.FailureCount,
+		Created:      w.Created,
+	}
+}
+
+// webhookIDParam 함수는 URL 경로의 {id}를 WebhookID로 변환한다.
+func webhookIDParam(r *http.Request) (entity.WebhookID, error) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return entity.WebhookID(id), nil
+}
+
+func respondWebhookError(w http.ResponseWriter, r *http.Request, err error) {
+	status := http.StatusInternalServerError
+	if errors.Is(err, store.ErrNotFound) {
+		status = http.StatusNotFound
+	}
+	Respond(w, r, &ErrResponse{
+		Message: err.Error(),
+	}, status)
+}
+
+// AddWebhook은 Webhook을 등록하는 핸들러이다.
+type AddWebhook struct {
+	Service   AddWebhookService
+	Validator *validator.Validate
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, AddWebhook 핸들러의 엔트리 포인트이다. (POST /webhooks)
+func (aw *AddWebhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var b struct {
+		URL    string             `json:"url" validate:"required,http_url,max=2048"`
+		Events []entity.EventType `json:"events" validate:"required,min=1,dive,required"`
+	}
+	if err := decodeBody(r, &b); err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, decodeStatus(err))
+		return
+	}
+	if err := aw.Validator.Struct(b); err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+	wh, err := aw.Service.AddWebhook(ctx, b.URL, b.Events)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, service.ErrUnknownEvent) || errors.Is(err, service.ErrInvalidWebhookURL) {
+			status = http.StatusBadRequest
+		}
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, status)
+		return
+	}
+	// 서명 키는 등록할 때만 응답한다.
+	rsp := struct {
+		webhook
+		Secret string `json:"secret"`
+	}{webhook: newWebhook(wh), Secret: wh.Secret}
+	Respond(w, r, rsp, http.StatusOK)
+}
+
+// ListWebhook은 사용자의 Webhook 목록을 반환하는 핸들러이다.
+type ListWebhook struct {
+	Service ListWebhooksService
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, ListWebhook 핸들러의 엔트리 포인트이다. (GET /webhooks)
+func (lw *ListWebhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	ws, err := lw.Service.ListWebhooks(ctx)
+	if err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusInternalServerError)
+		return
+	}
+	rsp := []webhook{}
+	for _, wh := range ws {
+		rsp = append(rsp, newWebhook(wh))
+	}
+	Respond(w, r, rsp, http.StatusOK)
+}
+
+// ListWebhookDeliveries는 Webhook의 전송 기록을 반환하는 핸들러이다.
+type ListWebhookDeliveries struct {
+	Service ListWebhooksService
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, ListWebhookDeliveries 핸들러의 엔트리 포인트이다. (GET /webhooks/{id}/deliveries)
+func (ld *ListWebhookDeliveries) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id, err := webhookIDParam(r)
+	if err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+	ds, err := ld.Service.ListWebhookDeliveries(ctx, id)
+	if err != nil {
+		respondWebhookError(w, r, err)
+		return
+	}
+	Respond(w, r, ds, http.StatusOK)
+}
+
+// DeleteWebhook은 Webhook을 삭제하는 핸들러이다.
+type DeleteWebhook struct {
+	Service EditWebhookService
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, DeleteWebhook 핸들러의 엔트리 포인트이다. (DELETE /webhooks/{id})
+func (dw *DeleteWebhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id, err := webhookIDParam(r)
+	if err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+	if err := dw.Service.DeleteWebhook(ctx, id); err != nil {
+		respondWebhookError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// EnableWebhook은 비활성화된 Webhook을 다시 활성화하는 핸들러이다.
+type EnableWebhook struct {
+	Service EditWebhookService
+}
+
+// ServeHTTP는 HTTP 요청을 처리하는 메서드로, EnableWebhook 핸들러의 엔트리 포인트이다. (POST /webhooks/{id}/enable)
+func (ew *EnableWebhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id, err := webhookIDParam(r)
+	if err != nil {
+		Respond(w, r, &ErrResponse{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+	wh, err := ew.Service.EnableWebhook(ctx, id)
+	if err != nil {
+		respondWebhookError(w, r, err)
+		return
+	}
+	Respond(w, r, newWebhook(wh), http.StatusOK)
+}
@@ -0,0 +1,27 @@
+package mail
+
+import (
+	"context"
+	"sync"
+)
+
+// Capture는 메일을 전송하지 않고 메모리에 보관한다. 테스트와 로컬 개발에서 사용한다.
+type Capture struct {
+	mu   sync.Mutex
+	msgs []*Message
+}
+
+// Send 메서드는 메일을 보관한다.
+func (c *Capture) Send(ctx context.Context, m *Message) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.msgs = append(c.msgs, m)
+	return nil
+}
+
+// Messages 메서드는 지금까지 보관한 메일을 반환한다.
+func (c *Capture) Messages() []*Message {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]*Message(nil), c.msgs...)
+}
@@ -0,0 +1,158 @@
+package mail
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	netmail "net/mail"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/clock"
+)
+
+// fakeSMTP는 테스트용으로 최소한의 SMTP 명령만 처리하는 서버이다.
+type fakeSMTP struct {
+	l    net.Listener
+	from string
+	rcpt []string
+	data chan string
+}
+
+func startFakeSMTP(t *testing.T) *fakeSMTP {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &fakeSMTP{l: l, data: make(chan string, 1)}
+	t.Cleanup(func() { _ = l.Close() })
+	go s.serve(t)
+	return s
+}
+
+func (s *fakeSMTP) serve(t *testing.T) {
+	conn, err := s.l.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	reply := func(line string) {
+		_, _ = io.WriteString(conn, line+"\r\n")
+	}
+	reply("220 localhost fake smtp")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		cmd := strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+			reply("250 localhost")
+		case strings.HasPrefix(cmd, "MAIL FROM:"):
+			s.from = strings.Trim(strings.TrimPrefix(cmd, "MAIL FROM:"), "<>")
+			reply("250 OK")
+		case strings.HasPrefix(cmd, "RCPT TO:"):
+			s.rcpt = append(s.rcpt, strings.Trim(strings.TrimPrefix(cmd, "RCPT TO:"), "<>"))
+			reply("250 OK")
+		case cmd == "DATA":
+			reply("354 end with <CRLF>.<CRLF>")
+			var b strings.Builder
+			for {
+				l, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if l == ".\r\n" {
+					break
+				}
+				b.WriteString(strings.TrimPrefix(l, "."))
+			}
+			s.data <- b.String()
+			reply("250 OK")
+		case cmd == "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("502 not implemented")
+		}
+	}
+}
+
+func TestSMTP_Send(t *testing.T) {
+	t.Parallel()
+
+	srv := startFakeSMTP(t)
+	host, port, err := net.SplitHostPort(srv.l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, _ := strconv.Atoi(port)
+	sut := &SMTP{Host: host, Port: p, From: "todo@example.com", Clocker: clock.FixedClocker{}}
+
+	msg, err := Render(TemplateAssigned, "alice@example.com", map[string]any{
+		"Name": "alice", "Message": `task "<b>release</b>" was assigned to you`, "TaskID": 1, "BaseURL": "http://todo.test",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := sut.Send(ctx, msg); err != nil {
+		t.Fatalf("want no error, but got %v", err)
+	}
+
+	var raw string
+	select {
+	case raw = <-srv.data:
+	case <-ctx.Done():
+		t.Fatal("message is not delivered")
+	}
+	if srv.from != "todo@example.com" || len(srv.rcpt) != 1 || srv.rcpt[0] != "alice@example.com" {
+		t.Errorf("unexpected envelope: from %q, rcpt %q", srv.from, srv.rcpt)
+	}
+	m, err := netmail.ReadMessage(strings.NewReader(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := m.Header.Get("Subject"); got != "[todo] A task was assigned to you" {
+		t.Errorf("unexpected subject %q", got)
+	}
+	mt, params, err := mime.ParseMediaType(m.Header.Get("Content-Type"))
+	if err != nil || mt != "multipart/alternative" {
+		t.Fatalf("unexpected content type %q: %v", m.Header.Get("Content-Type"), err)
+	}
+	parts := map[string]string{}
+	mr := multipart.NewReader(m.Body, params["boundary"])
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		// multipart.Reader는 quoted-printable 본문을 자동으로 디코딩한다.
+		b, err := io.ReadAll(part)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ct, _, _ := mime.ParseMediaType(part.Header.Get("Content-Type"))
+		parts[ct] = string(b)
+	}
+	if !strings.Contains(parts["text/plain"], `task "<b>release</b>" was assigned to you`) {
+		t.Errorf("unexpected text part: %q", parts["text/plain"])
+	}
+	// HTML 본문에서는 값이 이스케이프되어야 한다.
+	if !strings.Contains(parts["text/html"], "&lt;b&gt;release&lt;/b&gt;") ||
+		!strings.Contains(parts["text/html"], `href="http://todo.test/tasks/1"`) {
+		t.Errorf("unexpected html part: %q", parts["text/html"])
+	}
+}
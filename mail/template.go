@@ -0,0 +1,48 @@
+package mail
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+)
+
+// 메일 템플릿 이름
+const (
+	TemplateAssigned      = "assigned"
+	TemplateReminder      = "reminder"
+	TemplatePasswordReset = "password_reset"
+)
+
+// 각 템플릿은 <name>.txt (subject와 text 블록)와 <name>.html (html 블록)으로 구성된다.
+//
+//go:embed templates/*
+var templateFS embed.FS
+
+var (
+	textTemplates = texttemplate.Must(texttemplate.ParseFS(templateFS, "templates/*.txt"))
+	htmlTemplates = htmltemplate.Must(htmltemplate.ParseFS(templateFS, "templates/*.html"))
+)
+
+// Render 함수는 템플릿 name에 data를 적용해 수신자 to에게 보낼 Message를 생성한다.
+// HTML 본문은 html/template으로 생성하므로 data의 값은 자동으로 이스케이프된다.
+func Render(name string, to string, data any) (*Message, error) {
+	var subject, text, html bytes.Buffer
+	if err := textTemplates.ExecuteTemplate(&subject, name+".subject", data); err != nil {
+		return nil, fmt.Errorf("failed to render subject of %s: %w", name, err)
+	}
+	if err := textTemplates.ExecuteTemplate(&text, name+".text", data); err != nil {
+		return nil, fmt.Errorf("failed to render text of %s: %w", name, err)
+	}
+	if err := htmlTemplates.ExecuteTemplate(&html, name+".html", data); err != nil {
+		return nil, fmt.Errorf("failed to render html of %s: %w", name, err)
+	}
+	return &Message{
+		To:      []string{to},
+		Subject: strings.TrimSpace(subject.String()),
+		Text:    text.String(),
+		HTML:    html.String(),
+	}, nil
+}
@@ -6,6 +6,7 @@ import (
 	"log"
 	"net"
 	"os"
+	_ "time/tzdata" // 배포용 이미지에 타임존 정보가 없어도 tz 파라미터를 해석할 수 있도록 한다.
 
 	"github.com/gitwub5/go_todo_app/config"
 )
@@ -28,12 +29,20 @@ func run(ctx context.Context) error {
 	}
 	url := fmt.Sprintf("http://%s", l.Addr().String())
 	log.Printf("start with: %v", url)
-	mux, cleanup, err := NewMux(ctx, cfg) // NewMux 함수를 사용하여 라우터를 생성한다.
+	mux, gs, cleanup, err := NewMux(ctx, cfg) // NewMux 함수를 사용하여 라우터를 생성한다.
 	// 오류가 반환돼도 cleanup 함수는 호출된다.
 	defer cleanup()
 	if err != nil {
 		return err
 	}
 	s := NewServer(l, mux) // NewServer 함수를 사용하여 서버를 생성한다.
-	return s.Run(ctx)      // Run 메서드를 사용하여 서버를 실행한다.
+	if gs != nil {
+		gl, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.GRPCPort))
+		if err != nil {
+			return fmt.Errorf("failed to listen grpc port %d: %w", cfg.GRPCPort, err)
+		}
+		log.Printf("start grpc with: %v", gl.Addr().String())
+		s.ServeGRPC(gl, gs)
+	}
+	return s.Run(ctx) // Run 메서드를 사용하여 서버를 실행한다.
 }
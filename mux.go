@@ -2,24 +2,57 @@ package main
 
 import (
 	"context"
+	"expvar"
+	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gitwub5/go_todo_app/auth"
 	"github.com/gitwub5/go_todo_app/clock"
 	"github.com/gitwub5/go_todo_app/config"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/graph"
 	"github.com/gitwub5/go_todo_app/handler"
+	"github.com/gitwub5/go_todo_app/mail"
+	"github.com/gitwub5/go_todo_app/openapi"
+	"github.com/gitwub5/go_todo_app/quickadd"
+	"github.com/gitwub5/go_todo_app/rpc"
 	"github.com/gitwub5/go_todo_app/service"
 	"github.com/gitwub5/go_todo_app/store"
+	"github.com/gitwub5/go_todo_app/stream"
+	"github.com/gitwub5/go_todo_app/webhook"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-playground/validator/v10"
+	"google.golang.org/grpc"
 )
 
-// context.Context와 *config.Config를 인자로 받고, http.Handler와 cleanup 함수를 반환
-func NewMux(ctx context.Context, cfg *config.Config) (http.Handler, func(), error) {
+// context.Context와 *config.Config를 인자로 받고, http.Handler와 gRPC 서버, cleanup 함수를 반환
+// cfg.GRPCPort가 0이면 gRPC 서버는 nil이다.
+func NewMux(ctx context.Context, cfg *config.Config) (http.Handler, *grpc.Server, func(), error) {
 	mux := chi.NewRouter()
 
+	// API 명세를 읽고, 설정되어 있으면 모든 요청을 명세로 검증한다. (미들웨어는 경로보다 먼저 등록해야 한다)
+	doc, err := openapi.Load(ctx)
+	if err != nil {
+		return nil, nil, func() {}, err
+	}
+	if cfg.OpenAPIValidation {
+		ov, err := openapi.NewValidator(doc)
+		if err != nil {
+			return nil, nil, func() {}, err
+		}
+		ov.ValidateResponse = cfg.Env == "dev" || cfg.Env == "test"
+		mux.Use(ov.Middleware)
+	}
+	// GET /openapi.json 요청을 처리하는 핸들러 등록
+	oh, err := openapi.Handler(doc)
+	if err != nil {
+		return nil, nil, func() {}, err
+	}
+	mux.Method(http.MethodGet, "/openapi.json", oh)
+
 	// /health 요청을 처리하는 핸들러 등록
-	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+	mux.Get("/health", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		_, _ = w.Write([]byte(`{"status": "ok"}`))
 	})
@@ -30,7 +63,7 @@ func NewMux(ctx context.Context, cfg *config.Config) (http.Handler, func(), erro
 	// 데이터베이스 연결 및 정리 함수 생성
 	db, cleanup, err := store.New(ctx, cfg)
 	if err != nil {
-		return nil, cleanup, err
+		return nil, nil, cleanup, err
 	}
 
 	// 실제 시간 시계 사용하여 Repository를 생성
@@ -39,22 +72,77 @@ func NewMux(ctx context.Context, cfg *config.Config) (http.Handler, func(), erro
 	// Redis 클라이언트 생성
 	rcli, err := store.NewKVS(ctx, cfg)
 	if err != nil {
-		return nil, cleanup, err
+		return nil, nil, cleanup, err
 	}
 	// JWTer 생성
 	jwter, err := auth.NewJWTer(rcli, clocker)
 	if err != nil {
-		return nil, cleanup, err
+		return nil, nil, cleanup, err
+	}
+	jwter.AccessTokenTTL = cfg.AccessTokenTTL
+	jwter.RefreshTokenTTL = cfg.RefreshTokenTTL
+	jwter.Issuer, jwter.Audience, jwter.ClockSkew = cfg.JWTIssuer, cfg.JWTAudience, cfg.JWTClockSkew
+	// 서명 키 디렉터리가 설정되어 있으면 그 키를 사용하고, 운영자가 키를 교체하면 주기적으로 다시 읽는다.
+	if cfg.JWTKeyDir != "" {
+		kd := auth.KeyDir(cfg.JWTKeyDir)
+		ks, err := kd.Load()
+		if err != nil {
+			return nil, nil, cleanup, fmt.Errorf("failed to load signing keys: %w", err)
+		}
+		jwter.SetKeys(ks)
+		if cfg.JWTKeyReloadInterval > 0 {
+			kctx, cancel := context.WithCancel(ctx)
+			go jwter.WatchKeys(kctx, kd, cfg.JWTKeyReloadInterval)
+			prev := cleanup
+			cleanup = func() {
+				cancel()
+				prev()
+			}
+		}
 	}
+	// GET /.well-known/jwks.json 요청을 처리하는 핸들러. 키를 다시 읽는 주기 동안 캐시할 수 있다.
+	mux.Method(http.MethodGet, "/.well-known/jwks.json", &handler.JWKS{Service: jwter, MaxAge: cfg.JWTKeyReloadInterval})
 
-	// POST /register 요청을 처리하는 핸들러 등록
+	// 서비스 계층의 이벤트를 알림함에 저장하고, SMTP가 설정되어 있으면 메일로도 보내는 Notifier
+	var notifier service.Notifier = &service.Inbox{DB: db, Repo: &r}
+	var mailer service.Mailer
+	// 알림함에 직접 등록하는 마감 초과 알림은 메일로만 전달한다.
+	var mailNotifier service.Notifier
+	if cfg.SMTPHost != "" {
+		mailer = mail.NewSMTP(cfg, clocker)
+		mailNotifier = &service.MailNotifier{DB: db, Repo: &r, Mailer: mailer, BaseURL: cfg.BaseURL}
+		notifier = service.Notifiers{notifier, mailNotifier}
+	}
+	// Task 변경 이벤트를 등록된 Webhook으로 전송하는 Dispatcher
+	whd := &webhook.Dispatcher{
+		DB:      db,
+		Repo:    &r,
+		Client:  webhook.NewClient(10 * time.Second),
+		Clocker: clocker,
+	}
+	// Task 변경 이벤트를 Redis Pub/Sub을 거쳐 모든 서버 인스턴스의 GET /events 구독자에게 전달하는 Broker
+	sctx, stopStream := context.WithCancel(ctx)
+	broker := &stream.Broker{Backend: rcli, Clocker: clocker, ReplaySize: cfg.EventReplaySize}
+	if err := broker.Start(sctx); err != nil {
+		stopStream()
+		return nil, nil, cleanup, err
+	}
+	pub := service.Publishers{whd, broker}
+	// 전송 중인 Webhook이 전송 기록을 남길 수 있도록 DB 연결을 닫기 전에 기다린다.
+	dbCleanup := cleanup
+	cleanup = func() {
+		stopStream()
+		whd.Close()
+		dbCleanup()
+	}
+
+	// POST /register 요청을 처리하는 핸들러
 	ru := &handler.RegisterUser{
 		Service:   &service.RegisterUser{DB: db, Repo: &r},
 		Validator: v,
 	}
-	mux.Post("/register", ru.ServeHTTP)
 
-	// POST /login 요청을 처리하는 핸들러 등록
+	// POST /login 요청을 처리하는 핸들러
 	l := &handler.Login{
 		Service: &service.Login{
 			DB:             db,
@@ -63,32 +151,392 @@ func NewMux(ctx context.Context, cfg *config.Config) (http.Handler, func(), erro
 		},
 		Validator: v,
 	}
-	mux.Post("/login", l.ServeHTTP)
+
+	// POST /token/refresh 요청을 처리하는 핸들러
+	rt := &handler.RefreshToken{
+		Service:   &service.RefreshToken{DB: db, Repo: &r, Tokens: jwter, Sessions: rcli},
+		Validator: v,
+	}
+
+	// POST /logout, GET·DELETE /sessions 요청을 처리하는 핸들러
+	lo := &handler.Logout{Service: jwter}
+	sess := &service.Sessions{Store: rcli}
+	lss := &handler.ListSessions{Service: sess}
+	dss := &handler.DeleteSession{Service: sess}
+	das := &handler.DeleteSessions{Service: sess}
+
+	// POST, GET /tokens, DELETE /tokens/{id} 요청을 처리하는 핸들러
+	pats := &service.PersonalAccessTokens{DB: db, Repo: &r, Clocker: clocker}
+	cpt := &handler.CreatePersonalAccessToken{Service: pats, Validator: v}
+	lpt := &handler.ListPersonalAccessTokens{Service: pats}
+	dpt := &handler.DeletePersonalAccessToken{Service: pats}
+	// 서드파티 애플리케이션을 위한 OAuth 2.0 인가 서버. 인가 코드는 Redis에 짧은 시간 동안 보관하고, 토큰은 JWTer가 발급한다.
+	oauth := &service.OAuth{
+		DB: db, Repo: &r, Codes: rcli, Tokens: jwter,
+		CodeTTL: cfg.OAuthCodeTTL, TokenTTL: jwter.AccessTokenTTL,
+	}
+	crc := &handler.CreateOAuthClient{Service: oauth, Validator: v}
+	lsc := &handler.ListOAuthClients{Service: oauth}
+	dlc := &handler.DeleteOAuthClient{Service: oauth}
+	oaz := &handler.Authorize{Service: oauth}
+	oap := &handler.ApproveAuthorization{Service: oauth}
+	otk := &handler.OAuthToken{Service: oauth}
+	orv := &handler.RevokeOAuthToken{Service: oauth}
+	oin := &handler.IntrospectOAuthToken{Service: oauth}
+	// 스크립트와 CI가 사용하는 경로는 JWT와 개인 액세스 토큰, OAuth 클라이언트의 토큰을 모두 받고, 경로마다 토큰에 필요한 범위를 확인한다.
+	// 토큰 관리처럼 범위를 정하지 않은 경로는 로그인해서 발급한 JWT만 받는다.
+	authn := &auth.Authenticator{JWT: jwter, PersonalAccessTokens: pats}
+	read, write := handler.RequireScope(entity.ScopeTasksRead), handler.RequireScope(entity.ScopeTasksWrite)
+
+	// POST /password/forgot, /password/reset 요청을 처리하는 핸들러 (메일을 보낼 수 있을 때만)
+	var (
+		fp  *handler.ForgotPassword
+		rsp *handler.ResetPassword
+	)
+	if mailer != nil {
+		prs := &service.PasswordReset{DB: db, Repo: &r, Store: rcli, Sessions: rcli, Mailer: mailer}
+		fp = &handler.ForgotPassword{Service: prs, Validator: v}
+		rsp = &handler.ResetPassword{Service: prs, Validator: v}
+	}
 
 	// POST /tasks 요청을 처리하는 핸들러
+	qp := &quickadd.Parser{Clocker: clocker}
 	at := &handler.AddTask{
-		Service:   &service.AddTask{DB: db, Repo: &r},
+		Service:   &service.AddTask{DB: db, Repo: &r, Publisher: pub},
+		Parser:    qp,
 		Validator: v,
 	}
-	// GET /tasks 요청 처리하는 핸들러
+	// POST /tasks/parse 요청 처리하는 핸들러
+	pt := &handler.ParseTask{Parser: qp, Validator: v}
+	// GET /tasks 요청 처리하는 핸들러 (/v2는 목록을 객체로 감싸 응답한다)
 	lt := &handler.ListTask{
 		Service: &service.ListTask{DB: db, Repo: &r},
 	}
+	lt2 := &handler.ListTask{Service: lt.Service, Envelope: true}
+
+	// PATCH /tasks/{id} 요청 처리하는 핸들러
+	ut := &handler.UpdateTask{
+		Service:   &service.UpdateTask{DB: db, Repo: &r, Publisher: pub},
+		Validator: v,
+	}
+
+	// DELETE /tasks/{id} 요청 처리하는 핸들러
+	dt := &handler.DeleteTask{
+		Service: &service.DeleteTask{DB: db, Repo: &r, Publisher: pub},
+	}
+
+	// PUT, DELETE /tasks/{id}/assignee 요청 처리하는 핸들러
+	asvc := &service.AssignTask{DB: db, Repo: &r, Notifier: notifier, Publisher: pub}
+	ast := &handler.AssignTask{Service: asvc, Validator: v}
+	ust := &handler.UnassignTask{Service: asvc}
+
+	// 프로젝트 관련 핸들러. 프로젝트 멤버끼리는 서로에게 Task를 담당자로 지정할 수 있다.
+	pjs := &service.Projects{DB: db, Repo: &r, Publisher: pub}
+	apj := &handler.AddProject{Service: pjs, Validator: v}
+	lpj := &handler.ListProjects{Service: pjs}
+	lpm := &handler.ListProjectMembers{Service: pjs}
+	apm := &handler.AddProjectMember{Service: pjs, Validator: v}
+	dpm := &handler.DeleteProjectMember{Service: pjs}
+	stp := &handler.SetTaskProject{Service: pjs, Validator: v}
+	utp := &handler.UnsetTaskProject{Service: pjs}
+
+	// 작업 시간 기록 관련 핸들러
+	sta := &handler.StartTimer{
+		Service: &service.StartTimer{DB: db, Repo: &r, Clocker: clocker},
+	}
+	sto := &handler.StopTimer{
+		Service: &service.StopTimer{DB: db, Repo: &r, Clocker: clocker},
+	}
+	ate := &handler.AddTimeEntry{
+		Service:   &service.AddTimeEntry{DB: db, Repo: &r},
+		Validator: v,
+	}
+	gtt := &handler.GetTaskTime{
+		Service: &service.GetTaskTime{DB: db, Repo: &r, Clocker: clocker},
+	}
+
+	// 알림 관련 핸들러
+	lnf := &handler.ListNotification{
+		Service: &service.ListNotification{DB: db, Repo: &r},
+	}
+	mns := &service.MarkNotification{DB: db, Repo: &r}
+	mnr := &handler.MarkNotificationRead{Service: mns}
+	man := &handler.MarkAllNotificationsRead{Service: mns}
+
+	// 마감 시간이 지난 Task를 주기적으로 확인해 알림을 보낸다.
+	if cfg.OverdueCheckInterval > 0 {
+		octx, cancel := context.WithCancel(ctx)
+		no := &service.NotifyOverdue{DB: db, Repo: &r, Notifier: mailNotifier, Clocker: clocker}
+		go no.Run(octx, cfg.OverdueCheckInterval)
+		prev := cleanup
+		cleanup = func() {
+			cancel()
+			prev()
+		}
+	}
+
+	// GET, PUT /mail/preferences 요청 처리하는 핸들러
+	mps := &service.MailPreference{DB: db, Repo: &r}
+	gmp := &handler.GetMailPreference{Service: mps}
+	ump := &handler.UpdateMailPreference{Service: mps, Validator: v}
+
+	// Webhook 관련 핸들러
+	awh := &handler.AddWebhook{
+		Service:   &service.AddWebhook{DB: db, Repo: &r},
+		Validator: v,
+	}
+	lws := &service.ListWebhook{DB: db, Repo: &r}
+	lwh := &handler.ListWebhook{Service: lws}
+	lwd := &handler.ListWebhookDeliveries{Service: lws}
+	ews := &service.EditWebhook{DB: db, Repo: &r}
+	dwh := &handler.DeleteWebhook{Service: ews}
+	ewh := &handler.EnableWebhook{Service: ews}
+
+	// GET, POST /sync 요청 처리하는 핸들러
+	sync := &service.Sync{DB: db, Repo: &r, Publisher: pub}
+	pls := &handler.PullSync{Service: sync}
+	phs := &handler.PushSync{Service: sync, Validator: v}
+
+	// GET /events 요청 처리하는 핸들러
+	evs := &handler.EventStream{Service: broker, Heartbeat: cfg.EventHeartbeatInterval}
+
+	// GET /ws 요청 처리하는 핸들러 (인증은 WebSocket 연결 안에서 처리한다)
+	ws := &handler.Collab{
+		Auth:   jwter,
+		Events: broker,
+		Presence: &service.Presence{
+			DB: db, Repo: &r, Store: rcli, Clocker: clocker,
+			// 갱신 주기를 한 번 놓쳐도 presence가 사라지지 않도록 한다.
+			TTL: 2 * cfg.EventHeartbeatInterval,
+		},
+		Interval:       cfg.EventHeartbeatInterval,
+		OriginPatterns: cfg.WSOriginPatterns,
+	}
+
+	// GET /mywork 요청 처리하는 핸들러
+	lw := &handler.ListWork{
+		Service: &service.ListTask{DB: db, Repo: &r},
+	}
+
+	// POST /graphql 요청 처리하는 핸들러 (REST 핸들러와 같은 서비스를 사용한다)
+	gs, err := graph.NewSchema(&graph.Resolver{
+		DB:       db,
+		Repo:     &r,
+		Lister:   &service.ListTask{DB: db, Repo: &r},
+		Projects: pjs,
+		Adder:    at.Service,
+		Updater:  ut.Service,
+		Assigner: asvc,
+	})
+	if err != nil {
+		return nil, nil, cleanup, err
+	}
+	gs.MaxDepth = cfg.GraphQLMaxDepth
+	gs.MaxComplexity = cfg.GraphQLMaxComplexity
+	gql := &handler.GraphQL{Executor: gs, Validator: v}
+
+	// GET /timesheet 요청 처리하는 핸들러
+	gts := &handler.GetTimesheet{
+		Service: &service.GetTimesheet{DB: db, Repo: &r, Clocker: clocker},
+	}
+
+	// 템플릿 관련 핸들러
+	atp := &handler.AddTemplate{
+		Service:   &service.AddTemplate{DB: db, Repo: &r},
+		Validator: v,
+	}
+	ltp := &handler.ListTemplate{
+		Service: &service.ListTemplate{DB: db, Repo: &r},
+	}
+	itp := &handler.InstantiateTemplate{
+		Service:   &service.InstantiateTemplate{DB: db, Repo: &r, Publisher: pub},
+		Validator: v,
+	}
+
+	// GET, POST /statuses 요청을 처리하는 핸들러
+	ls := &handler.ListTaskStatus{
+		Service: &service.ListTaskStatus{DB: db, Repo: &r},
+	}
+	as := &handler.AddTaskStatus{
+		Service:   &service.AddTaskStatus{DB: db, Repo: &r},
+		Validator: v,
+	}
+
+	// routes 함수는 version의 API 경로를 등록한 라우터를 만든다.
+	// 버전마다 같은 경로를 등록하고, 응답 형식이 바뀐 엔드포인트만 버전에 맞는 핸들러를 사용한다.
+	routes := func(version int) chi.Router {
+		api := chi.NewRouter()
+		api.Post("/register", ru.ServeHTTP) // POST /register 요청을 처리하는 핸들러 등록
+		api.Post("/login", l.ServeHTTP)     // POST /login 요청을 처리하는 핸들러 등록
+		api.Post("/token/refresh", rt.ServeHTTP)
+		api.With(handler.AuthMiddleware(jwter)).Post("/logout", lo.ServeHTTP)
+		if mailer != nil {
+			api.Post("/password/forgot", fp.ServeHTTP)
+			api.Post("/password/reset", rsp.ServeHTTP)
+		}
+
+		api.Route("/tasks", func(r chi.Router) {
+			r.Use(handler.AuthMiddleware(authn)) // /tasks 하위 모든 요청에 대해 인증 미들웨어 적용
+			r.Group(func(r chi.Router) {
+				r.Use(read)
+				if version >= 2 { // GET /tasks 요청 처리하는 핸들러 등록
+					r.Get("/", lt2.ServeHTTP)
+				} else {
+					r.Get("/", lt.ServeHTTP)
+				}
+				r.Post("/parse", pt.ServeHTTP) // POST /tasks/parse 요청 처리하는 핸들러 등록
+				r.Get("/{id}/time", gtt.ServeHTTP)
+			})
+			r.Group(func(r chi.Router) {
+				r.Use(write)
+				r.Post("/", at.ServeHTTP)       // POST /tasks 요청을 처리하는 핸들러 등록
+				r.Patch("/{id}", ut.ServeHTTP)  // PATCH /tasks/{id} 요청 처리하는 핸들러 등록
+				r.Delete("/{id}", dt.ServeHTTP) // DELETE /tasks/{id} 요청 처리하는 핸들러 등록
+				r.Put("/{id}/assignee", ast.ServeHTTP)
+				r.Delete("/{id}/assignee", ust.ServeHTTP)
+				r.Put("/{id}/project", stp.ServeHTTP)
+				r.Delete("/{id}/project", utp.ServeHTTP)
+				r.Post("/{id}/timer/start", sta.ServeHTTP)
+				r.Post("/{id}/timer/stop", sto.ServeHTTP)
+				r.Post("/{id}/time", ate.ServeHTTP)
+			})
+		})
+
+		api.Route("/sessions", func(r chi.Router) {
+			r.Use(handler.AuthMiddleware(jwter))
+			r.Get("/", lss.ServeHTTP)
+			r.Delete("/", das.ServeHTTP) // 모든 기기에서 로그아웃
+			r.Delete("/{id}", dss.ServeHTTP)
+		})
+
+		api.Route("/tokens", func(r chi.Router) {
+			r.Use(handler.AuthMiddleware(jwter))
+			r.Post("/", cpt.ServeHTTP)
+			r.Get("/", lpt.ServeHTTP)
+			r.Delete("/{id}", dpt.ServeHTTP)
+		})
+
+		api.Route("/notifications", func(r chi.Router) {
+			r.Use(handler.AuthMiddleware(jwter))
+			r.Get("/", lnf.ServeHTTP)
+			r.Post("/{id}/read", mnr.ServeHTTP)
+			r.Post("/read-all", man.ServeHTTP)
+		})
+
+		api.Route("/mail/preferences", func(r chi.Router) {
+			r.Use(handler.AuthMiddleware(jwter))
+			r.Get("/", gmp.ServeHTTP)
+			r.Put("/", ump.ServeHTTP)
+		})
+
+		api.Route("/webhooks", func(r chi.Router) {
+			r.Use(handler.AuthMiddleware(jwter))
+			r.Post("/", awh.ServeHTTP)
+			r.Get("/", lwh.ServeHTTP)
+			r.Delete("/{id}", dwh.ServeHTTP)
+			r.Post("/{id}/enable", ewh.ServeHTTP)
+			r.Get("/{id}/deliveries", lwd.ServeHTTP)
+		})
+
+		api.Route("/sync", func(r chi.Router) {
+			r.Use(handler.AuthMiddleware(authn), read)
+			r.Get("/", pls.ServeHTTP)
+			r.With(write).Post("/", phs.ServeHTTP)
+		})
+
+		api.Route("/events", func(r chi.Router) {
+			r.Use(handler.AuthMiddleware(authn), read)
+			r.Get("/", evs.ServeHTTP)
+		})
 
-	mux.Route("/tasks", func(r chi.Router) {
-		r.Use(handler.AuthMiddleware(jwter)) // /tasks 하위 모든 요청에 대해 인증 미들웨어 적용
-		r.Post("/", at.ServeHTTP)            // POST /tasks 요청을 처리하는 핸들러 등록
-		r.Get("/", lt.ServeHTTP)             // GET /tasks 요청 처리하는 핸들러 등록
+		api.Get("/ws", ws.ServeHTTP)
+
+		api.Route("/mywork", func(r chi.Router) {
+			r.Use(handler.AuthMiddleware(authn), read)
+			r.Get("/", lw.ServeHTTP)
+		})
+
+		// 요청 본문을 실행하기 전에는 조회인지 변경인지 알 수 없으므로 두 범위가 모두 필요하다.
+		api.Route("/graphql", func(r chi.Router) {
+			r.Use(handler.AuthMiddleware(authn), read, write)
+			r.Post("/", gql.ServeHTTP)
+		})
+
+		api.Route("/timesheet", func(r chi.Router) {
+			r.Use(handler.AuthMiddleware(authn), read)
+			r.Get("/", gts.ServeHTTP)
+		})
+
+		api.Route("/templates", func(r chi.Router) {
+			r.Use(handler.AuthMiddleware(jwter))
+			r.Post("/", atp.ServeHTTP)
+			r.Get("/", ltp.ServeHTTP)
+			r.Post("/{id}/instantiate", itp.ServeHTTP)
+		})
+
+		api.Route("/projects", func(r chi.Router) {
+			r.Use(handler.AuthMiddleware(jwter))
+			r.Post("/", apj.ServeHTTP)
+			r.Get("/", lpj.ServeHTTP)
+			r.Get("/{id}/members", lpm.ServeHTTP)
+			r.Post("/{id}/members", apm.ServeHTTP)
+			r.Delete("/{id}/members/{user_id}", dpm.ServeHTTP)
+		})
+
+		api.Route("/statuses", func(r chi.Router) {
+			r.Use(handler.AuthMiddleware(jwter))
+			r.Get("/", ls.ServeHTTP)
+			r.Post("/", as.ServeHTTP)
+		})
+
+		// /admin 권한 사용자만 접속할 수 있는 엔드포인트
+		api.Route("/admin", func(r chi.Router) {
+			r.Use(handler.AuthMiddleware(jwter), handler.AdminMiddleware)
+			r.Get("/", func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json; charset=utf-8")
+				_, _ = w.Write([]byte(`{"message": "admin only"}`))
+			})
+			// 접두사가 없는 경로의 사용량(legacy_requests) 등 expvar로 공개한 지표를 조회한다.
+			r.Get("/metrics", expvar.Handler().ServeHTTP)
+		})
+		return api
+	}
+	v1 := routes(1)
+	mux.Mount("/v1", v1)
+	mux.Mount("/v2", routes(2))
+	// 접두사가 없는 기존 경로는 전환 기간 동안 /v1과 같게 응답하고, 폐기 예정임을 헤더로 알린다.
+	mux.Group(func(r chi.Router) {
+		r.Use(handler.Deprecated(cfg.LegacyDeprecatedAt, cfg.LegacySunset, "/v1"))
+		r.Mount("/", v1)
 	})
 
-	// /admin 권한 사용자만 접속할 수 있는 엔드포인트
-	mux.Route("/admin", func(r chi.Router) {
-		r.Use(handler.AuthMiddleware(jwter), handler.AdminMiddleware)
-		r.Get("/", func(w http.ResponseWriter, r *http.Request) {
-			w.Header().Set("Content-Type", "application/json; charset=utf-8")
-			_, _ = w.Write([]byte(`{"message": "admin only"}`))
+	// OAuth 2.0 엔드포인트는 클라이언트에 설정하는 URL이므로 버전 접두사 없이 제공한다.
+	mux.Method(http.MethodGet, "/.well-known/oauth-authorization-server", &handler.OAuthMetadata{Issuer: cfg.BaseURL})
+	mux.Route("/oauth", func(r chi.Router) {
+		r.Get("/authorize", oaz.ServeHTTP)  // 동의 화면
+		r.Post("/authorize", oap.ServeHTTP) // 동의 화면의 승인, 거부
+		r.Post("/token", otk.ServeHTTP)
+		r.Post("/revoke", orv.ServeHTTP)
+		r.Post("/introspect", oin.ServeHTTP)
+		r.Route("/clients", func(r chi.Router) {
+			r.Use(handler.AuthMiddleware(jwter))
+			r.Post("/", crc.ServeHTTP)
+			r.Get("/", lsc.ServeHTTP)
+			r.Delete("/{id}", dlc.ServeHTTP)
 		})
 	})
 
-	return mux, cleanup, nil
+	// 내부 서비스를 위한 gRPC 서버 (REST 핸들러와 같은 서비스를 사용한다)
+	var rs *grpc.Server
+	if cfg.GRPCPort > 0 {
+		rs = rpc.NewServer(jwter, &rpc.TaskServer{
+			Lister:  &service.ListTask{DB: db, Repo: &r},
+			Getter:  &service.GetTask{DB: db, Repo: &r},
+			Adder:   at.Service,
+			Updater: ut.Service,
+			Deleter: dt.Service,
+		})
+	}
+
+	return mux, rs, cleanup, nil
 }
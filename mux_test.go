@@ -0,0 +1,331 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/client"
+	"github.com/gitwub5/go_todo_app/config"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/handler"
+	"github.com/gitwub5/go_todo_app/openapi"
+	"github.com/gitwub5/go_todo_app/testutil"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/go-cmp/cmp"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+)
+
+// NewMux에 등록된 모든 경로가 API 명세에 있고, 명세의 모든 경로가 등록되어 있는지 확인한다.
+func TestNewMux_OpenAPI(t *testing.T) {
+	testutil.OpenDBForTest(t)
+	testutil.OpenRedisForTest(t)
+	cfg, err := config.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 메일 설정이 있을 때만 등록되는 경로도 확인한다.
+	cfg.SMTPHost = "localhost"
+	cfg.OverdueCheckInterval = 0
+	cfg.OpenAPIValidation = true
+	ctx := context.Background()
+	mux, _, cleanup, err := NewMux(ctx, cfg)
+	t.Cleanup(cleanup)
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc, err := openapi.Load(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 명세의 경로는 /v1 기준이므로 명세에 따로 설명한 /v2 경로가 아니면 버전 접두사를 떼고 찾는다.
+	described := func(method, route string) bool {
+		if p := doc.Paths.Find(route); p != nil && p.GetOperation(method) != nil {
+			return true
+		}
+		for _, prefix := range []string{"/v1", "/v2"} {
+			if rest, ok := strings.CutPrefix(route, prefix); ok && rest != "" {
+				p := doc.Paths.Find(rest)
+				return p != nil && p.GetOperation(method) != nil
+			}
+		}
+		return false
+	}
+	registered := map[string]bool{}
+	walk := func(method, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+		if len(route) > 1 {
+			route = strings.TrimSuffix(route, "/")
+		}
+		registered[method+" "+route] = true
+		if !described(method, route) {
+			t.Errorf("%s %s is not described in openapi.yaml", method, route)
+		}
+		return nil
+	}
+	if err := chi.Walk(mux.(chi.Routes), walk); err != nil {
+		t.Fatal(err)
+	}
+	for path, item := range doc.Paths.Map() {
+		// 버전이 없는 경로와 /v2에만 있는 경로를 제외하면 접두사가 없는 경로, /v1, /v2 모두 등록되어 있어야 한다.
+		paths := []string{path}
+		if path != "/health" && path != "/openapi.json" && path != "/.well-known/jwks.json" &&
+			path != "/.well-known/oauth-authorization-server" && !strings.HasPrefix(path, "/oauth/") && !strings.HasPrefix(path, "/v2/") {
+			paths = append(paths, "/v1"+path, "/v2"+path)
+		}
+		for method := range item.Operations() {
+			for _, p := range paths {
+				if !registered[method+" "+p] {
+					t.Errorf("%s %s in openapi.yaml is not registered", method, p)
+				}
+			}
+		}
+	}
+
+	// 명세와 다른 요청은 인증보다 먼저 거부한다.
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/tasks?assignee=you", nil)
+	mux.ServeHTTP(w, r)
+	testutil.AssertResponse(t, w.Result(), http.StatusBadRequest, []byte(
+		`{"message": "request does not match the API specification", "details": ["query.assignee: value is not one of the allowed values [\"me\"]"]}`,
+	))
+
+	// 접두사가 없는 경로는 폐기 예정임을 헤더로 알린다.
+	for target, deprecated := range map[string]bool{"/tasks": true, "/v1/tasks": false, "/health": false} {
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, target, nil))
+		if got := w.Header().Get("Deprecation") != ""; got != deprecated {
+			t.Errorf("%s: want deprecated %t, but got %t", target, deprecated, got)
+		}
+		if deprecated {
+			if want, got := `</v1/tasks>; rel="successor-version"`, w.Header().Get("Link"); got != want {
+				t.Errorf("%s: want Link %q, but got %q", target, want, got)
+			}
+		}
+	}
+}
+
+// client 패키지로 NewMux가 만든 서버의 API를 처음부터 끝까지 호출한다.
+func TestNewMux_Client(t *testing.T) {
+	testutil.OpenDBForTest(t)
+	testutil.OpenRedisForTest(t)
+	cfg, err := config.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.OverdueCheckInterval = 0
+	cfg.OpenAPIValidation = true
+	// 내장된 개발용 키 대신 키 디렉터리의 ES256 키로 서명한다.
+	kd := auth.KeyDir(t.TempDir())
+	kid, err := kd.Generate(jwa.ES256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := kd.Activate(kid); err != nil {
+		t.Fatal(err)
+	}
+	cfg.JWTKeyDir, cfg.JWTKeyReloadInterval = string(kd), 0
+	ctx := context.Background()
+	mux, _, cleanup, err := NewMux(ctx, cfg)
+	t.Cleanup(cleanup)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	// 다른 테스트와 겹치지 않는 사용자로 다시 로그인하는 흐름까지 확인한다.
+	name := fmt.Sprintf("cl%x", time.Now().UnixNano())
+	sut := &client.Client{BaseURL: srv.URL, UserName: name, Password: "secret"}
+	if _, err := sut.Register(ctx, client.RegisterRequest{Name: name, Password: "secret", Role: "user"}); err != nil {
+		t.Fatal(err)
+	}
+	parent, err := sut.AddTask(ctx, client.AddTaskRequest{Title: "release"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sut.Token() == "" {
+		t.Fatal("want client to log in automatically")
+	}
+	child, err := sut.AddTask(ctx, client.AddTaskRequest{Title: "write notes", ParentID: &parent.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := entity.TaskStatusDone
+	if _, err := sut.UpdateTask(ctx, child.ID, client.UpdateTaskRequest{Status: &done}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := sut.ListTasks(ctx, client.ListTasksOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[entity.TaskID]entity.TaskStatus{parent.ID: entity.TaskStatusTodo, child.ID: entity.TaskStatusDone}
+	statuses := map[entity.TaskID]entity.TaskStatus{}
+	for _, task := range got {
+		statuses[task.ID] = task.Status
+	}
+	if diff := cmp.Diff(statuses, want); diff != "" {
+		t.Errorf("ListTasks differs: (-got +want)\n%s", diff)
+	}
+	deleted, err := sut.DeleteTask(ctx, parent.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deleted) != 2 {
+		t.Errorf("want 2 deleted tasks, but got %v", deleted)
+	}
+
+	// 서버의 에러 응답은 handler.ErrResponse로 꺼낼 수 있다.
+	_, err = sut.DeleteTask(ctx, parent.ID)
+	var e *client.Error
+	var er *handler.ErrResponse
+	if !errors.As(err, &e) || e.StatusCode != http.StatusNotFound || !errors.As(err, &er) {
+		t.Errorf("want 404 ErrResponse, but got %v", err)
+	}
+
+	// 개인 액세스 토큰은 허용한 범위의 경로만 호출할 수 있고, 폐기하면 사용할 수 없다.
+	call := func(method, path, token, body string) *http.Response {
+		t.Helper()
+		req, err := http.NewRequestWithContext(ctx, method, srv.URL+path, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Content-Type", "application/json")
+		rsp, err := srv.Client().Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { _ = rsp.Body.Close() })
+		return rsp
+	}
+	rsp := call(http.MethodPost, "/v1/tokens", sut.Token(), `{"name": "report", "scopes": ["tasks:read"]}`)
+	var pat struct {
+		ID    entity.PersonalAccessTokenID `json:"id"`
+		Token string                       `json:"token"`
+	}
+	if err := json.NewDecoder(rsp.Body).Decode(&pat); err != nil || rsp.StatusCode != http.StatusOK {
+		t.Fatalf("want personal access token, but got %d: %v", rsp.StatusCode, err)
+	}
+	for _, c := range []struct {
+		method, path, body string
+		want               int
+	}{
+		{http.MethodGet, "/v1/tasks", "", http.StatusOK},
+		{http.MethodPost, "/v1/tasks", `{"title": "from script"}`, http.StatusForbidden},
+		{http.MethodGet, "/v1/tokens", "", http.StatusUnauthorized},
+	} {
+		if got := call(c.method, c.path, pat.Token, c.body).StatusCode; got != c.want {
+			t.Errorf("%s %s with personal access token: want status %d, but got %d", c.method, c.path, c.want, got)
+		}
+	}
+	if got := call(http.MethodDelete, fmt.Sprintf("/v1/tokens/%d", pat.ID), sut.Token(), "").StatusCode; got != http.StatusNoContent {
+		t.Errorf("want status %d, but got %d", http.StatusNoContent, got)
+	}
+	if got := call(http.MethodGet, "/v1/tasks", pat.Token, "").StatusCode; got != http.StatusUnauthorized {
+		t.Errorf("want revoked token to be rejected, but got status %d", got)
+	}
+
+	// OAuth 클라이언트는 PKCE로 인가 코드를 받아 토큰으로 교환하고, 사용자가 허용한 범위의 경로만 호출할 수 있다.
+	rsp = call(http.MethodPost, "/oauth/clients", sut.Token(),
+		`{"name": "reporter", "redirect_uris": ["https://app.example.com/cb"], "scopes": ["tasks:read"]}`)
+	var oc struct {
+		ID string `json:"id"`
+	}
+	if err := json.NewDecoder(rsp.Body).Decode(&oc); err != nil || rsp.StatusCode != http.StatusOK {
+		t.Fatalf("want oauth client, but got %d: %v", rsp.StatusCode, err)
+	}
+	verifier := base64.RawURLEncoding.EncodeToString([]byte(strings.Repeat(name, 4)))
+	sum := sha256.Sum256([]byte(verifier))
+	authz := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {oc.ID},
+		"redirect_uri":          {"https://app.example.com/cb"},
+		"scope":                 {"tasks:read"},
+		"state":                 {"xyz"},
+		"code_challenge":        {base64.RawURLEncoding.EncodeToString(sum[:])},
+		"code_challenge_method": {"S256"},
+	}
+	if got := call(http.MethodGet, "/oauth/authorize?"+authz.Encode(), "", "").StatusCode; got != http.StatusOK {
+		t.Errorf("want consent page, but got status %d", got)
+	}
+	form := func(path string, v url.Values) *http.Response {
+		t.Helper()
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, srv.URL+path, strings.NewReader(v.Encode()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		// 승인하면 redirect_uri로 리다이렉트하므로 따라가지 않고 Location에서 인가 코드를 꺼낸다.
+		cli := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+		rsp, err := cli.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { _ = rsp.Body.Close() })
+		return rsp
+	}
+	authz.Set("user_name", name)
+	authz.Set("password", "secret")
+	authz.Set("action", "approve")
+	rsp = form("/oauth/authorize", authz)
+	loc, err := url.Parse(rsp.Header.Get("Location"))
+	if err != nil || rsp.StatusCode != http.StatusFound || loc.Query().Get("code") == "" || loc.Query().Get("state") != "xyz" {
+		t.Fatalf("want redirect with authorization code, but got %d %q", rsp.StatusCode, rsp.Header.Get("Location"))
+	}
+	rsp = form("/oauth/token", url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {loc.Query().Get("code")},
+		"redirect_uri":  {"https://app.example.com/cb"},
+		"code_verifier": {verifier},
+		"client_id":     {oc.ID},
+	})
+	var ot entity.OAuthToken
+	if err := json.NewDecoder(rsp.Body).Decode(&ot); err != nil || rsp.StatusCode != http.StatusOK {
+		t.Fatalf("want oauth token, but got %d: %v", rsp.StatusCode, err)
+	}
+	for _, c := range []struct {
+		method, path, body string
+		want               int
+	}{
+		{http.MethodGet, "/v1/tasks", "", http.StatusOK},
+		{http.MethodPost, "/v1/tasks", `{"title": "from app"}`, http.StatusForbidden},
+		{http.MethodGet, "/v1/tokens", "", http.StatusUnauthorized},
+	} {
+		if got := call(c.method, c.path, ot.AccessToken, c.body).StatusCode; got != c.want {
+			t.Errorf("%s %s with oauth token: want status %d, but got %d", c.method, c.path, c.want, got)
+		}
+	}
+	rsp = form("/oauth/introspect", url.Values{"token": {ot.AccessToken}, "client_id": {oc.ID}})
+	var ti entity.TokenIntrospection
+	if err := json.NewDecoder(rsp.Body).Decode(&ti); err != nil || !ti.Active || ti.Scope != "tasks:read" {
+		t.Errorf("want active token with scope tasks:read, but got %+v: %v", ti, err)
+	}
+	if got := form("/oauth/revoke", url.Values{"token": {ot.AccessToken}, "client_id": {oc.ID}}).StatusCode; got != http.StatusOK {
+		t.Errorf("want status %d, but got %d", http.StatusOK, got)
+	}
+	if got := call(http.MethodGet, "/v1/tasks", ot.AccessToken, "").StatusCode; got != http.StatusUnauthorized {
+		t.Errorf("want revoked oauth token to be rejected, but got status %d", got)
+	}
+
+	// 발급받은 토큰은 JWKS로 공개한 키로 검증할 수 있다.
+	set, err := jwk.Fetch(ctx, srv.URL+"/.well-known/jwks.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := jwt.ParseString(sut.Token(), jwt.WithKeySet(set)); err != nil {
+		t.Errorf("want token signed by %q, but got %v", kid, err)
+	}
+}
@@ -0,0 +1,41 @@
+// Package openapi는 REST API의 명세(openapi.yaml)를 제공하고, 요청과 응답이 명세를 따르는지 검증한다.
+package openapi
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// NewMux에 경로를 추가하거나 요청, 응답의 형식을 바꾸면 openapi.yaml도 함께 수정한다.
+//
+//go:embed openapi.yaml
+var spec []byte
+
+// Load 함수는 내장된 API 명세를 읽고, 명세 자체가 올바른지 검증한다.
+func Load(ctx context.Context) (*openapi3.T, error) {
+	doc, err := openapi3.NewLoader().LoadFromData(spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load openapi.yaml: %w", err)
+	}
+	if err := doc.Validate(ctx); err != nil {
+		return nil, fmt.Errorf("invalid openapi.yaml: %w", err)
+	}
+	return doc, nil
+}
+
+// Handler 함수는 API 명세를 JSON으로 응답하는 핸들러를 반환한다. (GET /openapi.json)
+func Handler(doc *openapi3.T) (http.Handler, error) {
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode openapi document: %w", err)
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		_, _ = w.Write(b)
+	}), nil
+}
@@ -0,0 +1,232 @@
+package openapi
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/legacy"
+	"github.com/gitwub5/go_todo_app/handler"
+)
+
+// Validator는 요청이 API 명세를 따르는지 검증하는 미들웨어를 제공한다.
+// 명세에 없는 경로와 메서드는 검증하지 않고 그대로 다음 핸들러에 넘긴다.
+type Validator struct {
+	router routers.Router
+	// ValidateResponse가 true이면 응답도 검증하고, 명세와 다른 응답은 500 에러로 바꾼다.
+	// 응답을 모두 버퍼에 담으므로 개발, 테스트 환경에서만 사용한다.
+	ValidateResponse bool
+}
+
+// NewValidator 함수는 doc으로 요청을 검증하는 Validator를 만든다.
+func NewValidator(doc *openapi3.T) (*Validator, error) {
+	r, err := legacy.NewRouter(doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build openapi router: %w", err)
+	}
+	return &Validator{router: r}, nil
+}
+
+// Middleware 메서드는 요청을 검증하고, 명세와 다르면 필드별 상세 내용과 함께 400 에러를 반환한다.
+// 인증은 AuthMiddleware가 처리하므로 여기서는 확인하지 않는다.
+func (v *Validator) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		route, params, err := v.findRoute(r)
+		if err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		opts := &openapi3filter.Options{
+			MultiError:          true,
+			SkipSettingDefaults: true,
+			AuthenticationFunc:  openapi3filter.NoopAuthenticationFunc,
+		}
+		// 명세는 JSON 바디만 설명한다. 핸들러는 Content-Type이 없는 바디를 JSON으로 디코딩하므로 같게 취급하고,
+		// MessagePack처럼 JSON이 아닌 바디는 핸들러가 디코딩하면서 확인한다.
+		switch ct := r.Header.Get("Content-Type"); {
+		case ct == "" && r.ContentLength != 0:
+			r.Header.Set("Content-Type", handler.MIMEJSON)
+		case ct != "" && !isJSON(ct):
+			opts.ExcludeRequestBody = true
+		}
+		in := &openapi3filter.RequestValidationInput{
+			Request:    r,
+			PathParams: params,
+			Route:      route,
+			Options:    opts,
+		}
+		if err := openapi3filter.ValidateRequest(ctx, in); err != nil {
+			handler.Respond(w, r, &handler.ErrResponse{
+				Message: "request does not match the API specification",
+				Details: details("", err),
+			}, http.StatusBadRequest)
+			return
+		}
+		if !v.ValidateResponse || streaming(route.Operation) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		rec := &recorder{header: http.Header{}, status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+		// MessagePack, CSV 응답은 JSON 응답을 변환한 것이므로 JSON 응답만 검증한다.
+		if !isJSON(rec.header.Get("Content-Type")) {
+			rec.flush(w)
+			return
+		}
+		out := &openapi3filter.ResponseValidationInput{
+			RequestValidationInput: in,
+			Status:                 rec.status,
+			Header:                 rec.header,
+			Options: &openapi3filter.Options{
+				MultiError:            true,
+				IncludeResponseStatus: true,
+			},
+		}
+		out.SetBodyBytes(rec.body.Bytes())
+		if err := openapi3filter.ValidateResponse(ctx, out); err != nil {
+			handler.Respond(w, r, &handler.ErrResponse{
+				Message: "response does not match the API specification",
+				Details: details("", err),
+			}, http.StatusInternalServerError)
+			return
+		}
+		rec.flush(w)
+	})
+}
+
+func isJSON(contentType string) bool {
+	mt, _, err := mime.ParseMediaType(contentType)
+	return err == nil && mt == handler.MIMEJSON
+}
+
+// findRoute 메서드는 요청에 해당하는 명세의 경로를 찾는다.
+// chi.Route로 등록한 경로는 끝에 /가 붙은 요청도 처리하므로 /를 떼고 찾는다.
+// 명세의 경로는 /v1 기준이므로, 명세에 따로 설명한 /v2 경로가 아니면 버전 접두사를 떼고 찾는다.
+func (v *Validator) findRoute(r *http.Request) (*routers.Route, map[string]string, error) {
+	p := r.URL.Path
+	if len(p) > 1 && strings.HasSuffix(p, "/") {
+		p = strings.TrimSuffix(p, "/")
+	}
+	route, params, err := v.router.FindRoute(withPath(r, p))
+	if err == nil {
+		return route, params, nil
+	}
+	for _, prefix := range versions {
+		if rest, ok := strings.CutPrefix(p, prefix); ok && strings.HasPrefix(rest, "/") {
+			return v.router.FindRoute(withPath(r, rest))
+		}
+	}
+	return nil, nil, err
+}
+
+// versions는 명세의 경로 앞에 붙는 API 버전 접두사이다.
+var versions = []string{"/v1", "/v2"}
+
+func withPath(r *http.Request, path string) *http.Request {
+	if path == r.URL.Path {
+		return r
+	}
+	u := *r.URL
+	u.Path = path
+	r = r.Clone(r.Context())
+	r.URL = &u
+	return r
+}
+
+// streaming 함수는 SSE나 WebSocket처럼 응답을 버퍼에 담을 수 없는 API인지 확인한다.
+func streaming(op *openapi3.Operation) bool {
+	if op.Responses.Value("101") != nil {
+		return true
+	}
+	for _, rsp := range op.Responses.Map() {
+		if rsp.Value != nil && rsp.Value.Content.Get("text/event-stream") != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// details 함수는 검증 에러를 "위치: 이유" 형식의 필드별 메시지로 나눈다.
+// 위치는 body.title, query.since, path.id처럼 나타낸다.
+// 에러는 서로를 감싸고 있으므로 errors.As 대신 바깥쪽부터 차례로 확인한다.
+func details(field string, err error) []string {
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		var ds []string
+		for _, err := range e {
+			ds = append(ds, details(field, err)...)
+		}
+		return ds
+	case *openapi3filter.RequestError:
+		switch {
+		case e.Parameter != nil:
+			field = e.Parameter.In + "." + e.Parameter.Name
+		case e.RequestBody != nil:
+			field = "body"
+		}
+		if e.Err == nil {
+			return []string{joinReason(field, e.Reason)}
+		}
+		return details(field, e.Err)
+	case *openapi3filter.ResponseError:
+		if e.Err == nil {
+			return []string{joinReason("response", e.Reason)}
+		}
+		return details("response", e.Err)
+	case *openapi3.SchemaError:
+		for _, p := range e.JSONPointer() {
+			field = joinField(field, p)
+		}
+		return []string{joinReason(field, e.Reason)}
+	}
+	return []string{joinReason(field, err.Error())}
+}
+
+func joinReason(field, reason string) string {
+	if field == "" {
+		return reason
+	}
+	return field + ": " + reason
+}
+
+func joinField(field, name string) string {
+	if field == "" {
+		return name
+	}
+	return field + "." + name
+}
+
+// recorder는 응답을 검증하기 위해 핸들러의 응답을 버퍼에 담는다.
+type recorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (r *recorder) Header() http.Header {
+	return r.header
+}
+
+func (r *recorder) WriteHeader(status int) {
+	r.status = status
+}
+
+func (r *recorder) Write(b []byte) (int, error) {
+	return r.body.Write(b)
+}
+
+// flush 메서드는 버퍼에 담은 응답을 w에 쓴다.
+func (r *recorder) flush(w http.ResponseWriter) {
+	for k, vs := range r.header {
+		w.Header()[k] = vs
+	}
+	w.WriteHeader(r.status)
+	_, _ = w.Write(r.body.Bytes())
+}
@@ -0,0 +1,154 @@
+package openapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gitwub5/go_todo_app/testutil"
+)
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	doc, err := Load(context.Background())
+	if err != nil {
+		t.Fatalf("want no error, but got %v", err)
+	}
+	h, err := Handler(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+	var got struct {
+		OpenAPI string                    `json:"openapi"`
+		Paths   map[string]map[string]any `json:"paths"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.OpenAPI != "3.0.3" {
+		t.Errorf("want openapi 3.0.3, but got %q", got.OpenAPI)
+	}
+	if _, ok := got.Paths["/tasks/{id}"]["patch"]; !ok {
+		t.Errorf("want PATCH /tasks/{id} in paths, but got %v", got.Paths["/tasks/{id}"])
+	}
+}
+
+func TestValidator_Middleware(t *testing.T) {
+	t.Parallel()
+
+	type want struct {
+		status  int
+		rspFile string
+	}
+	tests := map[string]struct {
+		method   string
+		target   string
+		reqFile  string
+		response bool
+		rsp      string
+		want     want
+	}{
+		"ok": {
+			method:   http.MethodPost,
+			target:   "/tasks",
+			reqFile:  "testdata/validator/ok_req.json.golden",
+			response: true,
+			rsp:      `{"id": 1}`,
+			want:     want{status: http.StatusOK, rspFile: "testdata/validator/ok_rsp.json.golden"},
+		},
+		"badBody": {
+			method:  http.MethodPost,
+			target:  "/sync",
+			reqFile: "testdata/validator/bad_body_req.json.golden",
+			want:    want{status: http.StatusBadRequest, rspFile: "testdata/validator/bad_body_rsp.json.golden"},
+		},
+		"badQuery": {
+			method: http.MethodGet,
+			target: "/timesheet?from=2022-5-1",
+			want:   want{status: http.StatusBadRequest, rspFile: "testdata/validator/bad_query_rsp.json.golden"},
+		},
+		"badPath": {
+			method: http.MethodDelete,
+			target: "/tasks/abc/assignee",
+			want:   want{status: http.StatusBadRequest, rspFile: "testdata/validator/bad_path_rsp.json.golden"},
+		},
+		"badResponse": {
+			method:   http.MethodPost,
+			target:   "/tasks/",
+			reqFile:  "testdata/validator/ok_req.json.golden",
+			response: true,
+			rsp:      `{"id": "1"}`,
+			want:     want{status: http.StatusInternalServerError, rspFile: "testdata/validator/bad_rsp.json.golden"},
+		},
+		"versioned": {
+			method: http.MethodDelete,
+			target: "/v1/tasks/abc/assignee",
+			want:   want{status: http.StatusBadRequest, rspFile: "testdata/validator/bad_path_rsp.json.golden"},
+		},
+		"v2Response": {
+			method:   http.MethodGet,
+			target:   "/v2/tasks",
+			response: true,
+			rsp:      `[]`,
+			want:     want{status: http.StatusInternalServerError, rspFile: "testdata/validator/bad_v2_rsp.json.golden"},
+		},
+		"notInSpec": {
+			method:   http.MethodGet,
+			target:   "/unknown?id=abc",
+			response: true,
+			rsp:      `{"id": "1"}`,
+			want:     want{status: http.StatusOK},
+		},
+	}
+	doc, err := Load(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			var body []byte
+			if tt.reqFile != "" {
+				body = testutil.LoadFile(t, tt.reqFile)
+			}
+			r := httptest.NewRequest(tt.method, tt.target, bytes.NewReader(body))
+			if body != nil {
+				r.Header.Set("Content-Type", "application/json")
+			}
+			sut, err := NewValidator(doc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sut.ValidateResponse = tt.response
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				// 검증한 뒤에도 핸들러가 요청 바디를 읽을 수 있어야 한다.
+				var got bytes.Buffer
+				if _, err := got.ReadFrom(r.Body); err != nil {
+					t.Fatal(err)
+				}
+				if !bytes.Equal(got.Bytes(), body) {
+					t.Errorf("want body %q, but got %q", body, got.Bytes())
+				}
+				w.Header().Set("Content-Type", "application/json; charset=utf-8")
+				_, _ = w.Write([]byte(tt.rsp))
+			})
+
+			w := httptest.NewRecorder()
+			sut.Middleware(next).ServeHTTP(w, r)
+
+			want := []byte(tt.rsp)
+			if tt.want.rspFile != "" {
+				want = testutil.LoadFile(t, tt.want.rspFile)
+			}
+			testutil.AssertResponse(t, w.Result(), tt.want.status, want)
+		})
+	}
+}
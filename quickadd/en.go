@@ -0,0 +1,307 @@
+package quickadd
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 영어 날짜, 시간, 반복 표현을 해석한다.
+
+var weekdaysEN = map[string]time.Weekday{
+	"sunday": time.Sunday, "monday": time.Monday, "tuesday": time.Tuesday,
+	"wednesday": time.Wednesday, "thursday": time.Thursday, "friday": time.Friday,
+	"saturday": time.Saturday,
+}
+
+// weekdayAbbrEN은 "next", "every" 같은 단어 뒤에서만 허용한다. ("wed", "sun" 같은 일반 단어와 구분하기 위해)
+var weekdayAbbrEN = map[string]time.Weekday{
+	"sun": time.Sunday, "mon": time.Monday, "tue": time.Tuesday, "tues": time.Tuesday,
+	"wed": time.Wednesday, "thu": time.Thursday, "thur": time.Thursday, "thurs": time.Thursday,
+	"fri": time.Friday, "sat": time.Saturday,
+}
+
+var monthsEN = map[string]time.Month{
+	"january": time.January, "february": time.February, "march": time.March,
+	"april": time.April, "may": time.May, "june": time.June, "july": time.July,
+	"august": time.August, "september": time.September, "october": time.October,
+	"november": time.November, "december": time.December,
+	"jan": time.January, "feb": time.February, "mar": time.March, "apr": time.April,
+	"jun": time.June, "jul": time.July, "aug": time.August, "sep": time.September,
+	"sept": time.September, "oct": time.October, "nov": time.November, "dec": time.December,
+}
+
+var unitsEN = map[string]Frequency{
+	"day": FrequencyDaily, "days": FrequencyDaily,
+	"week": FrequencyWeekly, "weeks": FrequencyWeekly,
+	"month": FrequencyMonthly, "months": FrequencyMonthly,
+	"year": FrequencyYearly, "years": FrequencyYearly,
+}
+
+var (
+	reOrdinalEN = regexp.MustCompile(`^(\d{1,2})(st|nd|rd|th)?,?$`)
+	reISODate   = regexp.MustCompile(`^(\d{4})-(\d{2})-(\d{2})$`)
+	reSlashDate = regexp.MustCompile(`^(\d{1,2})/(\d{1,2})$`)
+	reYear      = regexp.MustCompile(`^(\d{4})$`)
+	reAMPM      = regexp.MustCompile(`^(\d{1,2})(?::(\d{2}))?(am|pm)$`)
+	reClock     = regexp.MustCompile(`^(\d{1,2}):(\d{2})$`)
+	reHour      = regexp.MustCompile(`^(\d{1,2})(?::(\d{2}))?$`)
+)
+
+func lower(ws []string, i int) string {
+	if i >= len(ws) {
+		return ""
+	}
+	return strings.ToLower(ws[i])
+}
+
+func weekdayEN(w string, abbr bool) (time.Weekday, bool) {
+	w = strings.TrimSuffix(w, ",")
+	if wd, ok := weekdaysEN[w]; ok {
+		return wd, true
+	}
+	if abbr {
+		wd, ok := weekdayAbbrEN[w]
+		return wd, ok
+	}
+	return 0, false
+}
+
+// ordinalEN 함수는 "1st", "15th", "3" 같은 날짜를 해석한다.
+func ordinalEN(w string) (int, bool) {
+	m := reOrdinalEN.FindStringSubmatch(w)
+	if m == nil {
+		return 0, false
+	}
+	n, _ := strconv.Atoi(m[1])
+	return n, n >= 1 && n <= 31
+}
+
+// matchRecurrenceEN 함수는 "daily", "every 2 weeks", "every monday", "every month on the 1st" 등을 해석한다.
+func matchRecurrenceEN(s *state, _ *Result, ws []string) int {
+	r := &Recurrence{Interval: 1}
+	n := 0
+	switch w := lower(ws, 0); w {
+	case "daily":
+		r.Frequency, n = FrequencyDaily, 1
+	case "weekly":
+		r.Frequency, n = FrequencyWeekly, 1
+	case "monthly":
+		r.Frequency, n = FrequencyMonthly, 1
+	case "yearly", "annually":
+		r.Frequency, n = FrequencyYearly, 1
+	case "every":
+		w1 := lower(ws, 1)
+		if wd, ok := weekdayEN(w1, true); ok {
+			r.Frequency, r.Weekday, n = FrequencyWeekly, &wd, 2
+			break
+		}
+		if f, ok := unitsEN[w1]; ok {
+			r.Frequency, n = f, 2
+			break
+		}
+		interval := 0
+		if w1 == "other" {
+			interval = 2
+		} else if v, err := strconv.Atoi(w1); err == nil && v > 0 {
+			interval = v
+		}
+		if f, ok := unitsEN[lower(ws, 2)]; ok && interval > 0 {
+			r.Frequency, r.Interval, n = f, interval, 3
+		}
+	}
+	if n == 0 {
+		return 0
+	}
+	n += recurrenceTailEN(r, ws[n:])
+	if !s.setRecurrence(r) {
+		return 0
+	}
+	return n
+}
+
+// recurrenceTailEN 함수는 반복 표현 뒤의 "on the 1st", "on monday"를 해석한다.
+func recurrenceTailEN(r *Recurrence, ws []string) int {
+	if lower(ws, 0) != "on" {
+		return 0
+	}
+	i := 1
+	if lower(ws, i) == "the" {
+		i++
+	}
+	w := lower(ws, i)
+	switch r.Frequency {
+	case FrequencyMonthly:
+		if d, ok := ordinalEN(w); ok {
+			r.MonthDay = d
+			return i + 1
+		}
+	case FrequencyWeekly:
+		if wd, ok := weekdayEN(w, true); ok && r.Weekday == nil {
+			r.Weekday = &wd
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// matchDateEN 함수는 "today", "tomorrow", "next friday", "in 3 days", "may 20", "2022-05-20" 등을 해석한다.
+func matchDateEN(s *state, res *Result, ws []string) int {
+	w := lower(ws, 0)
+	switch w {
+	case "on", "by", "due":
+		// "on friday", "by tomorrow" 처럼 날짜 앞에 오는 전치사는 함께 소비한다.
+		if n := matchDateEN(s, res, ws[1:]); n > 0 {
+			return n + 1
+		}
+		return 0
+	case "today", "tonight":
+		return ok(s.setDate(s.today()), 1)
+	case "tomorrow", "tmrw", "tmr":
+		return ok(s.setDate(s.today().AddDate(0, 0, 1)), 1)
+	case "next", "this":
+		w1 := lower(ws, 1)
+		if wd, found := weekdayEN(w1, true); found {
+			if w == "next" {
+				return ok(s.setDate(s.weekdayOfNextWeek(wd)), 2)
+			}
+			return ok(s.setDate(s.weekdayOfThisWeek(wd)), 2)
+		}
+		if w == "next" {
+			switch w1 {
+			case "week":
+				return ok(s.setDate(s.weekdayOfNextWeek(time.Monday)), 2)
+			case "month":
+				t := s.today()
+				return ok(s.setDate(time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, s.loc)), 2)
+			}
+		}
+		return 0
+	case "in":
+		// "in 3 days", "in a week"
+		amount := 0
+		if a := lower(ws, 1); a == "a" || a == "an" {
+			amount = 1
+		} else if v, err := strconv.Atoi(a); err == nil && v > 0 {
+			amount = v
+		}
+		f, found := unitsEN[lower(ws, 2)]
+		if amount == 0 || !found {
+			return 0
+		}
+		t := s.today()
+		switch f {
+		case FrequencyDaily:
+			t = t.AddDate(0, 0, amount)
+		case FrequencyWeekly:
+			t = t.AddDate(0, 0, 7*amount)
+		case FrequencyMonthly:
+			t = t.AddDate(0, amount, 0)
+		case FrequencyYearly:
+			t = t.AddDate(amount, 0, 0)
+		}
+		return ok(s.setDate(t), 3)
+	}
+	if wd, found := weekdayEN(w, false); found {
+		return ok(s.setDate(s.nextWeekday(wd)), 1)
+	}
+	if m := reISODate.FindStringSubmatch(w); m != nil {
+		y, _ := strconv.Atoi(m[1])
+		mo, _ := strconv.Atoi(m[2])
+		d, _ := strconv.Atoi(m[3])
+		t, valid := validDate(y, time.Month(mo), d, s.loc)
+		return ok(valid && s.setDate(t), 1)
+	}
+	if m := reSlashDate.FindStringSubmatch(w); m != nil {
+		mo, _ := strconv.Atoi(m[1])
+		d, _ := strconv.Atoi(m[2])
+		t, valid := s.monthDay(time.Month(mo), d)
+		return ok(valid && s.setDate(t), 1)
+	}
+	// "may 20", "may 20th, 2023"
+	if mo, found := monthsEN[w]; found {
+		if d, valid := ordinalEN(lower(ws, 1)); valid {
+			if y := reYear.FindStringSubmatch(lower(ws, 2)); y != nil {
+				year, _ := strconv.Atoi(y[1])
+				t, valid := validDate(year, mo, d, s.loc)
+				return ok(valid && s.setDate(t), 3)
+			}
+			t, valid := s.monthDay(mo, d)
+			return ok(valid && s.setDate(t), 2)
+		}
+		return 0
+	}
+	// "20 may", "1st jan"
+	if d, valid := ordinalEN(w); valid {
+		if mo, found := monthsEN[lower(ws, 1)]; found {
+			t, valid := s.monthDay(mo, d)
+			return ok(valid && s.setDate(t), 2)
+		}
+	}
+	return 0
+}
+
+// matchTimeEN 함수는 "9am", "9:30 pm", "at 21:00", "noon" 등을 해석한다.
+func matchTimeEN(s *state, _ *Result, ws []string) int {
+	w := lower(ws, 0)
+	switch w {
+	case "noon":
+		return ok(s.setTime(12, 0), 1)
+	case "midnight":
+		return ok(s.setTime(0, 0), 1)
+	case "at":
+		if n := matchTimeEN(s, nil, ws[1:]); n > 0 {
+			return n + 1
+		}
+		// "at 9"처럼 "at" 뒤에 오는 숫자만 시간으로 본다.
+		if m := reHour.FindStringSubmatch(lower(ws, 1)); m != nil {
+			h, _ := strconv.Atoi(m[1])
+			min, _ := strconv.Atoi(m[2])
+			return ok(s.setTime(h, min), 2)
+		}
+		return 0
+	}
+	if m := reAMPM.FindStringSubmatch(w); m != nil {
+		return ok(setAMPM(s, m[1], m[2], m[3]), 1)
+	}
+	if m := reHour.FindStringSubmatch(w); m != nil {
+		if ap := lower(ws, 1); ap == "am" || ap == "pm" {
+			return ok(setAMPM(s, m[1], m[2], ap), 2)
+		}
+	}
+	if m := reClock.FindStringSubmatch(w); m != nil {
+		h, _ := strconv.Atoi(m[1])
+		min, _ := strconv.Atoi(m[2])
+		return ok(s.setTime(h, min), 1)
+	}
+	return 0
+}
+
+func setAMPM(s *state, hour, min, ampm string) bool {
+	h, _ := strconv.Atoi(hour)
+	mi, _ := strconv.Atoi(min)
+	if h < 1 || h > 12 {
+		return false
+	}
+	return s.setTime(to24(h, ampm == "pm"), mi)
+}
+
+// to24 함수는 12시간제 시간을 24시간제로 변환한다.
+func to24(h int, pm bool) int {
+	if h == 12 {
+		h = 0
+	}
+	if pm {
+		h += 12
+	}
+	return h
+}
+
+// ok 함수는 cond가 true이면 n을, 아니면 0을 반환한다.
+func ok(cond bool, n int) int {
+	if cond {
+		return n
+	}
+	return 0
+}
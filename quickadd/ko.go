@@ -0,0 +1,245 @@
+package quickadd
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 한국어 날짜, 시간, 반복 표현을 해석한다.
+
+var weekdaysKO = map[string]time.Weekday{
+	"일요일": time.Sunday, "월요일": time.Monday, "화요일": time.Tuesday,
+	"수요일": time.Wednesday, "목요일": time.Thursday, "금요일": time.Friday,
+	"토요일": time.Saturday,
+}
+
+var (
+	reDaysLaterKO = regexp.MustCompile(`^(\d+)(일|주|개월|달|년)(후|뒤)?$`)
+	reEveryKO     = regexp.MustCompile(`^(\d+)(일|주|개월|달|년)마다$`)
+	reDayKO       = regexp.MustCompile(`^(\d{1,2})일$`)
+	reMonthKO     = regexp.MustCompile(`^(\d{1,2})월$`)
+	reMonthDayKO  = regexp.MustCompile(`^(\d{1,2})월(\d{1,2})일$`)
+	reYearKO      = regexp.MustCompile(`^(\d{4})년$`)
+	reHourKO      = regexp.MustCompile(`^(\d{1,2})시(?:(\d{1,2})분|(반))?$`)
+	reMinuteKO    = regexp.MustCompile(`^(\d{1,2})분$`)
+)
+
+// trimKO 함수는 "내일까지", "9시에" 처럼 날짜와 시간 뒤에 붙는 조사를 제거한다.
+func trimKO(w string) string {
+	for _, suffix := range []string{"까지", "에는", "에"} {
+		if t := strings.TrimSuffix(w, suffix); t != w && t != "" {
+			return t
+		}
+	}
+	return w
+}
+
+func word(ws []string, i int) string {
+	if i >= len(ws) {
+		return ""
+	}
+	return trimKO(ws[i])
+}
+
+func frequencyKO(unit string) Frequency {
+	switch unit {
+	case "일":
+		return FrequencyDaily
+	case "주":
+		return FrequencyWeekly
+	case "개월", "달":
+		return FrequencyMonthly
+	default:
+		return FrequencyYearly
+	}
+}
+
+// matchRecurrenceKO 함수는 "매일", "매주 월요일", "매달 1일", "격주", "3일마다" 등을 해석한다.
+func matchRecurrenceKO(s *state, _ *Result, ws []string) int {
+	r := &Recurrence{Interval: 1}
+	n := 0
+	switch w := word(ws, 0); w {
+	case "매일":
+		r.Frequency, n = FrequencyDaily, 1
+	case "매주", "격주":
+		r.Frequency, n = FrequencyWeekly, 1
+		if w == "격주" {
+			r.Interval = 2
+		}
+		if wd, found := weekdaysKO[word(ws, 1)]; found {
+			r.Weekday, n = &wd, 2
+		}
+	case "매달", "매월":
+		r.Frequency, n = FrequencyMonthly, 1
+		if m := reDayKO.FindStringSubmatch(word(ws, 1)); m != nil {
+			d, _ := strconv.Atoi(m[1])
+			if d >= 1 && d <= 31 {
+				r.MonthDay, n = d, 2
+			}
+		}
+	case "매년", "매해":
+		r.Frequency, n = FrequencyYearly, 1
+	default:
+		m := reEveryKO.FindStringSubmatch(w)
+		if m == nil {
+			return 0
+		}
+		v, _ := strconv.Atoi(m[1])
+		if v < 1 {
+			return 0
+		}
+		r.Frequency, r.Interval, n = frequencyKO(m[2]), v, 1
+	}
+	return ok(s.setRecurrence(r), n)
+}
+
+// matchDateKO 함수는 "오늘", "내일", "다음주 금요일", "3일 후", "5월 20일" 등을 해석한다.
+func matchDateKO(s *state, _ *Result, ws []string) int {
+	w := word(ws, 0)
+	today := s.today()
+	switch w {
+	case "오늘":
+		return ok(s.setDate(today), 1)
+	case "내일":
+		return ok(s.setDate(today.AddDate(0, 0, 1)), 1)
+	case "모레":
+		return ok(s.setDate(today.AddDate(0, 0, 2)), 1)
+	case "글피":
+		return ok(s.setDate(today.AddDate(0, 0, 3)), 1)
+	case "다음달":
+		return ok(s.setDate(time.Date(today.Year(), today.Month()+1, 1, 0, 0, 0, 0, s.loc)), 1)
+	case "다음주", "담주", "이번주":
+		return matchWeekKO(s, w, ws[1:], 1)
+	case "다음", "이번":
+		// "다음 주 금요일"처럼 띄어 쓴 경우
+		if word(ws, 1) == "주" {
+			return matchWeekKO(s, w+"주", ws[2:], 2)
+		}
+		return 0
+	}
+	if wd, found := weekdaysKO[w]; found {
+		return ok(s.setDate(s.nextWeekday(wd)), 1)
+	}
+	// "3일 후", "2주 뒤", "3일후"
+	if m := reDaysLaterKO.FindStringSubmatch(w); m != nil {
+		n := 1
+		if m[3] == "" {
+			if next := word(ws, 1); next != "후" && next != "뒤" {
+				return 0
+			}
+			n = 2
+		}
+		v, _ := strconv.Atoi(m[1])
+		t := today
+		switch frequencyKO(m[2]) {
+		case FrequencyDaily:
+			t = t.AddDate(0, 0, v)
+		case FrequencyWeekly:
+			t = t.AddDate(0, 0, 7*v)
+		case FrequencyMonthly:
+			t = t.AddDate(0, v, 0)
+		case FrequencyYearly:
+			t = t.AddDate(v, 0, 0)
+		}
+		return ok(s.setDate(t), n)
+	}
+	// "2023년 5월 20일"
+	if m := reYearKO.FindStringSubmatch(w); m != nil {
+		y, _ := strconv.Atoi(m[1])
+		mo, d, n := monthDayKO(ws[1:])
+		if n == 0 {
+			return 0
+		}
+		t, valid := validDate(y, mo, d, s.loc)
+		return ok(valid && s.setDate(t), n+1)
+	}
+	// "5월 20일", "5월20일"
+	if mo, d, n := monthDayKO(ws); n > 0 {
+		t, valid := s.monthDay(mo, d)
+		return ok(valid && s.setDate(t), n)
+	}
+	return 0
+}
+
+// matchWeekKO 함수는 "다음주", "이번주" 뒤의 요일을 해석한다. 요일이 없으면 다음 주 월요일로 본다.
+func matchWeekKO(s *state, week string, ws []string, consumed int) int {
+	wd, found := weekdaysKO[word(ws, 0)]
+	switch {
+	case found && week == "이번주":
+		return ok(s.setDate(s.weekdayOfThisWeek(wd)), consumed+1)
+	case found:
+		return ok(s.setDate(s.weekdayOfNextWeek(wd)), consumed+1)
+	case week == "이번주":
+		return 0
+	default:
+		return ok(s.setDate(s.weekdayOfNextWeek(time.Monday)), consumed)
+	}
+}
+
+// monthDayKO 함수는 "5월 20일" 또는 "5월20일"을 해석하고 소비한 단어 수를 반환한다.
+func monthDayKO(ws []string) (time.Month, int, int) {
+	if m := reMonthDayKO.FindStringSubmatch(word(ws, 0)); m != nil {
+		mo, _ := strconv.Atoi(m[1])
+		d, _ := strconv.Atoi(m[2])
+		return time.Month(mo), d, 1
+	}
+	mm := reMonthKO.FindStringSubmatch(word(ws, 0))
+	dm := reDayKO.FindStringSubmatch(word(ws, 1))
+	if mm == nil || dm == nil {
+		return 0, 0, 0
+	}
+	mo, _ := strconv.Atoi(mm[1])
+	d, _ := strconv.Atoi(dm[1])
+	return time.Month(mo), d, 2
+}
+
+// matchTimeKO 함수는 "오전 9시", "오후 3시 30분", "9시반", "정오" 등을 해석한다.
+func matchTimeKO(s *state, _ *Result, ws []string) int {
+	w := word(ws, 0)
+	switch w {
+	case "정오":
+		return ok(s.setTime(12, 0), 1)
+	case "자정":
+		return ok(s.setTime(0, 0), 1)
+	}
+	pm, ampm, prefixed := false, false, 0
+	for _, p := range []struct {
+		word string
+		pm   bool
+	}{{"오전", false}, {"아침", false}, {"오후", true}, {"저녁", true}, {"밤", true}} {
+		if w == p.word {
+			pm, ampm, prefixed = p.pm, true, 1
+			break
+		}
+		// "오후3시"처럼 붙여 쓴 경우
+		if t := strings.TrimPrefix(w, p.word); t != w {
+			pm, ampm = p.pm, true
+			ws = append([]string{t}, ws[1:]...)
+			break
+		}
+	}
+	m := reHourKO.FindStringSubmatch(word(ws, prefixed))
+	if m == nil {
+		return 0
+	}
+	n := prefixed + 1
+	h, _ := strconv.Atoi(m[1])
+	min, _ := strconv.Atoi(m[2])
+	if m[3] != "" {
+		min = 30
+	} else if m[2] == "" {
+		if mm := reMinuteKO.FindStringSubmatch(word(ws, n)); mm != nil {
+			min, _ = strconv.Atoi(mm[1])
+			n++
+		}
+	}
+	if ampm {
+		if h < 1 || h > 12 {
+			return 0
+		}
+		h = to24(h, pm)
+	}
+	return ok(s.setTime(h, min), n)
+}
@@ -0,0 +1,303 @@
+// quickadd 패키지는 "Pay rent every month on the 1st #finance !high tomorrow 9am" 같은
+// 자연어 문자열을 Task의 제목, 라벨, 우선순위, 마감 시간, 반복 규칙으로 분해한다.
+// 날짜 표현은 영어와 한국어를 지원하며, 모든 상대 날짜는 clock.Clocker와 사용자 타임존을 기준으로 계산한다.
+package quickadd
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/clock"
+)
+
+var ErrEmptyTitle = errors.New("title is empty")
+
+type Priority string // 우선순위를 나타내는 타입
+
+// Priority 상수
+const (
+	PriorityNone   Priority = ""
+	PriorityLow    Priority = "low"
+	PriorityMedium Priority = "medium"
+	PriorityHigh   Priority = "high"
+	PriorityUrgent Priority = "urgent"
+)
+
+type Frequency string // 반복 주기를 나타내는 타입
+
+// Frequency 상수
+const (
+	FrequencyDaily   Frequency = "daily"
+	FrequencyWeekly  Frequency = "weekly"
+	FrequencyMonthly Frequency = "monthly"
+	FrequencyYearly  Frequency = "yearly"
+)
+
+// Recurrence 구조체는 반복 규칙을 나타낸다.
+// Weekday는 매주 반복할 요일, MonthDay는 매달 반복할 날짜이며 지정하지 않으면 nil, 0이다.
+type Recurrence struct {
+	Frequency Frequency
+	Interval  int
+	Weekday   *time.Weekday
+	MonthDay  int
+}
+
+// Result 구조체는 해석 결과를 나타낸다.
+// 시간 없이 날짜만 지정된 경우 Due는 그날 0시이고 AllDay가 true가 된다.
+type Result struct {
+	Title      string
+	Labels     []string
+	Priority   Priority
+	Due        *time.Time
+	AllDay     bool
+	Recurrence *Recurrence
+}
+
+// Parser 구조체는 자연어 문자열을 해석한다.
+type Parser struct {
+	Clocker clock.Clocker
+}
+
+// Parse 메서드는 text를 해석한다. 날짜는 loc 기준으로 계산하며, loc가 nil이면 UTC를 사용한다.
+// 큰따옴표로 감싼 부분은 해석하지 않고 그대로 제목에 포함한다.
+func (p *Parser) Parse(text string, loc *time.Location) (*Result, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	s := &state{now: p.Clocker.Now().In(loc), loc: loc}
+	res := &Result{Labels: []string{}}
+
+	var title []string
+	for _, seg := range split(text) {
+		if seg.literal {
+			title = append(title, seg.words...)
+			continue
+		}
+		words := seg.words
+		for i := 0; i < len(words); {
+			if n := s.match(res, words[i:]); n > 0 {
+				i += n
+				continue
+			}
+			title = append(title, words[i])
+			i++
+		}
+	}
+
+	res.Title = strings.TrimSpace(strings.Join(title, " "))
+	if res.Title == "" {
+		return nil, ErrEmptyTitle
+	}
+	res.Recurrence = s.rec
+	res.Due, res.AllDay = s.due()
+	return res, nil
+}
+
+// state 구조체는 해석 중인 날짜와 시간을 보관한다.
+type state struct {
+	now  time.Time
+	loc  *time.Location
+	date *time.Time // 날짜만 의미가 있으며 시각은 0시이다.
+	hour int
+	min  int
+	time bool
+	rec  *Recurrence
+}
+
+type matcher func(s *state, res *Result, ws []string) int
+
+// matchers는 앞에서부터 순서대로 시도한다. 반복 규칙이 날짜보다 먼저 와야 "every monday"가 날짜로 해석되지 않는다.
+var matchers = []matcher{
+	matchLabel,
+	matchPriority,
+	matchRecurrenceEN,
+	matchRecurrenceKO,
+	matchDateEN,
+	matchDateKO,
+	matchTimeEN,
+	matchTimeKO,
+}
+
+// match 메서드는 ws의 앞부분이 해석 가능한 표현이면 소비한 단어 수를, 아니면 0을 반환한다.
+func (s *state) match(res *Result, ws []string) int {
+	for _, m := range matchers {
+		if n := m(s, res, ws); n > 0 {
+			return n
+		}
+	}
+	return 0
+}
+
+// setDate 메서드는 이미 날짜가 정해진 경우 false를 반환해서 뒤에 나온 날짜 표현이 제목에 남도록 한다.
+func (s *state) setDate(t time.Time) bool {
+	if s.date != nil {
+		return false
+	}
+	d := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, s.loc)
+	s.date = &d
+	return true
+}
+
+func (s *state) setTime(hour, min int) bool {
+	if s.time || hour < 0 || hour > 23 || min < 0 || min > 59 {
+		return false
+	}
+	s.hour, s.min, s.time = hour, min, true
+	return true
+}
+
+func (s *state) setRecurrence(r *Recurrence) bool {
+	if s.rec != nil {
+		return false
+	}
+	s.rec = r
+	return true
+}
+
+// today 메서드는 오늘 0시를 반환한다.
+func (s *state) today() time.Time {
+	return time.Date(s.now.Year(), s.now.Month(), s.now.Day(), 0, 0, 0, 0, s.loc)
+}
+
+// due 메서드는 해석한 날짜, 시간, 반복 규칙으로 마감 시간을 계산한다.
+func (s *state) due() (*time.Time, bool) {
+	var d time.Time
+	switch {
+	case s.date != nil:
+		d = *s.date
+	case s.rec != nil:
+		d = s.firstOccurrence()
+	case s.time:
+		d = s.today()
+	default:
+		return nil, false
+	}
+	if !s.time {
+		return &d, true
+	}
+	d = time.Date(d.Year(), d.Month(), d.Day(), s.hour, s.min, 0, 0, s.loc)
+	// 날짜 없이 시간만 지정했는데 이미 지난 시간이면 다음 날로 본다.
+	if s.date == nil && s.rec == nil && d.Before(s.now) {
+		d = d.AddDate(0, 0, 1)
+	}
+	return &d, false
+}
+
+// firstOccurrence 메서드는 오늘 이후 반복 규칙이 처음 적용되는 날짜를 반환한다.
+func (s *state) firstOccurrence() time.Time {
+	today := s.today()
+	switch {
+	case s.rec.Weekday != nil:
+		return today.AddDate(0, 0, (int(*s.rec.Weekday)-int(today.Weekday())+7)%7)
+	case s.rec.MonthDay > 0:
+		for i := 0; i < 12; i++ {
+			first := time.Date(today.Year(), today.Month()+time.Month(i), 1, 0, 0, 0, 0, s.loc)
+			d, ok := validDate(first.Year(), first.Month(), s.rec.MonthDay, s.loc)
+			if ok && !d.Before(today) {
+				return d
+			}
+		}
+	}
+	return today
+}
+
+// nextWeekday 메서드는 오늘 이후 처음 오는 wd 요일을 반환한다. 오늘이 wd이면 다음 주를 반환한다.
+func (s *state) nextWeekday(wd time.Weekday) time.Time {
+	today := s.today()
+	n := (int(wd) - int(today.Weekday()) + 7) % 7
+	if n == 0 {
+		n = 7
+	}
+	return today.AddDate(0, 0, n)
+}
+
+// weekdayOfNextWeek 메서드는 다음 주(월요일 시작)의 wd 요일을 반환한다.
+func (s *state) weekdayOfNextWeek(wd time.Weekday) time.Time {
+	today := s.today()
+	monday := today.AddDate(0, 0, -((int(today.Weekday())+6)%7)+7)
+	return monday.AddDate(0, 0, (int(wd)+6)%7)
+}
+
+// weekdayOfThisWeek 메서드는 이번 주(월요일 시작)의 wd 요일을 반환한다.
+func (s *state) weekdayOfThisWeek(wd time.Weekday) time.Time {
+	return s.weekdayOfNextWeek(wd).AddDate(0, 0, -7)
+}
+
+// monthDay 메서드는 연도 없이 지정된 월, 일이 이미 지났으면 내년 날짜를 반환한다.
+func (s *state) monthDay(m time.Month, d int) (time.Time, bool) {
+	t, ok := validDate(s.now.Year(), m, d, s.loc)
+	if !ok {
+		return time.Time{}, false
+	}
+	if t.Before(s.today()) {
+		return validDate(s.now.Year()+1, m, d, s.loc)
+	}
+	return t, true
+}
+
+// validDate 함수는 존재하는 날짜인지 확인한다. (예: 2월 30일은 false)
+func validDate(y int, m time.Month, d int, loc *time.Location) (time.Time, bool) {
+	t := time.Date(y, m, d, 0, 0, 0, 0, loc)
+	if t.Year() != y || t.Month() != m || t.Day() != d {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+func matchLabel(_ *state, res *Result, ws []string) int {
+	w := ws[0]
+	if len(w) < 2 || w[0] != '#' {
+		return 0
+	}
+	label := w[1:]
+	for _, l := range res.Labels {
+		if l == label {
+			return 1
+		}
+	}
+	res.Labels = append(res.Labels, label)
+	return 1
+}
+
+var priorities = map[string]Priority{
+	"urgent": PriorityUrgent, "1": PriorityUrgent, "긴급": PriorityUrgent,
+	"high": PriorityHigh, "2": PriorityHigh, "높음": PriorityHigh,
+	"medium": PriorityMedium, "med": PriorityMedium, "3": PriorityMedium, "보통": PriorityMedium,
+	"low": PriorityLow, "4": PriorityLow, "낮음": PriorityLow,
+}
+
+func matchPriority(_ *state, res *Result, ws []string) int {
+	w := ws[0]
+	if len(w) < 2 || w[0] != '!' || res.Priority != PriorityNone {
+		return 0
+	}
+	p, ok := priorities[strings.ToLower(w[1:])]
+	if !ok {
+		return 0
+	}
+	res.Priority = p
+	return 1
+}
+
+// segment는 공백으로 나눈 단어 묶음이다. literal이면 해석하지 않는다.
+type segment struct {
+	words   []string
+	literal bool
+}
+
+// split 함수는 큰따옴표로 감싼 부분과 나머지 부분을 나눈다.
+func split(text string) []segment {
+	var segs []segment
+	parts := strings.Split(text, `"`)
+	for i, p := range parts {
+		ws := strings.Fields(p)
+		if len(ws) == 0 {
+			continue
+		}
+		// 따옴표가 닫히지 않은 마지막 부분은 일반 문자열로 취급한다.
+		literal := i%2 == 1 && i < len(parts)-1
+		segs = append(segs, segment{words: ws, literal: literal})
+	}
+	return segs
+}
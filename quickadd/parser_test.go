@@ -0,0 +1,199 @@
+package quickadd
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/clock"
+	"github.com/google/go-cmp/cmp"
+)
+
+func weekday(wd time.Weekday) *time.Weekday { return &wd }
+
+func at(t time.Time) *time.Time { return &t }
+
+func TestParser_Parse(t *testing.T) {
+	seoul, err := time.LoadLocation("Asia/Seoul")
+	if err != nil {
+		t.Fatal(err)
+	}
+	la, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// clock.FixedClocker는 2022-05-10(화) 12:34:56 UTC를 반환한다.
+	tests := map[string]struct {
+		text string
+		loc  *time.Location
+		want *Result
+	}{
+		"example": {
+			text: "Pay rent every month on the 1st #finance !high tomorrow 9am",
+			want: &Result{
+				Title:      "Pay rent",
+				Labels:     []string{"finance"},
+				Priority:   PriorityHigh,
+				Due:        at(time.Date(2022, 5, 11, 9, 0, 0, 0, time.UTC)),
+				Recurrence: &Recurrence{Frequency: FrequencyMonthly, Interval: 1, MonthDay: 1},
+			},
+		},
+		"titleOnly": {
+			text: "Buy milk",
+			want: &Result{Title: "Buy milk", Labels: []string{}},
+		},
+		"nextWeekday": {
+			text: "Call mom next friday",
+			want: &Result{
+				Title:  "Call mom",
+				Labels: []string{},
+				Due:    at(time.Date(2022, 5, 20, 0, 0, 0, 0, time.UTC)),
+				AllDay: true,
+			},
+		},
+		"weekdayWithTime": {
+			text: "Submit report by friday 5pm",
+			want: &Result{
+				Title:  "Submit report",
+				Labels: []string{},
+				Due:    at(time.Date(2022, 5, 13, 17, 0, 0, 0, time.UTC)),
+			},
+		},
+		"everyWeekday": {
+			text: "Team sync every monday at 10:00",
+			want: &Result{
+				Title:      "Team sync",
+				Labels:     []string{},
+				Due:        at(time.Date(2022, 5, 16, 10, 0, 0, 0, time.UTC)),
+				Recurrence: &Recurrence{Frequency: FrequencyWeekly, Interval: 1, Weekday: weekday(time.Monday)},
+			},
+		},
+		"monthName": {
+			text: "Dentist may 20 at 3:30 pm",
+			want: &Result{
+				Title:  "Dentist",
+				Labels: []string{},
+				Due:    at(time.Date(2022, 5, 20, 15, 30, 0, 0, time.UTC)),
+			},
+		},
+		// 이미 지난 시간이면 다음 날로 본다.
+		"passedTime": {
+			text: "Lunch noon",
+			want: &Result{
+				Title:  "Lunch",
+				Labels: []string{},
+				Due:    at(time.Date(2022, 5, 11, 12, 0, 0, 0, time.UTC)),
+			},
+		},
+		"abbreviationIsNotDate": {
+			text: "Meeting wed",
+			want: &Result{Title: "Meeting wed", Labels: []string{}},
+		},
+		"quoted": {
+			text: `"Read tomorrow" article tomorrow`,
+			want: &Result{
+				Title:  "Read tomorrow article",
+				Labels: []string{},
+				Due:    at(time.Date(2022, 5, 11, 0, 0, 0, 0, time.UTC)),
+				AllDay: true,
+			},
+		},
+		// 로스앤젤레스는 아직 5월 10일 오전이다.
+		"timezone": {
+			text: "Call tomorrow",
+			loc:  la,
+			want: &Result{
+				Title:  "Call",
+				Labels: []string{},
+				Due:    at(time.Date(2022, 5, 11, 0, 0, 0, 0, la)),
+				AllDay: true,
+			},
+		},
+		"korean": {
+			text: "보고서 제출 내일 오후 3시 #업무 !긴급",
+			loc:  seoul,
+			want: &Result{
+				Title:    "보고서 제출",
+				Labels:   []string{"업무"},
+				Priority: PriorityUrgent,
+				Due:      at(time.Date(2022, 5, 11, 15, 0, 0, 0, seoul)),
+			},
+		},
+		"koreanMonthly": {
+			text: "월세 내기 매달 1일",
+			loc:  seoul,
+			want: &Result{
+				Title:      "월세 내기",
+				Labels:     []string{},
+				Due:        at(time.Date(2022, 6, 1, 0, 0, 0, 0, seoul)),
+				AllDay:     true,
+				Recurrence: &Recurrence{Frequency: FrequencyMonthly, Interval: 1, MonthDay: 1},
+			},
+		},
+		"koreanNextWeek": {
+			text: "회의 다음주 금요일 9시 30분에",
+			loc:  seoul,
+			want: &Result{
+				Title:  "회의",
+				Labels: []string{},
+				Due:    at(time.Date(2022, 5, 20, 9, 30, 0, 0, seoul)),
+			},
+		},
+		"koreanRelative": {
+			text: "운동 3일 후",
+			loc:  seoul,
+			want: &Result{
+				Title:  "운동",
+				Labels: []string{},
+				Due:    at(time.Date(2022, 5, 13, 0, 0, 0, 0, seoul)),
+				AllDay: true,
+			},
+		},
+		"koreanMonthDay": {
+			text: "5월 20일까지 생일 선물 준비",
+			loc:  seoul,
+			want: &Result{
+				Title:  "생일 선물 준비",
+				Labels: []string{},
+				Due:    at(time.Date(2022, 5, 20, 0, 0, 0, 0, seoul)),
+				AllDay: true,
+			},
+		},
+		"koreanWeekly": {
+			text: "청소 매주 토요일",
+			loc:  seoul,
+			want: &Result{
+				Title:      "청소",
+				Labels:     []string{},
+				Due:        at(time.Date(2022, 5, 14, 0, 0, 0, 0, seoul)),
+				AllDay:     true,
+				Recurrence: &Recurrence{Frequency: FrequencyWeekly, Interval: 1, Weekday: weekday(time.Saturday)},
+			},
+		},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			sut := &Parser{Clocker: clock.FixedClocker{}}
+			got, err := sut.Parse(tt.text, tt.loc)
+			if err != nil {
+				t.Fatalf("want no error, but got %v", err)
+			}
+			if d := cmp.Diff(got, tt.want); len(d) != 0 {
+				t.Errorf("differs: (-got +want)\n%s", d)
+			}
+		})
+	}
+}
+
+func TestParser_Parse_EmptyTitle(t *testing.T) {
+	t.Parallel()
+
+	sut := &Parser{Clocker: clock.FixedClocker{}}
+	_, err := sut.Parse("#tag !high tomorrow", nil)
+	if !errors.Is(err, ErrEmptyTitle) {
+		t.Errorf("want %v, but got %v", ErrEmptyTitle, err)
+	}
+}
@@ -0,0 +1,66 @@
+package rpc
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// authenticate 함수는 메타데이터의 authorization에서 액세스 토큰을 꺼내 검증한다.
+// REST API와 같은 JWT를 "Bearer <token>" 형식으로 전달한다.
+func authenticate(ctx context.Context, a Authenticator) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	vs := md.Get("authorization")
+	if len(vs) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "missing access token")
+	}
+	token, ok := strings.CutPrefix(vs[0], "Bearer ")
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authorization must be a bearer token")
+	}
+	ctx, err := a.Authenticate(ctx, token)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return ctx, nil
+}
+
+// UnaryAuthInterceptor 함수는 단항 RPC를 실행하기 전에 액세스 토큰을 검증한다.
+func UnaryAuthInterceptor(a Authenticator) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+	) (any, error) {
+		ctx, err := authenticate(ctx, a)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamAuthInterceptor 함수는 스트리밍 RPC를 실행하기 전에 액세스 토큰을 검증한다.
+func StreamAuthInterceptor(a Authenticator) grpc.StreamServerInterceptor {
+	return func(
+		srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler,
+	) error {
+		ctx, err := authenticate(ss.Context(), a)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authStream은 사용자 ID를 설정한 context를 반환하는 grpc.ServerStream이다.
+type authStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authStream) Context() context.Context {
+	return s.ctx
+}
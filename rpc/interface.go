@@ -0,0 +1,39 @@
+package rpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/entity"
+)
+
+// gRPC 서비스도 REST 핸들러와 같은 service 패키지의 타입을 사용한다.
+
+//go:generate go run github.com/matryer/moq -out moq_test.go . Authenticator TaskLister TaskGetter TaskAdder TaskUpdater TaskDeleter
+
+// Authenticator는 문자열로 전달된 액세스 토큰을 검증하고, 사용자 ID를 설정한 context를 반환한다.
+type Authenticator interface {
+	Authenticate(ctx context.Context, token string) (context.Context, error)
+}
+
+type TaskLister interface {
+	ListTasks(ctx context.Context) (entity.Tasks, error)
+	ListAssignedTasks(ctx context.Context) (entity.Tasks, error)
+	ListWorkTasks(ctx context.Context) (entity.Tasks, error)
+}
+
+type TaskGetter interface {
+	GetTask(ctx context.Context, id entity.TaskID) (*entity.Task, error)
+}
+
+type TaskAdder interface {
+	AddTask(ctx context.Context, title string, due *time.Time, parent *entity.TaskID, attrs entity.TaskAttributes) (*entity.Task, error)
+}
+
+type TaskUpdater interface {
+	UpdateTask(ctx context.Context, id entity.TaskID, title *string, status *entity.TaskStatus) (*entity.Task, error)
+}
+
+type TaskDeleter interface {
+	DeleteTask(ctx context.Context, id entity.TaskID) (entity.Tasks, error)
+}
@@ -0,0 +1,555 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package rpc
+
+import (
+	"context"
+	"github.com/gitwub5/go_todo_app/entity"
+	"sync"
+	"time"
+)
+
+// Ensure, that AuthenticatorMock does implement Authenticator.
+// If this is not the case, regenerate this file with moq.
+var _ Authenticator = &AuthenticatorMock{}
+
+// AuthenticatorMock is a mock implementation of Authenticator.
+//
+//	func TestSomethingThatUsesAuthenticator(t *testing.T) {
+//
+//		// make and configure a mocked Authenticator
+//		mockedAuthenticator := &AuthenticatorMock{
+//			AuthenticateFunc: func(ctx context.Context, token string) (context.Context, error) {
+//				panic("mock out the Authenticate method")
+//			},
+//		}
+//
+//		// use mockedAuthenticator in code that requires Authenticator
+//		// and then make assertions.
+//
+//	}
+type AuthenticatorMock struct {
+	// AuthenticateFunc mocks the Authenticate method.
+	AuthenticateFunc func(ctx context.Context, token string) (context.Context, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Authenticate holds details about calls to the Authenticate method.
+		Authenticate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Token is the token argument value.
+			Token string
+		}
+	}
+	lockAuthenticate sync.RWMutex
+}
+
+// Authenticate calls AuthenticateFunc.
+func (mock *AuthenticatorMock) Authenticate(ctx context.Context, token string) (context.Context, error) {
+	if mock.AuthenticateFunc == nil {
+		panic("AuthenticatorMock.AuthenticateFunc: method is nil but Authenticator.Authenticate was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Token string
+	}{
+		Ctx:   ctx,
+		Token: token,
+	}
+	mock.lockAuthenticate.Lock()
+	mock.calls.Authenticate = append(mock.calls.Authenticate, callInfo)
+	mock.lockAuthenticate.Unlock()
+	return mock.AuthenticateFunc(ctx, token)
+}
+
+// AuthenticateCalls gets all the calls that were made to Authenticate.
+// Check the length with:
+//
+//	len(mockedAuthenticator.AuthenticateCalls())
+func (mock *AuthenticatorMock) AuthenticateCalls() []struct {
+	Ctx   context.Context
+	Token string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Token string
+	}
+	mock.lockAuthenticate.RLock()
+	calls = mock.calls.Authenticate
+	mock.lockAuthenticate.RUnlock()
+	return calls
+}
+
+// Ensure, that TaskListerMock does implement TaskLister.
+// If this is not the case, regenerate this file with moq.
+var _ TaskLister = &TaskListerMock{}
+
+// TaskListerMock is a mock implementation of TaskLister.
+//
+//	func TestSomethingThatUsesTaskLister(t *testing.T) {
+//
+//		// make and configure a mocked TaskLister
+//		mockedTaskLister := &TaskListerMock{
+//			ListAssignedTasksFunc: func(ctx context.Context) (entity.Tasks, error) {
+//				panic("mock out the ListAssignedTasks method")
+//			},
+//			ListTasksFunc: func(ctx context.Context) (entity.Tasks, error) {
+//				panic("mock out the ListTasks method")
+//			},
+//			ListWorkTasksFunc: func(ctx context.Context) (entity.Tasks, error) {
+//				panic("mock out the ListWorkTasks method")
+//			},
+//		}
+//
+//		// use mockedTaskLister in code that requires TaskLister
+//		// and then make assertions.
+//
+//	}
+type TaskListerMock struct {
+	// ListAssignedTasksFunc mocks the ListAssignedTasks method.
+	ListAssignedTasksFunc func(ctx context.Context) (entity.Tasks, error)
+
+	// ListTasksFunc mocks the ListTasks method.
+	ListTasksFunc func(ctx context.Context) (entity.Tasks, error)
+
+	// ListWorkTasksFunc mocks the ListWorkTasks method.
+	ListWorkTasksFunc func(ctx context.Context) (entity.Tasks, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ListAssignedTasks holds details about calls to the ListAssignedTasks method.
+		ListAssignedTasks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// ListTasks holds details about calls to the ListTasks method.
+		ListTasks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// ListWorkTasks holds details about calls to the ListWorkTasks method.
+		ListWorkTasks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+	}
+	lockListAssignedTasks sync.RWMutex
+	lockListTasks         sync.RWMutex
+	lockListWorkTasks     sync.RWMutex
+}
+
+// ListAssignedTasks calls ListAssignedTasksFunc.
+func (mock *TaskListerMock) ListAssignedTasks(ctx context.Context) (entity.Tasks, error) {
+	if mock.ListAssignedTasksFunc == nil {
+		panic("TaskListerMock.ListAssignedTasksFunc: method is nil but TaskLister.ListAssignedTasks was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListAssignedTasks.Lock()
+	mock.calls.ListAssignedTasks = append(mock.calls.ListAssignedTasks, callInfo)
+	mock.lockListAssignedTasks.Unlock()
+	return mock.ListAssignedTasksFunc(ctx)
+}
+
+// ListAssignedTasksCalls gets all the calls that were made to ListAssignedTasks.
+// Check the length with:
+//
+//	len(mockedTaskLister.ListAssignedTasksCalls())
+func (mock *TaskListerMock) ListAssignedTasksCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockListAssignedTasks.RLock()
+	calls = mock.calls.ListAssignedTasks
+	mock.lockListAssignedTasks.RUnlock()
+	return calls
+}
+
+// ListTasks calls ListTasksFunc.
+func (mock *TaskListerMock) ListTasks(ctx context.Context) (entity.Tasks, error) {
+	if mock.ListTasksFunc == nil {
+		panic("TaskListerMock.ListTasksFunc: method is nil but TaskLister.ListTasks was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListTasks.Lock()
+	mock.calls.ListTasks = append(mock.calls.ListTasks, callInfo)
+	mock.lockListTasks.Unlock()
+	return mock.ListTasksFunc(ctx)
+}
+
+// ListTasksCalls gets all the calls that were made to ListTasks.
+// Check the length with:
+//
+//	len(mockedTaskLister.ListTasksCalls())
+func (mock *TaskListerMock) ListTasksCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockListTasks.RLock()
+	calls = mock.calls.ListTasks
+	mock.lockListTasks.RUnlock()
+	return calls
+}
+
+// ListWorkTasks calls ListWorkTasksFunc.
+func (mock *TaskListerMock) ListWorkTasks(ctx context.Context) (entity.Tasks, error) {
+	if mock.ListWorkTasksFunc == nil {
+		panic("TaskListerMock.ListWorkTasksFunc: method is nil but TaskLister.ListWorkTasks was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListWorkTasks.Lock()
+	mock.calls.ListWorkTasks = append(mock.calls.ListWorkTasks, callInfo)
+	mock.lockListWorkTasks.Unlock()
+	return mock.ListWorkTasksFunc(ctx)
+}
+
+// ListWorkTasksCalls gets all the calls that were made to ListWorkTasks.
+// Check the length with:
+//
+//	len(mockedTaskLister.ListWorkTasksCalls())
+func (mock *TaskListerMock) ListWorkTasksCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockListWorkTasks.RLock()
+	calls = mock.calls.ListWorkTasks
+	mock.lockListWorkTasks.RUnlock()
+	return calls
+}
+
+// Ensure, that TaskGetterMock does implement TaskGetter.
+// If this is not the case, regenerate this file with moq.
+var _ TaskGetter = &TaskGetterMock{}
+
+// TaskGetterMock is a mock implementation of TaskGetter.
+//
+//	func TestSomethingThatUsesTaskGetter(t *testing.T) {
+//
+//		// make and configure a mocked TaskGetter
+//		mockedTaskGetter := &TaskGetterMock{
+//			GetTaskFunc: func(ctx context.Context, id entity.TaskID) (*entity.Task, error) {
+//				panic("mock out the GetTask method")
+//			},
+//		}
+//
+//		// use mockedTaskGetter in code that requires TaskGetter
+//		// and then make assertions.
+//
+//	}
+type TaskGetterMock struct {
+	// GetTaskFunc mocks the GetTask method.
+	GetTaskFunc func(ctx context.Context, id entity.TaskID) (*entity.Task, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetTask holds details about calls to the GetTask method.
+		GetTask []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID entity.TaskID
+		}
+	}
+	lockGetTask sync.RWMutex
+}
+
+// GetTask calls GetTaskFunc.
+func (mock *TaskGetterMock) GetTask(ctx context.Context, id entity.TaskID) (*entity.Task, error) {
+	if mock.GetTaskFunc == nil {
+		panic("TaskGetterMock.GetTaskFunc: method is nil but TaskGetter.GetTask was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  entity.TaskID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetTask.Lock()
+	mock.calls.GetTask = append(mock.calls.GetTask, callInfo)
+	mock.lockGetTask.Unlock()
+	return mock.GetTaskFunc(ctx, id)
+}
+
+// GetTaskCalls gets all the calls that were made to GetTask.
+// Check the length with:
+//
+//	len(mockedTaskGetter.GetTaskCalls())
+func (mock *TaskGetterMock) GetTaskCalls() []struct {
+	Ctx context.Context
+	ID  entity.TaskID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  entity.TaskID
+	}
+	mock.lockGetTask.RLock()
+	calls = mock.calls.GetTask
+	mock.lockGetTask.RUnlock()
+	return calls
+}
+
+// Ensure, that TaskAdderMock does implement TaskAdder.
+// If this is not the case, regenerate this file with moq.
+var _ TaskAdder = &TaskAdderMock{}
+
+// TaskAdderMock is a mock implementation of TaskAdder.
+//
+//	func TestSomethingThatUsesTaskAdder(t *testing.T) {
+//
+//		// make and configure a mocked TaskAdder
+//		mockedTaskAdder := &TaskAdderMock{
+//			AddTaskFunc: func(ctx context.Context, title string, due *time.Time, parent *entity.TaskID, attrs entity.TaskAttributes) (*entity.Task, error) {
+//				panic("mock out the AddTask method")
+//			},
+//		}
+//
+//		// use mockedTaskAdder in code that requires TaskAdder
+//		// and then make assertions.
+//
+//	}
+type TaskAdderMock struct {
+	// AddTaskFunc mocks the AddTask method.
+	AddTaskFunc func(ctx context.Context, title string, due *time.Time, parent *entity.TaskID, attrs entity.TaskAttributes) (*entity.Task, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AddTask holds details about calls to the AddTask method.
+		AddTask []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Title is the title argument value.
+			Title string
+			// Due is the due argument value.
+			Due *time.Time
+			// Parent is the parent argument value.
+			Parent *entity.TaskID
+			// Attrs is the attrs argument value.
+			Attrs entity.TaskAttributes
+		}
+	}
+	lockAddTask sync.RWMutex
+}
+
+// AddTask calls AddTaskFunc.
+func (mock *TaskAdderMock) AddTask(ctx context.Context, title string, due *time.Time, parent *entity.TaskID, attrs entity.TaskAttributes) (*entity.Task, error) {
+	if mock.AddTaskFunc == nil {
+		panic("TaskAdderMock.AddTaskFunc: method is nil but TaskAdder.AddTask was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Title  string
+		Due    *time.Time
+		Parent *entity.TaskID
+		Attrs  entity.TaskAttributes
+	}{
+		Ctx:    ctx,
+		Title:  title,
+		Due:    due,
+		Parent: parent,
+		Attrs:  attrs,
+	}
+	mock.lockAddTask.Lock()
+	mock.calls.AddTask = append(mock.calls.AddTask, callInfo)
+	mock.lockAddTask.Unlock()
+	return mock.AddTaskFunc(ctx, title, due, parent, attrs)
+}
+
+// AddTaskCalls gets all the calls that were made to AddTask.
+// Check the length with:
+//
+//	len(mockedTaskAdder.AddTaskCalls())
+func (mock *TaskAdderMock) AddTaskCalls() []struct {
+	Ctx    context.Context
+	Title  string
+	Due    *time.Time
+	Parent *entity.TaskID
+	Attrs  entity.TaskAttributes
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Title  string
+		Due    *time.Time
+		Parent *entity.TaskID
+		Attrs  entity.TaskAttributes
+	}
+	mock.lockAddTask.RLock()
+	calls = mock.calls.AddTask
+	mock.lockAddTask.RUnlock()
+	return calls
+}
+
+// Ensure, that TaskUpdaterMock does implement TaskUpdater.
+// If this is not the case, regenerate this file with moq.
+var _ TaskUpdater = &TaskUpdaterMock{}
+
+// TaskUpdaterMock is a mock implementation of TaskUpdater.
+//
+//	func TestSomethingThatUsesTaskUpdater(t *testing.T) {
+//
+//		// make and configure a mocked TaskUpdater
+//		mockedTaskUpdater := &TaskUpdaterMock{
+//			UpdateTaskFunc: func(ctx context.Context, id entity.TaskID, title *string, status *entity.TaskStatus) (*entity.Task, error) {
+//				panic("mock out the UpdateTask method")
+//			},
+//		}
+//
+//		// use mockedTaskUpdater in code that requires TaskUpdater
+//		// and then make assertions.
+//
+//	}
+type TaskUpdaterMock struct {
+	// UpdateTaskFunc mocks the UpdateTask method.
+	UpdateTaskFunc func(ctx context.Context, id entity.TaskID, title *string, status *entity.TaskStatus) (*entity.Task, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// UpdateTask holds details about calls to the UpdateTask method.
+		UpdateTask []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID entity.TaskID
+			// Title is the title argument value.
+			Title *string
+			// Status is the status argument value.
+			Status *entity.TaskStatus
+		}
+	}
+	lockUpdateTask sync.RWMutex
+}
+
+// UpdateTask calls UpdateTaskFunc.
+func (mock *TaskUpdaterMock) UpdateTask(ctx context.Context, id entity.TaskID, title *string, status *entity.TaskStatus) (*entity.Task, error) {
+	if mock.UpdateTaskFunc == nil {
+		panic("TaskUpdaterMock.UpdateTaskFunc: method is nil but TaskUpdater.UpdateTask was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		ID     entity.TaskID
+		Title  *string
+		Status *entity.TaskStatus
+	}{
+		Ctx:    ctx,
+		ID:     id,
+		Title:  title,
+		Status: status,
+	}
+	mock.lockUpdateTask.Lock()
+	mock.calls.UpdateTask = append(mock.calls.UpdateTask, callInfo)
+	mock.lockUpdateTask.Unlock()
+	return mock.UpdateTaskFunc(ctx, id, title, status)
+}
+
+// UpdateTaskCalls gets all the calls that were made to UpdateTask.
+// Check the length with:
+//
+//	len(mockedTaskUpdater.UpdateTaskCalls())
+func (mock *TaskUpdaterMock) UpdateTaskCalls() []struct {
+	Ctx    context.Context
+	ID     entity.TaskID
+	Title  *string
+	Status *entity.TaskStatus
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ID     entity.TaskID
+		Title  *string
+		Status *entity.TaskStatus
+	}
+	mock.lockUpdateTask.RLock()
+	calls = mock.calls.UpdateTask
+	mock.lockUpdateTask.RUnlock()
+	return calls
+}
+
+// Ensure, that TaskDeleterMock does implement TaskDeleter.
+// If this is not the case, regenerate this file with moq.
+var _ TaskDeleter = &TaskDeleterMock{}
+
+// TaskDeleterMock is a mock implementation of TaskDeleter.
+//
+//	func TestSomethingThatUsesTaskDeleter(t *testing.T) {
+//
+//		// make and configure a mocked TaskDeleter
+//		mockedTaskDeleter := &TaskDeleterMock{
+//			DeleteTaskFunc: func(ctx context.Context, id entity.TaskID) (entity.Tasks, error) {
+//				panic("mock out the DeleteTask method")
+//			},
+//		}
+//
+//		// use mockedTaskDeleter in code that requires TaskDeleter
+//		// and then make assertions.
+//
+//	}
+type TaskDeleterMock struct {
+	// DeleteTaskFunc mocks the DeleteTask method.
+	DeleteTaskFunc func(ctx context.Context, id entity.TaskID) (entity.Tasks, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// DeleteTask holds details about calls to the DeleteTask method.
+		DeleteTask []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID entity.TaskID
+		}
+	}
+	lockDeleteTask sync.RWMutex
+}
+
+// DeleteTask calls DeleteTaskFunc.
+func (mock *TaskDeleterMock) DeleteTask(ctx context.Context, id entity.TaskID) (entity.Tasks, error) {
+	if mock.DeleteTaskFunc == nil {
+		panic("TaskDeleterMock.DeleteTaskFunc: method is nil but TaskDeleter.DeleteTask was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  entity.TaskID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockDeleteTask.Lock()
+	mock.calls.DeleteTask = append(mock.calls.DeleteTask, callInfo)
+	mock.lockDeleteTask.Unlock()
+	return mock.DeleteTaskFunc(ctx, id)
+}
+
+// DeleteTaskCalls gets all the calls that were made to DeleteTask.
+// Check the length with:
+//
+//	len(mockedTaskDeleter.DeleteTaskCalls())
+func (mock *TaskDeleterMock) DeleteTaskCalls() []struct {
+	Ctx context.Context
+	ID  entity.TaskID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  entity.TaskID
+	}
+	mock.lockDeleteTask.RLock()
+	calls = mock.calls.DeleteTask
+	mock.lockDeleteTask.RUnlock()
+	return calls
+}
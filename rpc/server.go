@@ -0,0 +1,222 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/rpc/todopb"
+	"github.com/gitwub5/go_todo_app/service"
+	"github.com/gitwub5/go_todo_app/store"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative todopb/todo.proto
+
+const (
+	// DefaultPageSize는 ListTasks의 page_size를 생략했을 때 반환하는 Task 수이다.
+	DefaultPageSize = 100
+	// MaxPageSize는 ListTasks의 page_size로 지정할 수 있는 최대 Task 수이다.
+	MaxPageSize = 1000
+)
+
+// TaskServer는 todopb.TaskServiceServer를 구현한다.
+type TaskServer struct {
+	todopb.UnimplementedTaskServiceServer
+
+	Lister  TaskLister
+	Getter  TaskGetter
+	Adder   TaskAdder
+	Updater TaskUpdater
+	Deleter TaskDeleter
+}
+
+// NewServer 함수는 모든 RPC에 액세스 토큰 검증을 적용한 gRPC 서버를 만든다.
+func NewServer(a Authenticator, ts *TaskServer, opts ...grpc.ServerOption) *grpc.Server {
+	opts = append(opts,
+		grpc.ChainUnaryInterceptor(UnaryAuthInterceptor(a)),
+		grpc.ChainStreamInterceptor(StreamAuthInterceptor(a)),
+	)
+	s := grpc.NewServer(opts...)
+	todopb.RegisterTaskServiceServer(s, ts)
+	return s
+}
+
+// toStatus 함수는 서비스의 에러에 해당하는 gRPC 상태 코드를 붙인다.
+func toStatus(err error) error {
+	switch {
+	case errors.Is(err, store.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrUnknownStatus):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+func newTask(t *entity.Task) *todopb.Task {
+	pt := &todopb.Task{
+		Id:       int64(t.ID),
+		UserId:   int64(t.UserID),
+		Title:    t.Title,
+		Status:   string(t.Status),
+		Created:  timestamppb.New(t.Created),
+		Modified: timestamppb.New(t.Modified),
+	}
+	if t.ParentID != nil {
+		id := int64(*t.ParentID)
+		pt.ParentId = &id
+	}
+	if t.AssigneeID != nil {
+		id := int64(*t.AssigneeID)
+		pt.AssigneeId = &id
+	}
+	if t.Due != nil {
+		pt.Due = timestamppb.New(*t.Due)
+	}
+	return pt
+}
+
+func (s *TaskServer) CreateTask(ctx context.Context, req *todopb.CreateTaskRequest) (*todopb.Task, error) {
+	if req.GetTitle() == "" {
+		return nil, status.Error(codes.InvalidArgument, "title is required")
+	}
+	var due *time.Time
+	if req.Due != nil {
+		if err := req.Due.CheckValid(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		d := req.Due.AsTime()
+		due = &d
+	}
+	var parent *entity.TaskID
+	if req.ParentId != nil {
+		id := entity.TaskID(req.GetParentId())
+		parent = &id
+	}
+	t, err := s.Adder.AddTask(ctx, req.GetTitle(), due, parent, entity.TaskAttributes{})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return newTask(t), nil
+}
+
+func (s *TaskServer) GetTask(ctx context.Context, req *todopb.GetTaskRequest) (*todopb.Task, error) {
+	t, err := s.Getter.GetTask(ctx, entity.TaskID(req.GetId()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return newTask(t), nil
+}
+
+func (s *TaskServer) UpdateTask(ctx context.Context, req *todopb.UpdateTaskRequest) (*todopb.Task, error) {
+	if req.Title != nil && req.GetTitle() == "" {
+		return nil, status.Error(codes.InvalidArgument, "title must not be empty")
+	}
+	var st *entity.TaskStatus
+	if req.Status != nil {
+		v := entity.TaskStatus(req.GetStatus())
+		st = &v
+	}
+	t, err := s.Updater.UpdateTask(ctx, entity.TaskID(req.GetId()), req.Title, st)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return newTask(t), nil
+}
+
+func (s *TaskServer) DeleteTask(ctx context.Context, req *todopb.DeleteTaskRequest) (*todopb.DeleteTaskResponse, error) {
+	ts, err := s.Deleter.DeleteTask(ctx, entity.TaskID(req.GetId()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	rsp := &todopb.DeleteTaskResponse{}
+	for _, t := range ts {
+		rsp.DeletedIds = append(rsp.DeletedIds, int64(t.ID))
+	}
+	return rsp, nil
+}
+
+// ListTasks 메서드는 Task를 ID 순서로 나누어 반환한다. page_token은 이전 페이지의 마지막 Task ID이다.
+func (s *TaskServer) ListTasks(ctx context.Context, req *todopb.ListTasksRequest) (*todopb.ListTasksResponse, error) {
+	size := int(req.GetPageSize())
+	switch {
+	case size < 0 || size > MaxPageSize:
+		return nil, status.Errorf(codes.InvalidArgument, "page_size must be between 0 and %d", MaxPageSize)
+	case size == 0:
+		size = DefaultPageSize
+	}
+	var after entity.TaskID
+	if tok := req.GetPageToken(); tok != "" {
+		id, err := strconv.ParseInt(tok, 10, 64)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page_token %q", tok)
+		}
+		after = entity.TaskID(id)
+	}
+	ts, err := s.list(ctx, req.GetScope(), req.GetStatuses())
+	if err != nil {
+		return nil, err
+	}
+	rsp := &todopb.ListTasksResponse{Tasks: []*todopb.Task{}}
+	for _, t := range ts {
+		if t.ID <= after {
+			continue
+		}
+		if len(rsp.Tasks) == size {
+			rsp.NextPageToken = strconv.FormatInt(rsp.Tasks[size-1].Id, 10)
+			break
+		}
+		rsp.Tasks = append(rsp.Tasks, newTask(t))
+	}
+	return rsp, nil
+}
+
+// StreamTasks 메서드는 조건에 맞는 모든 Task를 하나씩 전송한다.
+func (s *TaskServer) StreamTasks(req *todopb.StreamTasksRequest, stream grpc.ServerStreamingServer[todopb.Task]) error {
+	ts, err := s.list(stream.Context(), req.GetScope(), req.GetStatuses())
+	if err != nil {
+		return err
+	}
+	for _, t := range ts {
+		if err := stream.Send(newTask(t)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// list 메서드는 범위에 해당하는 서비스로 Task를 조회하고, 상태로 걸러낸다.
+func (s *TaskServer) list(ctx context.Context, scope todopb.TaskScope, statuses []string) (entity.Tasks, error) {
+	var ts entity.Tasks
+	var err error
+	switch scope {
+	case todopb.TaskScope_TASK_SCOPE_OWNED:
+		ts, err = s.Lister.ListTasks(ctx)
+	case todopb.TaskScope_TASK_SCOPE_ASSIGNED:
+		ts, err = s.Lister.ListAssignedTasks(ctx)
+	default:
+		ts, err = s.Lister.ListWorkTasks(ctx)
+	}
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	if len(statuses) == 0 {
+		return ts, nil
+	}
+	want := map[entity.TaskStatus]bool{}
+	for _, st := range statuses {
+		want[entity.TaskStatus(st)] = true
+	}
+	filtered := entity.Tasks{}
+	for _, t := range ts {
+		if want[t.Status] {
+			filtered = append(filtered, t)
+		}
+	}
+	return filtered, nil
+}
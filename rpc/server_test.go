@@ -0,0 +1,219 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/clock"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/rpc/todopb"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func prepareClient(t *testing.T, ts *TaskServer) todopb.TaskServiceClient {
+	t.Helper()
+	a := &AuthenticatorMock{
+		AuthenticateFunc: func(ctx context.Context, token string) (context.Context, error) {
+			if token != "valid" {
+				return nil, errors.New("invalid token")
+			}
+			return auth.SetUserID(ctx, 1), nil
+		},
+	}
+	l := bufconn.Listen(1024 * 1024)
+	s := NewServer(a, ts)
+	go func() { _ = s.Serve(l) }()
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return l.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return todopb.NewTaskServiceClient(conn)
+}
+
+func withToken(token string) context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+}
+
+func prepareTasks() entity.Tasks {
+	now := clock.FixedClocker{}.Now()
+	ts := entity.Tasks{}
+	for i := 1; i <= 5; i++ {
+		st := entity.TaskStatusTodo
+		if i%2 == 0 {
+			st = entity.TaskStatusDone
+		}
+		ts = append(ts, &entity.Task{
+			ID: entity.TaskID(i), UserID: 1, Title: fmt.Sprintf("task %d", i),
+			Status: st, Created: now, Modified: now,
+		})
+	}
+	return ts
+}
+
+func TestTaskServer_Auth(t *testing.T) {
+	t.Parallel()
+
+	client := prepareClient(t, &TaskServer{
+		Getter: &TaskGetterMock{
+			GetTaskFunc: func(ctx context.Context, id entity.TaskID) (*entity.Task, error) {
+				if _, ok := auth.GetUserID(ctx); !ok {
+					t.Error("want user_id in context")
+				}
+				return nil, fmt.Errorf("failed to get: task %d: %w", id, store.ErrNotFound)
+			},
+		},
+	})
+	tests := map[string]struct {
+		ctx  context.Context
+		want codes.Code
+	}{
+		"noToken":      {ctx: context.Background(), want: codes.Unauthenticated},
+		"invalidToken": {ctx: withToken("invalid"), want: codes.Unauthenticated},
+		"notFound":     {ctx: withToken("valid"), want: codes.NotFound},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := client.GetTask(tt.ctx, &todopb.GetTaskRequest{Id: 9})
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("want %v, but got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestTaskServer_CreateTask(t *testing.T) {
+	t.Parallel()
+
+	due := time.Date(2022, 5, 11, 9, 0, 0, 0, time.UTC)
+	client := prepareClient(t, &TaskServer{
+		Adder: &TaskAdderMock{
+			AddTaskFunc: func(ctx context.Context, title string, d *time.Time, parent *entity.TaskID, attrs entity.TaskAttributes) (*entity.Task, error) {
+				if d == nil || !d.Equal(due) || parent == nil || *parent != 1 {
+					t.Fatalf("unexpected input: %v, %v", d, parent)
+				}
+				return &entity.Task{ID: 2, UserID: 1, ParentID: parent, Title: title, Status: entity.TaskStatusTodo, Due: d}, nil
+			},
+		},
+	})
+	parent := int64(1)
+	got, err := client.CreateTask(withToken("valid"), &todopb.CreateTaskRequest{
+		Title: "write docs", Due: timestamppb.New(due), ParentId: &parent,
+	})
+	if err != nil {
+		t.Fatalf("want no error, but got %v", err)
+	}
+	if got.GetId() != 2 || got.GetParentId() != 1 || !got.GetDue().AsTime().Equal(due) {
+		t.Errorf("unexpected task: %v", got)
+	}
+
+	_, err = client.CreateTask(withToken("valid"), &todopb.CreateTaskRequest{})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("want InvalidArgument, but got %v", err)
+	}
+}
+
+func TestTaskServer_ListTasks(t *testing.T) {
+	t.Parallel()
+
+	tasks := prepareTasks()
+	client := prepareClient(t, &TaskServer{
+		Lister: &TaskListerMock{
+			ListWorkTasksFunc: func(ctx context.Context) (entity.Tasks, error) {
+				return tasks, nil
+			},
+		},
+	})
+	ctx := withToken("valid")
+	var ids []int64
+	token := ""
+	for pages := 0; ; pages++ {
+		if pages > 3 {
+			t.Fatal("too many pages")
+		}
+		rsp, err := client.ListTasks(ctx, &todopb.ListTasksRequest{PageSize: 2, PageToken: token})
+		if err != nil {
+			t.Fatalf("want no error, but got %v", err)
+		}
+		for _, pt := range rsp.GetTasks() {
+			ids = append(ids, pt.GetId())
+		}
+		if token = rsp.GetNextPageToken(); token == "" {
+			break
+		}
+	}
+	if d := cmp.Diff([]int64{1, 2, 3, 4, 5}, ids); d != "" {
+		t.Errorf("differs: (-want +got)\n%s", d)
+	}
+
+	_, err := client.ListTasks(ctx, &todopb.ListTasksRequest{PageToken: "abc"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("want InvalidArgument, but got %v", err)
+	}
+}
+
+func TestTaskServer_StreamTasks(t *testing.T) {
+	t.Parallel()
+
+	tasks := prepareTasks()
+	client := prepareClient(t, &TaskServer{
+		Lister: &TaskListerMock{
+			ListTasksFunc: func(ctx context.Context) (entity.Tasks, error) {
+				return tasks, nil
+			},
+		},
+	})
+	stream, err := client.StreamTasks(withToken("valid"), &todopb.StreamTasksRequest{
+		Scope:    todopb.TaskScope_TASK_SCOPE_OWNED,
+		Statuses: []string{string(entity.TaskStatusDone)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []int64
+	for {
+		pt, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("want no error, but got %v", err)
+		}
+		ids = append(ids, pt.GetId())
+	}
+	if d := cmp.Diff([]int64{2, 4}, ids); d != "" {
+		t.Errorf("differs: (-want +got)\n%s", d)
+	}
+
+	stream, err = client.StreamTasks(context.Background(), &todopb.StreamTasksRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := stream.Recv(); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("want Unauthenticated, but got %v", err)
+	}
+}
@@ -10,22 +10,37 @@ import (
 	"syscall"
 
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
 )
 
 type Server struct {
 	srv *http.Server
 	l   net.Listener
+	// gRPC 서버는 ServeGRPC로 등록한 경우에만 실행한다.
+	grpc *grpc.Server
+	gl   net.Listener
 }
 
 func NewServer(l net.Listener, mux http.Handler) *Server {
+	// GET /events처럼 오래 유지되는 요청이 Shutdown을 막지 않도록, 종료를 시작하면 요청의 context를 취소한다.
+	ctx, cancel := context.WithCancel(context.Background())
+	srv := &http.Server{
+		Handler:     mux,
+		BaseContext: func(net.Listener) context.Context { return ctx },
+	}
+	srv.RegisterOnShutdown(cancel)
 	return &Server{
-		srv: &http.Server{
-			Handler: mux,
-		},
-		l: l,
+		srv: srv,
+		l:   l,
 	}
 }
 
+// ServeGRPC 메서드는 Run에서 HTTP 서버와 함께 실행할 gRPC 서버를 등록한다.
+func (s *Server) ServeGRPC(l net.Listener, gs *grpc.Server) {
+	s.grpc = gs
+	s.gl = l
+}
+
 // Server 타입의 Run 메서드 구현
 func (s *Server) Run(ctx context.Context) error {
 	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
@@ -39,11 +54,25 @@ func (s *Server) Run(ctx context.Context) error {
 		}
 		return nil
 	})
+	if s.grpc != nil {
+		eg.Go(func() error {
+			if err := s.grpc.Serve(s.gl); err != nil &&
+				err != grpc.ErrServerStopped {
+				log.Printf("failed to close grpc: %+v", err)
+				return err
+			}
+			return nil
+		})
+	}
 
 	<-ctx.Done()
 	if err := s.srv.Shutdown(context.Background()); err != nil {
 		log.Printf("failed to shutdown: %+v", err)
 	}
+	if s.grpc != nil {
+		// 진행 중인 RPC가 끝날 때까지 기다린다.
+		s.grpc.GracefulStop()
+	}
 
 	return eg.Wait()
 }
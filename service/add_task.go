@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/gitwub5/go_todo_app/auth"
 	"github.com/gitwub5/go_todo_app/entity"
@@ -10,23 +11,53 @@ import (
 )
 
 type AddTask struct {
-	DB   store.Execer
-	Repo TaskAdder
+	DB        store.TxBeginner
+	Repo      TaskCreator
+	Publisher EventPublisher // Task 변경 이벤트를 전달한다. nil이면 전달하지 않는다.
 }
 
-func (a *AddTask) AddTask(ctx context.Context, title string) (*entity.Task, error) {
+// AddTask 메서드는 Task를 등록한다. parent를 지정하면 해당 Task의 하위 Task로 등록한다.
+// attrs의 라벨, 우선순위, 반복 규칙도 함께 저장한다.
+func (a *AddTask) AddTask(
+	ctx context.Context, title string, due *time.Time, parent *entity.TaskID, attrs entity.TaskAttributes,
+) (*entity.Task, error) {
 	id, ok := auth.GetUserID(ctx)
 	if !ok {
 		return nil, fmt.Errorf("user_id not found")
 	}
+	// Task와 변경 내역을 함께 기록한다.
+	tx, err := a.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin: %w", err)
+	}
+	// Commit 이후의 Rollback은 아무것도 하지 않는다.
+	defer func() { _ = tx.Rollback() }()
+
+	// 다른 사용자의 Task 아래에는 등록할 수 없다. 하위 Task는 상위 Task의 프로젝트에 속한다.
+	var project *entity.ProjectID
+	if parent != nil {
+		p, err := a.Repo.GetTask(ctx, tx, id, *parent)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get parent: %w", err)
+		}
+		project = p.ProjectID
+	}
 	t := &entity.Task{
-		UserID: id,
-		Title:  title,
-		Status: entity.TaskStatusTodo,
+		UserID:    id,
+		ProjectID: project,
+		ParentID:  parent,
+		Title:     title,
+		Status:    entity.TaskStatusTodo,
+		Due:       due,
+
+		TaskAttributes: attrs,
 	}
-	err := a.Repo.AddTask(ctx, a.DB, t)
-	if err != nil {
+	if err := a.Repo.AddTask(ctx, tx, t); err != nil {
 		return nil, fmt.Errorf("failed to register: %w", err)
 	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit: %w", err)
+	}
+	publish(ctx, a.Publisher, entity.EventTaskCreated, t)
 	return t, nil
 }
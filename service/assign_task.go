@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+)
+
+// ErrUnknownAssignee는 담당자로 지정하려는 사용자가 Task의 프로젝트를 함께 사용하지 않을 때 반환된다.
+// 존재하지 않는 사용자도 같은 에러를 반환하므로, 담당자 지정으로 다른 사용자의 존재를 확인할 수는 없다.
+var ErrUnknownAssignee = errors.New("unknown assignee")
+
+// AssignTask는 Task의 담당자를 지정하거나 해제한다.
+// 담당자를 변경할 수 있는 사용자는 Task의 소유자이다. 소유자는 자기 자신을 담당자로 지정할 수 있고,
+// 다른 사용자는 Task가 속한 프로젝트에 소유자와 함께 멤버로 있을 때만 지정할 수 있다.
+type AssignTask struct {
+	DB        store.TxQueryer
+	Repo      TaskAssigner
+	Notifier  Notifier       // 담당자에게 알림을 보낸다. nil이면 알림을 보내지 않는다.
+	Publisher EventPublisher // Task 변경 이벤트를 전달한다. nil이면 전달하지 않는다.
+}
+
+// AssignTask 메서드는 Task의 담당자를 지정한다.
+func (a *AssignTask) AssignTask(
+	ctx context.Context, tid entity.TaskID, assignee entity.UserID,
+) (*entity.Task, error) {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("user_id not found")
+	}
+	t, err := a.assign(ctx, id, tid, &assignee)
+	if err != nil {
+		return nil, err
+	}
+	publish(ctx, a.Publisher, entity.EventTaskAssigned, t)
+	// 자기 자신을 담당자로 지정한 경우에는 알리지 않는다.
+	if assignee != id {
+		notify(ctx, a.Notifier, &entity.Notification{
+			UserID:  assignee,
+			Type:    entity.NotificationTaskAssigned,
+			TaskID:  &t.ID,
+			Message: fmt.Sprintf("task %q was assigned to you", t.Title),
+		})
+	}
+	return t, nil
+}
+
+// UnassignTask 메서드는 Task의 담당자를 해제한다.
+func (a *AssignTask) UnassignTask(ctx context.Context, tid entity.TaskID) (*entity.Task, error) {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("user_id not found")
+	}
+	t, err := a.assign(ctx, id, tid, nil)
+	if err != nil {
+		return nil, err
+	}
+	publish(ctx, a.Publisher, entity.EventTaskUpdated, t)
+	return t, nil
+}
+
+func (a *AssignTask) assign(
+	ctx context.Context, uid entity.UserID, tid entity.TaskID, assignee *entity.UserID,
+) (*entity.Task, error) {
+	// 담당자와 변경 내역을 함께 기록한다.
+	tx, err := a.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin: %w", err)
+	}
+	// Commit 이후의 Rollback은 아무것도 하지 않는다.
+	defer func() { _ = tx.Rollback() }()
+
+	t, err := a.Repo.GetTask(ctx, tx, uid, tid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get: %w", err)
+	}
+	if assignee != nil && *assignee != uid {
+		if err := a.shareProject(ctx, tx, t, uid, *assignee); err != nil {
+			return nil, err
+		}
+	}
+	t.AssigneeID = assignee
+	if err := a.Repo.AssignTask(ctx, tx, t); err != nil {
+		return nil, fmt.Errorf("failed to assign: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit: %w", err)
+	}
+	return t, nil
+}
+
+// shareProject 메서드는 소유자와 담당자가 모두 Task가 속한 프로젝트의 멤버인지 확인한다.
+func (a *AssignTask) shareProject(
+	ctx context.Context, db store.Queryer, t *entity.Task, uid, assignee entity.UserID,
+) error {
+	if t.ProjectID == nil {
+		return fmt.Errorf("task %d is not in a project: %w", t.ID, ErrUnknownAssignee)
+	}
+	for _, id := range []entity.UserID{uid, assignee} {
+		ok, err := a.Repo.IsProjectMember(ctx, db, *t.ProjectID, id)
+		if err != nil {
+			return fmt.Errorf("failed to check member: %w", err)
+		}
+		if !ok {
+			return fmt.Errorf("user %d: %w", assignee, ErrUnknownAssignee)
+		}
+	}
+	return nil
+}
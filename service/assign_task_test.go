@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/jmoiron/sqlx"
+)
+
+func TestAssignTask_Project(t *testing.T) {
+	t.Parallel()
+
+	pid := entity.ProjectID(5)
+	tests := map[string]struct {
+		project  *entity.ProjectID
+		assignee entity.UserID
+		members  []entity.UserID
+		wantErr  error
+	}{
+		// 소유자는 프로젝트와 관계없이 자기 자신을 담당자로 지정할 수 있다.
+		"self":   {assignee: 10},
+		"member": {project: &pid, assignee: 20, members: []entity.UserID{10, 20}},
+		// 프로젝트에 속하지 않은 Task는 다른 사용자에게 지정할 수 없다.
+		"noProject": {assignee: 20, wantErr: ErrUnknownAssignee},
+		"stranger":  {project: &pid, assignee: 30, members: []entity.UserID{10, 20}, wantErr: ErrUnknownAssignee},
+		// 프로젝트에서 나간 소유자는 남은 멤버에게 지정할 수 없다.
+		"ownerLeft": {project: &pid, assignee: 20, members: []entity.UserID{20}, wantErr: ErrUnknownAssignee},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			t.Cleanup(func() { _ = db.Close() })
+			mock.ExpectBegin()
+			if tt.wantErr == nil {
+				mock.ExpectCommit()
+			} else {
+				mock.ExpectRollback()
+			}
+
+			moq := &TaskAssignerMock{}
+			moq.GetTaskFunc = func(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.TaskID) (*entity.Task, error) {
+				return &entity.Task{ID: id, UserID: uid, ProjectID: tt.project, Title: "test"}, nil
+			}
+			moq.IsProjectMemberFunc = func(ctx context.Context, db store.Queryer, id entity.ProjectID, uid entity.UserID) (bool, error) {
+				if id != pid {
+					t.Errorf("want project %d, but got %d", pid, id)
+				}
+				for _, m := range tt.members {
+					if m == uid {
+						return true, nil
+					}
+				}
+				return false, nil
+			}
+			moq.AssignTaskFunc = func(ctx context.Context, db store.ExecQueryer, t *entity.Task) error {
+				return nil
+			}
+
+			sut := &AssignTask{DB: sqlx.NewDb(db, "mysql"), Repo: moq}
+			ctx := auth.SetUserID(context.Background(), 10)
+			got, err := sut.AssignTask(ctx, 1, tt.assignee)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("want %v, but got %v", tt.wantErr, err)
+			}
+			if tt.wantErr == nil && (got.AssigneeID == nil || *got.AssigneeID != tt.assignee) {
+				t.Errorf("want assignee %d, but got %v", tt.assignee, got.AssigneeID)
+			}
+			if tt.wantErr != nil && len(moq.AssignTaskCalls()) != 0 {
+				t.Error("want not to assign")
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+)
+
+// DeleteTask는 Task를 하위 Task와 함께 삭제한다. 소유자만 삭제할 수 있다.
+type DeleteTask struct {
+	DB        store.TxQueryer
+	Repo      TaskRemover
+	Publisher EventPublisher // Task 변경 이벤트를 전달한다. nil이면 전달하지 않는다.
+}
+
+// DeleteTask 메서드는 삭제한 Task를 삭제한 순서로 반환한다.
+func (d *DeleteTask) DeleteTask(ctx context.Context, tid entity.TaskID) (entity.Tasks, error) {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("user_id not found")
+	}
+	tx, err := d.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin: %w", err)
+	}
+	// Commit 이후의 Rollback은 아무것도 하지 않는다.
+	defer func() { _ = tx.Rollback() }()
+
+	t, err := d.Repo.GetTask(ctx, tx, id, tid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get: %w", err)
+	}
+	deleted, err := deleteTaskTree(ctx, tx, d.Repo, t)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit: %w", err)
+	}
+	for _, t := range deleted {
+		publish(ctx, d.Publisher, entity.EventTaskDeleted, t)
+	}
+	return deleted, nil
+}
+
+// deleteTaskTree 함수는 Task와 하위 Task를 삭제하고, 삭제한 Task를 삭제한 순서로 반환한다.
+// 하위 Task의 삭제도 변경 내역에 남도록 가장 아래의 Task부터 삭제한다.
+func deleteTaskTree(
+	ctx context.Context, db store.ExecQueryer, repo TaskRemover, t *entity.Task,
+) (entity.Tasks, error) {
+	ts, err := repo.ListTasks(ctx, db, t.UserID)
+	if err != nil {
+		return nil, err
+	}
+	children := map[entity.TaskID]entity.Tasks{}
+	for _, c := range ts {
+		if c.ParentID != nil {
+			children[*c.ParentID] = append(children[*c.ParentID], c)
+		}
+	}
+	deleted := entity.Tasks{}
+	var remove func(t *entity.Task) error
+	remove = func(t *entity.Task) error {
+		for _, c := range children[t.ID] {
+			if err := remove(c); err != nil {
+				return err
+			}
+		}
+		if err := repo.DeleteTask(ctx, db, t); err != nil {
+			return err
+		}
+		deleted = append(deleted, t)
+		return nil
+	}
+	if err := remove(t); err != nil {
+		return nil, err
+	}
+	return deleted, nil
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/gitwub5/go_todo_app/entity"
+)
+
+// publish 함수는 Task 변경 이벤트를 전달한다.
+// 전달에 실패해도 원래 처리는 성공으로 끝나도록 에러를 로그로만 남긴다.
+func publish(ctx context.Context, p EventPublisher, typ entity.EventType, t *entity.Task) {
+	if p == nil {
+		return
+	}
+	if err := p.Publish(ctx, &entity.TaskEvent{Type: typ, UserID: t.UserID, Task: t}); err != nil {
+		log.Printf("failed to publish %s event of task %d: %v", typ, t.ID, err)
+	}
+}
+
+// Publishers는 여러 EventPublisher에 같은 이벤트를 전달한다.
+// 하나가 실패해도 나머지에는 전달하고, 발생한 에러를 모두 묶어서 반환한다.
+type Publishers []EventPublisher
+
+var _ EventPublisher = Publishers(nil)
+
+func (ps Publishers) Publish(ctx context.Context, e *entity.TaskEvent) error {
+	var errs []error
+	for _, p := range ps {
+		if err := p.Publish(ctx, e); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
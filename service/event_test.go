@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/google/go-cmp/cmp"
+	"github.com/jmoiron/sqlx"
+)
+
+func TestUpdateTask_Publish(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		from, to entity.TaskStatus
+		want     []entity.EventType
+	}{
+		"completed": {
+			from: "doing", to: "done",
+			want: []entity.EventType{entity.EventTaskUpdated, entity.EventTaskCompleted},
+		},
+		// 이미 완료된 Task의 상태를 다시 완료로 바꾸면 완료 이벤트를 보내지 않는다.
+		"alreadyClosed": {
+			from: "done", to: "done",
+			want: []entity.EventType{entity.EventTaskUpdated},
+		},
+		"reopened": {
+			from: "done", to: "todo",
+			want: []entity.EventType{entity.EventTaskUpdated},
+		},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			t.Cleanup(func() { _ = db.Close() })
+			mock.ExpectBegin()
+			mock.ExpectCommit()
+
+			moq := &TaskEditorMock{}
+			moq.GetTaskFunc = func(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.TaskID) (*entity.Task, error) {
+				return &entity.Task{ID: id, UserID: uid, Status: tt.from}, nil
+			}
+			moq.ListTaskStatusesFunc = func(ctx context.Context, db store.Queryer, id entity.UserID) (entity.TaskStatusDefs, error) {
+				return nil, nil
+			}
+			moq.UpdateTaskFunc = func(ctx context.Context, db store.Execer, t *entity.Task) error {
+				return nil
+			}
+			var got []entity.EventType
+			pub := &EventPublisherMock{}
+			pub.PublishFunc = func(ctx context.Context, e *entity.TaskEvent) error {
+				got = append(got, e.Type)
+				return nil
+			}
+
+			sut := &UpdateTask{DB: sqlx.NewDb(db, "mysql"), Repo: moq, Publisher: pub}
+			ctx := auth.SetUserID(context.Background(), 10)
+			to := tt.to
+			if _, err := sut.UpdateTask(ctx, 1, nil, &to); err != nil {
+				t.Fatalf("want no error, but got %v", err)
+			}
+			if d := cmp.Diff(got, tt.want); len(d) != 0 {
+				t.Errorf("differs: (-got +want)\n%s", d)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
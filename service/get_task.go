@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+)
+
+type GetTask struct {
+	DB   store.Queryer
+	Repo WorkTaskGetter
+}
+
+// GetTask 메서드는 사용자가 소유하거나 담당하는 Task를 반환한다.
+func (g *GetTask) GetTask(ctx context.Context, tid entity.TaskID) (*entity.Task, error) {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("user_id not found")
+	}
+	t, err := g.Repo.GetWorkTask(ctx, g.DB, id, tid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get: %w", err)
+	}
+	return t, nil
+}
@@ -2,12 +2,14 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/mail"
 	"github.com/gitwub5/go_todo_app/store"
 )
 
-//go:generate go run github.com/matryer/moq -out moq_test.go . TaskAdder TaskLister UserRegister UserGetter TokenGenerator
+//go:generate go run github.com/matryer/moq -out moq_test.go . TaskAdder TaskLister TaskGetter WorkTaskGetter TaskUpdater TaskStatusLister TaskStatusAdder TaskStatusRepository TaskListRepository TaskAssigner ProjectRepository TaskEditor TaskRemover TimeTracker TemplateRepository SyncRepository Notifier NotificationRepository OverdueRepository EventPublisher WebhookRepository PresenceRepository PresenceStore Mailer MailPreferenceRepository PasswordResetRepository PersonalAccessTokenRepository OAuthRepository AuthorizationCodeStore OAuthTokens TokenStore UserRegister UserGetter UserByIDGetter TokenGenerator TokenRotator SessionStore
 type TaskAdder interface {
 	AddTask(ctx context.Context, db store.Execer, t *entity.Task) error
 }
@@ -16,6 +18,208 @@ type TaskLister interface {
 	ListTasks(ctx context.Context, db store.Queryer, id entity.UserID) (entity.Tasks, error)
 }
 
+type TaskGetter interface {
+	GetTask(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.TaskID) (*entity.Task, error)
+}
+
+// WorkTaskGetter는 사용자가 소유하거나 담당하는 Task를 가져온다.
+type WorkTaskGetter interface {
+	GetWorkTask(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.TaskID) (*entity.Task, error)
+}
+
+type TaskUpdater interface {
+	UpdateTask(ctx context.Context, db store.Execer, t *entity.Task) error
+}
+
+type TaskStatusLister interface {
+	ListTaskStatuses(ctx context.Context, db store.Queryer, id entity.UserID) (entity.TaskStatusDefs, error)
+}
+
+type TaskStatusAdder interface {
+	AddTaskStatus(ctx context.Context, db store.Execer, s *entity.TaskStatusDef) error
+}
+
+type TaskListRepository interface {
+	TaskLister
+	ListAssignedTasks(ctx context.Context, db store.Queryer, id entity.UserID) (entity.Tasks, error)
+	ListWorkTasks(ctx context.Context, db store.Queryer, id entity.UserID) (entity.Tasks, error)
+}
+
+type TaskAssigner interface {
+	TaskGetter
+	ProjectMemberChecker
+	AssignTask(ctx context.Context, db store.ExecQueryer, t *entity.Task) error
+}
+
+type ProjectMemberChecker interface {
+	IsProjectMember(ctx context.Context, db store.Queryer, id entity.ProjectID, uid entity.UserID) (bool, error)
+}
+
+type ProjectRepository interface {
+	TaskAssigner
+	UserByIDGetter
+	AddProject(ctx context.Context, db store.Execer, p *entity.Project) error
+	ListProjects(ctx context.Context, db store.Queryer, uid entity.UserID) (entity.Projects, error)
+	GetProject(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.ProjectID) (*entity.Project, error)
+	ListProjectMembers(ctx context.Context, db store.Queryer, id entity.ProjectID) ([]entity.UserID, error)
+	AddProjectMember(ctx context.Context, db store.Execer, id entity.ProjectID, uid entity.UserID) error
+	DeleteProjectMember(ctx context.Context, db store.Execer, id entity.ProjectID, uid entity.UserID) error
+	ListProjectTasks(ctx context.Context, db store.Queryer, id entity.ProjectID) (entity.Tasks, error)
+	SetTaskProject(ctx context.Context, db store.Execer, t *entity.Task) error
+}
+
+type TaskCreator interface {
+	TaskAdder
+	TaskGetter
+}
+
+type TaskStatusRepository interface {
+	TaskStatusLister
+	TaskStatusAdder
+}
+
+type TaskEditor interface {
+	TaskGetter
+	TaskUpdater
+	TaskStatusLister
+}
+
+type TaskRemover interface {
+	TaskGetter
+	TaskLister
+	DeleteTask(ctx context.Context, db store.Execer, t *entity.Task) error
+}
+
+type TimeTracker interface {
+	TaskGetter
+	AddTimeEntry(ctx context.Context, db store.Execer, e *entity.TimeEntry) error
+	GetRunningTimeEntry(ctx context.Context, db store.Queryer, uid entity.UserID) (*entity.TimeEntry, error)
+	StopTimeEntry(ctx context.Context, db store.Execer, e *entity.TimeEntry) error
+	ListTimeEntries(ctx context.Context, db store.Queryer, uid entity.UserID, tid entity.TaskID) (entity.TimeEntries, error)
+	ListTimeEntriesBetween(ctx context.Context, db store.Queryer, uid entity.UserID, from, to time.Time) (entity.TimeEntries, error)
+}
+
+type TemplateRepository interface {
+	TaskAdder
+	TaskGetter
+	TaskLister
+	ProjectMemberChecker
+	AddTemplate(ctx context.Context, db store.Execer, t *entity.Template) error
+	ListTemplates(ctx context.Context, db store.Queryer, uid entity.UserID) (entity.Templates, error)
+	GetTemplate(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.TemplateID) (*entity.Template, error)
+}
+
+type SyncRepository interface {
+	TaskRemover
+	TaskAdder
+	TaskUpdater
+	TaskStatusLister
+	ListWorkTasks(ctx context.Context, db store.Queryer, id entity.UserID) (entity.Tasks, error)
+	GetTaskByClientID(ctx context.Context, db store.Queryer, uid entity.UserID, clientID string) (*entity.Task, error)
+	ListTasksByIDs(ctx context.Context, db store.Queryer, ids []entity.TaskID) (entity.Tasks, error)
+	ListTaskChanges(ctx context.Context, db store.Queryer, uid entity.UserID, since int64, limit int) (entity.TaskChanges, error)
+	LatestTaskChangeSeq(ctx context.Context, db store.Queryer, uid entity.UserID) (int64, error)
+}
+
+// Notifier는 서비스 계층에서 발생한 이벤트를 사용자에게 알리는 인터페이스이다.
+type Notifier interface {
+	Notify(ctx context.Context, n *entity.Notification) error
+}
+
+type NotificationRepository interface {
+	AddNotification(ctx context.Context, db store.Execer, n *entity.Notification) error
+	ListNotifications(ctx context.Context, db store.Queryer, uid entity.UserID, unreadOnly bool) (entity.Notifications, error)
+	CountUnreadNotifications(ctx context.Context, db store.Queryer, uid entity.UserID) (int, error)
+	MarkNotificationRead(ctx context.Context, db store.ExecQueryer, uid entity.UserID, id entity.NotificationID) error
+	MarkAllNotificationsRead(ctx context.Context, db store.Execer, uid entity.UserID) (int64, error)
+}
+
+type OverdueRepository interface {
+	ListOverdueTasks(ctx context.Context, db store.Queryer, now time.Time) (entity.Tasks, error)
+	AddNotification(ctx context.Context, db store.Execer, n *entity.Notification) error
+}
+
+// EventPublisher는 서비스 계층에서 발생한 Task 변경 이벤트를 외부로 전달하는 인터페이스이다.
+type EventPublisher interface {
+	Publish(ctx context.Context, e *entity.TaskEvent) error
+}
+
+type PresenceRepository interface {
+	ProjectMemberChecker
+}
+
+// PresenceStore는 프로젝트 보드를 보고 있는 사용자를 만료 시각과 함께 기록하는 저장소이다.
+type PresenceStore interface {
+	TouchPresence(ctx context.Context, pid entity.ProjectID, uid entity.UserID, session string, expires time.Time) error
+	RemovePresence(ctx context.Context, pid entity.ProjectID, uid entity.UserID, session string) error
+	ListPresence(ctx context.Context, pid entity.ProjectID, now time.Time) ([]entity.UserID, error)
+}
+
+type WebhookRepository interface {
+	AddWebhook(ctx context.Context, db store.Execer, w *entity.Webhook) error
+	ListWebhooks(ctx context.Context, db store.Queryer, uid entity.UserID) (entity.Webhooks, error)
+	GetWebhook(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.WebhookID) (*entity.Webhook, error)
+	DeleteWebhook(ctx context.Context, db store.Execer, uid entity.UserID, id entity.WebhookID) error
+	UpdateWebhookStatus(ctx context.Context, db store.Execer, w *entity.Webhook) error
+	ListWebhookDeliveries(ctx context.Context, db store.Queryer, id entity.WebhookID, limit int) (entity.WebhookDeliveries, error)
+}
+
+// Mailer는 메일을 전송하는 인터페이스이다. mail.SMTP와 mail.Capture가 구현한다.
+type Mailer interface {
+	Send(ctx context.Context, m *mail.Message) error
+}
+
+type MailPreferenceRepository interface {
+	GetUserByID(ctx context.Context, db store.Queryer, id entity.UserID) (*entity.User, error)
+	UpdateUserEmail(ctx context.Context, db store.Execer, id entity.UserID, email *string) error
+	ListMailOptOuts(ctx context.Context, db store.Queryer, uid entity.UserID) ([]entity.MailKind, error)
+	SetMailOptOut(ctx context.Context, db store.Execer, uid entity.UserID, kind entity.MailKind, optOut bool) error
+}
+
+type PasswordResetRepository interface {
+	UserGetter
+	UpdateUserPassword(ctx context.Context, db store.Execer, id entity.UserID, password string) error
+}
+
+type PersonalAccessTokenRepository interface {
+	UserByIDGetter
+	AddPersonalAccessToken(ctx context.Context, db store.Execer, t *entity.PersonalAccessToken) error
+	ListPersonalAccessTokens(ctx context.Context, db store.Queryer, uid entity.UserID) (entity.PersonalAccessTokens, error)
+	GetPersonalAccessTokenByHash(ctx context.Context, db store.Queryer, hash string) (*entity.PersonalAccessToken, error)
+	DeletePersonalAccessToken(ctx context.Context, db store.Execer, uid entity.UserID, id entity.PersonalAccessTokenID) error
+	TouchPersonalAccessToken(ctx context.Context, db store.Execer, id entity.PersonalAccessTokenID, interval time.Duration) error
+}
+
+type OAuthRepository interface {
+	UserGetter
+	UserByIDGetter
+	AddOAuthClient(ctx context.Context, db store.Execer, c *entity.OAuthClient) error
+	ListOAuthClients(ctx context.Context, db store.Queryer, uid entity.UserID) (entity.OAuthClients, error)
+	GetOAuthClient(ctx context.Context, db store.Queryer, id entity.OAuthClientID) (*entity.OAuthClient, error)
+	DeleteOAuthClient(ctx context.Context, db store.Execer, uid entity.UserID, id entity.OAuthClientID) error
+}
+
+// AuthorizationCodeStore는 OAuth 인가 코드를 짧은 시간 동안 보관한다. store.KVS가 구현한다.
+type AuthorizationCodeStore interface {
+	SaveAuthorizationCode(ctx context.Context, key string, c *entity.AuthorizationCode, ttl time.Duration) error
+	// 코드를 삭제하고 내용을 반환 (없거나 이미 사용했으면 store.ErrNotFound)
+	UseAuthorizationCode(ctx context.Context, key string) (*entity.AuthorizationCode, error)
+}
+
+// OAuthTokens는 OAuth 클라이언트에 액세스 토큰을 발급하고, 발급한 토큰을 확인하거나 폐기한다. auth.JWTer가 구현한다.
+type OAuthTokens interface {
+	GenerateOAuthToken(ctx context.Context, u entity.User, cid entity.OAuthClientID, scopes entity.Scopes) ([]byte, error)
+	InspectToken(ctx context.Context, raw string) (*entity.TokenClaims, error)
+	RevokeToken(ctx context.Context, jti string) error
+}
+
+// TokenStore는 만료 시간이 있는 토큰과 사용자 ID를 저장하는 인터페이스이다. store.KVS가 구현한다.
+type TokenStore interface {
+	Save(ctx context.Context, key string, userID entity.UserID, ttl time.Duration) error
+	// 키를 삭제하고 저장되어 있던 사용자 ID를 반환 (없거나 이미 사용했으면 store.ErrNotFound)
+	Take(ctx context.Context, key string) (entity.UserID, error)
+}
+
 type UserRegister interface {
 	RegisterUser(ctx context.Context, db store.Execer, u *entity.User) error
 }
@@ -24,6 +228,24 @@ type UserGetter interface {
 	GetUser(ctx context.Context, db store.Queryer, name string) (*entity.User, error)
 }
 
+type UserByIDGetter interface {
+	GetUserByID(ctx context.Context, db store.Queryer, id entity.UserID) (*entity.User, error)
+}
+
+// TokenGenerator는 세션을 시작하고 액세스 토큰과 리프레시 토큰을 발급한다. auth.JWTer가 구현한다.
 type TokenGenerator interface {
-	GenerateToken(ctx context.Context, u entity.User) ([]byte, error)
+	StartSession(ctx context.Context, uid entity.UserID, c entity.Client) (entity.SessionID, string, error)
+	GenerateToken(ctx context.Context, u entity.User, sid entity.SessionID) ([]byte, error)
+}
+
+// TokenRotator는 리프레시 토큰을 교체하고 새 액세스 토큰을 발급한다. auth.JWTer가 구현한다.
+type TokenRotator interface {
+	GenerateToken(ctx context.Context, u entity.User, sid entity.SessionID) ([]byte, error)
+	RotateRefreshToken(ctx context.Context, token string) (entity.UserID, entity.SessionID, string, error)
+}
+
+// SessionStore는 사용자의 세션을 조회하고 삭제한다. store.KVS가 구현한다.
+type SessionStore interface {
+	ListSessions(ctx context.Context, uid entity.UserID) ([]*entity.Session, error)
+	DeleteSession(ctx context.Context, id entity.SessionID) error
 }
@@ -11,7 +11,7 @@ import (
 
 type ListTask struct {
 	DB   store.Queryer
-	Repo TaskLister
+	Repo TaskListRepository
 }
 
 func (l *ListTask) ListTasks(ctx context.Context) (entity.Tasks, error) {
@@ -25,3 +25,29 @@ func (l *ListTask) ListTasks(ctx context.Context) (entity.Tasks, error) {
 	}
 	return ts, nil
 }
+
+// ListAssignedTasks 메서드는 사용자가 담당자로 지정된 Task 목록을 반환한다.
+func (l *ListTask) ListAssignedTasks(ctx context.Context) (entity.Tasks, error) {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("user_id not found")
+	}
+	ts, err := l.Repo.ListAssignedTasks(ctx, l.DB, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list: %w", err)
+	}
+	return ts, nil
+}
+
+// ListWorkTasks 메서드는 사용자가 소유하거나 담당하는 Task 목록을 반환한다.
+func (l *ListTask) ListWorkTasks(ctx context.Context) (entity.Tasks, error) {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("user_id not found")
+	}
+	ts, err := l.Repo.ListWorkTasks(ctx, l.DB, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list: %w", err)
+	}
+	return ts, nil
+}
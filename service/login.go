@@ -2,32 +2,46 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/gitwub5/go_todo_app/entity"
 	"github.com/gitwub5/go_todo_app/store"
 )
 
+// ErrUserDisabled는 운영자가 비활성화한 사용자가 로그인할 때 반환된다.
+var ErrUserDisabled = errors.New("user is disabled")
+
 type Login struct {
 	DB             store.Queryer
 	Repo           UserGetter
 	TokenGenerator TokenGenerator
 }
 
-func (l *Login) Login(ctx context.Context, name, pw string) (string, error) {
+// Login 메서드는 사용자 이름과 비밀번호를 확인하고 c에서 새 세션을 시작해 액세스 토큰과 리프레시 토큰을 발급한다.
+func (l *Login) Login(ctx context.Context, name, pw string, c entity.Client) (*entity.Tokens, error) {
 	// 사용자 정보 조회
 	u, err := l.Repo.GetUser(ctx, l.DB, name)
 	if err != nil {
-		return "", fmt.Errorf("failed to list: %w", err)
+		return nil, fmt.Errorf("failed to list: %w", err)
 	}
 	// 비밀번호 비교
 	if err := u.ComparePassword(pw); err != nil {
-		return "", fmt.Errorf("wrong password: %w", err)
+		return nil, fmt.Errorf("wrong password: %w", err)
+	}
+	// 비활성화된 사용자는 비밀번호가 맞아도 로그인할 수 없다.
+	if u.Disabled != nil {
+		return nil, ErrUserDisabled
+	}
+	sid, refresh, err := l.TokenGenerator.StartSession(ctx, u.ID, c)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start session: %w", err)
 	}
 	// JWT 생성
-	jwt, err := l.TokenGenerator.GenerateToken(ctx, *u)
+	jwt, err := l.TokenGenerator.GenerateToken(ctx, *u, sid)
 	if err != nil {
-		return "", fmt.Errorf("failed to generate JWT: %w", err)
+		return nil, fmt.Errorf("failed to generate JWT: %w", err)
 	}
 
-	return string(jwt), nil
+	return &entity.Tokens{AccessToken: string(jwt), RefreshToken: refresh}, nil
 }
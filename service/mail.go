@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/mail"
+	"github.com/gitwub5/go_todo_app/store"
+)
+
+// ErrUnknownMailKind는 수신을 거부할 수 없는 메일 종류를 지정했을 때 반환된다.
+var ErrUnknownMailKind = errors.New("unknown mail kind")
+
+// loadMailPreference 함수는 사용자의 메일 주소와 수신 거부 설정을 함께 조회한다.
+func loadMailPreference(
+	ctx context.Context, db store.Queryer, repo MailPreferenceRepository, id entity.UserID,
+) (*entity.User, *entity.MailPreference, error) {
+	u, err := repo.GetUserByID(ctx, db, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	optOuts, err := repo.ListMailOptOuts(ctx, db, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	return u, &entity.MailPreference{Email: u.Email, OptOuts: optOuts}, nil
+}
+
+// MailNotifier는 알림을 메일로 전송하는 Notifier 구현이다.
+// 메일 주소가 없거나 수신을 거부한 사용자에게는 보내지 않는다.
+type MailNotifier struct {
+	DB      store.Queryer
+	Repo    MailPreferenceRepository
+	Mailer  Mailer
+	BaseURL string
+}
+
+var _ Notifier = (*MailNotifier)(nil)
+
+// mailKinds는 알림 종류별로 보낼 메일 종류와 템플릿이다.
+var mailKinds = map[entity.NotificationType]struct {
+	kind     entity.MailKind
+	template string
+}{
+	entity.NotificationTaskAssigned: {entity.MailAssigned, mail.TemplateAssigned},
+	entity.NotificationTaskOverdue:  {entity.MailReminder, mail.TemplateReminder},
+}
+
+// Notify 메서드는 알림 종류에 맞는 템플릿으로 메일을 보낸다. 메일로 보내지 않는 알림은 무시한다.
+func (m *MailNotifier) Notify(ctx context.Context, n *entity.Notification) error {
+	mk, ok := mailKinds[n.Type]
+	if !ok {
+		return nil
+	}
+	u, pref, err := loadMailPreference(ctx, m.DB, m.Repo, n.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to load mail preference: %w", err)
+	}
+	if !pref.Allows(mk.kind) {
+		return nil
+	}
+	msg, err := mail.Render(mk.template, *pref.Email, struct {
+		Name    string
+		Message string
+		TaskID  *entity.TaskID
+		BaseURL string
+	}{u.Name, n.Message, n.TaskID, m.BaseURL})
+	if err != nil {
+		return err
+	}
+	if err := m.Mailer.Send(ctx, msg); err != nil {
+		return fmt.Errorf("failed to send mail: %w", err)
+	}
+	return nil
+}
+
+type MailPreference struct {
+	DB   store.ExecQueryer
+	Repo MailPreferenceRepository
+}
+
+func (m *MailPreference) GetMailPreference(ctx context.Context) (*entity.MailPreference, error) {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("user_id not found")
+	}
+	_, pref, err := loadMailPreference(ctx, m.DB, m.Repo, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get: %w", err)
+	}
+	return pref, nil
+}
+
+// UpdateMailPreference 메서드는 메일 주소와 종류별 수신 여부를 변경한다.
+// email이 nil이면 메일 주소를 바꾸지 않고, 빈 문자열이면 메일 주소를 삭제한다.
+// enabled에 포함되지 않은 종류의 설정은 그대로 둔다.
+func (m *MailPreference) UpdateMailPreference(
+	ctx context.Context, email *string, enabled map[entity.MailKind]bool,
+) (*entity.MailPreference, error) {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("user_id not found")
+	}
+	for k := range enabled {
+		if !isOptOutMailKind(k) {
+			return nil, fmt.Errorf("%q: %w", k, ErrUnknownMailKind)
+		}
+	}
+	if email != nil {
+		var v *string
+		if *email != "" {
+			v = email
+		}
+		if err := m.Repo.UpdateUserEmail(ctx, m.DB, id, v); err != nil {
+			return nil, fmt.Errorf("failed to update email: %w", err)
+		}
+	}
+	for k, on := range enabled {
+		if err := m.Repo.SetMailOptOut(ctx, m.DB, id, k, !on); err != nil {
+			return nil, fmt.Errorf("failed to update %s: %w", k, err)
+		}
+	}
+	return m.GetMailPreference(ctx)
+}
+
+func isOptOutMailKind(k entity.MailKind) bool {
+	for _, o := range entity.OptOutMailKinds {
+		if o == k {
+			return true
+		}
+	}
+	return false
+}
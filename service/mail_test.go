@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/mail"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMailNotifier(t *testing.T) {
+	t.Parallel()
+
+	email := "alice@example.com"
+	tests := map[string]struct {
+		typ     entity.NotificationType
+		email   *string
+		optOuts []entity.MailKind
+		subject string
+	}{
+		"assigned": {
+			typ: entity.NotificationTaskAssigned, email: &email,
+			subject: "[todo] A task was assigned to you",
+		},
+		"reminder": {
+			typ: entity.NotificationTaskOverdue, email: &email,
+			optOuts: []entity.MailKind{entity.MailAssigned},
+			subject: "[todo] Reminder: a task is overdue",
+		},
+		"optedOut": {
+			typ: entity.NotificationTaskOverdue, email: &email,
+			optOuts: []entity.MailKind{entity.MailReminder},
+		},
+		"noEmail": {
+			typ: entity.NotificationTaskAssigned,
+		},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			moq := &MailPreferenceRepositoryMock{}
+			moq.GetUserByIDFunc = func(ctx context.Context, db store.Queryer, id entity.UserID) (*entity.User, error) {
+				return &entity.User{ID: id, Name: "alice", Email: tt.email}, nil
+			}
+			moq.ListMailOptOutsFunc = func(ctx context.Context, db store.Queryer, uid entity.UserID) ([]entity.MailKind, error) {
+				return tt.optOuts, nil
+			}
+			capture := &mail.Capture{}
+			sut := &MailNotifier{Repo: moq, Mailer: capture, BaseURL: "http://todo.test"}
+
+			tid := entity.TaskID(1)
+			err := sut.Notify(context.Background(), &entity.Notification{
+				UserID: 10, Type: tt.typ, TaskID: &tid, Message: `task "test" is overdue`,
+			})
+			if err != nil {
+				t.Fatalf("want no error, but got %v", err)
+			}
+			msgs := capture.Messages()
+			if tt.subject == "" {
+				if len(msgs) != 0 {
+					t.Errorf("want no mail, but got %d", len(msgs))
+				}
+				return
+			}
+			if len(msgs) != 1 {
+				t.Fatalf("want 1 mail, but got %d", len(msgs))
+			}
+			m := msgs[0]
+			if m.Subject != tt.subject || m.To[0] != email {
+				t.Errorf("unexpected mail: %+v", m)
+			}
+			if !strings.Contains(m.Text, "http://todo.test/tasks/1") {
+				t.Errorf("want link in text, but got %q", m.Text)
+			}
+		})
+	}
+}
+
+func TestPasswordReset(t *testing.T) {
+	t.Parallel()
+
+	email := "alice@example.com"
+	tokens := map[string]entity.UserID{}
+	kvs := &TokenStoreMock{}
+	kvs.SaveFunc = func(ctx context.Context, key string, userID entity.UserID, ttl time.Duration) error {
+		if ttl != passwordResetTTL {
+			t.Errorf("want ttl %v, but got %v", passwordResetTTL, ttl)
+		}
+		tokens[key] = userID
+		return nil
+	}
+	kvs.TakeFunc = func(ctx context.Context, key string) (entity.UserID, error) {
+		id, ok := tokens[key]
+		if !ok {
+			return 0, store.ErrNotFound
+		}
+		delete(tokens, key)
+		return id, nil
+	}
+	var deleted []entity.SessionID
+	sessions := &SessionStoreMock{
+		ListSessionsFunc: func(ctx context.Context, uid entity.UserID) ([]*entity.Session, error) {
+			if uid != 10 {
+				t.Errorf("want sessions of user 10, but got %d", uid)
+			}
+			return []*entity.Session{{ID: "a", UserID: uid}, {ID: "b", UserID: uid}}, nil
+		},
+		DeleteSessionFunc: func(ctx context.Context, id entity.SessionID) error {
+			deleted = append(deleted, id)
+			return nil
+		},
+	}
+	var updated entity.UserID
+	moq := &PasswordResetRepositoryMock{}
+	moq.GetUserFunc = func(ctx context.Context, db store.Queryer, name string) (*entity.User, error) {
+		if name != "alice" {
+			return nil, store.ErrNotFound
+		}
+		return &entity.User{ID: 10, Name: name, Email: &email}, nil
+	}
+	moq.UpdateUserPasswordFunc = func(ctx context.Context, db store.Execer, id entity.UserID, password string) error {
+		updated = id
+		return nil
+	}
+	capture := &mail.Capture{}
+	sut := &PasswordReset{Repo: moq, Store: kvs, Sessions: sessions, Mailer: capture}
+	ctx := context.Background()
+
+	// 존재하지 않는 사용자도 성공으로 처리하지만 메일은 보내지 않는다.
+	if err := sut.RequestReset(ctx, "bob"); err != nil {
+		t.Fatalf("want no error, but got %v", err)
+	}
+	if err := sut.RequestReset(ctx, "alice"); err != nil {
+		t.Fatalf("want no error, but got %v", err)
+	}
+	msgs := capture.Messages()
+	if len(msgs) != 1 {
+		t.Fatalf("want 1 mail, but got %d", len(msgs))
+	}
+	var token string
+	for k := range tokens {
+		token = strings.TrimPrefix(k, "password_reset:")
+	}
+	if !strings.Contains(msgs[0].Text, token) {
+		t.Errorf("want token %q in mail, but got %q", token, msgs[0].Text)
+	}
+
+	if err := sut.ResetPassword(ctx, token, "new password"); err != nil {
+		t.Fatalf("want no error, but got %v", err)
+	}
+	if updated != 10 {
+		t.Errorf("want password of user 10 updated, but got %d", updated)
+	}
+	// 이전 패스워드로 로그인한 세션은 모두 삭제한다.
+	if d := cmp.Diff(deleted, []entity.SessionID{"a", "b"}); d != "" {
+		t.Errorf("deleted sessions differ: (-got +want)\n%s", d)
+	}
+	// 토큰은 한 번만 사용할 수 있다.
+	if err := sut.ResetPassword(ctx, token, "new password"); err != ErrInvalidResetToken {
+		t.Errorf("want %v, but got %v", ErrInvalidResetToken, err)
+	}
+
+	// 메일 전송에 실패해도 존재하지 않는 사용자와 같이 성공으로 처리한다.
+	sut.Mailer = &MailerMock{
+		SendFunc: func(ctx context.Context, m *mail.Message) error {
+			return errors.New("smtp unavailable")
+		},
+	}
+	if err := sut.RequestReset(ctx, "alice"); err != nil {
+		t.Errorf("want no error, but got %v", err)
+	}
+}
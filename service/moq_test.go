@@ -0,0 +1,7229 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/mail"
+	"github.com/gitwub5/go_todo_app/store"
+	"sync"
+	"time"
+)
+
+// Ensure, that TaskAdderMock does implement TaskAdder.
+// If this is not the case, regenerate this file with moq.
+var _ TaskAdder = &TaskAdderMock{}
+
+// TaskAdderMock is a mock implementation of TaskAdder.
+//
+//	func TestSomethingThatUsesTaskAdder(t *testing.T) {
+//
+//		// make and configure a mocked TaskAdder
+//		mockedTaskAdder := &TaskAdderMock{
+//			AddTaskFunc: func(ctx context.Context, db store.Execer, t *entity.Task) error {
+//				panic("mock out the AddTask method")
+//			},
+//		}
+//
+//		// use mockedTaskAdder in code that requires TaskAdder
+//		// and then make assertions.
+//
+//	}
+type TaskAdderMock struct {
+	// AddTaskFunc mocks the AddTask method.
+	AddTaskFunc func(ctx context.Context, db store.Execer, t *entity.Task) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AddTask holds details about calls to the AddTask method.
+		AddTask []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Execer
+			// T is the t argument value.
+			T *entity.Task
+		}
+	}
+	lockAddTask sync.RWMutex
+}
+
+// AddTask calls AddTaskFunc.
+func (mock *TaskAdderMock) AddTask(ctx context.Context, db store.Execer, t *entity.Task) error {
+	if mock.AddTaskFunc == nil {
+		panic("TaskAdderMock.AddTaskFunc: method is nil but TaskAdder.AddTask was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Execer
+		T   *entity.Task
+	}{
+		Ctx: ctx,
+		Db:  db,
+		T:   t,
+	}
+	mock.lockAddTask.Lock()
+	mock.calls.AddTask = append(mock.calls.AddTask, callInfo)
+	mock.lockAddTask.Unlock()
+	return mock.AddTaskFunc(ctx, db, t)
+}
+
+// AddTaskCalls gets all the calls that were made to AddTask.
+// Check the length with:
+//
+//	len(mockedTaskAdder.AddTaskCalls())
+func (mock *TaskAdderMock) AddTaskCalls() []struct {
+	Ctx context.Context
+	Db  store.Execer
+	T   *entity.Task
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Execer
+		T   *entity.Task
+	}
+	mock.lockAddTask.RLock()
+	calls = mock.calls.AddTask
+	mock.lockAddTask.RUnlock()
+	return calls
+}
+
+// Ensure, that TaskListerMock does implement TaskLister.
+// If this is not the case, regenerate this file with moq.
+var _ TaskLister = &TaskListerMock{}
+
+// TaskListerMock is a mock implementation of TaskLister.
+//
+//	func TestSomethingThatUsesTaskLister(t *testing.T) {
+//
+//		// make and configure a mocked TaskLister
+//		mockedTaskLister := &TaskListerMock{
+//			ListTasksFunc: func(ctx context.Context, db store.Queryer, id entity.UserID) (entity.Tasks, error) {
+//				panic("mock out the ListTasks method")
+//			},
+//		}
+//
+//		// use mockedTaskLister in code that requires TaskLister
+//		// and then make assertions.
+//
+//	}
+type TaskListerMock struct {
+	// ListTasksFunc mocks the ListTasks method.
+	ListTasksFunc func(ctx context.Context, db store.Queryer, id entity.UserID) (entity.Tasks, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ListTasks holds details about calls to the ListTasks method.
+		ListTasks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// ID is the id argument value.
+			ID entity.UserID
+		}
+	}
+	lockListTasks sync.RWMutex
+}
+
+// ListTasks calls ListTasksFunc.
+func (mock *TaskListerMock) ListTasks(ctx context.Context, db store.Queryer, id entity.UserID) (entity.Tasks, error) {
+	if mock.ListTasksFunc == nil {
+		panic("TaskListerMock.ListTasksFunc: method is nil but TaskLister.ListTasks was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.UserID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		ID:  id,
+	}
+	mock.lockListTasks.Lock()
+	mock.calls.ListTasks = append(mock.calls.ListTasks, callInfo)
+	mock.lockListTasks.Unlock()
+	return mock.ListTasksFunc(ctx, db, id)
+}
+
+// ListTasksCalls gets all the calls that were made to ListTasks.
+// Check the length with:
+//
+//	len(mockedTaskLister.ListTasksCalls())
+func (mock *TaskListerMock) ListTasksCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	ID  entity.UserID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.UserID
+	}
+	mock.lockListTasks.RLock()
+	calls = mock.calls.ListTasks
+	mock.lockListTasks.RUnlock()
+	return calls
+}
+
+// Ensure, that TaskGetterMock does implement TaskGetter.
+// If this is not the case, regenerate this file with moq.
+var _ TaskGetter = &TaskGetterMock{}
+
+// TaskGetterMock is a mock implementation of TaskGetter.
+//
+//	func TestSomethingThatUsesTaskGetter(t *testing.T) {
+//
+//		// make and configure a mocked TaskGetter
+//		mockedTaskGetter := &TaskGetterMock{
+//			GetTaskFunc: func(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.TaskID) (*entity.Task, error) {
+//				panic("mock out the GetTask method")
+//			},
+//		}
+//
+//		// use mockedTaskGetter in code that requires TaskGetter
+//		// and then make assertions.
+//
+//	}
+type TaskGetterMock struct {
+	// GetTaskFunc mocks the GetTask method.
+	GetTaskFunc func(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.TaskID) (*entity.Task, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetTask holds details about calls to the GetTask method.
+		GetTask []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// UID is the uid argument value.
+			UID entity.UserID
+			// ID is the id argument value.
+			ID entity.TaskID
+		}
+	}
+	lockGetTask sync.RWMutex
+}
+
+// GetTask calls GetTaskFunc.
+func (mock *TaskGetterMock) GetTask(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.TaskID) (*entity.Task, error) {
+	if mock.GetTaskFunc == nil {
+		panic("TaskGetterMock.GetTaskFunc: method is nil but TaskGetter.GetTask was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+		ID  entity.TaskID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		UID: uid,
+		ID:  id,
+	}
+	mock.lockGetTask.Lock()
+	mock.calls.GetTask = append(mock.calls.GetTask, callInfo)
+	mock.lockGetTask.Unlock()
+	return mock.GetTaskFunc(ctx, db, uid, id)
+}
+
+// GetTaskCalls gets all the calls that were made to GetTask.
+// Check the length with:
+//
+//	len(mockedTaskGetter.GetTaskCalls())
+func (mock *TaskGetterMock) GetTaskCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	UID entity.UserID
+	ID  entity.TaskID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+		ID  entity.TaskID
+	}
+	mock.lockGetTask.RLock()
+	calls = mock.calls.GetTask
+	mock.lockGetTask.RUnlock()
+	return calls
+}
+
+// Ensure, that WorkTaskGetterMock does implement WorkTaskGetter.
+// If this is not the case, regenerate this file with moq.
+var _ WorkTaskGetter = &WorkTaskGetterMock{}
+
+// WorkTaskGetterMock is a mock implementation of WorkTaskGetter.
+//
+//	func TestSomethingThatUsesWorkTaskGetter(t *testing.T) {
+//
+//		// make and configure a mocked WorkTaskGetter
+//		mockedWorkTaskGetter := &WorkTaskGetterMock{
+//			GetWorkTaskFunc: func(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.TaskID) (*entity.Task, error) {
+//				panic("mock out the GetWorkTask method")
+//			},
+//		}
+//
+//		// use mockedWorkTaskGetter in code that requires WorkTaskGetter
+//		// and then make assertions.
+//
+//	}
+type WorkTaskGetterMock struct {
+	// GetWorkTaskFunc mocks the GetWorkTask method.
+	GetWorkTaskFunc func(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.TaskID) (*entity.Task, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetWorkTask holds details about calls to the GetWorkTask method.
+		GetWorkTask []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// UID is the uid argument value.
+			UID entity.UserID
+			// ID is the id argument value.
+			ID entity.TaskID
+		}
+	}
+	lockGetWorkTask sync.RWMutex
+}
+
+// GetWorkTask calls GetWorkTaskFunc.
+func (mock *WorkTaskGetterMock) GetWorkTask(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.TaskID) (*entity.Task, error) {
+	if mock.GetWorkTaskFunc == nil {
+		panic("WorkTaskGetterMock.GetWorkTaskFunc: method is nil but WorkTaskGetter.GetWorkTask was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+		ID  entity.TaskID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		UID: uid,
+		ID:  id,
+	}
+	mock.lockGetWorkTask.Lock()
+	mock.calls.GetWorkTask = append(mock.calls.GetWorkTask, callInfo)
+	mock.lockGetWorkTask.Unlock()
+	return mock.GetWorkTaskFunc(ctx, db, uid, id)
+}
+
+// GetWorkTaskCalls gets all the calls that were made to GetWorkTask.
+// Check the length with:
+//
+//	len(mockedWorkTaskGetter.GetWorkTaskCalls())
+func (mock *WorkTaskGetterMock) GetWorkTaskCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	UID entity.UserID
+	ID  entity.TaskID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+		ID  entity.TaskID
+	}
+	mock.lockGetWorkTask.RLock()
+	calls = mock.calls.GetWorkTask
+	mock.lockGetWorkTask.RUnlock()
+	return calls
+}
+
+// Ensure, that TaskUpdaterMock does implement TaskUpdater.
+// If this is not the case, regenerate this file with moq.
+var _ TaskUpdater = &TaskUpdaterMock{}
+
+// TaskUpdaterMock is a mock implementation of TaskUpdater.
+//
+//	func TestSomethingThatUsesTaskUpdater(t *testing.T) {
+//
+//		// make and configure a mocked TaskUpdater
+//		mockedTaskUpdater := &TaskUpdaterMock{
+//			UpdateTaskFunc: func(ctx context.Context, db store.Execer, t *entity.Task) error {
+//				panic("mock out the UpdateTask method")
+//			},
+//		}
+//
+//		// use mockedTaskUpdater in code that requires TaskUpdater
+//		// and then make assertions.
+//
+//	}
+type TaskUpdaterMock struct {
+	// UpdateTaskFunc mocks the UpdateTask method.
+	UpdateTaskFunc func(ctx context.Context, db store.Execer, t *entity.Task) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// UpdateTask holds details about calls to the UpdateTask method.
+		UpdateTask []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Execer
+			// T is the t argument value.
+			T *entity.Task
+		}
+	}
+	lockUpdateTask sync.RWMutex
+}
+
+// UpdateTask calls UpdateTaskFunc.
+func (mock *TaskUpdaterMock) UpdateTask(ctx context.Context, db store.Execer, t *entity.Task) error {
+	if mock.UpdateTaskFunc == nil {
+		panic("TaskUpdaterMock.UpdateTaskFunc: method is nil but TaskUpdater.UpdateTask was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Execer
+		T   *entity.Task
+	}{
+		Ctx: ctx,
+		Db:  db,
+		T:   t,
+	}
+	mock.lockUpdateTask.Lock()
+	mock.calls.UpdateTask = append(mock.calls.UpdateTask, callInfo)
+	mock.lockUpdateTask.Unlock()
+	return mock.UpdateTaskFunc(ctx, db, t)
+}
+
+// UpdateTaskCalls gets all the calls that were made to UpdateTask.
+// Check the length with:
+//
+//	len(mockedTaskUpdater.UpdateTaskCalls())
+func (mock *TaskUpdaterMock) UpdateTaskCalls() []struct {
+	Ctx context.Context
+	Db  store.Execer
+	T   *entity.Task
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Execer
+		T   *entity.Task
+	}
+	mock.lockUpdateTask.RLock()
+	calls = mock.calls.UpdateTask
+	mock.lockUpdateTask.RUnlock()
+	return calls
+}
+
+// Ensure, that TaskStatusListerMock does implement TaskStatusLister.
+// If this is not the case, regenerate this file with moq.
+var _ TaskStatusLister = &TaskStatusListerMock{}
+
+// TaskStatusListerMock is a mock implementation of TaskStatusLister.
+//
+//	func TestSomethingThatUsesTaskStatusLister(t *testing.T) {
+//
+//		// make and configure a mocked TaskStatusLister
+//		mockedTaskStatusLister := &TaskStatusListerMock{
+//			ListTaskStatusesFunc: func(ctx context.Context, db store.Queryer, id entity.UserID) (entity.TaskStatusDefs, error) {
+//				panic("mock out the ListTaskStatuses method")
+//			},
+//		}
+//
+//		// use mockedTaskStatusLister in code that requires TaskStatusLister
+//		// and then make assertions.
+//
+//	}
+type TaskStatusListerMock struct {
+	// ListTaskStatusesFunc mocks the ListTaskStatuses method.
+	ListTaskStatusesFunc func(ctx context.Context, db store.Queryer, id entity.UserID) (entity.TaskStatusDefs, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ListTaskStatuses holds details about calls to the ListTaskStatuses method.
+		ListTaskStatuses []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// ID is the id argument value.
+			ID entity.UserID
+		}
+	}
+	lockListTaskStatuses sync.RWMutex
+}
+
+// ListTaskStatuses calls ListTaskStatusesFunc.
+func (mock *TaskStatusListerMock) ListTaskStatuses(ctx context.Context, db store.Queryer, id entity.UserID) (entity.TaskStatusDefs, error) {
+	if mock.ListTaskStatusesFunc == nil {
+		panic("TaskStatusListerMock.ListTaskStatusesFunc: method is nil but TaskStatusLister.ListTaskStatuses was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.UserID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		ID:  id,
+	}
+	mock.lockListTaskStatuses.Lock()
+	mock.calls.ListTaskStatuses = append(mock.calls.ListTaskStatuses, callInfo)
+	mock.lockListTaskStatuses.Unlock()
+	return mock.ListTaskStatusesFunc(ctx, db, id)
+}
+
+// ListTaskStatusesCalls gets all the calls that were made to ListTaskStatuses.
+// Check the length with:
+//
+//	len(mockedTaskStatusLister.ListTaskStatusesCalls())
+func (mock *TaskStatusListerMock) ListTaskStatusesCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	ID  entity.UserID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.UserID
+	}
+	mock.lockListTaskStatuses.RLock()
+	calls = mock.calls.ListTaskStatuses
+	mock.lockListTaskStatuses.RUnlock()
+	return calls
+}
+
+// Ensure, that TaskStatusAdderMock does implement TaskStatusAdder.
+// If this is not the case, regenerate this file with moq.
+var _ TaskStatusAdder = &TaskStatusAdderMock{}
+
+// TaskStatusAdderMock is a mock implementation of TaskStatusAdder.
+//
+//	func TestSomethingThatUsesTaskStatusAdder(t *testing.T) {
+//
+//		// make and configure a mocked TaskStatusAdder
+//		mockedTaskStatusAdder := &TaskStatusAdderMock{
+//			AddTaskStatusFunc: func(ctx context.Context, db store.Execer, s *entity.TaskStatusDef) error {
+//				panic("mock out the AddTaskStatus method")
+//			},
+//		}
+//
+//		// use mockedTaskStatusAdder in code that requires TaskStatusAdder
+//		// and then make assertions.
+//
+//	}
+type TaskStatusAdderMock struct {
+	// AddTaskStatusFunc mocks the AddTaskStatus method.
+	AddTaskStatusFunc func(ctx context.Context, db store.Execer, s *entity.TaskStatusDef) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AddTaskStatus holds details about calls to the AddTaskStatus method.
+		AddTaskStatus []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Execer
+			// S is the s argument value.
+			S *entity.TaskStatusDef
+		}
+	}
+	lockAddTaskStatus sync.RWMutex
+}
+
+// AddTaskStatus calls AddTaskStatusFunc.
+func (mock *TaskStatusAdderMock) AddTaskStatus(ctx context.Context, db store.Execer, s *entity.TaskStatusDef) error {
+	if mock.AddTaskStatusFunc == nil {
+		panic("TaskStatusAdderMock.AddTaskStatusFunc: method is nil but TaskStatusAdder.AddTaskStatus was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Execer
+		S   *entity.TaskStatusDef
+	}{
+		Ctx: ctx,
+		Db:  db,
+		S:   s,
+	}
+	mock.lockAddTaskStatus.Lock()
+	mock.calls.AddTaskStatus = append(mock.calls.AddTaskStatus, callInfo)
+	mock.lockAddTaskStatus.Unlock()
+	return mock.AddTaskStatusFunc(ctx, db, s)
+}
+
+// AddTaskStatusCalls gets all the calls that were made to AddTaskStatus.
+// Check the length with:
+//
+//	len(mockedTaskStatusAdder.AddTaskStatusCalls())
+func (mock *TaskStatusAdderMock) AddTaskStatusCalls() []struct {
+	Ctx context.Context
+	Db  store.Execer
+	S   *entity.TaskStatusDef
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Execer
+		S   *entity.TaskStatusDef
+	}
+	mock.lockAddTaskStatus.RLock()
+	calls = mock.calls.AddTaskStatus
+	mock.lockAddTaskStatus.RUnlock()
+	return calls
+}
+
+// Ensure, that TaskStatusRepositoryMock does implement TaskStatusRepository.
+// If this is not the case, regenerate this file with moq.
+var _ TaskStatusRepository = &TaskStatusRepositoryMock{}
+
+// TaskStatusRepositoryMock is a mock implementation of TaskStatusRepository.
+//
+//	func TestSomethingThatUsesTaskStatusRepository(t *testing.T) {
+//
+//		// make and configure a mocked TaskStatusRepository
+//		mockedTaskStatusRepository := &TaskStatusRepositoryMock{
+//			AddTaskStatusFunc: func(ctx context.Context, db store.Execer, s *entity.TaskStatusDef) error {
+//				panic("mock out the AddTaskStatus method")
+//			},
+//			ListTaskStatusesFunc: func(ctx context.Context, db store.Queryer, id entity.UserID) (entity.TaskStatusDefs, error) {
+//				panic("mock out the ListTaskStatuses method")
+//			},
+//		}
+//
+//		// use mockedTaskStatusRepository in code that requires TaskStatusRepository
+//		// and then make assertions.
+//
+//	}
+type TaskStatusRepositoryMock struct {
+	// AddTaskStatusFunc mocks the AddTaskStatus method.
+	AddTaskStatusFunc func(ctx context.Context, db store.Execer, s *entity.TaskStatusDef) error
+
+	// ListTaskStatusesFunc mocks the ListTaskStatuses method.
+	ListTaskStatusesFunc func(ctx context.Context, db store.Queryer, id entity.UserID) (entity.TaskStatusDefs, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AddTaskStatus holds details about calls to the AddTaskStatus method.
+		AddTaskStatus []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Execer
+			// S is the s argument value.
+			S *entity.TaskStatusDef
+		}
+		// ListTaskStatuses holds details about calls to the ListTaskStatuses method.
+		ListTaskStatuses []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// ID is the id argument value.
+			ID entity.UserID
+		}
+	}
+	lockAddTaskStatus    sync.RWMutex
+	lockListTaskStatuses sync.RWMutex
+}
+
+// AddTaskStatus calls AddTaskStatusFunc.
+func (mock *TaskStatusRepositoryMock) AddTaskStatus(ctx context.Context, db store.Execer, s *entity.TaskStatusDef) error {
+	if mock.AddTaskStatusFunc == nil {
+		panic("TaskStatusRepositoryMock.AddTaskStatusFunc: method is nil but TaskStatusRepository.AddTaskStatus was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Execer
+		S   *entity.TaskStatusDef
+	}{
+		Ctx: ctx,
+		Db:  db,
+		S:   s,
+	}
+	mock.lockAddTaskStatus.Lock()
+	mock.calls.AddTaskStatus = append(mock.calls.AddTaskStatus, callInfo)
+	mock.lockAddTaskStatus.Unlock()
+	return mock.AddTaskStatusFunc(ctx, db, s)
+}
+
+// AddTaskStatusCalls gets all the calls that were made to AddTaskStatus.
+// Check the length with:
+//
+//	len(mockedTaskStatusRepository.AddTaskStatusCalls())
+func (mock *TaskStatusRepositoryMock) AddTaskStatusCalls() []struct {
+	Ctx context.Context
+	Db  store.Execer
+	S   *entity.TaskStatusDef
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Execer
+		S   *entity.TaskStatusDef
+	}
+	mock.lockAddTaskStatus.RLock()
+	calls = mock.calls.AddTaskStatus
+	mock.lockAddTaskStatus.RUnlock()
+	return calls
+}
+
+// ListTaskStatuses calls ListTaskStatusesFunc.
+func (mock *TaskStatusRepositoryMock) ListTaskStatuses(ctx context.Context, db store.Queryer, id entity.UserID) (entity.TaskStatusDefs, error) {
+	if mock.ListTaskStatusesFunc == nil {
+		panic("TaskStatusRepositoryMock.ListTaskStatusesFunc: method is nil but TaskStatusRepository.ListTaskStatuses was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.UserID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		ID:  id,
+	}
+	mock.lockListTaskStatuses.Lock()
+	mock.calls.ListTaskStatuses = append(mock.calls.ListTaskStatuses, callInfo)
+	mock.lockListTaskStatuses.Unlock()
+	return mock.ListTaskStatusesFunc(ctx, db, id)
+}
+
+// ListTaskStatusesCalls gets all the calls that were made to ListTaskStatuses.
+// Check the length with:
+//
+//	len(mockedTaskStatusRepository.ListTaskStatusesCalls())
+func (mock *TaskStatusRepositoryMock) ListTaskStatusesCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	ID  entity.UserID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.UserID
+	}
+	mock.lockListTaskStatuses.RLock()
+	calls = mock.calls.ListTaskStatuses
+	mock.lockListTaskStatuses.RUnlock()
+	return calls
+}
+
+// Ensure, that TaskListRepositoryMock does implement TaskListRepository.
+// If this is not the case, regenerate this file with moq.
+var _ TaskListRepository = &TaskListRepositoryMock{}
+
+// TaskListRepositoryMock is a mock implementation of TaskListRepository.
+//
+//	func TestSomethingThatUsesTaskListRepository(t *testing.T) {
+//
+//		// make and configure a mocked TaskListRepository
+//		mockedTaskListRepository := &TaskListRepositoryMock{
+//			ListAssignedTasksFunc: func(ctx context.Context, db store.Queryer, id entity.UserID) (entity.Tasks, error) {
+//				panic("mock out the ListAssignedTasks method")
+//			},
+//			ListTasksFunc: func(ctx context.Context, db store.Queryer, id entity.UserID) (entity.Tasks, error) {
+//				panic("mock out the ListTasks method")
+//			},
+//			ListWorkTasksFunc: func(ctx context.Context, db store.Queryer, id entity.UserID) (entity.Tasks, error) {
+//				panic("mock out the ListWorkTasks method")
+//			},
+//		}
+//
+//		// use mockedTaskListRepository in code that requires TaskListRepository
+//		// and then make assertions.
+//
+//	}
+type TaskListRepositoryMock struct {
+	// ListAssignedTasksFunc mocks the ListAssignedTasks method.
+	ListAssignedTasksFunc func(ctx context.Context, db store.Queryer, id entity.UserID) (entity.Tasks, error)
+
+	// ListTasksFunc mocks the ListTasks method.
+	ListTasksFunc func(ctx context.Context, db store.Queryer, id entity.UserID) (entity.Tasks, error)
+
+	// ListWorkTasksFunc mocks the ListWorkTasks method.
+	ListWorkTasksFunc func(ctx context.Context, db store.Queryer, id entity.UserID) (entity.Tasks, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ListAssignedTasks holds details about calls to the ListAssignedTasks method.
+		ListAssignedTasks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// ID is the id argument value.
+			ID entity.UserID
+		}
+		// ListTasks holds details about calls to the ListTasks method.
+		ListTasks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// ID is the id argument value.
+			ID entity.UserID
+		}
+		// ListWorkTasks holds details about calls to the ListWorkTasks method.
+		ListWorkTasks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// ID is the id argument value.
+			ID entity.UserID
+		}
+	}
+	lockListAssignedTasks sync.RWMutex
+	lockListTasks         sync.RWMutex
+	lockListWorkTasks     sync.RWMutex
+}
+
+// ListAssignedTasks calls ListAssignedTasksFunc.
+func (mock *TaskListRepositoryMock) ListAssignedTasks(ctx context.Context, db store.Queryer, id entity.UserID) (entity.Tasks, error) {
+	if mock.ListAssignedTasksFunc == nil {
+		panic("TaskListRepositoryMock.ListAssignedTasksFunc: method is nil but TaskListRepository.ListAssignedTasks was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.UserID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		ID:  id,
+	}
+	mock.lockListAssignedTasks.Lock()
+	mock.calls.ListAssignedTasks = append(mock.calls.ListAssignedTasks, callInfo)
+	mock.lockListAssignedTasks.Unlock()
+	return mock.ListAssignedTasksFunc(ctx, db, id)
+}
+
+// ListAssignedTasksCalls gets all the calls that were made to ListAssignedTasks.
+// Check the length with:
+//
+//	len(mockedTaskListRepository.ListAssignedTasksCalls())
+func (mock *TaskListRepositoryMock) ListAssignedTasksCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	ID  entity.UserID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.UserID
+	}
+	mock.lockListAssignedTasks.RLock()
+	calls = mock.calls.ListAssignedTasks
+	mock.lockListAssignedTasks.RUnlock()
+	return calls
+}
+
+// ListTasks calls ListTasksFunc.
+func (mock *TaskListRepositoryMock) ListTasks(ctx context.Context, db store.Queryer, id entity.UserID) (entity.Tasks, error) {
+	if mock.ListTasksFunc == nil {
+		panic("TaskListRepositoryMock.ListTasksFunc: method is nil but TaskListRepository.ListTasks was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.UserID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		ID:  id,
+	}
+	mock.lockListTasks.Lock()
+	mock.calls.ListTasks = append(mock.calls.ListTasks, callInfo)
+	mock.lockListTasks.Unlock()
+	return mock.ListTasksFunc(ctx, db, id)
+}
+
+// ListTasksCalls gets all the calls that were made to ListTasks.
+// Check the length with:
+//
+//	len(mockedTaskListRepository.ListTasksCalls())
+func (mock *TaskListRepositoryMock) ListTasksCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	ID  entity.UserID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.UserID
+	}
+	mock.lockListTasks.RLock()
+	calls = mock.calls.ListTasks
+	mock.lockListTasks.RUnlock()
+	return calls
+}
+
+// ListWorkTasks calls ListWorkTasksFunc.
+func (mock *TaskListRepositoryMock) ListWorkTasks(ctx context.Context, db store.Queryer, id entity.UserID) (entity.Tasks, error) {
+	if mock.ListWorkTasksFunc == nil {
+		panic("TaskListRepositoryMock.ListWorkTasksFunc: method is nil but TaskListRepository.ListWorkTasks was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.UserID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		ID:  id,
+	}
+	mock.lockListWorkTasks.Lock()
+	mock.calls.ListWorkTasks = append(mock.calls.ListWorkTasks, callInfo)
+	mock.lockListWorkTasks.Unlock()
+	return mock.ListWorkTasksFunc(ctx, db, id)
+}
+
+// ListWorkTasksCalls gets all the calls that were made to ListWorkTasks.
+// Check the length with:
+//
+//	len(mockedTaskListRepository.ListWorkTasksCalls())
+func (mock *TaskListRepositoryMock) ListWorkTasksCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	ID  entity.UserID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.UserID
+	}
+	mock.lockListWorkTasks.RLock()
+	calls = mock.calls.ListWorkTasks
+	mock.lockListWorkTasks.RUnlock()
+	return calls
+}
+
+// Ensure, that TaskAssignerMock does implement TaskAssigner.
+// If this is not the case, regenerate this file with moq.
+var _ TaskAssigner = &TaskAssignerMock{}
+
+// TaskAssignerMock is a mock implementation of TaskAssigner.
+//
+//	func TestSomethingThatUsesTaskAssigner(t *testing.T) {
+//
+//		// make and configure a mocked TaskAssigner
+//		mockedTaskAssigner := &TaskAssignerMock{
+//			AssignTaskFunc: func(ctx context.Context, db store.ExecQueryer, t *entity.Task) error {
+//				panic("mock out the AssignTask method")
+//			},
+//			GetTaskFunc: func(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.TaskID) (*entity.Task, error) {
+//				panic("mock out the GetTask method")
+//			},
+//			IsProjectMemberFunc: func(ctx context.Context, db store.Queryer, id entity.ProjectID, uid entity.UserID) (bool, error) {
+//				panic("mock out the IsProjectMember method")
+//			},
+//		}
+//
+//		// use mockedTaskAssigner in code that requires TaskAssigner
+//		// and then make assertions.
+//
+//	}
+type TaskAssignerMock struct {
+	// AssignTaskFunc mocks the AssignTask method.
+	AssignTaskFunc func(ctx context.Context, db store.ExecQueryer, t *entity.Task) error
+
+	// GetTaskFunc mocks the GetTask method.
+	GetTaskFunc func(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.TaskID) (*entity.Task, error)
+
+	// IsProjectMemberFunc mocks the IsProjectMember method.
+	IsProjectMemberFunc func(ctx context.Context, db store.Queryer, id entity.ProjectID, uid entity.UserID) (bool, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AssignTask holds details about calls to the AssignTask method.
+		AssignTask []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.ExecQueryer
+			// T is the t argument value.
+			T *entity.Task
+		}
+		// GetTask holds details about calls to the GetTask method.
+		GetTask []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// UID is the uid argument value.
+			UID entity.UserID
+			// ID is the id argument value.
+			ID entity.TaskID
+		}
+		// IsProjectMember holds details about calls to the IsProjectMember method.
+		IsProjectMember []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// ID is the id argument value.
+			ID entity.ProjectID
+			// UID is the uid argument value.
+			UID entity.UserID
+		}
+	}
+	lockAssignTask      sync.RWMutex
+	lockGetTask         sync.RWMutex
+	lockIsProjectMember sync.RWMutex
+}
+
+// AssignTask calls AssignTaskFunc.
+func (mock *TaskAssignerMock) AssignTask(ctx context.Context, db store.ExecQueryer, t *entity.Task) error {
+	if mock.AssignTaskFunc == nil {
+		panic("TaskAssignerMock.AssignTaskFunc: method is nil but TaskAssigner.AssignTask was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.ExecQueryer
+		T   *entity.Task
+	}{
+		Ctx: ctx,
+		Db:  db,
+		T:   t,
+	}
+	mock.lockAssignTask.Lock()
+	mock.calls.AssignTask = append(mock.calls.AssignTask, callInfo)
+	mock.lockAssignTask.Unlock()
+	return mock.AssignTaskFunc(ctx, db, t)
+}
+
+// AssignTaskCalls gets all the calls that were made to AssignTask.
+// Check the length with:
+//
+//	len(mockedTaskAssigner.AssignTaskCalls())
+func (mock *TaskAssignerMock) AssignTaskCalls() []struct {
+	Ctx context.Context
+	Db  store.ExecQueryer
+	T   *entity.Task
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.ExecQueryer
+		T   *entity.Task
+	}
+	mock.lockAssignTask.RLock()
+	calls = mock.calls.AssignTask
+	mock.lockAssignTask.RUnlock()
+	return calls
+}
+
+// GetTask calls GetTaskFunc.
+func (mock *TaskAssignerMock) GetTask(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.TaskID) (*entity.Task, error) {
+	if mock.GetTaskFunc == nil {
+		panic("TaskAssignerMock.GetTaskFunc: method is nil but TaskAssigner.GetTask was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+		ID  entity.TaskID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		UID: uid,
+		ID:  id,
+	}
+	mock.lockGetTask.Lock()
+	mock.calls.GetTask = append(mock.calls.GetTask, callInfo)
+	mock.lockGetTask.Unlock()
+	return mock.GetTaskFunc(ctx, db, uid, id)
+}
+
+// GetTaskCalls gets all the calls that were made to GetTask.
+// Check the length with:
+//
+//	len(mockedTaskAssigner.GetTaskCalls())
+func (mock *TaskAssignerMock) GetTaskCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	UID entity.UserID
+	ID  entity.TaskID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+		ID  entity.TaskID
+	}
+	mock.lockGetTask.RLock()
+	calls = mock.calls.GetTask
+	mock.lockGetTask.RUnlock()
+	return calls
+}
+
+// IsProjectMember calls IsProjectMemberFunc.
+func (mock *TaskAssignerMock) IsProjectMember(ctx context.Context, db store.Queryer, id entity.ProjectID, uid entity.UserID) (bool, error) {
+	if mock.IsProjectMemberFunc == nil {
+		panic("TaskAssignerMock.IsProjectMemberFunc: method is nil but TaskAssigner.IsProjectMember was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.ProjectID
+		UID entity.UserID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		ID:  id,
+		UID: uid,
+	}
+	mock.lockIsProjectMember.Lock()
+	mock.calls.IsProjectMember = append(mock.calls.IsProjectMember, callInfo)
+	mock.lockIsProjectMember.Unlock()
+	return mock.IsProjectMemberFunc(ctx, db, id, uid)
+}
+
+// IsProjectMemberCalls gets all the calls that were made to IsProjectMember.
+// Check the length with:
+//
+//	len(mockedTaskAssigner.IsProjectMemberCalls())
+func (mock *TaskAssignerMock) IsProjectMemberCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	ID  entity.ProjectID
+	UID entity.UserID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.ProjectID
+		UID entity.UserID
+	}
+	mock.lockIsProjectMember.RLock()
+	calls = mock.calls.IsProjectMember
+	mock.lockIsProjectMember.RUnlock()
+	return calls
+}
+
+// Ensure, that ProjectRepositoryMock does implement ProjectRepository.
+// If this is not the case, regenerate this file with moq.
+var _ ProjectRepository = &ProjectRepositoryMock{}
+
+// ProjectRepositoryMock is a mock implementation of ProjectRepository.
+//
+//	func TestSomethingThatUsesProjectRepository(t *testing.T) {
+//
+//		// make and configure a mocked ProjectRepository
+//		mockedProjectRepository := &ProjectRepositoryMock{
+//			AddProjectFunc: func(ctx context.Context, db store.Execer, p *entity.Project) error {
+//				panic("mock out the AddProject method")
+//			},
+//			AddProjectMemberFunc: func(ctx context.Context, db store.Execer, id entity.ProjectID, uid entity.UserID) error {
+//				panic("mock out the AddProjectMember method")
+//			},
+//			AssignTaskFunc: func(ctx context.Context, db store.ExecQueryer, t *entity.Task) error {
+//				panic("mock out the AssignTask method")
+//			},
+//			DeleteProjectMemberFunc: func(ctx context.Context, db store.Execer, id entity.ProjectID, uid entity.UserID) error {
+//				panic("mock out the DeleteProjectMember method")
+//			},
+//			GetProjectFunc: func(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.ProjectID) (*entity.Project, error) {
+//				panic("mock out the GetProject method")
+//			},
+//			GetTaskFunc: func(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.TaskID) (*entity.Task, error) {
+//				panic("mock out the GetTask method")
+//			},
+//			GetUserByIDFunc: func(ctx context.Context, db store.Queryer, id entity.UserID) (*entity.User, error) {
+//				panic("mock out the GetUserByID method")
+//			},
+//			IsProjectMemberFunc: func(ctx context.Context, db store.Queryer, id entity.ProjectID, uid entity.UserID) (bool, error) {
+//				panic("mock out the IsProjectMember method")
+//			},
+//			ListProjectMembersFunc: func(ctx context.Context, db store.Queryer, id entity.ProjectID) ([]entity.UserID, error) {
+//				panic("mock out the ListProjectMembers method")
+//			},
+//			ListProjectTasksFunc: func(ctx context.Context, db store.Queryer, id entity.ProjectID) (entity.Tasks, error) {
+//				panic("mock out the ListProjectTasks method")
+//			},
+//			ListProjectsFunc: func(ctx context.Context, db store.Queryer, uid entity.UserID) (entity.Projects, error) {
+//				panic("mock out the ListProjects method")
+//			},
+//			SetTaskProjectFunc: func(ctx context.Context, db store.Execer, t *entity.Task) error {
+//				panic("mock out the SetTaskProject method")
+//			},
+//		}
+//
+//		// use mockedProjectRepository in code that requires ProjectRepository
+//		// and then make assertions.
+//
+//	}
+type ProjectRepositoryMock struct {
+	// AddProjectFunc mocks the AddProject method.
+	AddProjectFunc func(ctx context.Context, db store.Execer, p *entity.Project) error
+
+	// AddProjectMemberFunc mocks the AddProjectMember method.
+	AddProjectMemberFunc func(ctx context.Context, db store.Execer, id entity.ProjectID, uid entity.UserID) error
+
+	// AssignTaskFunc mocks the AssignTask method.
+	AssignTaskFunc func(ctx context.Context, db store.ExecQueryer, t *entity.Task) error
+
+	// DeleteProjectMemberFunc mocks the DeleteProjectMember method.
+	DeleteProjectMemberFunc func(ctx context.Context, db store.Execer, id entity.ProjectID, uid entity.UserID) error
+
+	// GetProjectFunc mocks the GetProject method.
+	GetProjectFunc func(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.ProjectID) (*entity.Project, error)
+
+	// GetTaskFunc mocks the GetTask method.
+	GetTaskFunc func(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.TaskID) (*entity.Task, error)
+
+	// GetUserByIDFunc mocks the GetUserByID method.
+	GetUserByIDFunc func(ctx context.Context, db store.Queryer, id entity.UserID) (*entity.User, error)
+
+	// IsProjectMemberFunc mocks the IsProjectMember method.
+	IsProjectMemberFunc func(ctx context.Context, db store.Queryer, id entity.ProjectID, uid entity.UserID) (bool, error)
+
+	// ListProjectMembersFunc mocks the ListProjectMembers method.
+	ListProjectMembersFunc func(ctx context.Context, db store.Queryer, id entity.ProjectID) ([]entity.UserID, error)
+
+	// ListProjectTasksFunc mocks the ListProjectTasks method.
+	ListProjectTasksFunc func(ctx context.Context, db store.Queryer, id entity.ProjectID) (entity.Tasks, error)
+
+	// ListProjectsFunc mocks the ListProjects method.
+	ListProjectsFunc func(ctx context.Context, db store.Queryer, uid entity.UserID) (entity.Projects, error)
+
+	// SetTaskProjectFunc mocks the SetTaskProject method.
+	SetTaskProjectFunc func(ctx context.Context, db store.Execer, t *entity.Task) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AddProject holds details about calls to the AddProject method.
+		AddProject []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Execer
+			// P is the p argument value.
+			P *entity.Project
+		}
+		// AddProjectMember holds details about calls to the AddProjectMember method.
+		AddProjectMember []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Execer
+			// ID is the id argument value.
+			ID entity.ProjectID
+			// UID is the uid argument value.
+			UID entity.UserID
+		}
+		// AssignTask holds details about calls to the AssignTask method.
+		AssignTask []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.ExecQueryer
+			// T is the t argument value.
+			T *entity.Task
+		}
+		// DeleteProjectMember holds details about calls to the DeleteProjectMember method.
+		DeleteProjectMember []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Execer
+			// ID is the id argument value.
+			ID entity.ProjectID
+			// UID is the uid argument value.
+			UID entity.UserID
+		}
+		// GetProject holds details about calls to the GetProject method.
+		GetProject []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// UID is the uid argument value.
+			UID entity.UserID
+			// ID is the id argument value.
+			ID entity.ProjectID
+		}
+		// GetTask holds details about calls to the GetTask method.
+		GetTask []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// UID is the uid argument value.
+			UID entity.UserID
+			// ID is the id argument value.
+			ID entity.TaskID
+		}
+		// GetUserByID holds details about calls to the GetUserByID method.
+		GetUserByID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// ID is the id argument value.
+			ID entity.UserID
+		}
+		// IsProjectMember holds details about calls to the IsProjectMember method.
+		IsProjectMember []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// ID is the id argument value.
+			ID entity.ProjectID
+			// UID is the uid argument value.
+			UID entity.UserID
+		}
+		// ListProjectMembers holds details about calls to the ListProjectMembers method.
+		ListProjectMembers []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// ID is the id argument value.
+			ID entity.ProjectID
+		}
+		// ListProjectTasks holds details about calls to the ListProjectTasks method.
+		ListProjectTasks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// ID is the id argument value.
+			ID entity.ProjectID
+		}
+		// ListProjects holds details about calls to the ListProjects method.
+		ListProjects []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// UID is the uid argument value.
+			UID entity.UserID
+		}
+		// SetTaskProject holds details about calls to the SetTaskProject method.
+		SetTaskProject []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Execer
+			// T is the t argument value.
+			T *entity.Task
+		}
+	}
+	lockAddProject          sync.RWMutex
+	lockAddProjectMember    sync.RWMutex
+	lockAssignTask          sync.RWMutex
+	lockDeleteProjectMember sync.RWMutex
+	lockGetProject          sync.RWMutex
+	lockGetTask             sync.RWMutex
+	lockGetUserByID         sync.RWMutex
+	lockIsProjectMember     sync.RWMutex
+	lockListProjectMembers  sync.RWMutex
+	lockListProjectTasks    sync.RWMutex
+	lockListProjects        sync.RWMutex
+	lockSetTaskProject      sync.RWMutex
+}
+
+// AddProject calls AddProjectFunc.
+func (mock *ProjectRepositoryMock) AddProject(ctx context.Context, db store.Execer, p *entity.Project) error {
+	if mock.AddProjectFunc == nil {
+		panic("ProjectRepositoryMock.AddProjectFunc: method is nil but ProjectRepository.AddProject was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Execer
+		P   *entity.Project
+	}{
+		Ctx: ctx,
+		Db:  db,
+		P:   p,
+	}
+	mock.lockAddProject.Lock()
+	mock.calls.AddProject = append(mock.calls.AddProject, callInfo)
+	mock.lockAddProject.Unlock()
+	return mock.AddProjectFunc(ctx, db, p)
+}
+
+// AddProjectCalls gets all the calls that were made to AddProject.
+// Check the length with:
+//
+//	len(mockedProjectRepository.AddProjectCalls())
+func (mock *ProjectRepositoryMock) AddProjectCalls() []struct {
+	Ctx context.Context
+	Db  store.Execer
+	P   *entity.Project
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Execer
+		P   *entity.Project
+	}
+	mock.lockAddProject.RLock()
+	calls = mock.calls.AddProject
+	mock.lockAddProject.RUnlock()
+	return calls
+}
+
+// AddProjectMember calls AddProjectMemberFunc.
+func (mock *ProjectRepositoryMock) AddProjectMember(ctx context.Context, db store.Execer, id entity.ProjectID, uid entity.UserID) error {
+	if mock.AddProjectMemberFunc == nil {
+		panic("ProjectRepositoryMock.AddProjectMemberFunc: method is nil but ProjectRepository.AddProjectMember was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Execer
+		ID  entity.ProjectID
+		UID entity.UserID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		ID:  id,
+		UID: uid,
+	}
+	mock.lockAddProjectMember.Lock()
+	mock.calls.AddProjectMember = append(mock.calls.AddProjectMember, callInfo)
+	mock.lockAddProjectMember.Unlock()
+	return mock.AddProjectMemberFunc(ctx, db, id, uid)
+}
+
+// AddProjectMemberCalls gets all the calls that were made to AddProjectMember.
+// Check the length with:
+//
+//	len(mockedProjectRepository.AddProjectMemberCalls())
+func (mock *ProjectRepositoryMock) AddProjectMemberCalls() []struct {
+	Ctx context.Context
+	Db  store.Execer
+	ID  entity.ProjectID
+	UID entity.UserID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Execer
+		ID  entity.ProjectID
+		UID entity.UserID
+	}
+	mock.lockAddProjectMember.RLock()
+	calls = mock.calls.AddProjectMember
+	mock.lockAddProjectMember.RUnlock()
+	return calls
+}
+
+// AssignTask calls AssignTaskFunc.
+func (mock *ProjectRepositoryMock) AssignTask(ctx context.Context, db store.ExecQueryer, t *entity.Task) error {
+	if mock.AssignTaskFunc == nil {
+		panic("ProjectRepositoryMock.AssignTaskFunc: method is nil but ProjectRepository.AssignTask was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.ExecQueryer
+		T   *entity.Task
+	}{
+		Ctx: ctx,
+		Db:  db,
+		T:   t,
+	}
+	mock.lockAssignTask.Lock()
+	mock.calls.AssignTask = append(mock.calls.AssignTask, callInfo)
+	mock.lockAssignTask.Unlock()
+	return mock.AssignTaskFunc(ctx, db, t)
+}
+
+// AssignTaskCalls gets all the calls that were made to AssignTask.
+// Check the length with:
+//
+//	len(mockedProjectRepository.AssignTaskCalls())
+func (mock *ProjectRepositoryMock) AssignTaskCalls() []struct {
+	Ctx context.Context
+	Db  store.ExecQueryer
+	T   *entity.Task
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.ExecQueryer
+		T   *entity.Task
+	}
+	mock.lockAssignTask.RLock()
+	calls = mock.calls.AssignTask
+	mock.lockAssignTask.RUnlock()
+	return calls
+}
+
+// DeleteProjectMember calls DeleteProjectMemberFunc.
+func (mock *ProjectRepositoryMock) DeleteProjectMember(ctx context.Context, db store.Execer, id entity.ProjectID, uid entity.UserID) error {
+	if mock.DeleteProjectMemberFunc == nil {
+		panic("ProjectRepositoryMock.DeleteProjectMemberFunc: method is nil but ProjectRepository.DeleteProjectMember was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Execer
+		ID  entity.ProjectID
+		UID entity.UserID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		ID:  id,
+		UID: uid,
+	}
+	mock.lockDeleteProjectMember.Lock()
+	mock.calls.DeleteProjectMember = append(mock.calls.DeleteProjectMember, callInfo)
+	mock.lockDeleteProjectMember.Unlock()
+	return mock.DeleteProjectMemberFunc(ctx, db, id, uid)
+}
+
+// DeleteProjectMemberCalls gets all the calls that were made to DeleteProjectMember.
+// Check the length with:
+//
+//	len(mockedProjectRepository.DeleteProjectMemberCalls())
+func (mock *ProjectRepositoryMock) DeleteProjectMemberCalls() []struct {
+	Ctx context.Context
+	Db  store.Execer
+	ID  entity.ProjectID
+	UID entity.UserID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Execer
+		ID  entity.ProjectID
+		UID entity.UserID
+	}
+	mock.lockDeleteProjectMember.RLock()
+	calls = mock.calls.DeleteProjectMember
+	mock.lockDeleteProjectMember.RUnlock()
+	return calls
+}
+
+// GetProject calls GetProjectFunc.
+func (mock *ProjectRepositoryMock) GetProject(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.ProjectID) (*entity.Project, error) {
+	if mock.GetProjectFunc == nil {
+		panic("ProjectRepositoryMock.GetProjectFunc: method is nil but ProjectRepository.GetProject was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+		ID  entity.ProjectID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		UID: uid,
+		ID:  id,
+	}
+	mock.lockGetProject.Lock()
+	mock.calls.GetProject = append(mock.calls.GetProject, callInfo)
+	mock.lockGetProject.Unlock()
+	return mock.GetProjectFunc(ctx, db, uid, id)
+}
+
+// GetProjectCalls gets all the calls that were made to GetProject.
+// Check the length with:
+//
+//	len(mockedProjectRepository.GetProjectCalls())
+func (mock *ProjectRepositoryMock) GetProjectCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	UID entity.UserID
+	ID  entity.ProjectID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+		ID  entity.ProjectID
+	}
+	mock.lockGetProject.RLock()
+	calls = mock.calls.GetProject
+	mock.lockGetProject.RUnlock()
+	return calls
+}
+
+// GetTask calls GetTaskFunc.
+func (mock *ProjectRepositoryMock) GetTask(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.TaskID) (*entity.Task, error) {
+	if mock.GetTaskFunc == nil {
+		panic("ProjectRepositoryMock.GetTaskFunc: method is nil but ProjectRepository.GetTask was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+		ID  entity.TaskID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		UID: uid,
+		ID:  id,
+	}
+	mock.lockGetTask.Lock()
+	mock.calls.GetTask = append(mock.calls.GetTask, callInfo)
+	mock.lockGetTask.Unlock()
+	return mock.GetTaskFunc(ctx, db, uid, id)
+}
+
+// GetTaskCalls gets all the calls that were made to GetTask.
+// Check the length with:
+//
+//	len(mockedProjectRepository.GetTaskCalls())
+func (mock *ProjectRepositoryMock) GetTaskCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	UID entity.UserID
+	ID  entity.TaskID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+		ID  entity.TaskID
+	}
+	mock.lockGetTask.RLock()
+	calls = mock.calls.GetTask
+	mock.lockGetTask.RUnlock()
+	return calls
+}
+
+// GetUserByID calls GetUserByIDFunc.
+func (mock *ProjectRepositoryMock) GetUserByID(ctx context.Context, db store.Queryer, id entity.UserID) (*entity.User, error) {
+	if mock.GetUserByIDFunc == nil {
+		panic("ProjectRepositoryMock.GetUserByIDFunc: method is nil but ProjectRepository.GetUserByID was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.UserID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		ID:  id,
+	}
+	mock.lockGetUserByID.Lock()
+	mock.calls.GetUserByID = append(mock.calls.GetUserByID, callInfo)
+	mock.lockGetUserByID.Unlock()
+	return mock.GetUserByIDFunc(ctx, db, id)
+}
+
+// GetUserByIDCalls gets all the calls that were made to GetUserByID.
+// Check the length with:
+//
+//	len(mockedProjectRepository.GetUserByIDCalls())
+func (mock *ProjectRepositoryMock) GetUserByIDCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	ID  entity.UserID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.UserID
+	}
+	mock.lockGetUserByID.RLock()
+	calls = mock.calls.GetUserByID
+	mock.lockGetUserByID.RUnlock()
+	return calls
+}
+
+// IsProjectMember calls IsProjectMemberFunc.
+func (mock *ProjectRepositoryMock) IsProjectMember(ctx context.Context, db store.Queryer, id entity.ProjectID, uid entity.UserID) (bool, error) {
+	if mock.IsProjectMemberFunc == nil {
+		panic("ProjectRepositoryMock.IsProjectMemberFunc: method is nil but ProjectRepository.IsProjectMember was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.ProjectID
+		UID entity.UserID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		ID:  id,
+		UID: uid,
+	}
+	mock.lockIsProjectMember.Lock()
+	mock.calls.IsProjectMember = append(mock.calls.IsProjectMember, callInfo)
+	mock.lockIsProjectMember.Unlock()
+	return mock.IsProjectMemberFunc(ctx, db, id, uid)
+}
+
+// IsProjectMemberCalls gets all the calls that were made to IsProjectMember.
+// Check the length with:
+//
+//	len(mockedProjectRepository.IsProjectMemberCalls())
+func (mock *ProjectRepositoryMock) IsProjectMemberCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	ID  entity.ProjectID
+	UID entity.UserID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.ProjectID
+		UID entity.UserID
+	}
+	mock.lockIsProjectMember.RLock()
+	calls = mock.calls.IsProjectMember
+	mock.lockIsProjectMember.RUnlock()
+	return calls
+}
+
+// ListProjectMembers calls ListProjectMembersFunc.
+func (mock *ProjectRepositoryMock) ListProjectMembers(ctx context.Context, db store.Queryer, id entity.ProjectID) ([]entity.UserID, error) {
+	if mock.ListProjectMembersFunc == nil {
+		panic("ProjectRepositoryMock.ListProjectMembersFunc: method is nil but ProjectRepository.ListProjectMembers was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.ProjectID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		ID:  id,
+	}
+	mock.lockListProjectMembers.Lock()
+	mock.calls.ListProjectMembers = append(mock.calls.ListProjectMembers, callInfo)
+	mock.lockListProjectMembers.Unlock()
+	return mock.ListProjectMembersFunc(ctx, db, id)
+}
+
+// ListProjectMembersCalls gets all the calls that were made to ListProjectMembers.
+// Check the length with:
+//
+//	len(mockedProjectRepository.ListProjectMembersCalls())
+func (mock *ProjectRepositoryMock) ListProjectMembersCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	ID  entity.ProjectID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.ProjectID
+	}
+	mock.lockListProjectMembers.RLock()
+	calls = mock.calls.ListProjectMembers
+	mock.lockListProjectMembers.RUnlock()
+	return calls
+}
+
+// ListProjectTasks calls ListProjectTasksFunc.
+func (mock *ProjectRepositoryMock) ListProjectTasks(ctx context.Context, db store.Queryer, id entity.ProjectID) (entity.Tasks, error) {
+	if mock.ListProjectTasksFunc == nil {
+		panic("ProjectRepositoryMock.ListProjectTasksFunc: method is nil but ProjectRepository.ListProjectTasks was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.ProjectID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		ID:  id,
+	}
+	mock.lockListProjectTasks.Lock()
+	mock.calls.ListProjectTasks = append(mock.calls.ListProjectTasks, callInfo)
+	mock.lockListProjectTasks.Unlock()
+	return mock.ListProjectTasksFunc(ctx, db, id)
+}
+
+// ListProjectTasksCalls gets all the calls that were made to ListProjectTasks.
+// Check the length with:
+//
+//	len(mockedProjectRepository.ListProjectTasksCalls())
+func (mock *ProjectRepositoryMock) ListProjectTasksCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	ID  entity.ProjectID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.ProjectID
+	}
+	mock.lockListProjectTasks.RLock()
+	calls = mock.calls.ListProjectTasks
+	mock.lockListProjectTasks.RUnlock()
+	return calls
+}
+
+// ListProjects calls ListProjectsFunc.
+func (mock *ProjectRepositoryMock) ListProjects(ctx context.Context, db store.Queryer, uid entity.UserID) (entity.Projects, error) {
+	if mock.ListProjectsFunc == nil {
+		panic("ProjectRepositoryMock.ListProjectsFunc: method is nil but ProjectRepository.ListProjects was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		UID: uid,
+	}
+	mock.lockListProjects.Lock()
+	mock.calls.ListProjects = append(mock.calls.ListProjects, callInfo)
+	mock.lockListProjects.Unlock()
+	return mock.ListProjectsFunc(ctx, db, uid)
+}
+
+// ListProjectsCalls gets all the calls that were made to ListProjects.
+// Check the length with:
+//
+//	len(mockedProjectRepository.ListProjectsCalls())
+func (mock *ProjectRepositoryMock) ListProjectsCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	UID entity.UserID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+	}
+	mock.lockListProjects.RLock()
+	calls = mock.calls.ListProjects
+	mock.lockListProjects.RUnlock()
+	return calls
+}
+
+// SetTaskProject calls SetTaskProjectFunc.
+func (mock *ProjectRepositoryMock) SetTaskProject(ctx context.Context, db store.Execer, t *entity.Task) error {
+	if mock.SetTaskProjectFunc == nil {
+		panic("ProjectRepositoryMock.SetTaskProjectFunc: method is nil but ProjectRepository.SetTaskProject was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Execer
+		T   *entity.Task
+	}{
+		Ctx: ctx,
+		Db:  db,
+		T:   t,
+	}
+	mock.lockSetTaskProject.Lock()
+	mock.calls.SetTaskProject = append(mock.calls.SetTaskProject, callInfo)
+	mock.lockSetTaskProject.Unlock()
+	return mock.SetTaskProjectFunc(ctx, db, t)
+}
+
+// SetTaskProjectCalls gets all the calls that were made to SetTaskProject.
+// Check the length with:
+//
+//	len(mockedProjectRepository.SetTaskProjectCalls())
+func (mock *ProjectRepositoryMock) SetTaskProjectCalls() []struct {
+	Ctx context.Context
+	Db  store.Execer
+	T   *entity.Task
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Execer
+		T   *entity.Task
+	}
+	mock.lockSetTaskProject.RLock()
+	calls = mock.calls.SetTaskProject
+	mock.lockSetTaskProject.RUnlock()
+	return calls
+}
+
+// Ensure, that TaskEditorMock does implement TaskEditor.
+// If this is not the case, regenerate this file with moq.
+var _ TaskEditor = &TaskEditorMock{}
+
+// TaskEditorMock is a mock implementation of TaskEditor.
+//
+//	func TestSomethingThatUsesTaskEditor(t *testing.T) {
+//
+//		// make and configure a mocked TaskEditor
+//		mockedTaskEditor := &TaskEditorMock{
+//			GetTaskFunc: func(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.TaskID) (*entity.Task, error) {
+//				panic("mock out the GetTask method")
+//			},
+//			ListTaskStatusesFunc: func(ctx context.Context, db store.Queryer, id entity.UserID) (entity.TaskStatusDefs, error) {
+//				panic("mock out the ListTaskStatuses method")
+//			},
+//			UpdateTaskFunc: func(ctx context.Context, db store.Execer, t *entity.Task) error {
+//				panic("mock out the UpdateTask method")
+//			},
+//		}
+//
+//		// use mockedTaskEditor in code that requires TaskEditor
+//		// and then make assertions.
+//
+//	}
+type TaskEditorMock struct {
+	// GetTaskFunc mocks the GetTask method.
+	GetTaskFunc func(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.TaskID) (*entity.Task, error)
+
+	// ListTaskStatusesFunc mocks the ListTaskStatuses method.
+	ListTaskStatusesFunc func(ctx context.Context, db store.Queryer, id entity.UserID) (entity.TaskStatusDefs, error)
+
+	// UpdateTaskFunc mocks the UpdateTask method.
+	UpdateTaskFunc func(ctx context.Context, db store.Execer, t *entity.Task) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetTask holds details about calls to the GetTask method.
+		GetTask []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// UID is the uid argument value.
+			UID entity.UserID
+			// ID is the id argument value.
+			ID entity.TaskID
+		}
+		// ListTaskStatuses holds details about calls to the ListTaskStatuses method.
+		ListTaskStatuses []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// ID is the id argument value.
+			ID entity.UserID
+		}
+		// UpdateTask holds details about calls to the UpdateTask method.
+		UpdateTask []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Execer
+			// T is the t argument value.
+			T *entity.Task
+		}
+	}
+	lockGetTask          sync.RWMutex
+	lockListTaskStatuses sync.RWMutex
+	lockUpdateTask       sync.RWMutex
+}
+
+// GetTask calls GetTaskFunc.
+func (mock *TaskEditorMock) GetTask(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.TaskID) (*entity.Task, error) {
+	if mock.GetTaskFunc == nil {
+		panic("TaskEditorMock.GetTaskFunc: method is nil but TaskEditor.GetTask was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+		ID  entity.TaskID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		UID: uid,
+		ID:  id,
+	}
+	mock.lockGetTask.Lock()
+	mock.calls.GetTask = append(mock.calls.GetTask, callInfo)
+	mock.lockGetTask.Unlock()
+	return mock.GetTaskFunc(ctx, db, uid, id)
+}
+
+// GetTaskCalls gets all the calls that were made to GetTask.
+// Check the length with:
+//
+//	len(mockedTaskEditor.GetTaskCalls())
+func (mock *TaskEditorMock) GetTaskCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	UID entity.UserID
+	ID  entity.TaskID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+		ID  entity.TaskID
+	}
+	mock.lockGetTask.RLock()
+	calls = mock.calls.GetTask
+	mock.lockGetTask.RUnlock()
+	return calls
+}
+
+// ListTaskStatuses calls ListTaskStatusesFunc.
+func (mock *TaskEditorMock) ListTaskStatuses(ctx context.Context, db store.Queryer, id entity.UserID) (entity.TaskStatusDefs, error) {
+	if mock.ListTaskStatusesFunc == nil {
+		panic("TaskEditorMock.ListTaskStatusesFunc: method is nil but TaskEditor.ListTaskStatuses was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.UserID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		ID:  id,
+	}
+	mock.lockListTaskStatuses.Lock()
+	mock.calls.ListTaskStatuses = append(mock.calls.ListTaskStatuses, callInfo)
+	mock.lockListTaskStatuses.Unlock()
+	return mock.ListTaskStatusesFunc(ctx, db, id)
+}
+
+// ListTaskStatusesCalls gets all the calls that were made to ListTaskStatuses.
+// Check the length with:
+//
+//	len(mockedTaskEditor.ListTaskStatusesCalls())
+func (mock *TaskEditorMock) ListTaskStatusesCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	ID  entity.UserID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.UserID
+	}
+	mock.lockListTaskStatuses.RLock()
+	calls = mock.calls.ListTaskStatuses
+	mock.lockListTaskStatuses.RUnlock()
+	return calls
+}
+
+// UpdateTask calls UpdateTaskFunc.
+func (mock *TaskEditorMock) UpdateTask(ctx context.Context, db store.Execer, t *entity.Task) error {
+	if mock.UpdateTaskFunc == nil {
+		panic("TaskEditorMock.UpdateTaskFunc: method is nil but TaskEditor.UpdateTask was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Execer
+		T   *entity.Task
+	}{
+		Ctx: ctx,
+		Db:  db,
+		T:   t,
+	}
+	mock.lockUpdateTask.Lock()
+	mock.calls.UpdateTask = append(mock.calls.UpdateTask, callInfo)
+	mock.lockUpdateTask.Unlock()
+	return mock.UpdateTaskFunc(ctx, db, t)
+}
+
+// UpdateTaskCalls gets all the calls that were made to UpdateTask.
+// Check the length with:
+//
+//	len(mockedTaskEditor.UpdateTaskCalls())
+func (mock *TaskEditorMock) UpdateTaskCalls() []struct {
+	Ctx context.Context
+	Db  store.Execer
+	T   *entity.Task
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Execer
+		T   *entity.Task
+	}
+	mock.lockUpdateTask.RLock()
+	calls = mock.calls.UpdateTask
+	mock.lockUpdateTask.RUnlock()
+	return calls
+}
+
+// Ensure, that TaskRemoverMock does implement TaskRemover.
+// If this is not the case, regenerate this file with moq.
+var _ TaskRemover = &TaskRemoverMock{}
+
+// TaskRemoverMock is a mock implementation of TaskRemover.
+//
+//	func TestSomethingThatUsesTaskRemover(t *testing.T) {
+//
+//		// make and configure a mocked TaskRemover
+//		mockedTaskRemover := &TaskRemoverMock{
+//			DeleteTaskFunc: func(ctx context.Context, db store.Execer, t *entity.Task) error {
+//				panic("mock out the DeleteTask method")
+//			},
+//			GetTaskFunc: func(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.TaskID) (*entity.Task, error) {
+//				panic("mock out the GetTask method")
+//			},
+//			ListTasksFunc: func(ctx context.Context, db store.Queryer, id entity.UserID) (entity.Tasks, error) {
+//				panic("mock out the ListTasks method")
+//			},
+//		}
+//
+//		// use mockedTaskRemover in code that requires TaskRemover
+//		// and then make assertions.
+//
+//	}
+type TaskRemoverMock struct {
+	// DeleteTaskFunc mocks the DeleteTask method.
+	DeleteTaskFunc func(ctx context.Context, db store.Execer, t *entity.Task) error
+
+	// GetTaskFunc mocks the GetTask method.
+	GetTaskFunc func(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.TaskID) (*entity.Task, error)
+
+	// ListTasksFunc mocks the ListTasks method.
+	ListTasksFunc func(ctx context.Context, db store.Queryer, id entity.UserID) (entity.Tasks, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// DeleteTask holds details about calls to the DeleteTask method.
+		DeleteTask []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Execer
+			// T is the t argument value.
+			T *entity.Task
+		}
+		// GetTask holds details about calls to the GetTask method.
+		GetTask []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// UID is the uid argument value.
+			UID entity.UserID
+			// ID is the id argument value.
+			ID entity.TaskID
+		}
+		// ListTasks holds details about calls to the ListTasks method.
+		ListTasks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// ID is the id argument value.
+			ID entity.UserID
+		}
+	}
+	lockDeleteTask sync.RWMutex
+	lockGetTask    sync.RWMutex
+	lockListTasks  sync.RWMutex
+}
+
+// DeleteTask calls DeleteTaskFunc.
+func (mock *TaskRemoverMock) DeleteTask(ctx context.Context, db store.Execer, t *entity.Task) error {
+	if mock.DeleteTaskFunc == nil {
+		panic("TaskRemoverMock.DeleteTaskFunc: method is nil but TaskRemover.DeleteTask was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Execer
+		T   *entity.Task
+	}{
+		Ctx: ctx,
+		Db:  db,
+		T:   t,
+	}
+	mock.lockDeleteTask.Lock()
+	mock.calls.DeleteTask = append(mock.calls.DeleteTask, callInfo)
+	mock.lockDeleteTask.Unlock()
+	return mock.DeleteTaskFunc(ctx, db, t)
+}
+
+// DeleteTaskCalls gets all the calls that were made to DeleteTask.
+// Check the length with:
+//
+//	len(mockedTaskRemover.DeleteTaskCalls())
+func (mock *TaskRemoverMock) DeleteTaskCalls() []struct {
+	Ctx context.Context
+	Db  store.Execer
+	T   *entity.Task
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Execer
+		T   *entity.Task
+	}
+	mock.lockDeleteTask.RLock()
+	calls = mock.calls.DeleteTask
+	mock.lockDeleteTask.RUnlock()
+	return calls
+}
+
+// GetTask calls GetTaskFunc.
+func (mock *TaskRemoverMock) GetTask(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.TaskID) (*entity.Task, error) {
+	if mock.GetTaskFunc == nil {
+		panic("TaskRemoverMock.GetTaskFunc: method is nil but TaskRemover.GetTask was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+		ID  entity.TaskID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		UID: uid,
+		ID:  id,
+	}
+	mock.lockGetTask.Lock()
+	mock.calls.GetTask = append(mock.calls.GetTask, callInfo)
+	mock.lockGetTask.Unlock()
+	return mock.GetTaskFunc(ctx, db, uid, id)
+}
+
+// GetTaskCalls gets all the calls that were made to GetTask.
+// Check the length with:
+//
+//	len(mockedTaskRemover.GetTaskCalls())
+func (mock *TaskRemoverMock) GetTaskCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	UID entity.UserID
+	ID  entity.TaskID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+		ID  entity.TaskID
+	}
+	mock.lockGetTask.RLock()
+	calls = mock.calls.GetTask
+	mock.lockGetTask.RUnlock()
+	return calls
+}
+
+// ListTasks calls ListTasksFunc.
+func (mock *TaskRemoverMock) ListTasks(ctx context.Context, db store.Queryer, id entity.UserID) (entity.Tasks, error) {
+	if mock.ListTasksFunc == nil {
+		panic("TaskRemoverMock.ListTasksFunc: method is nil but TaskRemover.ListTasks was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.UserID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		ID:  id,
+	}
+	mock.lockListTasks.Lock()
+	mock.calls.ListTasks = append(mock.calls.ListTasks, callInfo)
+	mock.lockListTasks.Unlock()
+	return mock.ListTasksFunc(ctx, db, id)
+}
+
+// ListTasksCalls gets all the calls that were made to ListTasks.
+// Check the length with:
+//
+//	len(mockedTaskRemover.ListTasksCalls())
+func (mock *TaskRemoverMock) ListTasksCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	ID  entity.UserID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.UserID
+	}
+	mock.lockListTasks.RLock()
+	calls = mock.calls.ListTasks
+	mock.lockListTasks.RUnlock()
+	return calls
+}
+
+// Ensure, that TimeTrackerMock does implement TimeTracker.
+// If this is not the case, regenerate this file with moq.
+var _ TimeTracker = &TimeTrackerMock{}
+
+// TimeTrackerMock is a mock implementation of TimeTracker.
+//
+//	func TestSomethingThatUsesTimeTracker(t *testing.T) {
+//
+//		// make and configure a mocked TimeTracker
+//		mockedTimeTracker := &TimeTrackerMock{
+//			AddTimeEntryFunc: func(ctx context.Context, db store.Execer, e *entity.TimeEntry) error {
+//				panic("mock out the AddTimeEntry method")
+//			},
+//			GetRunningTimeEntryFunc: func(ctx context.Context, db store.Queryer, uid entity.UserID) (*entity.TimeEntry, error) {
+//				panic("mock out the GetRunningTimeEntry method")
+//			},
+//			GetTaskFunc: func(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.TaskID) (*entity.Task, error) {
+//				panic("mock out the GetTask method")
+//			},
+//			ListTimeEntriesFunc: func(ctx context.Context, db store.Queryer, uid entity.UserID, tid entity.TaskID) (entity.TimeEntries, error) {
+//				panic("mock out the ListTimeEntries method")
+//			},
+//			ListTimeEntriesBetweenFunc: func(ctx context.Context, db store.Queryer, uid entity.UserID, from time.Time, to time.Time) (entity.TimeEntries, error) {
+//				panic("mock out the ListTimeEntriesBetween method")
+//			},
+//			StopTimeEntryFunc: func(ctx context.Context, db store.Execer, e *entity.TimeEntry) error {
+//				panic("mock out the StopTimeEntry method")
+//			},
+//		}
+//
+//		// use mockedTimeTracker in code that requires TimeTracker
+//		// and then make assertions.
+//
+//	}
+type TimeTrackerMock struct {
+	// AddTimeEntryFunc mocks the AddTimeEntry method.
+	AddTimeEntryFunc func(ctx context.Context, db store.Execer, e *entity.TimeEntry) error
+
+	// GetRunningTimeEntryFunc mocks the GetRunningTimeEntry method.
+	GetRunningTimeEntryFunc func(ctx context.Context, db store.Queryer, uid entity.UserID) (*entity.TimeEntry, error)
+
+	// GetTaskFunc mocks the GetTask method.
+	GetTaskFunc func(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.TaskID) (*entity.Task, error)
+
+	// ListTimeEntriesFunc mocks the ListTimeEntries method.
+	ListTimeEntriesFunc func(ctx context.Context, db store.Queryer, uid entity.UserID, tid entity.TaskID) (entity.TimeEntries, error)
+
+	// ListTimeEntriesBetweenFunc mocks the ListTimeEntriesBetween method.
+	ListTimeEntriesBetweenFunc func(ctx context.Context, db store.Queryer, uid entity.UserID, from time.Time, to time.Time) (entity.TimeEntries, error)
+
+	// StopTimeEntryFunc mocks the StopTimeEntry method.
+	StopTimeEntryFunc func(ctx context.Context, db store.Execer, e *entity.TimeEntry) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AddTimeEntry holds details about calls to the AddTimeEntry method.
+		AddTimeEntry []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Execer
+			// E is the e argument value.
+			E *entity.TimeEntry
+		}
+		// GetRunningTimeEntry holds details about calls to the GetRunningTimeEntry method.
+		GetRunningTimeEntry []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// UID is the uid argument value.
+			UID entity.UserID
+		}
+		// GetTask holds details about calls to the GetTask method.
+		GetTask []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// UID is the uid argument value.
+			UID entity.UserID
+			// ID is the id argument value.
+			ID entity.TaskID
+		}
+		// ListTimeEntries holds details about calls to the ListTimeEntries method.
+		ListTimeEntries []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// UID is the uid argument value.
+			UID entity.UserID
+			// Tid is the tid argument value.
+			Tid entity.TaskID
+		}
+		// ListTimeEntriesBetween holds details about calls to the ListTimeEntriesBetween method.
+		ListTimeEntriesBetween []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// UID is the uid argument value.
+			UID entity.UserID
+			// From is the from argument value.
+			From time.Time
+			// To is the to argument value.
+			To time.Time
+		}
+		// StopTimeEntry holds details about calls to the StopTimeEntry method.
+		StopTimeEntry []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Execer
+			// E is the e argument value.
+			E *entity.TimeEntry
+		}
+	}
+	lockAddTimeEntry           sync.RWMutex
+	lockGetRunningTimeEntry    sync.RWMutex
+	lockGetTask                sync.RWMutex
+	lockListTimeEntries        sync.RWMutex
+	lockListTimeEntriesBetween sync.RWMutex
+	lockStopTimeEntry          sync.RWMutex
+}
+
+// AddTimeEntry calls AddTimeEntryFunc.
+func (mock *TimeTrackerMock) AddTimeEntry(ctx context.Context, db store.Execer, e *entity.TimeEntry) error {
+	if mock.AddTimeEntryFunc == nil {
+		panic("TimeTrackerMock.AddTimeEntryFunc: method is nil but TimeTracker.AddTimeEntry was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Execer
+		E   *entity.TimeEntry
+	}{
+		Ctx: ctx,
+		Db:  db,
+		E:   e,
+	}
+	mock.lockAddTimeEntry.Lock()
+	mock.calls.AddTimeEntry = append(mock.calls.AddTimeEntry, callInfo)
+	mock.lockAddTimeEntry.Unlock()
+	return mock.AddTimeEntryFunc(ctx, db, e)
+}
+
+// AddTimeEntryCalls gets all the calls that were made to AddTimeEntry.
+// Check the length with:
+//
+//	len(mockedTimeTracker.AddTimeEntryCalls())
+func (mock *TimeTrackerMock) AddTimeEntryCalls() []struct {
+	Ctx context.Context
+	Db  store.Execer
+	E   *entity.TimeEntry
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Execer
+		E   *entity.TimeEntry
+	}
+	mock.lockAddTimeEntry.RLock()
+	calls = mock.calls.AddTimeEntry
+	mock.lockAddTimeEntry.RUnlock()
+	return calls
+}
+
+// GetRunningTimeEntry calls GetRunningTimeEntryFunc.
+func (mock *TimeTrackerMock) GetRunningTimeEntry(ctx context.Context, db store.Queryer, uid entity.UserID) (*entity.TimeEntry, error) {
+	if mock.GetRunningTimeEntryFunc == nil {
+		panic("TimeTrackerMock.GetRunningTimeEntryFunc: method is nil but TimeTracker.GetRunningTimeEntry was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		UID: uid,
+	}
+	mock.lockGetRunningTimeEntry.Lock()
+	mock.calls.GetRunningTimeEntry = append(mock.calls.GetRunningTimeEntry, callInfo)
+	mock.lockGetRunningTimeEntry.Unlock()
+	return mock.GetRunningTimeEntryFunc(ctx, db, uid)
+}
+
+// GetRunningTimeEntryCalls gets all the calls that were made to GetRunningTimeEntry.
+// Check the length with:
+//
+//	len(mockedTimeTracker.GetRunningTimeEntryCalls())
+func (mock *TimeTrackerMock) GetRunningTimeEntryCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	UID entity.UserID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+	}
+	mock.lockGetRunningTimeEntry.RLock()
+	calls = mock.calls.GetRunningTimeEntry
+	mock.lockGetRunningTimeEntry.RUnlock()
+	return calls
+}
+
+// GetTask calls GetTaskFunc.
+func (mock *TimeTrackerMock) GetTask(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.TaskID) (*entity.Task, error) {
+	if mock.GetTaskFunc == nil {
+		panic("TimeTrackerMock.GetTaskFunc: method is nil but TimeTracker.GetTask was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+		ID  entity.TaskID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		UID: uid,
+		ID:  id,
+	}
+	mock.lockGetTask.Lock()
+	mock.calls.GetTask = append(mock.calls.GetTask, callInfo)
+	mock.lockGetTask.Unlock()
+	return mock.GetTaskFunc(ctx, db, uid, id)
+}
+
+// GetTaskCalls gets all the calls that were made to GetTask.
+// Check the length with:
+//
+//	len(mockedTimeTracker.GetTaskCalls())
+func (mock *TimeTrackerMock) GetTaskCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	UID entity.UserID
+	ID  entity.TaskID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+		ID  entity.TaskID
+	}
+	mock.lockGetTask.RLock()
+	calls = mock.calls.GetTask
+	mock.lockGetTask.RUnlock()
+	return calls
+}
+
+// ListTimeEntries calls ListTimeEntriesFunc.
+func (mock *TimeTrackerMock) ListTimeEntries(ctx context.Context, db store.Queryer, uid entity.UserID, tid entity.TaskID) (entity.TimeEntries, error) {
+	if mock.ListTimeEntriesFunc == nil {
+		panic("TimeTrackerMock.ListTimeEntriesFunc: method is nil but TimeTracker.ListTimeEntries was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+		Tid entity.TaskID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		UID: uid,
+		Tid: tid,
+	}
+	mock.lockListTimeEntries.Lock()
+	mock.calls.ListTimeEntries = append(mock.calls.ListTimeEntries, callInfo)
+	mock.lockListTimeEntries.Unlock()
+	return mock.ListTimeEntriesFunc(ctx, db, uid, tid)
+}
+
+// ListTimeEntriesCalls gets all the calls that were made to ListTimeEntries.
+// Check the length with:
+//
+//	len(mockedTimeTracker.ListTimeEntriesCalls())
+func (mock *TimeTrackerMock) ListTimeEntriesCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	UID entity.UserID
+	Tid entity.TaskID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+		Tid entity.TaskID
+	}
+	mock.lockListTimeEntries.RLock()
+	calls = mock.calls.ListTimeEntries
+	mock.lockListTimeEntries.RUnlock()
+	return calls
+}
+
+// ListTimeEntriesBetween calls ListTimeEntriesBetweenFunc.
+func (mock *TimeTrackerMock) ListTimeEntriesBetween(ctx context.Context, db store.Queryer, uid entity.UserID, from time.Time, to time.Time) (entity.TimeEntries, error) {
+	if mock.ListTimeEntriesBetweenFunc == nil {
+		panic("TimeTrackerMock.ListTimeEntriesBetweenFunc: method is nil but TimeTracker.ListTimeEntriesBetween was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Db   store.Queryer
+		UID  entity.UserID
+		From time.Time
+		To   time.Time
+	}{
+		Ctx:  ctx,
+		Db:   db,
+		UID:  uid,
+		From: from,
+		To:   to,
+	}
+	mock.lockListTimeEntriesBetween.Lock()
+	mock.calls.ListTimeEntriesBetween = append(mock.calls.ListTimeEntriesBetween, callInfo)
+	mock.lockListTimeEntriesBetween.Unlock()
+	return mock.ListTimeEntriesBetweenFunc(ctx, db, uid, from, to)
+}
+
+// ListTimeEntriesBetweenCalls gets all the calls that were made to ListTimeEntriesBetween.
+// Check the length with:
+//
+//	len(mockedTimeTracker.ListTimeEntriesBetweenCalls())
+func (mock *TimeTrackerMock) ListTimeEntriesBetweenCalls() []struct {
+	Ctx  context.Context
+	Db   store.Queryer
+	UID  entity.UserID
+	From time.Time
+	To   time.Time
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Db   store.Queryer
+		UID  entity.UserID
+		From time.Time
+		To   time.Time
+	}
+	mock.lockListTimeEntriesBetween.RLock()
+	calls = mock.calls.ListTimeEntriesBetween
+	mock.lockListTimeEntriesBetween.RUnlock()
+	return calls
+}
+
+// StopTimeEntry calls StopTimeEntryFunc.
+func (mock *TimeTrackerMock) StopTimeEntry(ctx context.Context, db store.Execer, e *entity.TimeEntry) error {
+	if mock.StopTimeEntryFunc == nil {
+		panic("TimeTrackerMock.StopTimeEntryFunc: method is nil but TimeTracker.StopTimeEntry was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Execer
+		E   *entity.TimeEntry
+	}{
+		Ctx: ctx,
+		Db:  db,
+		E:   e,
+	}
+	mock.lockStopTimeEntry.Lock()
+	mock.calls.StopTimeEntry = append(mock.calls.StopTimeEntry, callInfo)
+	mock.lockStopTimeEntry.Unlock()
+	return mock.StopTimeEntryFunc(ctx, db, e)
+}
+
+// StopTimeEntryCalls gets all the calls that were made to StopTimeEntry.
+// Check the length with:
+//
+//	len(mockedTimeTracker.StopTimeEntryCalls())
+func (mock *TimeTrackerMock) StopTimeEntryCalls() []struct {
+	Ctx context.Context
+	Db  store.Execer
+	E   *entity.TimeEntry
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Execer
+		E   *entity.TimeEntry
+	}
+	mock.lockStopTimeEntry.RLock()
+	calls = mock.calls.StopTimeEntry
+	mock.lockStopTimeEntry.RUnlock()
+	return calls
+}
+
+// Ensure, that TemplateRepositoryMock does implement TemplateRepository.
+// If this is not the case, regenerate this file with moq.
+var _ TemplateRepository = &TemplateRepositoryMock{}
+
+// TemplateRepositoryMock is a mock implementation of TemplateRepository.
+//
+//	func TestSomethingThatUsesTemplateRepository(t *testing.T) {
+//
+//		// make and configure a mocked TemplateRepository
+//		mockedTemplateRepository := &TemplateRepositoryMock{
+//			AddTaskFunc: func(ctx context.Context, db store.Execer, t *entity.Task) error {
+//				panic("mock out the AddTask method")
+//			},
+//			AddTemplateFunc: func(ctx context.Context, db store.Execer, t *entity.Template) error {
+//				panic("mock out the AddTemplate method")
+//			},
+//			GetTaskFunc: func(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.TaskID) (*entity.Task, error) {
+//				panic("mock out the GetTask method")
+//			},
+//			GetTemplateFunc: func(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.TemplateID) (*entity.Template, error) {
+//				panic("mock out the GetTemplate method")
+//			},
+//			IsProjectMemberFunc: func(ctx context.Context, db store.Queryer, id entity.ProjectID, uid entity.UserID) (bool, error) {
+//				panic("mock out the IsProjectMember method")
+//			},
+//			ListTasksFunc: func(ctx context.Context, db store.Queryer, id entity.UserID) (entity.Tasks, error) {
+//				panic("mock out the ListTasks method")
+//			},
+//			ListTemplatesFunc: func(ctx context.Context, db store.Queryer, uid entity.UserID) (entity.Templates, error) {
+//				panic("mock out the ListTemplates method")
+//			},
+//		}
+//
+//		// use mockedTemplateRepository in code that requires TemplateRepository
+//		// and then make assertions.
+//
+//	}
+type TemplateRepositoryMock struct {
+	// AddTaskFunc mocks the AddTask method.
+	AddTaskFunc func(ctx context.Context, db store.Execer, t *entity.Task) error
+
+	// AddTemplateFunc mocks the AddTemplate method.
+	AddTemplateFunc func(ctx context.Context, db store.Execer, t *entity.Template) error
+
+	// GetTaskFunc mocks the GetTask method.
+	GetTaskFunc func(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.TaskID) (*entity.Task, error)
+
+	// GetTemplateFunc mocks the GetTemplate method.
+	GetTemplateFunc func(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.TemplateID) (*entity.Template, error)
+
+	// IsProjectMemberFunc mocks the IsProjectMember method.
+	IsProjectMemberFunc func(ctx context.Context, db store.Queryer, id entity.ProjectID, uid entity.UserID) (bool, error)
+
+	// ListTasksFunc mocks the ListTasks method.
+	ListTasksFunc func(ctx context.Context, db store.Queryer, id entity.UserID) (entity.Tasks, error)
+
+	// ListTemplatesFunc mocks the ListTemplates method.
+	ListTemplatesFunc func(ctx context.Context, db store.Queryer, uid entity.UserID) (entity.Templates, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AddTask holds details about calls to the AddTask method.
+		AddTask []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Execer
+			// T is the t argument value.
+			T *entity.Task
+		}
+		// AddTemplate holds details about calls to the AddTemplate method.
+		AddTemplate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Execer
+			// T is the t argument value.
+			T *entity.Template
+		}
+		// GetTask holds details about calls to the GetTask method.
+		GetTask []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// UID is the uid argument value.
+			UID entity.UserID
+			// ID is the id argument value.
+			ID entity.TaskID
+		}
+		// GetTemplate holds details about calls to the GetTemplate method.
+		GetTemplate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// UID is the uid argument value.
+			UID entity.UserID
+			// ID is the id argument value.
+			ID entity.TemplateID
+		}
+		// IsProjectMember holds details about calls to the IsProjectMember method.
+		IsProjectMember []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// ID is the id argument value.
+			ID entity.ProjectID
+			// UID is the uid argument value.
+			UID entity.UserID
+		}
+		// ListTasks holds details about calls to the ListTasks method.
+		ListTasks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// ID is the id argument value.
+			ID entity.UserID
+		}
+		// ListTemplates holds details about calls to the ListTemplates method.
+		ListTemplates []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// UID is the uid argument value.
+			UID entity.UserID
+		}
+	}
+	lockAddTask         sync.RWMutex
+	lockAddTemplate     sync.RWMutex
+	lockGetTask         sync.RWMutex
+	lockGetTemplate     sync.RWMutex
+	lockIsProjectMember sync.RWMutex
+	lockListTasks       sync.RWMutex
+	lockListTemplates   sync.RWMutex
+}
+
+// AddTask calls AddTaskFunc.
+func (mock *TemplateRepositoryMock) AddTask(ctx context.Context, db store.Execer, t *entity.Task) error {
+	if mock.AddTaskFunc == nil {
+		panic("TemplateRepositoryMock.AddTaskFunc: method is nil but TemplateRepository.AddTask was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Execer
+		T   *entity.Task
+	}{
+		Ctx: ctx,
+		Db:  db,
+		T:   t,
+	}
+	mock.lockAddTask.Lock()
+	mock.calls.AddTask = append(mock.calls.AddTask, callInfo)
+	mock.lockAddTask.Unlock()
+	return mock.AddTaskFunc(ctx, db, t)
+}
+
+// AddTaskCalls gets all the calls that were made to AddTask.
+// Check the length with:
+//
+//	len(mockedTemplateRepository.AddTaskCalls())
+func (mock *TemplateRepositoryMock) AddTaskCalls() []struct {
+	Ctx context.Context
+	Db  store.Execer
+	T   *entity.Task
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Execer
+		T   *entity.Task
+	}
+	mock.lockAddTask.RLock()
+	calls = mock.calls.AddTask
+	mock.lockAddTask.RUnlock()
+	return calls
+}
+
+// AddTemplate calls AddTemplateFunc.
+func (mock *TemplateRepositoryMock) AddTemplate(ctx context.Context, db store.Execer, t *entity.Template) error {
+	if mock.AddTemplateFunc == nil {
+		panic("TemplateRepositoryMock.AddTemplateFunc: method is nil but TemplateRepository.AddTemplate was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Execer
+		T   *entity.Template
+	}{
+		Ctx: ctx,
+		Db:  db,
+		T:   t,
+	}
+	mock.lockAddTemplate.Lock()
+	mock.calls.AddTemplate = append(mock.calls.AddTemplate, callInfo)
+	mock.lockAddTemplate.Unlock()
+	return mock.AddTemplateFunc(ctx, db, t)
+}
+
+// AddTemplateCalls gets all the calls that were made to AddTemplate.
+// Check the length with:
+//
+//	len(mockedTemplateRepository.AddTemplateCalls())
+func (mock *TemplateRepositoryMock) AddTemplateCalls() []struct {
+	Ctx context.Context
+	Db  store.Execer
+	T   *entity.Template
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Execer
+		T   *entity.Template
+	}
+	mock.lockAddTemplate.RLock()
+	calls = mock.calls.AddTemplate
+	mock.lockAddTemplate.RUnlock()
+	return calls
+}
+
+// GetTask calls GetTaskFunc.
+func (mock *TemplateRepositoryMock) GetTask(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.TaskID) (*entity.Task, error) {
+	if mock.GetTaskFunc == nil {
+		panic("TemplateRepositoryMock.GetTaskFunc: method is nil but TemplateRepository.GetTask was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+		ID  entity.TaskID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		UID: uid,
+		ID:  id,
+	}
+	mock.lockGetTask.Lock()
+	mock.calls.GetTask = append(mock.calls.GetTask, callInfo)
+	mock.lockGetTask.Unlock()
+	return mock.GetTaskFunc(ctx, db, uid, id)
+}
+
+// GetTaskCalls gets all the calls that were made to GetTask.
+// Check the length with:
+//
+//	len(mockedTemplateRepository.GetTaskCalls())
+func (mock *TemplateRepositoryMock) GetTaskCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	UID entity.UserID
+	ID  entity.TaskID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+		ID  entity.TaskID
+	}
+	mock.lockGetTask.RLock()
+	calls = mock.calls.GetTask
+	mock.lockGetTask.RUnlock()
+	return calls
+}
+
+// GetTemplate calls GetTemplateFunc.
+func (mock *TemplateRepositoryMock) GetTemplate(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.TemplateID) (*entity.Template, error) {
+	if mock.GetTemplateFunc == nil {
+		panic("TemplateRepositoryMock.GetTemplateFunc: method is nil but TemplateRepository.GetTemplate was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+		ID  entity.TemplateID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		UID: uid,
+		ID:  id,
+	}
+	mock.lockGetTemplate.Lock()
+	mock.calls.GetTemplate = append(mock.calls.GetTemplate, callInfo)
+	mock.lockGetTemplate.Unlock()
+	return mock.GetTemplateFunc(ctx, db, uid, id)
+}
+
+// GetTemplateCalls gets all the calls that were made to GetTemplate.
+// Check the length with:
+//
+//	len(mockedTemplateRepository.GetTemplateCalls())
+func (mock *TemplateRepositoryMock) GetTemplateCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	UID entity.UserID
+	ID  entity.TemplateID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+		ID  entity.TemplateID
+	}
+	mock.lockGetTemplate.RLock()
+	calls = mock.calls.GetTemplate
+	mock.lockGetTemplate.RUnlock()
+	return calls
+}
+
+// IsProjectMember calls IsProjectMemberFunc.
+func (mock *TemplateRepositoryMock) IsProjectMember(ctx context.Context, db store.Queryer, id entity.ProjectID, uid entity.UserID) (bool, error) {
+	if mock.IsProjectMemberFunc == nil {
+		panic("TemplateRepositoryMock.IsProjectMemberFunc: method is nil but TemplateRepository.IsProjectMember was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.ProjectID
+		UID entity.UserID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		ID:  id,
+		UID: uid,
+	}
+	mock.lockIsProjectMember.Lock()
+	mock.calls.IsProjectMember = append(mock.calls.IsProjectMember, callInfo)
+	mock.lockIsProjectMember.Unlock()
+	return mock.IsProjectMemberFunc(ctx, db, id, uid)
+}
+
+// IsProjectMemberCalls gets all the calls that were made to IsProjectMember.
+// Check the length with:
+//
+//	len(mockedTemplateRepository.IsProjectMemberCalls())
+func (mock *TemplateRepositoryMock) IsProjectMemberCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	ID  entity.ProjectID
+	UID entity.UserID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.ProjectID
+		UID entity.UserID
+	}
+	mock.lockIsProjectMember.RLock()
+	calls = mock.calls.IsProjectMember
+	mock.lockIsProjectMember.RUnlock()
+	return calls
+}
+
+// ListTasks calls ListTasksFunc.
+func (mock *TemplateRepositoryMock) ListTasks(ctx context.Context, db store.Queryer, id entity.UserID) (entity.Tasks, error) {
+	if mock.ListTasksFunc == nil {
+		panic("TemplateRepositoryMock.ListTasksFunc: method is nil but TemplateRepository.ListTasks was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.UserID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		ID:  id,
+	}
+	mock.lockListTasks.Lock()
+	mock.calls.ListTasks = append(mock.calls.ListTasks, callInfo)
+	mock.lockListTasks.Unlock()
+	return mock.ListTasksFunc(ctx, db, id)
+}
+
+// ListTasksCalls gets all the calls that were made to ListTasks.
+// Check the length with:
+//
+//	len(mockedTemplateRepository.ListTasksCalls())
+func (mock *TemplateRepositoryMock) ListTasksCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	ID  entity.UserID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.UserID
+	}
+	mock.lockListTasks.RLock()
+	calls = mock.calls.ListTasks
+	mock.lockListTasks.RUnlock()
+	return calls
+}
+
+// ListTemplates calls ListTemplatesFunc.
+func (mock *TemplateRepositoryMock) ListTemplates(ctx context.Context, db store.Queryer, uid entity.UserID) (entity.Templates, error) {
+	if mock.ListTemplatesFunc == nil {
+		panic("TemplateRepositoryMock.ListTemplatesFunc: method is nil but TemplateRepository.ListTemplates was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		UID: uid,
+	}
+	mock.lockListTemplates.Lock()
+	mock.calls.ListTemplates = append(mock.calls.ListTemplates, callInfo)
+	mock.lockListTemplates.Unlock()
+	return mock.ListTemplatesFunc(ctx, db, uid)
+}
+
+// ListTemplatesCalls gets all the calls that were made to ListTemplates.
+// Check the length with:
+//
+//	len(mockedTemplateRepository.ListTemplatesCalls())
+func (mock *TemplateRepositoryMock) ListTemplatesCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	UID entity.UserID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+	}
+	mock.lockListTemplates.RLock()
+	calls = mock.calls.ListTemplates
+	mock.lockListTemplates.RUnlock()
+	return calls
+}
+
+// Ensure, that SyncRepositoryMock does implement SyncRepository.
+// If this is not the case, regenerate this file with moq.
+var _ SyncRepository = &SyncRepositoryMock{}
+
+// SyncRepositoryMock is a mock implementation of SyncRepository.
+//
+//	func TestSomethingThatUsesSyncRepository(t *testing.T) {
+//
+//		// make and configure a mocked SyncRepository
+//		mockedSyncRepository := &SyncRepositoryMock{
+//			AddTaskFunc: func(ctx context.Context, db store.Execer, t *entity.Task) error {
+//				panic("mock out the AddTask method")
+//			},
+//			DeleteTaskFunc: func(ctx context.Context, db store.Execer, t *entity.Task) error {
+//				panic("mock out the DeleteTask method")
+//			},
+//			GetTaskFunc: func(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.TaskID) (*entity.Task, error) {
+//				panic("mock out the GetTask method")
+//			},
+//			GetTaskByClientIDFunc: func(ctx context.Context, db store.Queryer, uid entity.UserID, clientID string) (*entity.Task, error) {
+//				panic("mock out the GetTaskByClientID method")
+//			},
+//			LatestTaskChangeSeqFunc: func(ctx context.Context, db store.Queryer, uid entity.UserID) (int64, error) {
+//				panic("mock out the LatestTaskChangeSeq method")
+//			},
+//			ListTaskChangesFunc: func(ctx context.Context, db store.Queryer, uid entity.UserID, since int64, limit int) (entity.TaskChanges, error) {
+//				panic("mock out the ListTaskChanges method")
+//			},
+//			ListTaskStatusesFunc: func(ctx context.Context, db store.Queryer, id entity.UserID) (entity.TaskStatusDefs, error) {
+//				panic("mock out the ListTaskStatuses method")
+//			},
+//			ListTasksFunc: func(ctx context.Context, db store.Queryer, id entity.UserID) (entity.Tasks, error) {
+//				panic("mock out the ListTasks method")
+//			},
+//			ListTasksByIDsFunc: func(ctx context.Context, db store.Queryer, ids []entity.TaskID) (entity.Tasks, error) {
+//				panic("mock out the ListTasksByIDs method")
+//			},
+//			ListWorkTasksFunc: func(ctx context.Context, db store.Queryer, id entity.UserID) (entity.Tasks, error) {
+//				panic("mock out the ListWorkTasks method")
+//			},
+//			UpdateTaskFunc: func(ctx context.Context, db store.Execer, t *entity.Task) error {
+//				panic("mock out the UpdateTask method")
+//			},
+//		}
+//
+//		// use mockedSyncRepository in code that requires SyncRepository
+//		// and then make assertions.
+//
+//	}
+type SyncRepositoryMock struct {
+	// AddTaskFunc mocks the AddTask method.
+	AddTaskFunc func(ctx context.Context, db store.Execer, t *entity.Task) error
+
+	// DeleteTaskFunc mocks the DeleteTask method.
+	DeleteTaskFunc func(ctx context.Context, db store.Execer, t *entity.Task) error
+
+	// GetTaskFunc mocks the GetTask method.
+	GetTaskFunc func(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.TaskID) (*entity.Task, error)
+
+	// GetTaskByClientIDFunc mocks the GetTaskByClientID method.
+	GetTaskByClientIDFunc func(ctx context.Context, db store.Queryer, uid entity.UserID, clientID string) (*entity.Task, error)
+
+	// LatestTaskChangeSeqFunc mocks the LatestTaskChangeSeq method.
+	LatestTaskChangeSeqFunc func(ctx context.Context, db store.Queryer, uid entity.UserID) (int64, error)
+
+	// ListTaskChangesFunc mocks the ListTaskChanges method.
+	ListTaskChangesFunc func(ctx context.Context, db store.Queryer, uid entity.UserID, since int64, limit int) (entity.TaskChanges, error)
+
+	// ListTaskStatusesFunc mocks the ListTaskStatuses method.
+	ListTaskStatusesFunc func(ctx context.Context, db store.Queryer, id entity.UserID) (entity.TaskStatusDefs, error)
+
+	// ListTasksFunc mocks the ListTasks method.
+	ListTasksFunc func(ctx context.Context, db store.Queryer, id entity.UserID) (entity.Tasks, error)
+
+	// ListTasksByIDsFunc mocks the ListTasksByIDs method.
+	ListTasksByIDsFunc func(ctx context.Context, db store.Queryer, ids []entity.TaskID) (entity.Tasks, error)
+
+	// ListWorkTasksFunc mocks the ListWorkTasks method.
+	ListWorkTasksFunc func(ctx context.Context, db store.Queryer, id entity.UserID) (entity.Tasks, error)
+
+	// UpdateTaskFunc mocks the UpdateTask method.
+	UpdateTaskFunc func(ctx context.Context, db store.Execer, t *entity.Task) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AddTask holds details about calls to the AddTask method.
+		AddTask []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Execer
+			// T is the t argument value.
+			T *entity.Task
+		}
+		// DeleteTask holds details about calls to the DeleteTask method.
+		DeleteTask []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Execer
+			// T is the t argument value.
+			T *entity.Task
+		}
+		// GetTask holds details about calls to the GetTask method.
+		GetTask []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// UID is the uid argument value.
+			UID entity.UserID
+			// ID is the id argument value.
+			ID entity.TaskID
+		}
+		// GetTaskByClientID holds details about calls to the GetTaskByClientID method.
+		GetTaskByClientID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// UID is the uid argument value.
+			UID entity.UserID
+			// ClientID is the clientID argument value.
+			ClientID string
+		}
+		// LatestTaskChangeSeq holds details about calls to the LatestTaskChangeSeq method.
+		LatestTaskChangeSeq []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// UID is the uid argument value.
+			UID entity.UserID
+		}
+		// ListTaskChanges holds details about calls to the ListTaskChanges method.
+		ListTaskChanges []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// UID is the uid argument value.
+			UID entity.UserID
+			// Since is the since argument value.
+			Since int64
+			// Limit is the limit argument value.
+			Limit int
+		}
+		// ListTaskStatuses holds details about calls to the ListTaskStatuses method.
+		ListTaskStatuses []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// ID is the id argument value.
+			ID entity.UserID
+		}
+		// ListTasks holds details about calls to the ListTasks method.
+		ListTasks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// ID is the id argument value.
+			ID entity.UserID
+		}
+		// ListTasksByIDs holds details about calls to the ListTasksByIDs method.
+		ListTasksByIDs []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// Ids is the ids argument value.
+			Ids []entity.TaskID
+		}
+		// ListWorkTasks holds details about calls to the ListWorkTasks method.
+		ListWorkTasks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// ID is the id argument value.
+			ID entity.UserID
+		}
+		// UpdateTask holds details about calls to the UpdateTask method.
+		UpdateTask []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Execer
+			// T is the t argument value.
+			T *entity.Task
+		}
+	}
+	lockAddTask             sync.RWMutex
+	lockDeleteTask          sync.RWMutex
+	lockGetTask             sync.RWMutex
+	lockGetTaskByClientID   sync.RWMutex
+	lockLatestTaskChangeSeq sync.RWMutex
+	lockListTaskChanges     sync.RWMutex
+	lockListTaskStatuses    sync.RWMutex
+	lockListTasks           sync.RWMutex
+	lockListTasksByIDs      sync.RWMutex
+	lockListWorkTasks       sync.RWMutex
+	lockUpdateTask          sync.RWMutex
+}
+
+// AddTask calls AddTaskFunc.
+func (mock *SyncRepositoryMock) AddTask(ctx context.Context, db store.Execer, t *entity.Task) error {
+	if mock.AddTaskFunc == nil {
+		panic("SyncRepositoryMock.AddTaskFunc: method is nil but SyncRepository.AddTask was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Execer
+		T   *entity.Task
+	}{
+		Ctx: ctx,
+		Db:  db,
+		T:   t,
+	}
+	mock.lockAddTask.Lock()
+	mock.calls.AddTask = append(mock.calls.AddTask, callInfo)
+	mock.lockAddTask.Unlock()
+	return mock.AddTaskFunc(ctx, db, t)
+}
+
+// AddTaskCalls gets all the calls that were made to AddTask.
+// Check the length with:
+//
+//	len(mockedSyncRepository.AddTaskCalls())
+func (mock *SyncRepositoryMock) AddTaskCalls() []struct {
+	Ctx context.Context
+	Db  store.Execer
+	T   *entity.Task
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Execer
+		T   *entity.Task
+	}
+	mock.lockAddTask.RLock()
+	calls = mock.calls.AddTask
+	mock.lockAddTask.RUnlock()
+	return calls
+}
+
+// DeleteTask calls DeleteTaskFunc.
+func (mock *SyncRepositoryMock) DeleteTask(ctx context.Context, db store.Execer, t *entity.Task) error {
+	if mock.DeleteTaskFunc == nil {
+		panic("SyncRepositoryMock.DeleteTaskFunc: method is nil but SyncRepository.DeleteTask was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Execer
+		T   *entity.Task
+	}{
+		Ctx: ctx,
+		Db:  db,
+		T:   t,
+	}
+	mock.lockDeleteTask.Lock()
+	mock.calls.DeleteTask = append(mock.calls.DeleteTask, callInfo)
+	mock.lockDeleteTask.Unlock()
+	return mock.DeleteTaskFunc(ctx, db, t)
+}
+
+// DeleteTaskCalls gets all the calls that were made to DeleteTask.
+// Check the length with:
+//
+//	len(mockedSyncRepository.DeleteTaskCalls())
+func (mock *SyncRepositoryMock) DeleteTaskCalls() []struct {
+	Ctx context.Context
+	Db  store.Execer
+	T   *entity.Task
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Execer
+		T   *entity.Task
+	}
+	mock.lockDeleteTask.RLock()
+	calls = mock.calls.DeleteTask
+	mock.lockDeleteTask.RUnlock()
+	return calls
+}
+
+// GetTask calls GetTaskFunc.
+func (mock *SyncRepositoryMock) GetTask(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.TaskID) (*entity.Task, error) {
+	if mock.GetTaskFunc == nil {
+		panic("SyncRepositoryMock.GetTaskFunc: method is nil but SyncRepository.GetTask was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+		ID  entity.TaskID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		UID: uid,
+		ID:  id,
+	}
+	mock.lockGetTask.Lock()
+	mock.calls.GetTask = append(mock.calls.GetTask, callInfo)
+	mock.lockGetTask.Unlock()
+	return mock.GetTaskFunc(ctx, db, uid, id)
+}
+
+// GetTaskCalls gets all the calls that were made to GetTask.
+// Check the length with:
+//
+//	len(mockedSyncRepository.GetTaskCalls())
+func (mock *SyncRepositoryMock) GetTaskCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	UID entity.UserID
+	ID  entity.TaskID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+		ID  entity.TaskID
+	}
+	mock.lockGetTask.RLock()
+	calls = mock.calls.GetTask
+	mock.lockGetTask.RUnlock()
+	return calls
+}
+
+// GetTaskByClientID calls GetTaskByClientIDFunc.
+func (mock *SyncRepositoryMock) GetTaskByClientID(ctx context.Context, db store.Queryer, uid entity.UserID, clientID string) (*entity.Task, error) {
+	if mock.GetTaskByClientIDFunc == nil {
+		panic("SyncRepositoryMock.GetTaskByClientIDFunc: method is nil but SyncRepository.GetTaskByClientID was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Db       store.Queryer
+		UID      entity.UserID
+		ClientID string
+	}{
+		Ctx:      ctx,
+		Db:       db,
+		UID:      uid,
+		ClientID: clientID,
+	}
+	mock.lockGetTaskByClientID.Lock()
+	mock.calls.GetTaskByClientID = append(mock.calls.GetTaskByClientID, callInfo)
+	mock.lockGetTaskByClientID.Unlock()
+	return mock.GetTaskByClientIDFunc(ctx, db, uid, clientID)
+}
+
+// GetTaskByClientIDCalls gets all the calls that were made to GetTaskByClientID.
+// Check the length with:
+//
+//	len(mockedSyncRepository.GetTaskByClientIDCalls())
+func (mock *SyncRepositoryMock) GetTaskByClientIDCalls() []struct {
+	Ctx      context.Context
+	Db       store.Queryer
+	UID      entity.UserID
+	ClientID string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Db       store.Queryer
+		UID      entity.UserID
+		ClientID string
+	}
+	mock.lockGetTaskByClientID.RLock()
+	calls = mock.calls.GetTaskByClientID
+	mock.lockGetTaskByClientID.RUnlock()
+	return calls
+}
+
+// LatestTaskChangeSeq calls LatestTaskChangeSeqFunc.
+func (mock *SyncRepositoryMock) LatestTaskChangeSeq(ctx context.Context, db store.Queryer, uid entity.UserID) (int64, error) {
+	if mock.LatestTaskChangeSeqFunc == nil {
+		panic("SyncRepositoryMock.LatestTaskChangeSeqFunc: method is nil but SyncRepository.LatestTaskChangeSeq was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		UID: uid,
+	}
+	mock.lockLatestTaskChangeSeq.Lock()
+	mock.calls.LatestTaskChangeSeq = append(mock.calls.LatestTaskChangeSeq, callInfo)
+	mock.lockLatestTaskChangeSeq.Unlock()
+	return mock.LatestTaskChangeSeqFunc(ctx, db, uid)
+}
+
+// LatestTaskChangeSeqCalls gets all the calls that were made to LatestTaskChangeSeq.
+// Check the length with:
+//
+//	len(mockedSyncRepository.LatestTaskChangeSeqCalls())
+func (mock *SyncRepositoryMock) LatestTaskChangeSeqCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	UID entity.UserID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+	}
+	mock.lockLatestTaskChangeSeq.RLock()
+	calls = mock.calls.LatestTaskChangeSeq
+	mock.lockLatestTaskChangeSeq.RUnlock()
+	return calls
+}
+
+// ListTaskChanges calls ListTaskChangesFunc.
+func (mock *SyncRepositoryMock) ListTaskChanges(ctx context.Context, db store.Queryer, uid entity.UserID, since int64, limit int) (entity.TaskChanges, error) {
+	if mock.ListTaskChangesFunc == nil {
+		panic("SyncRepositoryMock.ListTaskChangesFunc: method is nil but SyncRepository.ListTaskChanges was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Db    store.Queryer
+		UID   entity.UserID
+		Since int64
+		Limit int
+	}{
+		Ctx:   ctx,
+		Db:    db,
+		UID:   uid,
+		Since: since,
+		Limit: limit,
+	}
+	mock.lockListTaskChanges.Lock()
+	mock.calls.ListTaskChanges = append(mock.calls.ListTaskChanges, callInfo)
+	mock.lockListTaskChanges.Unlock()
+	return mock.ListTaskChangesFunc(ctx, db, uid, since, limit)
+}
+
+// ListTaskChangesCalls gets all the calls that were made to ListTaskChanges.
+// Check the length with:
+//
+//	len(mockedSyncRepository.ListTaskChangesCalls())
+func (mock *SyncRepositoryMock) ListTaskChangesCalls() []struct {
+	Ctx   context.Context
+	Db    store.Queryer
+	UID   entity.UserID
+	Since int64
+	Limit int
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Db    store.Queryer
+		UID   entity.UserID
+		Since int64
+		Limit int
+	}
+	mock.lockListTaskChanges.RLock()
+	calls = mock.calls.ListTaskChanges
+	mock.lockListTaskChanges.RUnlock()
+	return calls
+}
+
+// ListTaskStatuses calls ListTaskStatusesFunc.
+func (mock *SyncRepositoryMock) ListTaskStatuses(ctx context.Context, db store.Queryer, id entity.UserID) (entity.TaskStatusDefs, error) {
+	if mock.ListTaskStatusesFunc == nil {
+		panic("SyncRepositoryMock.ListTaskStatusesFunc: method is nil but SyncRepository.ListTaskStatuses was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.UserID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		ID:  id,
+	}
+	mock.lockListTaskStatuses.Lock()
+	mock.calls.ListTaskStatuses = append(mock.calls.ListTaskStatuses, callInfo)
+	mock.lockListTaskStatuses.Unlock()
+	return mock.ListTaskStatusesFunc(ctx, db, id)
+}
+
+// ListTaskStatusesCalls gets all the calls that were made to ListTaskStatuses.
+// Check the length with:
+//
+//	len(mockedSyncRepository.ListTaskStatusesCalls())
+func (mock *SyncRepositoryMock) ListTaskStatusesCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	ID  entity.UserID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.UserID
+	}
+	mock.lockListTaskStatuses.RLock()
+	calls = mock.calls.ListTaskStatuses
+	mock.lockListTaskStatuses.RUnlock()
+	return calls
+}
+
+// ListTasks calls ListTasksFunc.
+func (mock *SyncRepositoryMock) ListTasks(ctx context.Context, db store.Queryer, id entity.UserID) (entity.Tasks, error) {
+	if mock.ListTasksFunc == nil {
+		panic("SyncRepositoryMock.ListTasksFunc: method is nil but SyncRepository.ListTasks was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.UserID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		ID:  id,
+	}
+	mock.lockListTasks.Lock()
+	mock.calls.ListTasks = append(mock.calls.ListTasks, callInfo)
+	mock.lockListTasks.Unlock()
+	return mock.ListTasksFunc(ctx, db, id)
+}
+
+// ListTasksCalls gets all the calls that were made to ListTasks.
+// Check the length with:
+//
+//	len(mockedSyncRepository.ListTasksCalls())
+func (mock *SyncRepositoryMock) ListTasksCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	ID  entity.UserID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.UserID
+	}
+	mock.lockListTasks.RLock()
+	calls = mock.calls.ListTasks
+	mock.lockListTasks.RUnlock()
+	return calls
+}
+
+// ListTasksByIDs calls ListTasksByIDsFunc.
+func (mock *SyncRepositoryMock) ListTasksByIDs(ctx context.Context, db store.Queryer, ids []entity.TaskID) (entity.Tasks, error) {
+	if mock.ListTasksByIDsFunc == nil {
+		panic("SyncRepositoryMock.ListTasksByIDsFunc: method is nil but SyncRepository.ListTasksByIDs was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		Ids []entity.TaskID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		Ids: ids,
+	}
+	mock.lockListTasksByIDs.Lock()
+	mock.calls.ListTasksByIDs = append(mock.calls.ListTasksByIDs, callInfo)
+	mock.lockListTasksByIDs.Unlock()
+	return mock.ListTasksByIDsFunc(ctx, db, ids)
+}
+
+// ListTasksByIDsCalls gets all the calls that were made to ListTasksByIDs.
+// Check the length with:
+//
+//	len(mockedSyncRepository.ListTasksByIDsCalls())
+func (mock *SyncRepositoryMock) ListTasksByIDsCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	Ids []entity.TaskID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		Ids []entity.TaskID
+	}
+	mock.lockListTasksByIDs.RLock()
+	calls = mock.calls.ListTasksByIDs
+	mock.lockListTasksByIDs.RUnlock()
+	return calls
+}
+
+// ListWorkTasks calls ListWorkTasksFunc.
+func (mock *SyncRepositoryMock) ListWorkTasks(ctx context.Context, db store.Queryer, id entity.UserID) (entity.Tasks, error) {
+	if mock.ListWorkTasksFunc == nil {
+		panic("SyncRepositoryMock.ListWorkTasksFunc: method is nil but SyncRepository.ListWorkTasks was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.UserID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		ID:  id,
+	}
+	mock.lockListWorkTasks.Lock()
+	mock.calls.ListWorkTasks = append(mock.calls.ListWorkTasks, callInfo)
+	mock.lockListWorkTasks.Unlock()
+	return mock.ListWorkTasksFunc(ctx, db, id)
+}
+
+// ListWorkTasksCalls gets all the calls that were made to ListWorkTasks.
+// Check the length with:
+//
+//	len(mockedSyncRepository.ListWorkTasksCalls())
+func (mock *SyncRepositoryMock) ListWorkTasksCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	ID  entity.UserID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.UserID
+	}
+	mock.lockListWorkTasks.RLock()
+	calls = mock.calls.ListWorkTasks
+	mock.lockListWorkTasks.RUnlock()
+	return calls
+}
+
+// UpdateTask calls UpdateTaskFunc.
+func (mock *SyncRepositoryMock) UpdateTask(ctx context.Context, db store.Execer, t *entity.Task) error {
+	if mock.UpdateTaskFunc == nil {
+		panic("SyncRepositoryMock.UpdateTaskFunc: method is nil but SyncRepository.UpdateTask was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Execer
+		T   *entity.Task
+	}{
+		Ctx: ctx,
+		Db:  db,
+		T:   t,
+	}
+	mock.lockUpdateTask.Lock()
+	mock.calls.UpdateTask = append(mock.calls.UpdateTask, callInfo)
+	mock.lockUpdateTask.Unlock()
+	return mock.UpdateTaskFunc(ctx, db, t)
+}
+
+// UpdateTaskCalls gets all the calls that were made to UpdateTask.
+// Check the length with:
+//
+//	len(mockedSyncRepository.UpdateTaskCalls())
+func (mock *SyncRepositoryMock) UpdateTaskCalls() []struct {
+	Ctx context.Context
+	Db  store.Execer
+	T   *entity.Task
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Execer
+		T   *entity.Task
+	}
+	mock.lockUpdateTask.RLock()
+	calls = mock.calls.UpdateTask
+	mock.lockUpdateTask.RUnlock()
+	return calls
+}
+
+// Ensure, that NotifierMock does implement Notifier.
+// If this is not the case, regenerate this file with moq.
+var _ Notifier = &NotifierMock{}
+
+// NotifierMock is a mock implementation of Notifier.
+//
+//	func TestSomethingThatUsesNotifier(t *testing.T) {
+//
+//		// make and configure a mocked Notifier
+//		mockedNotifier := &NotifierMock{
+//			NotifyFunc: func(ctx context.Context, n *entity.Notification) error {
+//				panic("mock out the Notify method")
+//			},
+//		}
+//
+//		// use mockedNotifier in code that requires Notifier
+//		// and then make assertions.
+//
+//	}
+type NotifierMock struct {
+	// NotifyFunc mocks the Notify method.
+	NotifyFunc func(ctx context.Context, n *entity.Notification) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Notify holds details about calls to the Notify method.
+		Notify []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// N is the n argument value.
+			N *entity.Notification
+		}
+	}
+	lockNotify sync.RWMutex
+}
+
+// Notify calls NotifyFunc.
+func (mock *NotifierMock) Notify(ctx context.Context, n *entity.Notification) error {
+	if mock.NotifyFunc == nil {
+		panic("NotifierMock.NotifyFunc: method is nil but Notifier.Notify was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		N   *entity.Notification
+	}{
+		Ctx: ctx,
+		N:   n,
+	}
+	mock.lockNotify.Lock()
+	mock.calls.Notify = append(mock.calls.Notify, callInfo)
+	mock.lockNotify.Unlock()
+	return mock.NotifyFunc(ctx, n)
+}
+
+// NotifyCalls gets all the calls that were made to Notify.
+// Check the length with:
+//
+//	len(mockedNotifier.NotifyCalls())
+func (mock *NotifierMock) NotifyCalls() []struct {
+	Ctx context.Context
+	N   *entity.Notification
+} {
+	var calls []struct {
+		Ctx context.Context
+		N   *entity.Notification
+	}
+	mock.lockNotify.RLock()
+	calls = mock.calls.Notify
+	mock.lockNotify.RUnlock()
+	return calls
+}
+
+// Ensure, that NotificationRepositoryMock does implement NotificationRepository.
+// If this is not the case, regenerate this file with moq.
+var _ NotificationRepository = &NotificationRepositoryMock{}
+
+// NotificationRepositoryMock is a mock implementation of NotificationRepository.
+//
+//	func TestSomethingThatUsesNotificationRepository(t *testing.T) {
+//
+//		// make and configure a mocked NotificationRepository
+//		mockedNotificationRepository := &NotificationRepositoryMock{
+//			AddNotificationFunc: func(ctx context.Context, db store.Execer, n *entity.Notification) error {
+//				panic("mock out the AddNotification method")
+//			},
+//			CountUnreadNotificationsFunc: func(ctx context.Context, db store.Queryer, uid entity.UserID) (int, error) {
+//				panic("mock out the CountUnreadNotifications method")
+//			},
+//			ListNotificationsFunc: func(ctx context.Context, db store.Queryer, uid entity.UserID, unreadOnly bool) (entity.Notifications, error) {
+//				panic("mock out the ListNotifications method")
+//			},
+//			MarkAllNotificationsReadFunc: func(ctx context.Context, db store.Execer, uid entity.UserID) (int64, error) {
+//				panic("mock out the MarkAllNotificationsRead method")
+//			},
+//			MarkNotificationReadFunc: func(ctx context.Context, db store.ExecQueryer, uid entity.UserID, id entity.NotificationID) error {
+//				panic("mock out the MarkNotificationRead method")
+//			},
+//		}
+//
+//		// use mockedNotificationRepository in code that requires NotificationRepository
+//		// and then make assertions.
+//
+//	}
+type NotificationRepositoryMock struct {
+	// AddNotificationFunc mocks the AddNotification method.
+	AddNotificationFunc func(ctx context.Context, db store.Execer, n *entity.Notification) error
+
+	// CountUnreadNotificationsFunc mocks the CountUnreadNotifications method.
+	CountUnreadNotificationsFunc func(ctx context.Context, db store.Queryer, uid entity.UserID) (int, error)
+
+	// ListNotificationsFunc mocks the ListNotifications method.
+	ListNotificationsFunc func(ctx context.Context, db store.Queryer, uid entity.UserID, unreadOnly bool) (entity.Notifications, error)
+
+	// MarkAllNotificationsReadFunc mocks the MarkAllNotificationsRead method.
+	MarkAllNotificationsReadFunc func(ctx context.Context, db store.Execer, uid entity.UserID) (int64, error)
+
+	// MarkNotificationReadFunc mocks the MarkNotificationRead method.
+	MarkNotificationReadFunc func(ctx context.Context, db store.ExecQueryer, uid entity.UserID, id entity.NotificationID) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AddNotification holds details about calls to the AddNotification method.
+		AddNotification []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Execer
+			// N is the n argument value.
+			N *entity.Notification
+		}
+		// CountUnreadNotifications holds details about calls to the CountUnreadNotifications method.
+		CountUnreadNotifications []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// UID is the uid argument value.
+			UID entity.UserID
+		}
+		// ListNotifications holds details about calls to the ListNotifications method.
+		ListNotifications []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// UID is the uid argument value.
+			UID entity.UserID
+			// UnreadOnly is the unreadOnly argument value.
+			UnreadOnly bool
+		}
+		// MarkAllNotificationsRead holds details about calls to the MarkAllNotificationsRead method.
+		MarkAllNotificationsRead []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Execer
+			// UID is the uid argument value.
+			UID entity.UserID
+		}
+		// MarkNotificationRead holds details about calls to the MarkNotificationRead method.
+		MarkNotificationRead []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.ExecQueryer
+			// UID is the uid argument value.
+			UID entity.UserID
+			// ID is the id argument value.
+			ID entity.NotificationID
+		}
+	}
+	lockAddNotification          sync.RWMutex
+	lockCountUnreadNotifications sync.RWMutex
+	lockListNotifications        sync.RWMutex
+	lockMarkAllNotificationsRead sync.RWMutex
+	lockMarkNotificationRead     sync.RWMutex
+}
+
+// AddNotification calls AddNotificationFunc.
+func (mock *NotificationRepositoryMock) AddNotification(ctx context.Context, db store.Execer, n *entity.Notification) error {
+	if mock.AddNotificationFunc == nil {
+		panic("NotificationRepositoryMock.AddNotificationFunc: method is nil but NotificationRepository.AddNotification was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Execer
+		N   *entity.Notification
+	}{
+		Ctx: ctx,
+		Db:  db,
+		N:   n,
+	}
+	mock.lockAddNotification.Lock()
+	mock.calls.AddNotification = append(mock.calls.AddNotification, callInfo)
+	mock.lockAddNotification.Unlock()
+	return mock.AddNotificationFunc(ctx, db, n)
+}
+
+// AddNotificationCalls gets all the calls that were made to AddNotification.
+// Check the length with:
+//
+//	len(mockedNotificationRepository.AddNotificationCalls())
+func (mock *NotificationRepositoryMock) AddNotificationCalls() []struct {
+	Ctx context.Context
+	Db  store.Execer
+	N   *entity.Notification
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Execer
+		N   *entity.Notification
+	}
+	mock.lockAddNotification.RLock()
+	calls = mock.calls.AddNotification
+	mock.lockAddNotification.RUnlock()
+	return calls
+}
+
+// CountUnreadNotifications calls CountUnreadNotificationsFunc.
+func (mock *NotificationRepositoryMock) CountUnreadNotifications(ctx context.Context, db store.Queryer, uid entity.UserID) (int, error) {
+	if mock.CountUnreadNotificationsFunc == nil {
+		panic("NotificationRepositoryMock.CountUnreadNotificationsFunc: method is nil but NotificationRepository.CountUnreadNotifications was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		UID: uid,
+	}
+	mock.lockCountUnreadNotifications.Lock()
+	mock.calls.CountUnreadNotifications = append(mock.calls.CountUnreadNotifications, callInfo)
+	mock.lockCountUnreadNotifications.Unlock()
+	return mock.CountUnreadNotificationsFunc(ctx, db, uid)
+}
+
+// CountUnreadNotificationsCalls gets all the calls that were made to CountUnreadNotifications.
+// Check the length with:
+//
+//	len(mockedNotificationRepository.CountUnreadNotificationsCalls())
+func (mock *NotificationRepositoryMock) CountUnreadNotificationsCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	UID entity.UserID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+	}
+	mock.lockCountUnreadNotifications.RLock()
+	calls = mock.calls.CountUnreadNotifications
+	mock.lockCountUnreadNotifications.RUnlock()
+	return calls
+}
+
+// ListNotifications calls ListNotificationsFunc.
+func (mock *NotificationRepositoryMock) ListNotifications(ctx context.Context, db store.Queryer, uid entity.UserID, unreadOnly bool) (entity.Notifications, error) {
+	if mock.ListNotificationsFunc == nil {
+		panic("NotificationRepositoryMock.ListNotificationsFunc: method is nil but NotificationRepository.ListNotifications was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Db         store.Queryer
+		UID        entity.UserID
+		UnreadOnly bool
+	}{
+		Ctx:        ctx,
+		Db:         db,
+		UID:        uid,
+		UnreadOnly: unreadOnly,
+	}
+	mock.lockListNotifications.Lock()
+	mock.calls.ListNotifications = append(mock.calls.ListNotifications, callInfo)
+	mock.lockListNotifications.Unlock()
+	return mock.ListNotificationsFunc(ctx, db, uid, unreadOnly)
+}
+
+// ListNotificationsCalls gets all the calls that were made to ListNotifications.
+// Check the length with:
+//
+//	len(mockedNotificationRepository.ListNotificationsCalls())
+func (mock *NotificationRepositoryMock) ListNotificationsCalls() []struct {
+	Ctx        context.Context
+	Db         store.Queryer
+	UID        entity.UserID
+	UnreadOnly bool
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Db         store.Queryer
+		UID        entity.UserID
+		UnreadOnly bool
+	}
+	mock.lockListNotifications.RLock()
+	calls = mock.calls.ListNotifications
+	mock.lockListNotifications.RUnlock()
+	return calls
+}
+
+// MarkAllNotificationsRead calls MarkAllNotificationsReadFunc.
+func (mock *NotificationRepositoryMock) MarkAllNotificationsRead(ctx context.Context, db store.Execer, uid entity.UserID) (int64, error) {
+	if mock.MarkAllNotificationsReadFunc == nil {
+		panic("NotificationRepositoryMock.MarkAllNotificationsReadFunc: method is nil but NotificationRepository.MarkAllNotificationsRead was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Execer
+		UID entity.UserID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		UID: uid,
+	}
+	mock.lockMarkAllNotificationsRead.Lock()
+	mock.calls.MarkAllNotificationsRead = append(mock.calls.MarkAllNotificationsRead, callInfo)
+	mock.lockMarkAllNotificationsRead.Unlock()
+	return mock.MarkAllNotificationsReadFunc(ctx, db, uid)
+}
+
+// MarkAllNotificationsReadCalls gets all the calls that were made to MarkAllNotificationsRead.
+// Check the length with:
+//
+//	len(mockedNotificationRepository.MarkAllNotificationsReadCalls())
+func (mock *NotificationRepositoryMock) MarkAllNotificationsReadCalls() []struct {
+	Ctx context.Context
+	Db  store.Execer
+	UID entity.UserID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Execer
+		UID entity.UserID
+	}
+	mock.lockMarkAllNotificationsRead.RLock()
+	calls = mock.calls.MarkAllNotificationsRead
+	mock.lockMarkAllNotificationsRead.RUnlock()
+	return calls
+}
+
+// MarkNotificationRead calls MarkNotificationReadFunc.
+func (mock *NotificationRepositoryMock) MarkNotificationRead(ctx context.Context, db store.ExecQueryer, uid entity.UserID, id entity.NotificationID) error {
+	if mock.MarkNotificationReadFunc == nil {
+		panic("NotificationRepositoryMock.MarkNotificationReadFunc: method is nil but NotificationRepository.MarkNotificationRead was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.ExecQueryer
+		UID entity.UserID
+		ID  entity.NotificationID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		UID: uid,
+		ID:  id,
+	}
+	mock.lockMarkNotificationRead.Lock()
+	mock.calls.MarkNotificationRead = append(mock.calls.MarkNotificationRead, callInfo)
+	mock.lockMarkNotificationRead.Unlock()
+	return mock.MarkNotificationReadFunc(ctx, db, uid, id)
+}
+
+// MarkNotificationReadCalls gets all the calls that were made to MarkNotificationRead.
+// Check the length with:
+//
+//	len(mockedNotificationRepository.MarkNotificationReadCalls())
+func (mock *NotificationRepositoryMock) MarkNotificationReadCalls() []struct {
+	Ctx context.Context
+	Db  store.ExecQueryer
+	UID entity.UserID
+	ID  entity.NotificationID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.ExecQueryer
+		UID entity.UserID
+		ID  entity.NotificationID
+	}
+	mock.lockMarkNotificationRead.RLock()
+	calls = mock.calls.MarkNotificationRead
+	mock.lockMarkNotificationRead.RUnlock()
+	return calls
+}
+
+// Ensure, that OverdueRepositoryMock does implement OverdueRepository.
+// If this is not the case, regenerate this file with moq.
+var _ OverdueRepository = &OverdueRepositoryMock{}
+
+// OverdueRepositoryMock is a mock implementation of OverdueRepository.
+//
+//	func TestSomethingThatUsesOverdueRepository(t *testing.T) {
+//
+//		// make and configure a mocked OverdueRepository
+//		mockedOverdueRepository := &OverdueRepositoryMock{
+//			AddNotificationFunc: func(ctx context.Context, db store.Execer, n *entity.Notification) error {
+//				panic("mock out the AddNotification method")
+//			},
+//			ListOverdueTasksFunc: func(ctx context.Context, db store.Queryer, now time.Time) (entity.Tasks, error) {
+//				panic("mock out the ListOverdueTasks method")
+//			},
+//		}
+//
+//		// use mockedOverdueRepository in code that requires OverdueRepository
+//		// and then make assertions.
+//
+//	}
+type OverdueRepositoryMock struct {
+	// AddNotificationFunc mocks the AddNotification method.
+	AddNotificationFunc func(ctx context.Context, db store.Execer, n *entity.Notification) error
+
+	// ListOverdueTasksFunc mocks the ListOverdueTasks method.
+	ListOverdueTasksFunc func(ctx context.Context, db store.Queryer, now time.Time) (entity.Tasks, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AddNotification holds details about calls to the AddNotification method.
+		AddNotification []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Execer
+			// N is the n argument value.
+			N *entity.Notification
+		}
+		// ListOverdueTasks holds details about calls to the ListOverdueTasks method.
+		ListOverdueTasks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// Now is the now argument value.
+			Now time.Time
+		}
+	}
+	lockAddNotification  sync.RWMutex
+	lockListOverdueTasks sync.RWMutex
+}
+
+// AddNotification calls AddNotificationFunc.
+func (mock *OverdueRepositoryMock) AddNotification(ctx context.Context, db store.Execer, n *entity.Notification) error {
+	if mock.AddNotificationFunc == nil {
+		panic("OverdueRepositoryMock.AddNotificationFunc: method is nil but OverdueRepository.AddNotification was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Execer
+		N   *entity.Notification
+	}{
+		Ctx: ctx,
+		Db:  db,
+		N:   n,
+	}
+	mock.lockAddNotification.Lock()
+	mock.calls.AddNotification = append(mock.calls.AddNotification, callInfo)
+	mock.lockAddNotification.Unlock()
+	return mock.AddNotificationFunc(ctx, db, n)
+}
+
+// AddNotificationCalls gets all the calls that were made to AddNotification.
+// Check the length with:
+//
+//	len(mockedOverdueRepository.AddNotificationCalls())
+func (mock *OverdueRepositoryMock) AddNotificationCalls() []struct {
+	Ctx context.Context
+	Db  store.Execer
+	N   *entity.Notification
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Execer
+		N   *entity.Notification
+	}
+	mock.lockAddNotification.RLock()
+	calls = mock.calls.AddNotification
+	mock.lockAddNotification.RUnlock()
+	return calls
+}
+
+// ListOverdueTasks calls ListOverdueTasksFunc.
+func (mock *OverdueRepositoryMock) ListOverdueTasks(ctx context.Context, db store.Queryer, now time.Time) (entity.Tasks, error) {
+	if mock.ListOverdueTasksFunc == nil {
+		panic("OverdueRepositoryMock.ListOverdueTasksFunc: method is nil but OverdueRepository.ListOverdueTasks was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		Now time.Time
+	}{
+		Ctx: ctx,
+		Db:  db,
+		Now: now,
+	}
+	mock.lockListOverdueTasks.Lock()
+	mock.calls.ListOverdueTasks = append(mock.calls.ListOverdueTasks, callInfo)
+	mock.lockListOverdueTasks.Unlock()
+	return mock.ListOverdueTasksFunc(ctx, db, now)
+}
+
+// ListOverdueTasksCalls gets all the calls that were made to ListOverdueTasks.
+// Check the length with:
+//
+//	len(mockedOverdueRepository.ListOverdueTasksCalls())
+func (mock *OverdueRepositoryMock) ListOverdueTasksCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	Now time.Time
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		Now time.Time
+	}
+	mock.lockListOverdueTasks.RLock()
+	calls = mock.calls.ListOverdueTasks
+	mock.lockListOverdueTasks.RUnlock()
+	return calls
+}
+
+// Ensure, that EventPublisherMock does implement EventPublisher.
+// If this is not the case, regenerate this file with moq.
+var _ EventPublisher = &EventPublisherMock{}
+
+// EventPublisherMock is a mock implementation of EventPublisher.
+//
+//	func TestSomethingThatUsesEventPublisher(t *testing.T) {
+//
+//		// make and configure a mocked EventPublisher
+//		mockedEventPublisher := &EventPublisherMock{
+//			PublishFunc: func(ctx context.Context, e *entity.TaskEvent) error {
+//				panic("mock out the Publish method")
+//			},
+//		}
+//
+//		// use mockedEventPublisher in code that requires EventPublisher
+//		// and then make assertions.
+//
+//	}
+type EventPublisherMock struct {
+	// PublishFunc mocks the Publish method.
+	PublishFunc func(ctx context.Context, e *entity.TaskEvent) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Publish holds details about calls to the Publish method.
+		Publish []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// E is the e argument value.
+			E *entity.TaskEvent
+		}
+	}
+	lockPublish sync.RWMutex
+}
+
+// Publish calls PublishFunc.
+func (mock *EventPublisherMock) Publish(ctx context.Context, e *entity.TaskEvent) error {
+	if mock.PublishFunc == nil {
+		panic("EventPublisherMock.PublishFunc: method is nil but EventPublisher.Publish was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		E   *entity.TaskEvent
+	}{
+		Ctx: ctx,
+		E:   e,
+	}
+	mock.lockPublish.Lock()
+	mock.calls.Publish = append(mock.calls.Publish, callInfo)
+	mock.lockPublish.Unlock()
+	return mock.PublishFunc(ctx, e)
+}
+
+// PublishCalls gets all the calls that were made to Publish.
+// Check the length with:
+//
+//	len(mockedEventPublisher.PublishCalls())
+func (mock *EventPublisherMock) PublishCalls() []struct {
+	Ctx context.Context
+	E   *entity.TaskEvent
+} {
+	var calls []struct {
+		Ctx context.Context
+		E   *entity.TaskEvent
+	}
+	mock.lockPublish.RLock()
+	calls = mock.calls.Publish
+	mock.lockPublish.RUnlock()
+	return calls
+}
+
+// Ensure, that WebhookRepositoryMock does implement WebhookRepository.
+// If this is not the case, regenerate this file with moq.
+var _ WebhookRepository = &WebhookRepositoryMock{}
+
+// WebhookRepositoryMock is a mock implementation of WebhookRepository.
+//
+//	func TestSomethingThatUsesWebhookRepository(t *testing.T) {
+//
+//		// make and configure a mocked WebhookRepository
+//		mockedWebhookRepository := &WebhookRepositoryMock{
+//			AddWebhookFunc: func(ctx context.Context, db store.Execer, w *entity.Webhook) error {
+//				panic("mock out the AddWebhook method")
+//			},
+//			DeleteWebhookFunc: func(ctx context.Context, db store.Execer, uid entity.UserID, id entity.WebhookID) error {
+//				panic("mock out the DeleteWebhook method")
+//			},
+//			GetWebhookFunc: func(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.WebhookID) (*entity.Webhook, error) {
+//				panic("mock out the GetWebhook method")
+//			},
+//			ListWebhookDeliveriesFunc: func(ctx context.Context, db store.Queryer, id entity.WebhookID, limit int) (entity.WebhookDeliveries, error) {
+//				panic("mock out the ListWebhookDeliveries method")
+//			},
+//			ListWebhooksFunc: func(ctx context.Context, db store.Queryer, uid entity.UserID) (entity.Webhooks, error) {
+//				panic("mock out the ListWebhooks method")
+//			},
+//			UpdateWebhookStatusFunc: func(ctx context.Context, db store.Execer, w *entity.Webhook) error {
+//				panic("mock out the UpdateWebhookStatus method")
+//			},
+//		}
+//
+//		// use mockedWebhookRepository in code that requires WebhookRepository
+//		// and then make assertions.
+//
+//	}
+type WebhookRepositoryMock struct {
+	// AddWebhookFunc mocks the AddWebhook method.
+	AddWebhookFunc func(ctx context.Context, db store.Execer, w *entity.Webhook) error
+
+	// DeleteWebhookFunc mocks the DeleteWebhook method.
+	DeleteWebhookFunc func(ctx context.Context, db store.Execer, uid entity.UserID, id entity.WebhookID) error
+
+	// GetWebhookFunc mocks the GetWebhook method.
+	GetWebhookFunc func(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.WebhookID) (*entity.Webhook, error)
+
+	// ListWebhookDeliveriesFunc mocks the ListWebhookDeliveries method.
+	ListWebhookDeliveriesFunc func(ctx context.Context, db store.Queryer, id entity.WebhookID, limit int) (entity.WebhookDeliveries, error)
+
+	// ListWebhooksFunc mocks the ListWebhooks method.
+	ListWebhooksFunc func(ctx context.Context, db store.Queryer, uid entity.UserID) (entity.Webhooks, error)
+
+	// UpdateWebhookStatusFunc mocks the UpdateWebhookStatus method.
+	UpdateWebhookStatusFunc func(ctx context.Context, db store.Execer, w *entity.Webhook) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AddWebhook holds details about calls to the AddWebhook method.
+		AddWebhook []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Execer
+			// W is the w argument value.
+			W *entity.Webhook
+		}
+		// DeleteWebhook holds details about calls to the DeleteWebhook method.
+		DeleteWebhook []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Execer
+			// UID is the uid argument value.
+			UID entity.UserID
+			// ID is the id argument value.
+			ID entity.WebhookID
+		}
+		// GetWebhook holds details about calls to the GetWebhook method.
+		GetWebhook []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// UID is the uid argument value.
+			UID entity.UserID
+			// ID is the id argument value.
+			ID entity.WebhookID
+		}
+		// ListWebhookDeliveries holds details about calls to the ListWebhookDeliveries method.
+		ListWebhookDeliveries []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// ID is the id argument value.
+			ID entity.WebhookID
+			// Limit is the limit argument value.
+			Limit int
+		}
+		// ListWebhooks holds details about calls to the ListWebhooks method.
+		ListWebhooks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// UID is the uid argument value.
+			UID entity.UserID
+		}
+		// UpdateWebhookStatus holds details about calls to the UpdateWebhookStatus method.
+		UpdateWebhookStatus []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Execer
+			// W is the w argument value.
+			W *entity.Webhook
+		}
+	}
+	lockAddWebhook            sync.RWMutex
+	lockDeleteWebhook         sync.RWMutex
+	lockGetWebhook            sync.RWMutex
+	lockListWebhookDeliveries sync.RWMutex
+	lockListWebhooks          sync.RWMutex
+	lockUpdateWebhookStatus   sync.RWMutex
+}
+
+// AddWebhook calls AddWebhookFunc.
+func (mock *WebhookRepositoryMock) AddWebhook(ctx context.Context, db store.Execer, w *entity.Webhook) error {
+	if mock.AddWebhookFunc == nil {
+		panic("WebhookRepositoryMock.AddWebhookFunc: method is nil but WebhookRepository.AddWebhook was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Execer
+		W   *entity.Webhook
+	}{
+		Ctx: ctx,
+		Db:  db,
+		W:   w,
+	}
+	mock.lockAddWebhook.Lock()
+	mock.calls.AddWebhook = append(mock.calls.AddWebhook, callInfo)
+	mock.lockAddWebhook.Unlock()
+	return mock.AddWebhookFunc(ctx, db, w)
+}
+
+// AddWebhookCalls gets all the calls that were made to AddWebhook.
+// Check the length with:
+//
+//	len(mockedWebhookRepository.AddWebhookCalls())
+func (mock *WebhookRepositoryMock) AddWebhookCalls() []struct {
+	Ctx context.Context
+	Db  store.Execer
+	W   *entity.Webhook
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Execer
+		W   *entity.Webhook
+	}
+	mock.lockAddWebhook.RLock()
+	calls = mock.calls.AddWebhook
+	mock.lockAddWebhook.RUnlock()
+	return calls
+}
+
+// DeleteWebhook calls DeleteWebhookFunc.
+func (mock *WebhookRepositoryMock) DeleteWebhook(ctx context.Context, db store.Execer, uid entity.UserID, id entity.WebhookID) error {
+	if mock.DeleteWebhookFunc == nil {
+		panic("WebhookRepositoryMock.DeleteWebhookFunc: method is nil but WebhookRepository.DeleteWebhook was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Execer
+		UID entity.UserID
+		ID  entity.WebhookID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		UID: uid,
+		ID:  id,
+	}
+	mock.lockDeleteWebhook.Lock()
+	mock.calls.DeleteWebhook = append(mock.calls.DeleteWebhook, callInfo)
+	mock.lockDeleteWebhook.Unlock()
+	return mock.DeleteWebhookFunc(ctx, db, uid, id)
+}
+
+// DeleteWebhookCalls gets all the calls that were made to DeleteWebhook.
+// Check the length with:
+//
+//	len(mockedWebhookRepository.DeleteWebhookCalls())
+func (mock *WebhookRepositoryMock) DeleteWebhookCalls() []struct {
+	Ctx context.Context
+	Db  store.Execer
+	UID entity.UserID
+	ID  entity.WebhookID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Execer
+		UID entity.UserID
+		ID  entity.WebhookID
+	}
+	mock.lockDeleteWebhook.RLock()
+	calls = mock.calls.DeleteWebhook
+	mock.lockDeleteWebhook.RUnlock()
+	return calls
+}
+
+// GetWebhook calls GetWebhookFunc.
+func (mock *WebhookRepositoryMock) GetWebhook(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.WebhookID) (*entity.Webhook, error) {
+	if mock.GetWebhookFunc == nil {
+		panic("WebhookRepositoryMock.GetWebhookFunc: method is nil but WebhookRepository.GetWebhook was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+		ID  entity.WebhookID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		UID: uid,
+		ID:  id,
+	}
+	mock.lockGetWebhook.Lock()
+	mock.calls.GetWebhook = append(mock.calls.GetWebhook, callInfo)
+	mock.lockGetWebhook.Unlock()
+	return mock.GetWebhookFunc(ctx, db, uid, id)
+}
+
+// GetWebhookCalls gets all the calls that were made to GetWebhook.
+// Check the length with:
+//
+//	len(mockedWebhookRepository.GetWebhookCalls())
+func (mock *WebhookRepositoryMock) GetWebhookCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	UID entity.UserID
+	ID  entity.WebhookID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+		ID  entity.WebhookID
+	}
+	mock.lockGetWebhook.RLock()
+	calls = mock.calls.GetWebhook
+	mock.lockGetWebhook.RUnlock()
+	return calls
+}
+
+// ListWebhookDeliveries calls ListWebhookDeliveriesFunc.
+func (mock *WebhookRepositoryMock) ListWebhookDeliveries(ctx context.Context, db store.Queryer, id entity.WebhookID, limit int) (entity.WebhookDeliveries, error) {
+	if mock.ListWebhookDeliveriesFunc == nil {
+		panic("WebhookRepositoryMock.ListWebhookDeliveriesFunc: method is nil but WebhookRepository.ListWebhookDeliveries was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Db    store.Queryer
+		ID    entity.WebhookID
+		Limit int
+	}{
+		Ctx:   ctx,
+		Db:    db,
+		ID:    id,
+		Limit: limit,
+	}
+	mock.lockListWebhookDeliveries.Lock()
+	mock.calls.ListWebhookDeliveries = append(mock.calls.ListWebhookDeliveries, callInfo)
+	mock.lockListWebhookDeliveries.Unlock()
+	return mock.ListWebhookDeliveriesFunc(ctx, db, id, limit)
+}
+
+// ListWebhookDeliveriesCalls gets all the calls that were made to ListWebhookDeliveries.
+// Check the length with:
+//
+//	len(mockedWebhookRepository.ListWebhookDeliveriesCalls())
+func (mock *WebhookRepositoryMock) ListWebhookDeliveriesCalls() []struct {
+	Ctx   context.Context
+	Db    store.Queryer
+	ID    entity.WebhookID
+	Limit int
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Db    store.Queryer
+		ID    entity.WebhookID
+		Limit int
+	}
+	mock.lockListWebhookDeliveries.RLock()
+	calls = mock.calls.ListWebhookDeliveries
+	mock.lockListWebhookDeliveries.RUnlock()
+	return calls
+}
+
+// ListWebhooks calls ListWebhooksFunc.
+func (mock *WebhookRepositoryMock) ListWebhooks(ctx context.Context, db store.Queryer, uid entity.UserID) (entity.Webhooks, error) {
+	if mock.ListWebhooksFunc == nil {
+		panic("WebhookRepositoryMock.ListWebhooksFunc: method is nil but WebhookRepository.ListWebhooks was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		UID: uid,
+	}
+	mock.lockListWebhooks.Lock()
+	mock.calls.ListWebhooks = append(mock.calls.ListWebhooks, callInfo)
+	mock.lockListWebhooks.Unlock()
+	return mock.ListWebhooksFunc(ctx, db, uid)
+}
+
+// ListWebhooksCalls gets all the calls that were made to ListWebhooks.
+// Check the length with:
+//
+//	len(mockedWebhookRepository.ListWebhooksCalls())
+func (mock *WebhookRepositoryMock) ListWebhooksCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	UID entity.UserID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+	}
+	mock.lockListWebhooks.RLock()
+	calls = mock.calls.ListWebhooks
+	mock.lockListWebhooks.RUnlock()
+	return calls
+}
+
+// UpdateWebhookStatus calls UpdateWebhookStatusFunc.
+func (mock *WebhookRepositoryMock) UpdateWebhookStatus(ctx context.Context, db store.Execer, w *entity.Webhook) error {
+	if mock.UpdateWebhookStatusFunc == nil {
+		panic("WebhookRepositoryMock.UpdateWebhookStatusFunc: method is nil but WebhookRepository.UpdateWebhookStatus was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Execer
+		W   *entity.Webhook
+	}{
+		Ctx: ctx,
+		Db:  db,
+		W:   w,
+	}
+	mock.lockUpdateWebhookStatus.Lock()
+	mock.calls.UpdateWebhookStatus = append(mock.calls.UpdateWebhookStatus, callInfo)
+	mock.lockUpdateWebhookStatus.Unlock()
+	return mock.UpdateWebhookStatusFunc(ctx, db, w)
+}
+
+// UpdateWebhookStatusCalls gets all the calls that were made to UpdateWebhookStatus.
+// Check the length with:
+//
+//	len(mockedWebhookRepository.UpdateWebhookStatusCalls())
+func (mock *WebhookRepositoryMock) UpdateWebhookStatusCalls() []struct {
+	Ctx context.Context
+	Db  store.Execer
+	W   *entity.Webhook
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Execer
+		W   *entity.Webhook
+	}
+	mock.lockUpdateWebhookStatus.RLock()
+	calls = mock.calls.UpdateWebhookStatus
+	mock.lockUpdateWebhookStatus.RUnlock()
+	return calls
+}
+
+// Ensure, that PresenceRepositoryMock does implement PresenceRepository.
+// If this is not the case, regenerate this file with moq.
+var _ PresenceRepository = &PresenceRepositoryMock{}
+
+// PresenceRepositoryMock is a mock implementation of PresenceRepository.
+//
+//	func TestSomethingThatUsesPresenceRepository(t *testing.T) {
+//
+//		// make and configure a mocked PresenceRepository
+//		mockedPresenceRepository := &PresenceRepositoryMock{
+//			IsProjectMemberFunc: func(ctx context.Context, db store.Queryer, id entity.ProjectID, uid entity.UserID) (bool, error) {
+//				panic("mock out the IsProjectMember method")
+//			},
+//		}
+//
+//		// use mockedPresenceRepository in code that requires PresenceRepository
+//		// and then make assertions.
+//
+//	}
+type PresenceRepositoryMock struct {
+	// IsProjectMemberFunc mocks the IsProjectMember method.
+	IsProjectMemberFunc func(ctx context.Context, db store.Queryer, id entity.ProjectID, uid entity.UserID) (bool, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// IsProjectMember holds details about calls to the IsProjectMember method.
+		IsProjectMember []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// ID is the id argument value.
+			ID entity.ProjectID
+			// UID is the uid argument value.
+			UID entity.UserID
+		}
+	}
+	lockIsProjectMember sync.RWMutex
+}
+
+// IsProjectMember calls IsProjectMemberFunc.
+func (mock *PresenceRepositoryMock) IsProjectMember(ctx context.Context, db store.Queryer, id entity.ProjectID, uid entity.UserID) (bool, error) {
+	if mock.IsProjectMemberFunc == nil {
+		panic("PresenceRepositoryMock.IsProjectMemberFunc: method is nil but PresenceRepository.IsProjectMember was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.ProjectID
+		UID entity.UserID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		ID:  id,
+		UID: uid,
+	}
+	mock.lockIsProjectMember.Lock()
+	mock.calls.IsProjectMember = append(mock.calls.IsProjectMember, callInfo)
+	mock.lockIsProjectMember.Unlock()
+	return mock.IsProjectMemberFunc(ctx, db, id, uid)
+}
+
+// IsProjectMemberCalls gets all the calls that were made to IsProjectMember.
+// Check the length with:
+//
+//	len(mockedPresenceRepository.IsProjectMemberCalls())
+func (mock *PresenceRepositoryMock) IsProjectMemberCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	ID  entity.ProjectID
+	UID entity.UserID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.ProjectID
+		UID entity.UserID
+	}
+	mock.lockIsProjectMember.RLock()
+	calls = mock.calls.IsProjectMember
+	mock.lockIsProjectMember.RUnlock()
+	return calls
+}
+
+// Ensure, that PresenceStoreMock does implement PresenceStore.
+// If this is not the case, regenerate this file with moq.
+var _ PresenceStore = &PresenceStoreMock{}
+
+// PresenceStoreMock is a mock implementation of PresenceStore.
+//
+//	func TestSomethingThatUsesPresenceStore(t *testing.T) {
+//
+//		// make and configure a mocked PresenceStore
+//		mockedPresenceStore := &PresenceStoreMock{
+//			ListPresenceFunc: func(ctx context.Context, pid entity.ProjectID, now time.Time) ([]entity.UserID, error) {
+//				panic("mock out the ListPresence method")
+//			},
+//			RemovePresenceFunc: func(ctx context.Context, pid entity.ProjectID, uid entity.UserID, session string) error {
+//				panic("mock out the RemovePresence method")
+//			},
+//			TouchPresenceFunc: func(ctx context.Context, pid entity.ProjectID, uid entity.UserID, session string, expires time.Time) error {
+//				panic("mock out the TouchPresence method")
+//			},
+//		}
+//
+//		// use mockedPresenceStore in code that requires PresenceStore
+//		// and then make assertions.
+//
+//	}
+type PresenceStoreMock struct {
+	// ListPresenceFunc mocks the ListPresence method.
+	ListPresenceFunc func(ctx context.Context, pid entity.ProjectID, now time.Time) ([]entity.UserID, error)
+
+	// RemovePresenceFunc mocks the RemovePresence method.
+	RemovePresenceFunc func(ctx context.Context, pid entity.ProjectID, uid entity.UserID, session string) error
+
+	// TouchPresenceFunc mocks the TouchPresence method.
+	TouchPresenceFunc func(ctx context.Context, pid entity.ProjectID, uid entity.UserID, session string, expires time.Time) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ListPresence holds details about calls to the ListPresence method.
+		ListPresence []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Pid is the pid argument value.
+			Pid entity.ProjectID
+			// Now is the now argument value.
+			Now time.Time
+		}
+		// RemovePresence holds details about calls to the RemovePresence method.
+		RemovePresence []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Pid is the pid argument value.
+			Pid entity.ProjectID
+			// UID is the uid argument value.
+			UID entity.UserID
+			// Session is the session argument value.
+			Session string
+		}
+		// TouchPresence holds details about calls to the TouchPresence method.
+		TouchPresence []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Pid is the pid argument value.
+			Pid entity.ProjectID
+			// UID is the uid argument value.
+			UID entity.UserID
+			// Session is the session argument value.
+			Session string
+			// Expires is the expires argument value.
+			Expires time.Time
+		}
+	}
+	lockListPresence   sync.RWMutex
+	lockRemovePresence sync.RWMutex
+	lockTouchPresence  sync.RWMutex
+}
+
+// ListPresence calls ListPresenceFunc.
+func (mock *PresenceStoreMock) ListPresence(ctx context.Context, pid entity.ProjectID, now time.Time) ([]entity.UserID, error) {
+	if mock.ListPresenceFunc == nil {
+		panic("PresenceStoreMock.ListPresenceFunc: method is nil but PresenceStore.ListPresence was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Pid entity.ProjectID
+		Now time.Time
+	}{
+		Ctx: ctx,
+		Pid: pid,
+		Now: now,
+	}
+	mock.lockListPresence.Lock()
+	mock.calls.ListPresence = append(mock.calls.ListPresence, callInfo)
+	mock.lockListPresence.Unlock()
+	return mock.ListPresenceFunc(ctx, pid, now)
+}
+
+// ListPresenceCalls gets all the calls that were made to ListPresence.
+// Check the length with:
+//
+//	len(mockedPresenceStore.ListPresenceCalls())
+func (mock *PresenceStoreMock) ListPresenceCalls() []struct {
+	Ctx context.Context
+	Pid entity.ProjectID
+	Now time.Time
+} {
+	var calls []struct {
+		Ctx context.Context
+		Pid entity.ProjectID
+		Now time.Time
+	}
+	mock.lockListPresence.RLock()
+	calls = mock.calls.ListPresence
+	mock.lockListPresence.RUnlock()
+	return calls
+}
+
+// RemovePresence calls RemovePresenceFunc.
+func (mock *PresenceStoreMock) RemovePresence(ctx context.Context, pid entity.ProjectID, uid entity.UserID, session string) error {
+	if mock.RemovePresenceFunc == nil {
+		panic("PresenceStoreMock.RemovePresenceFunc: method is nil but PresenceStore.RemovePresence was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Pid     entity.ProjectID
+		UID     entity.UserID
+		Session string
+	}{
+		Ctx:     ctx,
+		Pid:     pid,
+		UID:     uid,
+		Session: session,
+	}
+	mock.lockRemovePresence.Lock()
+	mock.calls.RemovePresence = append(mock.calls.RemovePresence, callInfo)
+	mock.lockRemovePresence.Unlock()
+	return mock.RemovePresenceFunc(ctx, pid, uid, session)
+}
+
+// RemovePresenceCalls gets all the calls that were made to RemovePresence.
+// Check the length with:
+//
+//	len(mockedPresenceStore.RemovePresenceCalls())
+func (mock *PresenceStoreMock) RemovePresenceCalls() []struct {
+	Ctx     context.Context
+	Pid     entity.ProjectID
+	UID     entity.UserID
+	Session string
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Pid     entity.ProjectID
+		UID     entity.UserID
+		Session string
+	}
+	mock.lockRemovePresence.RLock()
+	calls = mock.calls.RemovePresence
+	mock.lockRemovePresence.RUnlock()
+	return calls
+}
+
+// TouchPresence calls TouchPresenceFunc.
+func (mock *PresenceStoreMock) TouchPresence(ctx context.Context, pid entity.ProjectID, uid entity.UserID, session string, expires time.Time) error {
+	if mock.TouchPresenceFunc == nil {
+		panic("PresenceStoreMock.TouchPresenceFunc: method is nil but PresenceStore.TouchPresence was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Pid     entity.ProjectID
+		UID     entity.UserID
+		Session string
+		Expires time.Time
+	}{
+		Ctx:     ctx,
+		Pid:     pid,
+		UID:     uid,
+		Session: session,
+		Expires: expires,
+	}
+	mock.lockTouchPresence.Lock()
+	mock.calls.TouchPresence = append(mock.calls.TouchPresence, callInfo)
+	mock.lockTouchPresence.Unlock()
+	return mock.TouchPresenceFunc(ctx, pid, uid, session, expires)
+}
+
+// TouchPresenceCalls gets all the calls that were made to TouchPresence.
+// Check the length with:
+//
+//	len(mockedPresenceStore.TouchPresenceCalls())
+func (mock *PresenceStoreMock) TouchPresenceCalls() []struct {
+	Ctx     context.Context
+	Pid     entity.ProjectID
+	UID     entity.UserID
+	Session string
+	Expires time.Time
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Pid     entity.ProjectID
+		UID     entity.UserID
+		Session string
+		Expires time.Time
+	}
+	mock.lockTouchPresence.RLock()
+	calls = mock.calls.TouchPresence
+	mock.lockTouchPresence.RUnlock()
+	return calls
+}
+
+// Ensure, that MailerMock does implement Mailer.
+// If this is not the case, regenerate this file with moq.
+var _ Mailer = &MailerMock{}
+
+// MailerMock is a mock implementation of Mailer.
+//
+//	func TestSomethingThatUsesMailer(t *testing.T) {
+//
+//		// make and configure a mocked Mailer
+//		mockedMailer := &MailerMock{
+//			SendFunc: func(ctx context.Context, m *mail.Message) error {
+//				panic("mock out the Send method")
+//			},
+//		}
+//
+//		// use mockedMailer in code that requires Mailer
+//		// and then make assertions.
+//
+//	}
+type MailerMock struct {
+	// SendFunc mocks the Send method.
+	SendFunc func(ctx context.Context, m *mail.Message) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Send holds details about calls to the Send method.
+		Send []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// M is the m argument value.
+			M *mail.Message
+		}
+	}
+	lockSend sync.RWMutex
+}
+
+// Send calls SendFunc.
+func (mock *MailerMock) Send(ctx context.Context, m *mail.Message) error {
+	if mock.SendFunc == nil {
+		panic("MailerMock.SendFunc: method is nil but Mailer.Send was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		M   *mail.Message
+	}{
+		Ctx: ctx,
+		M:   m,
+	}
+	mock.lockSend.Lock()
+	mock.calls.Send = append(mock.calls.Send, callInfo)
+	mock.lockSend.Unlock()
+	return mock.SendFunc(ctx, m)
+}
+
+// SendCalls gets all the calls that were made to Send.
+// Check the length with:
+//
+//	len(mockedMailer.SendCalls())
+func (mock *MailerMock) SendCalls() []struct {
+	Ctx context.Context
+	M   *mail.Message
+} {
+	var calls []struct {
+		Ctx context.Context
+		M   *mail.Message
+	}
+	mock.lockSend.RLock()
+	calls = mock.calls.Send
+	mock.lockSend.RUnlock()
+	return calls
+}
+
+// Ensure, that MailPreferenceRepositoryMock does implement MailPreferenceRepository.
+// If this is not the case, regenerate this file with moq.
+var _ MailPreferenceRepository = &MailPreferenceRepositoryMock{}
+
+// MailPreferenceRepositoryMock is a mock implementation of MailPreferenceRepository.
+//
+//	func TestSomethingThatUsesMailPreferenceRepository(t *testing.T) {
+//
+//		// make and configure a mocked MailPreferenceRepository
+//		mockedMailPreferenceRepository := &MailPreferenceRepositoryMock{
+//			GetUserByIDFunc: func(ctx context.Context, db store.Queryer, id entity.UserID) (*entity.User, error) {
+//				panic("mock out the GetUserByID method")
+//			},
+//			ListMailOptOutsFunc: func(ctx context.Context, db store.Queryer, uid entity.UserID) ([]entity.MailKind, error) {
+//				panic("mock out the ListMailOptOuts method")
+//			},
+//			SetMailOptOutFunc: func(ctx context.Context, db store.Execer, uid entity.UserID, kind entity.MailKind, optOut bool) error {
+//				panic("mock out the SetMailOptOut method")
+//			},
+//			UpdateUserEmailFunc: func(ctx context.Context, db store.Execer, id entity.UserID, email *string) error {
+//				panic("mock out the UpdateUserEmail method")
+//			},
+//		}
+//
+//		// use mockedMailPreferenceRepository in code that requires MailPreferenceRepository
+//		// and then make assertions.
+//
+//	}
+type MailPreferenceRepositoryMock struct {
+	// GetUserByIDFunc mocks the GetUserByID method.
+	GetUserByIDFunc func(ctx context.Context, db store.Queryer, id entity.UserID) (*entity.User, error)
+
+	// ListMailOptOutsFunc mocks the ListMailOptOuts method.
+	ListMailOptOutsFunc func(ctx context.Context, db store.Queryer, uid entity.UserID) ([]entity.MailKind, error)
+
+	// SetMailOptOutFunc mocks the SetMailOptOut method.
+	SetMailOptOutFunc func(ctx context.Context, db store.Execer, uid entity.UserID, kind entity.MailKind, optOut bool) error
+
+	// UpdateUserEmailFunc mocks the UpdateUserEmail method.
+	UpdateUserEmailFunc func(ctx context.Context, db store.Execer, id entity.UserID, email *string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetUserByID holds details about calls to the GetUserByID method.
+		GetUserByID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// ID is the id argument value.
+			ID entity.UserID
+		}
+		// ListMailOptOuts holds details about calls to the ListMailOptOuts method.
+		ListMailOptOuts []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// UID is the uid argument value.
+			UID entity.UserID
+		}
+		// SetMailOptOut holds details about calls to the SetMailOptOut method.
+		SetMailOptOut []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Execer
+			// UID is the uid argument value.
+			UID entity.UserID
+			// Kind is the kind argument value.
+			Kind entity.MailKind
+			// OptOut is the optOut argument value.
+			OptOut bool
+		}
+		// UpdateUserEmail holds details about calls to the UpdateUserEmail method.
+		UpdateUserEmail []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Execer
+			// ID is the id argument value.
+			ID entity.UserID
+			// Email is the email argument value.
+			Email *string
+		}
+	}
+	lockGetUserByID     sync.RWMutex
+	lockListMailOptOuts sync.RWMutex
+	lockSetMailOptOut   sync.RWMutex
+	lockUpdateUserEmail sync.RWMutex
+}
+
+// GetUserByID calls GetUserByIDFunc.
+func (mock *MailPreferenceRepositoryMock) GetUserByID(ctx context.Context, db store.Queryer, id entity.UserID) (*entity.User, error) {
+	if mock.GetUserByIDFunc == nil {
+		panic("MailPreferenceRepositoryMock.GetUserByIDFunc: method is nil but MailPreferenceRepository.GetUserByID was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.UserID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		ID:  id,
+	}
+	mock.lockGetUserByID.Lock()
+	mock.calls.GetUserByID = append(mock.calls.GetUserByID, callInfo)
+	mock.lockGetUserByID.Unlock()
+	return mock.GetUserByIDFunc(ctx, db, id)
+}
+
+// GetUserByIDCalls gets all the calls that were made to GetUserByID.
+// Check the length with:
+//
+//	len(mockedMailPreferenceRepository.GetUserByIDCalls())
+func (mock *MailPreferenceRepositoryMock) GetUserByIDCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	ID  entity.UserID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.UserID
+	}
+	mock.lockGetUserByID.RLock()
+	calls = mock.calls.GetUserByID
+	mock.lockGetUserByID.RUnlock()
+	return calls
+}
+
+// ListMailOptOuts calls ListMailOptOutsFunc.
+func (mock *MailPreferenceRepositoryMock) ListMailOptOuts(ctx context.Context, db store.Queryer, uid entity.UserID) ([]entity.MailKind, error) {
+	if mock.ListMailOptOutsFunc == nil {
+		panic("MailPreferenceRepositoryMock.ListMailOptOutsFunc: method is nil but MailPreferenceRepository.ListMailOptOuts was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		UID: uid,
+	}
+	mock.lockListMailOptOuts.Lock()
+	mock.calls.ListMailOptOuts = append(mock.calls.ListMailOptOuts, callInfo)
+	mock.lockListMailOptOuts.Unlock()
+	return mock.ListMailOptOutsFunc(ctx, db, uid)
+}
+
+// ListMailOptOutsCalls gets all the calls that were made to ListMailOptOuts.
+// Check the length with:
+//
+//	len(mockedMailPreferenceRepository.ListMailOptOutsCalls())
+func (mock *MailPreferenceRepositoryMock) ListMailOptOutsCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	UID entity.UserID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+	}
+	mock.lockListMailOptOuts.RLock()
+	calls = mock.calls.ListMailOptOuts
+	mock.lockListMailOptOuts.RUnlock()
+	return calls
+}
+
+// SetMailOptOut calls SetMailOptOutFunc.
+func (mock *MailPreferenceRepositoryMock) SetMailOptOut(ctx context.Context, db store.Execer, uid entity.UserID, kind entity.MailKind, optOut bool) error {
+	if mock.SetMailOptOutFunc == nil {
+		panic("MailPreferenceRepositoryMock.SetMailOptOutFunc: method is nil but MailPreferenceRepository.SetMailOptOut was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Db     store.Execer
+		UID    entity.UserID
+		Kind   entity.MailKind
+		OptOut bool
+	}{
+		Ctx:    ctx,
+		Db:     db,
+		UID:    uid,
+		Kind:   kind,
+		OptOut: optOut,
+	}
+	mock.lockSetMailOptOut.Lock()
+	mock.calls.SetMailOptOut = append(mock.calls.SetMailOptOut, callInfo)
+	mock.lockSetMailOptOut.Unlock()
+	return mock.SetMailOptOutFunc(ctx, db, uid, kind, optOut)
+}
+
+// SetMailOptOutCalls gets all the calls that were made to SetMailOptOut.
+// Check the length with:
+//
+//	len(mockedMailPreferenceRepository.SetMailOptOutCalls())
+func (mock *MailPreferenceRepositoryMock) SetMailOptOutCalls() []struct {
+	Ctx    context.Context
+	Db     store.Execer
+	UID    entity.UserID
+	Kind   entity.MailKind
+	OptOut bool
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Db     store.Execer
+		UID    entity.UserID
+		Kind   entity.MailKind
+		OptOut bool
+	}
+	mock.lockSetMailOptOut.RLock()
+	calls = mock.calls.SetMailOptOut
+	mock.lockSetMailOptOut.RUnlock()
+	return calls
+}
+
+// UpdateUserEmail calls UpdateUserEmailFunc.
+func (mock *MailPreferenceRepositoryMock) UpdateUserEmail(ctx context.Context, db store.Execer, id entity.UserID, email *string) error {
+	if mock.UpdateUserEmailFunc == nil {
+		panic("MailPreferenceRepositoryMock.UpdateUserEmailFunc: method is nil but MailPreferenceRepository.UpdateUserEmail was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Db    store.Execer
+		ID    entity.UserID
+		Email *string
+	}{
+		Ctx:   ctx,
+		Db:    db,
+		ID:    id,
+		Email: email,
+	}
+	mock.lockUpdateUserEmail.Lock()
+	mock.calls.UpdateUserEmail = append(mock.calls.UpdateUserEmail, callInfo)
+	mock.lockUpdateUserEmail.Unlock()
+	return mock.UpdateUserEmailFunc(ctx, db, id, email)
+}
+
+// UpdateUserEmailCalls gets all the calls that were made to UpdateUserEmail.
+// Check the length with:
+//
+//	len(mockedMailPreferenceRepository.UpdateUserEmailCalls())
+func (mock *MailPreferenceRepositoryMock) UpdateUserEmailCalls() []struct {
+	Ctx   context.Context
+	Db    store.Execer
+	ID    entity.UserID
+	Email *string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Db    store.Execer
+		ID    entity.UserID
+		Email *string
+	}
+	mock.lockUpdateUserEmail.RLock()
+	calls = mock.calls.UpdateUserEmail
+	mock.lockUpdateUserEmail.RUnlock()
+	return calls
+}
+
+// Ensure, that PasswordResetRepositoryMock does implement PasswordResetRepository.
+// If this is not the case, regenerate this file with moq.
+var _ PasswordResetRepository = &PasswordResetRepositoryMock{}
+
+// PasswordResetRepositoryMock is a mock implementation of PasswordResetRepository.
+//
+//	func TestSomethingThatUsesPasswordResetRepository(t *testing.T) {
+//
+//		// make and configure a mocked PasswordResetRepository
+//		mockedPasswordResetRepository := &PasswordResetRepositoryMock{
+//			GetUserFunc: func(ctx context.Context, db store.Queryer, name string) (*entity.User, error) {
+//				panic("mock out the GetUser method")
+//			},
+//			UpdateUserPasswordFunc: func(ctx context.Context, db store.Execer, id entity.UserID, password string) error {
+//				panic("mock out the UpdateUserPassword method")
+//			},
+//		}
+//
+//		// use mockedPasswordResetRepository in code that requires PasswordResetRepository
+//		// and then make assertions.
+//
+//	}
+type PasswordResetRepositoryMock struct {
+	// GetUserFunc mocks the GetUser method.
+	GetUserFunc func(ctx context.Context, db store.Queryer, name string) (*entity.User, error)
+
+	// UpdateUserPasswordFunc mocks the UpdateUserPassword method.
+	UpdateUserPasswordFunc func(ctx context.Context, db store.Execer, id entity.UserID, password string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetUser holds details about calls to the GetUser method.
+		GetUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// Name is the name argument value.
+			Name string
+		}
+		// UpdateUserPassword holds details about calls to the UpdateUserPassword method.
+		UpdateUserPassword []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Execer
+			// ID is the id argument value.
+			ID entity.UserID
+			// Password is the password argument value.
+			Password string
+		}
+	}
+	lockGetUser            sync.RWMutex
+	lockUpdateUserPassword sync.RWMutex
+}
+
+// GetUser calls GetUserFunc.
+func (mock *PasswordResetRepositoryMock) GetUser(ctx context.Context, db store.Queryer, name string) (*entity.User, error) {
+	if mock.GetUserFunc == nil {
+		panic("PasswordResetRepositoryMock.GetUserFunc: method is nil but PasswordResetRepository.GetUser was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Db   store.Queryer
+		Name string
+	}{
+		Ctx:  ctx,
+		Db:   db,
+		Name: name,
+	}
+	mock.lockGetUser.Lock()
+	mock.calls.GetUser = append(mock.calls.GetUser, callInfo)
+	mock.lockGetUser.Unlock()
+	return mock.GetUserFunc(ctx, db, name)
+}
+
+// GetUserCalls gets all the calls that were made to GetUser.
+// Check the length with:
+//
+//	len(mockedPasswordResetRepository.GetUserCalls())
+func (mock *PasswordResetRepositoryMock) GetUserCalls() []struct {
+	Ctx  context.Context
+	Db   store.Queryer
+	Name string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Db   store.Queryer
+		Name string
+	}
+	mock.lockGetUser.RLock()
+	calls = mock.calls.GetUser
+	mock.lockGetUser.RUnlock()
+	return calls
+}
+
+// UpdateUserPassword calls UpdateUserPasswordFunc.
+func (mock *PasswordResetRepositoryMock) UpdateUserPassword(ctx context.Context, db store.Execer, id entity.UserID, password string) error {
+	if mock.UpdateUserPasswordFunc == nil {
+		panic("PasswordResetRepositoryMock.UpdateUserPasswordFunc: method is nil but PasswordResetRepository.UpdateUserPassword was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Db       store.Execer
+		ID       entity.UserID
+		Password string
+	}{
+		Ctx:      ctx,
+		Db:       db,
+		ID:       id,
+		Password: password,
+	}
+	mock.lockUpdateUserPassword.Lock()
+	mock.calls.UpdateUserPassword = append(mock.calls.UpdateUserPassword, callInfo)
+	mock.lockUpdateUserPassword.Unlock()
+	return mock.UpdateUserPasswordFunc(ctx, db, id, password)
+}
+
+// UpdateUserPasswordCalls gets all the calls that were made to UpdateUserPassword.
+// Check the length with:
+//
+//	len(mockedPasswordResetRepository.UpdateUserPasswordCalls())
+func (mock *PasswordResetRepositoryMock) UpdateUserPasswordCalls() []struct {
+	Ctx      context.Context
+	Db       store.Execer
+	ID       entity.UserID
+	Password string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Db       store.Execer
+		ID       entity.UserID
+		Password string
+	}
+	mock.lockUpdateUserPassword.RLock()
+	calls = mock.calls.UpdateUserPassword
+	mock.lockUpdateUserPassword.RUnlock()
+	return calls
+}
+
+// Ensure, that PersonalAccessTokenRepositoryMock does implement PersonalAccessTokenRepository.
+// If this is not the case, regenerate this file with moq.
+var _ PersonalAccessTokenRepository = &PersonalAccessTokenRepositoryMock{}
+
+// PersonalAccessTokenRepositoryMock is a mock implementation of PersonalAccessTokenRepository.
+//
+//	func TestSomethingThatUsesPersonalAccessTokenRepository(t *testing.T) {
+//
+//		// make and configure a mocked PersonalAccessTokenRepository
+//		mockedPersonalAccessTokenRepository := &PersonalAccessTokenRepositoryMock{
+//			AddPersonalAccessTokenFunc: func(ctx context.Context, db store.Execer, t *entity.PersonalAccessToken) error {
+//				panic("mock out the AddPersonalAccessToken method")
+//			},
+//			DeletePersonalAccessTokenFunc: func(ctx context.Context, db store.Execer, uid entity.UserID, id entity.PersonalAccessTokenID) error {
+//				panic("mock out the DeletePersonalAccessToken method")
+//			},
+//			GetPersonalAccessTokenByHashFunc: func(ctx context.Context, db store.Queryer, hash string) (*entity.PersonalAccessToken, error) {
+//				panic("mock out the GetPersonalAccessTokenByHash method")
+//			},
+//			GetUserByIDFunc: func(ctx context.Context, db store.Queryer, id entity.UserID) (*entity.User, error) {
+//				panic("mock out the GetUserByID method")
+//			},
+//			ListPersonalAccessTokensFunc: func(ctx context.Context, db store.Queryer, uid entity.UserID) (entity.PersonalAccessTokens, error) {
+//				panic("mock out the ListPersonalAccessTokens method")
+//			},
+//			TouchPersonalAccessTokenFunc: func(ctx context.Context, db store.Execer, id entity.PersonalAccessTokenID, interval time.Duration) error {
+//				panic("mock out the TouchPersonalAccessToken method")
+//			},
+//		}
+//
+//		// use mockedPersonalAccessTokenRepository in code that requires PersonalAccessTokenRepository
+//		// and then make assertions.
+//
+//	}
+type PersonalAccessTokenRepositoryMock struct {
+	// AddPersonalAccessTokenFunc mocks the AddPersonalAccessToken method.
+	AddPersonalAccessTokenFunc func(ctx context.Context, db store.Execer, t *entity.PersonalAccessToken) error
+
+	// DeletePersonalAccessTokenFunc mocks the DeletePersonalAccessToken method.
+	DeletePersonalAccessTokenFunc func(ctx context.Context, db store.Execer, uid entity.UserID, id entity.PersonalAccessTokenID) error
+
+	// GetPersonalAccessTokenByHashFunc mocks the GetPersonalAccessTokenByHash method.
+	GetPersonalAccessTokenByHashFunc func(ctx context.Context, db store.Queryer, hash string) (*entity.PersonalAccessToken, error)
+
+	// GetUserByIDFunc mocks the GetUserByID method.
+	GetUserByIDFunc func(ctx context.Context, db store.Queryer, id entity.UserID) (*entity.User, error)
+
+	// ListPersonalAccessTokensFunc mocks the ListPersonalAccessTokens method.
+	ListPersonalAccessTokensFunc func(ctx context.Context, db store.Queryer, uid entity.UserID) (entity.PersonalAccessTokens, error)
+
+	// TouchPersonalAccessTokenFunc mocks the TouchPersonalAccessToken method.
+	TouchPersonalAccessTokenFunc func(ctx context.Context, db store.Execer, id entity.PersonalAccessTokenID, interval time.Duration) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AddPersonalAccessToken holds details about calls to the AddPersonalAccessToken method.
+		AddPersonalAccessToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Execer
+			// T is the t argument value.
+			T *entity.PersonalAccessToken
+		}
+		// DeletePersonalAccessToken holds details about calls to the DeletePersonalAccessToken method.
+		DeletePersonalAccessToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Execer
+			// UID is the uid argument value.
+			UID entity.UserID
+			// ID is the id argument value.
+			ID entity.PersonalAccessTokenID
+		}
+		// GetPersonalAccessTokenByHash holds details about calls to the GetPersonalAccessTokenByHash method.
+		GetPersonalAccessTokenByHash []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// Hash is the hash argument value.
+			Hash string
+		}
+		// GetUserByID holds details about calls to the GetUserByID method.
+		GetUserByID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// ID is the id argument value.
+			ID entity.UserID
+		}
+		// ListPersonalAccessTokens holds details about calls to the ListPersonalAccessTokens method.
+		ListPersonalAccessTokens []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// UID is the uid argument value.
+			UID entity.UserID
+		}
+		// TouchPersonalAccessToken holds details about calls to the TouchPersonalAccessToken method.
+		TouchPersonalAccessToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Execer
+			// ID is the id argument value.
+			ID entity.PersonalAccessTokenID
+			// Interval is the interval argument value.
+			Interval time.Duration
+		}
+	}
+	lockAddPersonalAccessToken       sync.RWMutex
+	lockDeletePersonalAccessToken    sync.RWMutex
+	lockGetPersonalAccessTokenByHash sync.RWMutex
+	lockGetUserByID                  sync.RWMutex
+	lockListPersonalAccessTokens     sync.RWMutex
+	lockTouchPersonalAccessToken     sync.RWMutex
+}
+
+// AddPersonalAccessToken calls AddPersonalAccessTokenFunc.
+func (mock *PersonalAccessTokenRepositoryMock) AddPersonalAccessToken(ctx context.Context, db store.Execer, t *entity.PersonalAccessToken) error {
+	if mock.AddPersonalAccessTokenFunc == nil {
+		panic("PersonalAccessTokenRepositoryMock.AddPersonalAccessTokenFunc: method is nil but PersonalAccessTokenRepository.AddPersonalAccessToken was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Execer
+		T   *entity.PersonalAccessToken
+	}{
+		Ctx: ctx,
+		Db:  db,
+		T:   t,
+	}
+	mock.lockAddPersonalAccessToken.Lock()
+	mock.calls.AddPersonalAccessToken = append(mock.calls.AddPersonalAccessToken, callInfo)
+	mock.lockAddPersonalAccessToken.Unlock()
+	return mock.AddPersonalAccessTokenFunc(ctx, db, t)
+}
+
+// AddPersonalAccessTokenCalls gets all the calls that were made to AddPersonalAccessToken.
+// Check the length with:
+//
+//	len(mockedPersonalAccessTokenRepository.AddPersonalAccessTokenCalls())
+func (mock *PersonalAccessTokenRepositoryMock) AddPersonalAccessTokenCalls() []struct {
+	Ctx context.Context
+	Db  store.Execer
+	T   *entity.PersonalAccessToken
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Execer
+		T   *entity.PersonalAccessToken
+	}
+	mock.lockAddPersonalAccessToken.RLock()
+	calls = mock.calls.AddPersonalAccessToken
+	mock.lockAddPersonalAccessToken.RUnlock()
+	return calls
+}
+
+// DeletePersonalAccessToken calls DeletePersonalAccessTokenFunc.
+func (mock *PersonalAccessTokenRepositoryMock) DeletePersonalAccessToken(ctx context.Context, db store.Execer, uid entity.UserID, id entity.PersonalAccessTokenID) error {
+	if mock.DeletePersonalAccessTokenFunc == nil {
+		panic("PersonalAccessTokenRepositoryMock.DeletePersonalAccessTokenFunc: method is nil but PersonalAccessTokenRepository.DeletePersonalAccessToken was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Execer
+		UID entity.UserID
+		ID  entity.PersonalAccessTokenID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		UID: uid,
+		ID:  id,
+	}
+	mock.lockDeletePersonalAccessToken.Lock()
+	mock.calls.DeletePersonalAccessToken = append(mock.calls.DeletePersonalAccessToken, callInfo)
+	mock.lockDeletePersonalAccessToken.Unlock()
+	return mock.DeletePersonalAccessTokenFunc(ctx, db, uid, id)
+}
+
+// DeletePersonalAccessTokenCalls gets all the calls that were made to DeletePersonalAccessToken.
+// Check the length with:
+//
+//	len(mockedPersonalAccessTokenRepository.DeletePersonalAccessTokenCalls())
+func (mock *PersonalAccessTokenRepositoryMock) DeletePersonalAccessTokenCalls() []struct {
+	Ctx context.Context
+	Db  store.Execer
+	UID entity.UserID
+	ID  entity.PersonalAccessTokenID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Execer
+		UID entity.UserID
+		ID  entity.PersonalAccessTokenID
+	}
+	mock.lockDeletePersonalAccessToken.RLock()
+	calls = mock.calls.DeletePersonalAccessToken
+	mock.lockDeletePersonalAccessToken.RUnlock()
+	return calls
+}
+
+// GetPersonalAccessTokenByHash calls GetPersonalAccessTokenByHashFunc.
+func (mock *PersonalAccessTokenRepositoryMock) GetPersonalAccessTokenByHash(ctx context.Context, db store.Queryer, hash string) (*entity.PersonalAccessToken, error) {
+	if mock.GetPersonalAccessTokenByHashFunc == nil {
+		panic("PersonalAccessTokenRepositoryMock.GetPersonalAccessTokenByHashFunc: method is nil but PersonalAccessTokenRepository.GetPersonalAccessTokenByHash was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Db   store.Queryer
+		Hash string
+	}{
+		Ctx:  ctx,
+		Db:   db,
+		Hash: hash,
+	}
+	mock.lockGetPersonalAccessTokenByHash.Lock()
+	mock.calls.GetPersonalAccessTokenByHash = append(mock.calls.GetPersonalAccessTokenByHash, callInfo)
+	mock.lockGetPersonalAccessTokenByHash.Unlock()
+	return mock.GetPersonalAccessTokenByHashFunc(ctx, db, hash)
+}
+
+// GetPersonalAccessTokenByHashCalls gets all the calls that were made to GetPersonalAccessTokenByHash.
+// Check the length with:
+//
+//	len(mockedPersonalAccessTokenRepository.GetPersonalAccessTokenByHashCalls())
+func (mock *PersonalAccessTokenRepositoryMock) GetPersonalAccessTokenByHashCalls() []struct {
+	Ctx  context.Context
+	Db   store.Queryer
+	Hash string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Db   store.Queryer
+		Hash string
+	}
+	mock.lockGetPersonalAccessTokenByHash.RLock()
+	calls = mock.calls.GetPersonalAccessTokenByHash
+	mock.lockGetPersonalAccessTokenByHash.RUnlock()
+	return calls
+}
+
+// GetUserByID calls GetUserByIDFunc.
+func (mock *PersonalAccessTokenRepositoryMock) GetUserByID(ctx context.Context, db store.Queryer, id entity.UserID) (*entity.User, error) {
+	if mock.GetUserByIDFunc == nil {
+		panic("PersonalAccessTokenRepositoryMock.GetUserByIDFunc: method is nil but PersonalAccessTokenRepository.GetUserByID was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.UserID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		ID:  id,
+	}
+	mock.lockGetUserByID.Lock()
+	mock.calls.GetUserByID = append(mock.calls.GetUserByID, callInfo)
+	mock.lockGetUserByID.Unlock()
+	return mock.GetUserByIDFunc(ctx, db, id)
+}
+
+// GetUserByIDCalls gets all the calls that were made to GetUserByID.
+// Check the length with:
+//
+//	len(mockedPersonalAccessTokenRepository.GetUserByIDCalls())
+func (mock *PersonalAccessTokenRepositoryMock) GetUserByIDCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	ID  entity.UserID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.UserID
+	}
+	mock.lockGetUserByID.RLock()
+	calls = mock.calls.GetUserByID
+	mock.lockGetUserByID.RUnlock()
+	return calls
+}
+
+// ListPersonalAccessTokens calls ListPersonalAccessTokensFunc.
+func (mock *PersonalAccessTokenRepositoryMock) ListPersonalAccessTokens(ctx context.Context, db store.Queryer, uid entity.UserID) (entity.PersonalAccessTokens, error) {
+	if mock.ListPersonalAccessTokensFunc == nil {
+		panic("PersonalAccessTokenRepositoryMock.ListPersonalAccessTokensFunc: method is nil but PersonalAccessTokenRepository.ListPersonalAccessTokens was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		UID: uid,
+	}
+	mock.lockListPersonalAccessTokens.Lock()
+	mock.calls.ListPersonalAccessTokens = append(mock.calls.ListPersonalAccessTokens, callInfo)
+	mock.lockListPersonalAccessTokens.Unlock()
+	return mock.ListPersonalAccessTokensFunc(ctx, db, uid)
+}
+
+// ListPersonalAccessTokensCalls gets all the calls that were made to ListPersonalAccessTokens.
+// Check the length with:
+//
+//	len(mockedPersonalAccessTokenRepository.ListPersonalAccessTokensCalls())
+func (mock *PersonalAccessTokenRepositoryMock) ListPersonalAccessTokensCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	UID entity.UserID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+	}
+	mock.lockListPersonalAccessTokens.RLock()
+	calls = mock.calls.ListPersonalAccessTokens
+	mock.lockListPersonalAccessTokens.RUnlock()
+	return calls
+}
+
+// TouchPersonalAccessToken calls TouchPersonalAccessTokenFunc.
+func (mock *PersonalAccessTokenRepositoryMock) TouchPersonalAccessToken(ctx context.Context, db store.Execer, id entity.PersonalAccessTokenID, interval time.Duration) error {
+	if mock.TouchPersonalAccessTokenFunc == nil {
+		panic("PersonalAccessTokenRepositoryMock.TouchPersonalAccessTokenFunc: method is nil but PersonalAccessTokenRepository.TouchPersonalAccessToken was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Db       store.Execer
+		ID       entity.PersonalAccessTokenID
+		Interval time.Duration
+	}{
+		Ctx:      ctx,
+		Db:       db,
+		ID:       id,
+		Interval: interval,
+	}
+	mock.lockTouchPersonalAccessToken.Lock()
+	mock.calls.TouchPersonalAccessToken = append(mock.calls.TouchPersonalAccessToken, callInfo)
+	mock.lockTouchPersonalAccessToken.Unlock()
+	return mock.TouchPersonalAccessTokenFunc(ctx, db, id, interval)
+}
+
+// TouchPersonalAccessTokenCalls gets all the calls that were made to TouchPersonalAccessToken.
+// Check the length with:
+//
+//	len(mockedPersonalAccessTokenRepository.TouchPersonalAccessTokenCalls())
+func (mock *PersonalAccessTokenRepositoryMock) TouchPersonalAccessTokenCalls() []struct {
+	Ctx      context.Context
+	Db       store.Execer
+	ID       entity.PersonalAccessTokenID
+	Interval time.Duration
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Db       store.Execer
+		ID       entity.PersonalAccessTokenID
+		Interval time.Duration
+	}
+	mock.lockTouchPersonalAccessToken.RLock()
+	calls = mock.calls.TouchPersonalAccessToken
+	mock.lockTouchPersonalAccessToken.RUnlock()
+	return calls
+}
+
+// Ensure, that OAuthRepositoryMock does implement OAuthRepository.
+// If this is not the case, regenerate this file with moq.
+var _ OAuthRepository = &OAuthRepositoryMock{}
+
+// OAuthRepositoryMock is a mock implementation of OAuthRepository.
+//
+//	func TestSomethingThatUsesOAuthRepository(t *testing.T) {
+//
+//		// make and configure a mocked OAuthRepository
+//		mockedOAuthRepository := &OAuthRepositoryMock{
+//			AddOAuthClientFunc: func(ctx context.Context, db store.Execer, c *entity.OAuthClient) error {
+//				panic("mock out the AddOAuthClient method")
+//			},
+//			DeleteOAuthClientFunc: func(ctx context.Context, db store.Execer, uid entity.UserID, id entity.OAuthClientID) error {
+//				panic("mock out the DeleteOAuthClient method")
+//			},
+//			GetOAuthClientFunc: func(ctx context.Context, db store.Queryer, id entity.OAuthClientID) (*entity.OAuthClient, error) {
+//				panic("mock out the GetOAuthClient method")
+//			},
+//			GetUserFunc: func(ctx context.Context, db store.Queryer, name string) (*entity.User, error) {
+//				panic("mock out the GetUser method")
+//			},
+//			GetUserByIDFunc: func(ctx context.Context, db store.Queryer, id entity.UserID) (*entity.User, error) {
+//				panic("mock out the GetUserByID method")
+//			},
+//			ListOAuthClientsFunc: func(ctx context.Context, db store.Queryer, uid entity.UserID) (entity.OAuthClients, error) {
+//				panic("mock out the ListOAuthClients method")
+//			},
+//		}
+//
+//		// use mockedOAuthRepository in code that requires OAuthRepository
+//		// and then make assertions.
+//
+//	}
+type OAuthRepositoryMock struct {
+	// AddOAuthClientFunc mocks the AddOAuthClient method.
+	AddOAuthClientFunc func(ctx context.Context, db store.Execer, c *entity.OAuthClient) error
+
+	// DeleteOAuthClientFunc mocks the DeleteOAuthClient method.
+	DeleteOAuthClientFunc func(ctx context.Context, db store.Execer, uid entity.UserID, id entity.OAuthClientID) error
+
+	// GetOAuthClientFunc mocks the GetOAuthClient method.
+	GetOAuthClientFunc func(ctx context.Context, db store.Queryer, id entity.OAuthClientID) (*entity.OAuthClient, error)
+
+	// GetUserFunc mocks the GetUser method.
+	GetUserFunc func(ctx context.Context, db store.Queryer, name string) (*entity.User, error)
+
+	// GetUserByIDFunc mocks the GetUserByID method.
+	GetUserByIDFunc func(ctx context.Context, db store.Queryer, id entity.UserID) (*entity.User, error)
+
+	// ListOAuthClientsFunc mocks the ListOAuthClients method.
+	ListOAuthClientsFunc func(ctx context.Context, db store.Queryer, uid entity.UserID) (entity.OAuthClients, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AddOAuthClient holds details about calls to the AddOAuthClient method.
+		AddOAuthClient []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Execer
+			// C is the c argument value.
+			C *entity.OAuthClient
+		}
+		// DeleteOAuthClient holds details about calls to the DeleteOAuthClient method.
+		DeleteOAuthClient []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Execer
+			// UID is the uid argument value.
+			UID entity.UserID
+			// ID is the id argument value.
+			ID entity.OAuthClientID
+		}
+		// GetOAuthClient holds details about calls to the GetOAuthClient method.
+		GetOAuthClient []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// ID is the id argument value.
+			ID entity.OAuthClientID
+		}
+		// GetUser holds details about calls to the GetUser method.
+		GetUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// Name is the name argument value.
+			Name string
+		}
+		// GetUserByID holds details about calls to the GetUserByID method.
+		GetUserByID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// ID is the id argument value.
+			ID entity.UserID
+		}
+		// ListOAuthClients holds details about calls to the ListOAuthClients method.
+		ListOAuthClients []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// UID is the uid argument value.
+			UID entity.UserID
+		}
+	}
+	lockAddOAuthClient    sync.RWMutex
+	lockDeleteOAuthClient sync.RWMutex
+	lockGetOAuthClient    sync.RWMutex
+	lockGetUser           sync.RWMutex
+	lockGetUserByID       sync.RWMutex
+	lockListOAuthClients  sync.RWMutex
+}
+
+// AddOAuthClient calls AddOAuthClientFunc.
+func (mock *OAuthRepositoryMock) AddOAuthClient(ctx context.Context, db store.Execer, c *entity.OAuthClient) error {
+	if mock.AddOAuthClientFunc == nil {
+		panic("OAuthRepositoryMock.AddOAuthClientFunc: method is nil but OAuthRepository.AddOAuthClient was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Execer
+		C   *entity.OAuthClient
+	}{
+		Ctx: ctx,
+		Db:  db,
+		C:   c,
+	}
+	mock.lockAddOAuthClient.Lock()
+	mock.calls.AddOAuthClient = append(mock.calls.AddOAuthClient, callInfo)
+	mock.lockAddOAuthClient.Unlock()
+	return mock.AddOAuthClientFunc(ctx, db, c)
+}
+
+// AddOAuthClientCalls gets all the calls that were made to AddOAuthClient.
+// Check the length with:
+//
+//	len(mockedOAuthRepository.AddOAuthClientCalls())
+func (mock *OAuthRepositoryMock) AddOAuthClientCalls() []struct {
+	Ctx context.Context
+	Db  store.Execer
+	C   *entity.OAuthClient
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Execer
+		C   *entity.OAuthClient
+	}
+	mock.lockAddOAuthClient.RLock()
+	calls = mock.calls.AddOAuthClient
+	mock.lockAddOAuthClient.RUnlock()
+	return calls
+}
+
+// DeleteOAuthClient calls DeleteOAuthClientFunc.
+func (mock *OAuthRepositoryMock) DeleteOAuthClient(ctx context.Context, db store.Execer, uid entity.UserID, id entity.OAuthClientID) error {
+	if mock.DeleteOAuthClientFunc == nil {
+		panic("OAuthRepositoryMock.DeleteOAuthClientFunc: method is nil but OAuthRepository.DeleteOAuthClient was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Execer
+		UID entity.UserID
+		ID  entity.OAuthClientID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		UID: uid,
+		ID:  id,
+	}
+	mock.lockDeleteOAuthClient.Lock()
+	mock.calls.DeleteOAuthClient = append(mock.calls.DeleteOAuthClient, callInfo)
+	mock.lockDeleteOAuthClient.Unlock()
+	return mock.DeleteOAuthClientFunc(ctx, db, uid, id)
+}
+
+// DeleteOAuthClientCalls gets all the calls that were made to DeleteOAuthClient.
+// Check the length with:
+//
+//	len(mockedOAuthRepository.DeleteOAuthClientCalls())
+func (mock *OAuthRepositoryMock) DeleteOAuthClientCalls() []struct {
+	Ctx context.Context
+	Db  store.Execer
+	UID entity.UserID
+	ID  entity.OAuthClientID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Execer
+		UID entity.UserID
+		ID  entity.OAuthClientID
+	}
+	mock.lockDeleteOAuthClient.RLock()
+	calls = mock.calls.DeleteOAuthClient
+	mock.lockDeleteOAuthClient.RUnlock()
+	return calls
+}
+
+// GetOAuthClient calls GetOAuthClientFunc.
+func (mock *OAuthRepositoryMock) GetOAuthClient(ctx context.Context, db store.Queryer, id entity.OAuthClientID) (*entity.OAuthClient, error) {
+	if mock.GetOAuthClientFunc == nil {
+		panic("OAuthRepositoryMock.GetOAuthClientFunc: method is nil but OAuthRepository.GetOAuthClient was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.OAuthClientID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		ID:  id,
+	}
+	mock.lockGetOAuthClient.Lock()
+	mock.calls.GetOAuthClient = append(mock.calls.GetOAuthClient, callInfo)
+	mock.lockGetOAuthClient.Unlock()
+	return mock.GetOAuthClientFunc(ctx, db, id)
+}
+
+// GetOAuthClientCalls gets all the calls that were made to GetOAuthClient.
+// Check the length with:
+//
+//	len(mockedOAuthRepository.GetOAuthClientCalls())
+func (mock *OAuthRepositoryMock) GetOAuthClientCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	ID  entity.OAuthClientID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.OAuthClientID
+	}
+	mock.lockGetOAuthClient.RLock()
+	calls = mock.calls.GetOAuthClient
+	mock.lockGetOAuthClient.RUnlock()
+	return calls
+}
+
+// GetUser calls GetUserFunc.
+func (mock *OAuthRepositoryMock) GetUser(ctx context.Context, db store.Queryer, name string) (*entity.User, error) {
+	if mock.GetUserFunc == nil {
+		panic("OAuthRepositoryMock.GetUserFunc: method is nil but OAuthRepository.GetUser was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Db   store.Queryer
+		Name string
+	}{
+		Ctx:  ctx,
+		Db:   db,
+		Name: name,
+	}
+	mock.lockGetUser.Lock()
+	mock.calls.GetUser = append(mock.calls.GetUser, callInfo)
+	mock.lockGetUser.Unlock()
+	return mock.GetUserFunc(ctx, db, name)
+}
+
+// GetUserCalls gets all the calls that were made to GetUser.
+// Check the length with:
+//
+//	len(mockedOAuthRepository.GetUserCalls())
+func (mock *OAuthRepositoryMock) GetUserCalls() []struct {
+	Ctx  context.Context
+	Db   store.Queryer
+	Name string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Db   store.Queryer
+		Name string
+	}
+	mock.lockGetUser.RLock()
+	calls = mock.calls.GetUser
+	mock.lockGetUser.RUnlock()
+	return calls
+}
+
+// GetUserByID calls GetUserByIDFunc.
+func (mock *OAuthRepositoryMock) GetUserByID(ctx context.Context, db store.Queryer, id entity.UserID) (*entity.User, error) {
+	if mock.GetUserByIDFunc == nil {
+		panic("OAuthRepositoryMock.GetUserByIDFunc: method is nil but OAuthRepository.GetUserByID was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.UserID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		ID:  id,
+	}
+	mock.lockGetUserByID.Lock()
+	mock.calls.GetUserByID = append(mock.calls.GetUserByID, callInfo)
+	mock.lockGetUserByID.Unlock()
+	return mock.GetUserByIDFunc(ctx, db, id)
+}
+
+// GetUserByIDCalls gets all the calls that were made to GetUserByID.
+// Check the length with:
+//
+//	len(mockedOAuthRepository.GetUserByIDCalls())
+func (mock *OAuthRepositoryMock) GetUserByIDCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	ID  entity.UserID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.UserID
+	}
+	mock.lockGetUserByID.RLock()
+	calls = mock.calls.GetUserByID
+	mock.lockGetUserByID.RUnlock()
+	return calls
+}
+
+// ListOAuthClients calls ListOAuthClientsFunc.
+func (mock *OAuthRepositoryMock) ListOAuthClients(ctx context.Context, db store.Queryer, uid entity.UserID) (entity.OAuthClients, error) {
+	if mock.ListOAuthClientsFunc == nil {
+		panic("OAuthRepositoryMock.ListOAuthClientsFunc: method is nil but OAuthRepository.ListOAuthClients was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		UID: uid,
+	}
+	mock.lockListOAuthClients.Lock()
+	mock.calls.ListOAuthClients = append(mock.calls.ListOAuthClients, callInfo)
+	mock.lockListOAuthClients.Unlock()
+	return mock.ListOAuthClientsFunc(ctx, db, uid)
+}
+
+// ListOAuthClientsCalls gets all the calls that were made to ListOAuthClients.
+// Check the length with:
+//
+//	len(mockedOAuthRepository.ListOAuthClientsCalls())
+func (mock *OAuthRepositoryMock) ListOAuthClientsCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	UID entity.UserID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+	}
+	mock.lockListOAuthClients.RLock()
+	calls = mock.calls.ListOAuthClients
+	mock.lockListOAuthClients.RUnlock()
+	return calls
+}
+
+// Ensure, that AuthorizationCodeStoreMock does implement AuthorizationCodeStore.
+// If this is not the case, regenerate this file with moq.
+var _ AuthorizationCodeStore = &AuthorizationCodeStoreMock{}
+
+// AuthorizationCodeStoreMock is a mock implementation of AuthorizationCodeStore.
+//
+//	func TestSomethingThatUsesAuthorizationCodeStore(t *testing.T) {
+//
+//		// make and configure a mocked AuthorizationCodeStore
+//		mockedAuthorizationCodeStore := &AuthorizationCodeStoreMock{
+//			SaveAuthorizationCodeFunc: func(ctx context.Context, key string, c *entity.AuthorizationCode, ttl time.Duration) error {
+//				panic("mock out the SaveAuthorizationCode method")
+//			},
+//			UseAuthorizationCodeFunc: func(ctx context.Context, key string) (*entity.AuthorizationCode, error) {
+//				panic("mock out the UseAuthorizationCode method")
+//			},
+//		}
+//
+//		// use mockedAuthorizationCodeStore in code that requires AuthorizationCodeStore
+//		// and then make assertions.
+//
+//	}
+type AuthorizationCodeStoreMock struct {
+	// SaveAuthorizationCodeFunc mocks the SaveAuthorizationCode method.
+	SaveAuthorizationCodeFunc func(ctx context.Context, key string, c *entity.AuthorizationCode, ttl time.Duration) error
+
+	// UseAuthorizationCodeFunc mocks the UseAuthorizationCode method.
+	UseAuthorizationCodeFunc func(ctx context.Context, key string) (*entity.AuthorizationCode, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// SaveAuthorizationCode holds details about calls to the SaveAuthorizationCode method.
+		SaveAuthorizationCode []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Key is the key argument value.
+			Key string
+			// C is the c argument value.
+			C *entity.AuthorizationCode
+			// TTL is the ttl argument value.
+			TTL time.Duration
+		}
+		// UseAuthorizationCode holds details about calls to the UseAuthorizationCode method.
+		UseAuthorizationCode []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Key is the key argument value.
+			Key string
+		}
+	}
+	lockSaveAuthorizationCode sync.RWMutex
+	lockUseAuthorizationCode  sync.RWMutex
+}
+
+// SaveAuthorizationCode calls SaveAuthorizationCodeFunc.
+func (mock *AuthorizationCodeStoreMock) SaveAuthorizationCode(ctx context.Context, key string, c *entity.AuthorizationCode, ttl time.Duration) error {
+	if mock.SaveAuthorizationCodeFunc == nil {
+		panic("AuthorizationCodeStoreMock.SaveAuthorizationCodeFunc: method is nil but AuthorizationCodeStore.SaveAuthorizationCode was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Key string
+		C   *entity.AuthorizationCode
+		TTL time.Duration
+	}{
+		Ctx: ctx,
+		Key: key,
+		C:   c,
+		TTL: ttl,
+	}
+	mock.lockSaveAuthorizationCode.Lock()
+	mock.calls.SaveAuthorizationCode = append(mock.calls.SaveAuthorizationCode, callInfo)
+	mock.lockSaveAuthorizationCode.Unlock()
+	return mock.SaveAuthorizationCodeFunc(ctx, key, c, ttl)
+}
+
+// SaveAuthorizationCodeCalls gets all the calls that were made to SaveAuthorizationCode.
+// Check the length with:
+//
+//	len(mockedAuthorizationCodeStore.SaveAuthorizationCodeCalls())
+func (mock *AuthorizationCodeStoreMock) SaveAuthorizationCodeCalls() []struct {
+	Ctx context.Context
+	Key string
+	C   *entity.AuthorizationCode
+	TTL time.Duration
+} {
+	var calls []struct {
+		Ctx context.Context
+		Key string
+		C   *entity.AuthorizationCode
+		TTL time.Duration
+	}
+	mock.lockSaveAuthorizationCode.RLock()
+	calls = mock.calls.SaveAuthorizationCode
+	mock.lockSaveAuthorizationCode.RUnlock()
+	return calls
+}
+
+// UseAuthorizationCode calls UseAuthorizationCodeFunc.
+func (mock *AuthorizationCodeStoreMock) UseAuthorizationCode(ctx context.Context, key string) (*entity.AuthorizationCode, error) {
+	if mock.UseAuthorizationCodeFunc == nil {
+		panic("AuthorizationCodeStoreMock.UseAuthorizationCodeFunc: method is nil but AuthorizationCodeStore.UseAuthorizationCode was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Key string
+	}{
+		Ctx: ctx,
+		Key: key,
+	}
+	mock.lockUseAuthorizationCode.Lock()
+	mock.calls.UseAuthorizationCode = append(mock.calls.UseAuthorizationCode, callInfo)
+	mock.lockUseAuthorizationCode.Unlock()
+	return mock.UseAuthorizationCodeFunc(ctx, key)
+}
+
+// UseAuthorizationCodeCalls gets all the calls that were made to UseAuthorizationCode.
+// Check the length with:
+//
+//	len(mockedAuthorizationCodeStore.UseAuthorizationCodeCalls())
+func (mock *AuthorizationCodeStoreMock) UseAuthorizationCodeCalls() []struct {
+	Ctx context.Context
+	Key string
+} {
+	var calls []struct {
+		Ctx context.Context
+		Key string
+	}
+	mock.lockUseAuthorizationCode.RLock()
+	calls = mock.calls.UseAuthorizationCode
+	mock.lockUseAuthorizationCode.RUnlock()
+	return calls
+}
+
+// Ensure, that OAuthTokensMock does implement OAuthTokens.
+// If this is not the case, regenerate this file with moq.
+var _ OAuthTokens = &OAuthTokensMock{}
+
+// OAuthTokensMock is a mock implementation of OAuthTokens.
+//
+//	func TestSomethingThatUsesOAuthTokens(t *testing.T) {
+//
+//		// make and configure a mocked OAuthTokens
+//		mockedOAuthTokens := &OAuthTokensMock{
+//			GenerateOAuthTokenFunc: func(ctx context.Context, u entity.User, cid entity.OAuthClientID, scopes entity.Scopes) ([]byte, error) {
+//				panic("mock out the GenerateOAuthToken method")
+//			},
+//			InspectTokenFunc: func(ctx context.Context, raw string) (*entity.TokenClaims, error) {
+//				panic("mock out the InspectToken method")
+//			},
+//			RevokeTokenFunc: func(ctx context.Context, jti string) error {
+//				panic("mock out the RevokeToken method")
+//			},
+//		}
+//
+//		// use mockedOAuthTokens in code that requires OAuthTokens
+//		// and then make assertions.
+//
+//	}
+type OAuthTokensMock struct {
+	// GenerateOAuthTokenFunc mocks the GenerateOAuthToken method.
+	GenerateOAuthTokenFunc func(ctx context.Context, u entity.User, cid entity.OAuthClientID, scopes entity.Scopes) ([]byte, error)
+
+	// InspectTokenFunc mocks the InspectToken method.
+	InspectTokenFunc func(ctx context.Context, raw string) (*entity.TokenClaims, error)
+
+	// RevokeTokenFunc mocks the RevokeToken method.
+	RevokeTokenFunc func(ctx context.Context, jti string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GenerateOAuthToken holds details about calls to the GenerateOAuthToken method.
+		GenerateOAuthToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// U is the u argument value.
+			U entity.User
+			// Cid is the cid argument value.
+			Cid entity.OAuthClientID
+			// Scopes is the scopes argument value.
+			Scopes entity.Scopes
+		}
+		// InspectToken holds details about calls to the InspectToken method.
+		InspectToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Raw is the raw argument value.
+			Raw string
+		}
+		// RevokeToken holds details about calls to the RevokeToken method.
+		RevokeToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Jti is the jti argument value.
+			Jti string
+		}
+	}
+	lockGenerateOAuthToken sync.RWMutex
+	lockInspectToken       sync.RWMutex
+	lockRevokeToken        sync.RWMutex
+}
+
+// GenerateOAuthToken calls GenerateOAuthTokenFunc.
+func (mock *OAuthTokensMock) GenerateOAuthToken(ctx context.Context, u entity.User, cid entity.OAuthClientID, scopes entity.Scopes) ([]byte, error) {
+	if mock.GenerateOAuthTokenFunc == nil {
+		panic("OAuthTokensMock.GenerateOAuthTokenFunc: method is nil but OAuthTokens.GenerateOAuthToken was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		U      entity.User
+		Cid    entity.OAuthClientID
+		Scopes entity.Scopes
+	}{
+		Ctx:    ctx,
+		U:      u,
+		Cid:    cid,
+		Scopes: scopes,
+	}
+	mock.lockGenerateOAuthToken.Lock()
+	mock.calls.GenerateOAuthToken = append(mock.calls.GenerateOAuthToken, callInfo)
+	mock.lockGenerateOAuthToken.Unlock()
+	return mock.GenerateOAuthTokenFunc(ctx, u, cid, scopes)
+}
+
+// GenerateOAuthTokenCalls gets all the calls that were made to GenerateOAuthToken.
+// Check the length with:
+//
+//	len(mockedOAuthTokens.GenerateOAuthTokenCalls())
+func (mock *OAuthTokensMock) GenerateOAuthTokenCalls() []struct {
+	Ctx    context.Context
+	U      entity.User
+	Cid    entity.OAuthClientID
+	Scopes entity.Scopes
+} {
+	var calls []struct {
+		Ctx    context.Context
+		U      entity.User
+		Cid    entity.OAuthClientID
+		Scopes entity.Scopes
+	}
+	mock.lockGenerateOAuthToken.RLock()
+	calls = mock.calls.GenerateOAuthToken
+	mock.lockGenerateOAuthToken.RUnlock()
+	return calls
+}
+
+// InspectToken calls InspectTokenFunc.
+func (mock *OAuthTokensMock) InspectToken(ctx context.Context, raw string) (*entity.TokenClaims, error) {
+	if mock.InspectTokenFunc == nil {
+		panic("OAuthTokensMock.InspectTokenFunc: method is nil but OAuthTokens.InspectToken was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Raw string
+	}{
+		Ctx: ctx,
+		Raw: raw,
+	}
+	mock.lockInspectToken.Lock()
+	mock.calls.InspectToken = append(mock.calls.InspectToken, callInfo)
+	mock.lockInspectToken.Unlock()
+	return mock.InspectTokenFunc(ctx, raw)
+}
+
+// InspectTokenCalls gets all the calls that were made to InspectToken.
+// Check the length with:
+//
+//	len(mockedOAuthTokens.InspectTokenCalls())
+func (mock *OAuthTokensMock) InspectTokenCalls() []struct {
+	Ctx context.Context
+	Raw string
+} {
+	var calls []struct {
+		Ctx context.Context
+		Raw string
+	}
+	mock.lockInspectToken.RLock()
+	calls = mock.calls.InspectToken
+	mock.lockInspectToken.RUnlock()
+	return calls
+}
+
+// RevokeToken calls RevokeTokenFunc.
+func (mock *OAuthTokensMock) RevokeToken(ctx context.Context, jti string) error {
+	if mock.RevokeTokenFunc == nil {
+		panic("OAuthTokensMock.RevokeTokenFunc: method is nil but OAuthTokens.RevokeToken was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Jti string
+	}{
+		Ctx: ctx,
+		Jti: jti,
+	}
+	mock.lockRevokeToken.Lock()
+	mock.calls.RevokeToken = append(mock.calls.RevokeToken, callInfo)
+	mock.lockRevokeToken.Unlock()
+	return mock.RevokeTokenFunc(ctx, jti)
+}
+
+// RevokeTokenCalls gets all the calls that were made to RevokeToken.
+// Check the length with:
+//
+//	len(mockedOAuthTokens.RevokeTokenCalls())
+func (mock *OAuthTokensMock) RevokeTokenCalls() []struct {
+	Ctx context.Context
+	Jti string
+} {
+	var calls []struct {
+		Ctx context.Context
+		Jti string
+	}
+	mock.lockRevokeToken.RLock()
+	calls = mock.calls.RevokeToken
+	mock.lockRevokeToken.RUnlock()
+	return calls
+}
+
+// Ensure, that TokenStoreMock does implement TokenStore.
+// If this is not the case, regenerate this file with moq.
+var _ TokenStore = &TokenStoreMock{}
+
+// TokenStoreMock is a mock implementation of TokenStore.
+//
+//	func TestSomethingThatUsesTokenStore(t *testing.T) {
+//
+//		// make and configure a mocked TokenStore
+//		mockedTokenStore := &TokenStoreMock{
+//			SaveFunc: func(ctx context.Context, key string, userID entity.UserID, ttl time.Duration) error {
+//				panic("mock out the Save method")
+//			},
+//			TakeFunc: func(ctx context.Context, key string) (entity.UserID, error) {
+//				panic("mock out the Take method")
+//			},
+//		}
+//
+//		// use mockedTokenStore in code that requires TokenStore
+//		// and then make assertions.
+//
+//	}
+type TokenStoreMock struct {
+	// SaveFunc mocks the Save method.
+	SaveFunc func(ctx context.Context, key string, userID entity.UserID, ttl time.Duration) error
+
+	// TakeFunc mocks the Take method.
+	TakeFunc func(ctx context.Context, key string) (entity.UserID, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Save holds details about calls to the Save method.
+		Save []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Key is the key argument value.
+			Key string
+			// UserID is the userID argument value.
+			UserID entity.UserID
+			// TTL is the ttl argument value.
+			TTL time.Duration
+		}
+		// Take holds details about calls to the Take method.
+		Take []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Key is the key argument value.
+			Key string
+		}
+	}
+	lockSave sync.RWMutex
+	lockTake sync.RWMutex
+}
+
+// Save calls SaveFunc.
+func (mock *TokenStoreMock) Save(ctx context.Context, key string, userID entity.UserID, ttl time.Duration) error {
+	if mock.SaveFunc == nil {
+		panic("TokenStoreMock.SaveFunc: method is nil but TokenStore.Save was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Key    string
+		UserID entity.UserID
+		TTL    time.Duration
+	}{
+		Ctx:    ctx,
+		Key:    key,
+		UserID: userID,
+		TTL:    ttl,
+	}
+	mock.lockSave.Lock()
+	mock.calls.Save = append(mock.calls.Save, callInfo)
+	mock.lockSave.Unlock()
+	return mock.SaveFunc(ctx, key, userID, ttl)
+}
+
+// SaveCalls gets all the calls that were made to Save.
+// Check the length with:
+//
+//	len(mockedTokenStore.SaveCalls())
+func (mock *TokenStoreMock) SaveCalls() []struct {
+	Ctx    context.Context
+	Key    string
+	UserID entity.UserID
+	TTL    time.Duration
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Key    string
+		UserID entity.UserID
+		TTL    time.Duration
+	}
+	mock.lockSave.RLock()
+	calls = mock.calls.Save
+	mock.lockSave.RUnlock()
+	return calls
+}
+
+// Take calls TakeFunc.
+func (mock *TokenStoreMock) Take(ctx context.Context, key string) (entity.UserID, error) {
+	if mock.TakeFunc == nil {
+		panic("TokenStoreMock.TakeFunc: method is nil but TokenStore.Take was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Key string
+	}{
+		Ctx: ctx,
+		Key: key,
+	}
+	mock.lockTake.Lock()
+	mock.calls.Take = append(mock.calls.Take, callInfo)
+	mock.lockTake.Unlock()
+	return mock.TakeFunc(ctx, key)
+}
+
+// TakeCalls gets all the calls that were made to Take.
+// Check the length with:
+//
+//	len(mockedTokenStore.TakeCalls())
+func (mock *TokenStoreMock) TakeCalls() []struct {
+	Ctx context.Context
+	Key string
+} {
+	var calls []struct {
+		Ctx context.Context
+		Key string
+	}
+	mock.lockTake.RLock()
+	calls = mock.calls.Take
+	mock.lockTake.RUnlock()
+	return calls
+}
+
+// Ensure, that UserRegisterMock does implement UserRegister.
+// If this is not the case, regenerate this file with moq.
+var _ UserRegister = &UserRegisterMock{}
+
+// UserRegisterMock is a mock implementation of UserRegister.
+//
+//	func TestSomethingThatUsesUserRegister(t *testing.T) {
+//
+//		// make and configure a mocked UserRegister
+//		mockedUserRegister := &UserRegisterMock{
+//			RegisterUserFunc: func(ctx context.Context, db store.Execer, u *entity.User) error {
+//				panic("mock out the RegisterUser method")
+//			},
+//		}
+//
+//		// use mockedUserRegister in code that requires UserRegister
+//		// and then make assertions.
+//
+//	}
+type UserRegisterMock struct {
+	// RegisterUserFunc mocks the RegisterUser method.
+	RegisterUserFunc func(ctx context.Context, db store.Execer, u *entity.User) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// RegisterUser holds details about calls to the RegisterUser method.
+		RegisterUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Execer
+			// U is the u argument value.
+			U *entity.User
+		}
+	}
+	lockRegisterUser sync.RWMutex
+}
+
+// RegisterUser calls RegisterUserFunc.
+func (mock *UserRegisterMock) RegisterUser(ctx context.Context, db store.Execer, u *entity.User) error {
+	if mock.RegisterUserFunc == nil {
+		panic("UserRegisterMock.RegisterUserFunc: method is nil but UserRegister.RegisterUser was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Execer
+		U   *entity.User
+	}{
+		Ctx: ctx,
+		Db:  db,
+		U:   u,
+	}
+	mock.lockRegisterUser.Lock()
+	mock.calls.RegisterUser = append(mock.calls.RegisterUser, callInfo)
+	mock.lockRegisterUser.Unlock()
+	return mock.RegisterUserFunc(ctx, db, u)
+}
+
+// RegisterUserCalls gets all the calls that were made to RegisterUser.
+// Check the length with:
+//
+//	len(mockedUserRegister.RegisterUserCalls())
+func (mock *UserRegisterMock) RegisterUserCalls() []struct {
+	Ctx context.Context
+	Db  store.Execer
+	U   *entity.User
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Execer
+		U   *entity.User
+	}
+	mock.lockRegisterUser.RLock()
+	calls = mock.calls.RegisterUser
+	mock.lockRegisterUser.RUnlock()
+	return calls
+}
+
+// Ensure, that UserGetterMock does implement UserGetter.
+// If this is not the case, regenerate this file with moq.
+var _ UserGetter = &UserGetterMock{}
+
+// UserGetterMock is a mock implementation of UserGetter.
+//
+//	func TestSomethingThatUsesUserGetter(t *testing.T) {
+//
+//		// make and configure a mocked UserGetter
+//		mockedUserGetter := &UserGetterMock{
+//			GetUserFunc: func(ctx context.Context, db store.Queryer, name string) (*entity.User, error) {
+//				panic("mock out the GetUser method")
+//			},
+//		}
+//
+//		// use mockedUserGetter in code that requires UserGetter
+//		// and then make assertions.
+//
+//	}
+type UserGetterMock struct {
+	// GetUserFunc mocks the GetUser method.
+	GetUserFunc func(ctx context.Context, db store.Queryer, name string) (*entity.User, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetUser holds details about calls to the GetUser method.
+		GetUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// Name is the name argument value.
+			Name string
+		}
+	}
+	lockGetUser sync.RWMutex
+}
+
+// GetUser calls GetUserFunc.
+func (mock *UserGetterMock) GetUser(ctx context.Context, db store.Queryer, name string) (*entity.User, error) {
+	if mock.GetUserFunc == nil {
+		panic("UserGetterMock.GetUserFunc: method is nil but UserGetter.GetUser was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Db   store.Queryer
+		Name string
+	}{
+		Ctx:  ctx,
+		Db:   db,
+		Name: name,
+	}
+	mock.lockGetUser.Lock()
+	mock.calls.GetUser = append(mock.calls.GetUser, callInfo)
+	mock.lockGetUser.Unlock()
+	return mock.GetUserFunc(ctx, db, name)
+}
+
+// GetUserCalls gets all the calls that were made to GetUser.
+// Check the length with:
+//
+//	len(mockedUserGetter.GetUserCalls())
+func (mock *UserGetterMock) GetUserCalls() []struct {
+	Ctx  context.Context
+	Db   store.Queryer
+	Name string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Db   store.Queryer
+		Name string
+	}
+	mock.lockGetUser.RLock()
+	calls = mock.calls.GetUser
+	mock.lockGetUser.RUnlock()
+	return calls
+}
+
+// Ensure, that UserByIDGetterMock does implement UserByIDGetter.
+// If this is not the case, regenerate this file with moq.
+var _ UserByIDGetter = &UserByIDGetterMock{}
+
+// UserByIDGetterMock is a mock implementation of UserByIDGetter.
+//
+//	func TestSomethingThatUsesUserByIDGetter(t *testing.T) {
+//
+//		// make and configure a mocked UserByIDGetter
+//		mockedUserByIDGetter := &UserByIDGetterMock{
+//			GetUserByIDFunc: func(ctx context.Context, db store.Queryer, id entity.UserID) (*entity.User, error) {
+//				panic("mock out the GetUserByID method")
+//			},
+//		}
+//
+//		// use mockedUserByIDGetter in code that requires UserByIDGetter
+//		// and then make assertions.
+//
+//	}
+type UserByIDGetterMock struct {
+	// GetUserByIDFunc mocks the GetUserByID method.
+	GetUserByIDFunc func(ctx context.Context, db store.Queryer, id entity.UserID) (*entity.User, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetUserByID holds details about calls to the GetUserByID method.
+		GetUserByID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// ID is the id argument value.
+			ID entity.UserID
+		}
+	}
+	lockGetUserByID sync.RWMutex
+}
+
+// GetUserByID calls GetUserByIDFunc.
+func (mock *UserByIDGetterMock) GetUserByID(ctx context.Context, db store.Queryer, id entity.UserID) (*entity.User, error) {
+	if mock.GetUserByIDFunc == nil {
+		panic("UserByIDGetterMock.GetUserByIDFunc: method is nil but UserByIDGetter.GetUserByID was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.UserID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		ID:  id,
+	}
+	mock.lockGetUserByID.Lock()
+	mock.calls.GetUserByID = append(mock.calls.GetUserByID, callInfo)
+	mock.lockGetUserByID.Unlock()
+	return mock.GetUserByIDFunc(ctx, db, id)
+}
+
+// GetUserByIDCalls gets all the calls that were made to GetUserByID.
+// Check the length with:
+//
+//	len(mockedUserByIDGetter.GetUserByIDCalls())
+func (mock *UserByIDGetterMock) GetUserByIDCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	ID  entity.UserID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		ID  entity.UserID
+	}
+	mock.lockGetUserByID.RLock()
+	calls = mock.calls.GetUserByID
+	mock.lockGetUserByID.RUnlock()
+	return calls
+}
+
+// Ensure, that TokenGeneratorMock does implement TokenGenerator.
+// If this is not the case, regenerate this file with moq.
+var _ TokenGenerator = &TokenGeneratorMock{}
+
+// TokenGeneratorMock is a mock implementation of TokenGenerator.
+//
+//	func TestSomethingThatUsesTokenGenerator(t *testing.T) {
+//
+//		// make and configure a mocked TokenGenerator
+//		mockedTokenGenerator := &TokenGeneratorMock{
+//			GenerateTokenFunc: func(ctx context.Context, u entity.User, sid entity.SessionID) ([]byte, error) {
+//				panic("mock out the GenerateToken method")
+//			},
+//			StartSessionFunc: func(ctx context.Context, uid entity.UserID, c entity.Client) (entity.SessionID, string, error) {
+//				panic("mock out the StartSession method")
+//			},
+//		}
+//
+//		// use mockedTokenGenerator in code that requires TokenGenerator
+//		// and then make assertions.
+//
+//	}
+type TokenGeneratorMock struct {
+	// GenerateTokenFunc mocks the GenerateToken method.
+	GenerateTokenFunc func(ctx context.Context, u entity.User, sid entity.SessionID) ([]byte, error)
+
+	// StartSessionFunc mocks the StartSession method.
+	StartSessionFunc func(ctx context.Context, uid entity.UserID, c entity.Client) (entity.SessionID, string, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GenerateToken holds details about calls to the GenerateToken method.
+		GenerateToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// U is the u argument value.
+			U entity.User
+			// Sid is the sid argument value.
+			Sid entity.SessionID
+		}
+		// StartSession holds details about calls to the StartSession method.
+		StartSession []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UID is the uid argument value.
+			UID entity.UserID
+			// C is the c argument value.
+			C entity.Client
+		}
+	}
+	lockGenerateToken sync.RWMutex
+	lockStartSession  sync.RWMutex
+}
+
+// GenerateToken calls GenerateTokenFunc.
+func (mock *TokenGeneratorMock) GenerateToken(ctx context.Context, u entity.User, sid entity.SessionID) ([]byte, error) {
+	if mock.GenerateTokenFunc == nil {
+		panic("TokenGeneratorMock.GenerateTokenFunc: method is nil but TokenGenerator.GenerateToken was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		U   entity.User
+		Sid entity.SessionID
+	}{
+		Ctx: ctx,
+		U:   u,
+		Sid: sid,
+	}
+	mock.lockGenerateToken.Lock()
+	mock.calls.GenerateToken = append(mock.calls.GenerateToken, callInfo)
+	mock.lockGenerateToken.Unlock()
+	return mock.GenerateTokenFunc(ctx, u, sid)
+}
+
+// GenerateTokenCalls gets all the calls that were made to GenerateToken.
+// Check the length with:
+//
+//	len(mockedTokenGenerator.GenerateTokenCalls())
+func (mock *TokenGeneratorMock) GenerateTokenCalls() []struct {
+	Ctx context.Context
+	U   entity.User
+	Sid entity.SessionID
+} {
+	var calls []struct {
+		Ctx context.Context
+		U   entity.User
+		Sid entity.SessionID
+	}
+	mock.lockGenerateToken.RLock()
+	calls = mock.calls.GenerateToken
+	mock.lockGenerateToken.RUnlock()
+	return calls
+}
+
+// StartSession calls StartSessionFunc.
+func (mock *TokenGeneratorMock) StartSession(ctx context.Context, uid entity.UserID, c entity.Client) (entity.SessionID, string, error) {
+	if mock.StartSessionFunc == nil {
+		panic("TokenGeneratorMock.StartSessionFunc: method is nil but TokenGenerator.StartSession was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		UID entity.UserID
+		C   entity.Client
+	}{
+		Ctx: ctx,
+		UID: uid,
+		C:   c,
+	}
+	mock.lockStartSession.Lock()
+	mock.calls.StartSession = append(mock.calls.StartSession, callInfo)
+	mock.lockStartSession.Unlock()
+	return mock.StartSessionFunc(ctx, uid, c)
+}
+
+// StartSessionCalls gets all the calls that were made to StartSession.
+// Check the length with:
+//
+//	len(mockedTokenGenerator.StartSessionCalls())
+func (mock *TokenGeneratorMock) StartSessionCalls() []struct {
+	Ctx context.Context
+	UID entity.UserID
+	C   entity.Client
+} {
+	var calls []struct {
+		Ctx context.Context
+		UID entity.UserID
+		C   entity.Client
+	}
+	mock.lockStartSession.RLock()
+	calls = mock.calls.StartSession
+	mock.lockStartSession.RUnlock()
+	return calls
+}
+
+// Ensure, that TokenRotatorMock does implement TokenRotator.
+// If this is not the case, regenerate this file with moq.
+var _ TokenRotator = &TokenRotatorMock{}
+
+// TokenRotatorMock is a mock implementation of TokenRotator.
+//
+//	func TestSomethingThatUsesTokenRotator(t *testing.T) {
+//
+//		// make and configure a mocked TokenRotator
+//		mockedTokenRotator := &TokenRotatorMock{
+//			GenerateTokenFunc: func(ctx context.Context, u entity.User, sid entity.SessionID) ([]byte, error) {
+//				panic("mock out the GenerateToken method")
+//			},
+//			RotateRefreshTokenFunc: func(ctx context.Context, token string) (entity.UserID, entity.SessionID, string, error) {
+//				panic("mock out the RotateRefreshToken method")
+//			},
+//		}
+//
+//		// use mockedTokenRotator in code that requires TokenRotator
+//		// and then make assertions.
+//
+//	}
+type TokenRotatorMock struct {
+	// GenerateTokenFunc mocks the GenerateToken method.
+	GenerateTokenFunc func(ctx context.Context, u entity.User, sid entity.SessionID) ([]byte, error)
+
+	// RotateRefreshTokenFunc mocks the RotateRefreshToken method.
+	RotateRefreshTokenFunc func(ctx context.Context, token string) (entity.UserID, entity.SessionID, string, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GenerateToken holds details about calls to the GenerateToken method.
+		GenerateToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// U is the u argument value.
+			U entity.User
+			// Sid is the sid argument value.
+			Sid entity.SessionID
+		}
+		// RotateRefreshToken holds details about calls to the RotateRefreshToken method.
+		RotateRefreshToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Token is the token argument value.
+			Token string
+		}
+	}
+	lockGenerateToken      sync.RWMutex
+	lockRotateRefreshToken sync.RWMutex
+}
+
+// GenerateToken calls GenerateTokenFunc.
+func (mock *TokenRotatorMock) GenerateToken(ctx context.Context, u entity.User, sid entity.SessionID) ([]byte, error) {
+	if mock.GenerateTokenFunc == nil {
+		panic("TokenRotatorMock.GenerateTokenFunc: method is nil but TokenRotator.GenerateToken was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		U   entity.User
+		Sid entity.SessionID
+	}{
+		Ctx: ctx,
+		U:   u,
+		Sid: sid,
+	}
+	mock.lockGenerateToken.Lock()
+	mock.calls.GenerateToken = append(mock.calls.GenerateToken, callInfo)
+	mock.lockGenerateToken.Unlock()
+	return mock.GenerateTokenFunc(ctx, u, sid)
+}
+
+// GenerateTokenCalls gets all the calls that were made to GenerateToken.
+// Check the length with:
+//
+//	len(mockedTokenRotator.GenerateTokenCalls())
+func (mock *TokenRotatorMock) GenerateTokenCalls() []struct {
+	Ctx context.Context
+	U   entity.User
+	Sid entity.SessionID
+} {
+	var calls []struct {
+		Ctx context.Context
+		U   entity.User
+		Sid entity.SessionID
+	}
+	mock.lockGenerateToken.RLock()
+	calls = mock.calls.GenerateToken
+	mock.lockGenerateToken.RUnlock()
+	return calls
+}
+
+// RotateRefreshToken calls RotateRefreshTokenFunc.
+func (mock *TokenRotatorMock) RotateRefreshToken(ctx context.Context, token string) (entity.UserID, entity.SessionID, string, error) {
+	if mock.RotateRefreshTokenFunc == nil {
+		panic("TokenRotatorMock.RotateRefreshTokenFunc: method is nil but TokenRotator.RotateRefreshToken was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Token string
+	}{
+		Ctx:   ctx,
+		Token: token,
+	}
+	mock.lockRotateRefreshToken.Lock()
+	mock.calls.RotateRefreshToken = append(mock.calls.RotateRefreshToken, callInfo)
+	mock.lockRotateRefreshToken.Unlock()
+	return mock.RotateRefreshTokenFunc(ctx, token)
+}
+
+// RotateRefreshTokenCalls gets all the calls that were made to RotateRefreshToken.
+// Check the length with:
+//
+//	len(mockedTokenRotator.RotateRefreshTokenCalls())
+func (mock *TokenRotatorMock) RotateRefreshTokenCalls() []struct {
+	Ctx   context.Context
+	Token string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Token string
+	}
+	mock.lockRotateRefreshToken.RLock()
+	calls = mock.calls.RotateRefreshToken
+	mock.lockRotateRefreshToken.RUnlock()
+	return calls
+}
+
+// Ensure, that SessionStoreMock does implement SessionStore.
+// If this is not the case, regenerate this file with moq.
+var _ SessionStore = &SessionStoreMock{}
+
+// SessionStoreMock is a mock implementation of SessionStore.
+//
+//	func TestSomethingThatUsesSessionStore(t *testing.T) {
+//
+//		// make and configure a mocked SessionStore
+//		mockedSessionStore := &SessionStoreMock{
+//			DeleteSessionFunc: func(ctx context.Context, id entity.SessionID) error {
+//				panic("mock out the DeleteSession method")
+//			},
+//			ListSessionsFunc: func(ctx context.Context, uid entity.UserID) ([]*entity.Session, error) {
+//				panic("mock out the ListSessions method")
+//			},
+//		}
+//
+//		// use mockedSessionStore in code that requires SessionStore
+//		// and then make assertions.
+//
+//	}
+type SessionStoreMock struct {
+	// DeleteSessionFunc mocks the DeleteSession method.
+	DeleteSessionFunc func(ctx context.Context, id entity.SessionID) error
+
+	// ListSessionsFunc mocks the ListSessions method.
+	ListSessionsFunc func(ctx context.Context, uid entity.UserID) ([]*entity.Session, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// DeleteSession holds details about calls to the DeleteSession method.
+		DeleteSession []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID entity.SessionID
+		}
+		// ListSessions holds details about calls to the ListSessions method.
+		ListSessions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UID is the uid argument value.
+			UID entity.UserID
+		}
+	}
+	lockDeleteSession sync.RWMutex
+	lockListSessions  sync.RWMutex
+}
+
+// DeleteSession calls DeleteSessionFunc.
+func (mock *SessionStoreMock) DeleteSession(ctx context.Context, id entity.SessionID) error {
+	if mock.DeleteSessionFunc == nil {
+		panic("SessionStoreMock.DeleteSessionFunc: method is nil but SessionStore.DeleteSession was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  entity.SessionID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockDeleteSession.Lock()
+	mock.calls.DeleteSession = append(mock.calls.DeleteSession, callInfo)
+	mock.lockDeleteSession.Unlock()
+	return mock.DeleteSessionFunc(ctx, id)
+}
+
+// DeleteSessionCalls gets all the calls that were made to DeleteSession.
+// Check the length with:
+//
+//	len(mockedSessionStore.DeleteSessionCalls())
+func (mock *SessionStoreMock) DeleteSessionCalls() []struct {
+	Ctx context.Context
+	ID  entity.SessionID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  entity.SessionID
+	}
+	mock.lockDeleteSession.RLock()
+	calls = mock.calls.DeleteSession
+	mock.lockDeleteSession.RUnlock()
+	return calls
+}
+
+// ListSessions calls ListSessionsFunc.
+func (mock *SessionStoreMock) ListSessions(ctx context.Context, uid entity.UserID) ([]*entity.Session, error) {
+	if mock.ListSessionsFunc == nil {
+		panic("SessionStoreMock.ListSessionsFunc: method is nil but SessionStore.ListSessions was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		UID entity.UserID
+	}{
+		Ctx: ctx,
+		UID: uid,
+	}
+	mock.lockListSessions.Lock()
+	mock.calls.ListSessions = append(mock.calls.ListSessions, callInfo)
+	mock.lockListSessions.Unlock()
+	return mock.ListSessionsFunc(ctx, uid)
+}
+
+// ListSessionsCalls gets all the calls that were made to ListSessions.
+// Check the length with:
+//
+//	len(mockedSessionStore.ListSessionsCalls())
+func (mock *SessionStoreMock) ListSessionsCalls() []struct {
+	Ctx context.Context
+	UID entity.UserID
+} {
+	var calls []struct {
+		Ctx context.Context
+		UID entity.UserID
+	}
+	mock.lockListSessions.RLock()
+	calls = mock.calls.ListSessions
+	mock.lockListSessions.RUnlock()
+	return calls
+}
@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/clock"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+)
+
+// Inbox는 알림을 사용자의 알림함(notification 테이블)에 저장하는 Notifier 구현이다.
+type Inbox struct {
+	DB   store.Execer
+	Repo NotificationRepository
+}
+
+var _ Notifier = (*Inbox)(nil)
+
+// Notify 메서드는 알림을 저장한다. DedupeKey가 같은 알림이 이미 있으면 아무것도 하지 않는다.
+func (i *Inbox) Notify(ctx context.Context, n *entity.Notification) error {
+	if err := i.Repo.AddNotification(ctx, i.DB, n); err != nil {
+		if errors.Is(err, store.ErrAlreadyEntry) {
+			return nil
+		}
+		return fmt.Errorf("failed to notify: %w", err)
+	}
+	return nil
+}
+
+// notify 함수는 알림 전송에 실패해도 원래 처리는 성공으로 끝나도록 에러를 로그로만 남긴다.
+func notify(ctx context.Context, n Notifier, ntf *entity.Notification) {
+	if n == nil {
+		return
+	}
+	if err := n.Notify(ctx, ntf); err != nil {
+		log.Printf("failed to send %s notification to user %d: %v", ntf.Type, ntf.UserID, err)
+	}
+}
+
+type ListNotification struct {
+	DB   store.Queryer
+	Repo NotificationRepository
+}
+
+// ListNotifications 메서드는 사용자의 알림 목록과 읽지 않은 알림 수를 반환한다.
+func (l *ListNotification) ListNotifications(
+	ctx context.Context, unreadOnly bool,
+) (entity.Notifications, int, error) {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return nil, 0, fmt.Errorf("user_id not found")
+	}
+	ns, err := l.Repo.ListNotifications(ctx, l.DB, id, unreadOnly)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list: %w", err)
+	}
+	unread, err := l.Repo.CountUnreadNotifications(ctx, l.DB, id)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count: %w", err)
+	}
+	return ns, unread, nil
+}
+
+type MarkNotification struct {
+	DB   store.ExecQueryer
+	Repo NotificationRepository
+}
+
+// MarkRead 메서드는 알림 하나를 읽음으로 표시하고 남은 읽지 않은 알림 수를 반환한다.
+func (m *MarkNotification) MarkRead(ctx context.Context, nid entity.NotificationID) (int, error) {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return 0, fmt.Errorf("user_id not found")
+	}
+	if err := m.Repo.MarkNotificationRead(ctx, m.DB, id, nid); err != nil {
+		return 0, fmt.Errorf("failed to mark: %w", err)
+	}
+	unread, err := m.Repo.CountUnreadNotifications(ctx, m.DB, id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count: %w", err)
+	}
+	return unread, nil
+}
+
+// MarkAllRead 메서드는 사용자의 모든 알림을 읽음으로 표시하고 표시한 알림 수를 반환한다.
+func (m *MarkNotification) MarkAllRead(ctx context.Context) (int64, error) {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return 0, fmt.Errorf("user_id not found")
+	}
+	n, err := m.Repo.MarkAllNotificationsRead(ctx, m.DB, id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to mark: %w", err)
+	}
+	return n, nil
+}
+
+// NotifyOverdue는 마감 시간이 지난 Task의 소유자와 담당자에게 알림을 보낸다.
+// 여러 서버 인스턴스가 동시에 실행해도 알림은 한 번만 보내도록, 알림함에 먼저 등록한 인스턴스만 Notifier로 전달한다.
+type NotifyOverdue struct {
+	DB       store.ExecQueryer
+	Repo     OverdueRepository
+	Notifier Notifier // 알림함 외에 메일 등으로 전달한다. nil이면 알림함에만 등록한다.
+	Clocker  clock.Clocker
+}
+
+// NotifyOverdue 메서드는 마감 시간이 지났지만 완료되지 않은 Task를 찾아 알림을 보내고, 보낸 알림 수를 반환한다.
+func (no *NotifyOverdue) NotifyOverdue(ctx context.Context) (int, error) {
+	tasks, err := no.Repo.ListOverdueTasks(ctx, no.DB, no.Clocker.Now())
+	if err != nil {
+		return 0, fmt.Errorf("failed to list: %w", err)
+	}
+	sent := 0
+	for _, t := range tasks {
+		recipients := []entity.UserID{t.UserID}
+		if t.AssigneeID != nil && *t.AssigneeID != t.UserID {
+			recipients = append(recipients, *t.AssigneeID)
+		}
+		for _, uid := range recipients {
+			tid := t.ID
+			key := entity.OverdueDedupeKey(t.ID, uid)
+			n := &entity.Notification{
+				UserID:    uid,
+				Type:      entity.NotificationTaskOverdue,
+				TaskID:    &tid,
+				Message:   fmt.Sprintf("task %q is overdue", t.Title),
+				DedupeKey: &key,
+			}
+			// DedupeKey의 유일 제약으로 알림을 선점한다. 이미 있으면 다른 인스턴스가 보낸 것이다.
+			if err := no.Repo.AddNotification(ctx, no.DB, n); err != nil {
+				if errors.Is(err, store.ErrAlreadyEntry) {
+					continue
+				}
+				return sent, fmt.Errorf("failed to notify: %w", err)
+			}
+			notify(ctx, no.Notifier, n)
+			sent++
+		}
+	}
+	return sent, nil
+}
+
+// Run 메서드는 ctx가 취소될 때까지 interval마다 NotifyOverdue를 실행한다.
+func (no *NotifyOverdue) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := no.NotifyOverdue(ctx); err != nil {
+				log.Printf("failed to notify overdue tasks: %v", err)
+			}
+		}
+	}
+}
+
+// Notifiers는 여러 Notifier에 같은 알림을 전달한다.
+// 하나가 실패해도 나머지에는 전달하고, 발생한 에러를 모두 묶어서 반환한다.
+type Notifiers []Notifier
+
+var _ Notifier = Notifiers(nil)
+
+func (ns Notifiers) Notify(ctx context.Context, n *entity.Notification) error {
+	var errs []error
+	for _, nt := range ns {
+		if err := nt.Notify(ctx, n); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/clock"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/google/go-cmp/cmp"
+	"github.com/jmoiron/sqlx"
+)
+
+func TestNotifyOverdue(t *testing.T) {
+	t.Parallel()
+
+	c := clock.FixedClocker{}
+	due := c.Now().Add(-time.Hour)
+	assignee := entity.UserID(20)
+	moq := &OverdueRepositoryMock{}
+	moq.ListOverdueTasksFunc = func(ctx context.Context, db store.Queryer, now time.Time) (entity.Tasks, error) {
+		if !now.Equal(c.Now()) {
+			t.Errorf("want %v, but got %v", c.Now(), now)
+		}
+		return entity.Tasks{
+			{ID: 1, UserID: 10, Title: "open", Status: "todo", Due: &due},
+			{ID: 2, UserID: 10, AssigneeID: &assignee, Title: "review", Status: "review", Due: &due},
+		}, nil
+	}
+	// 담당자에게 보낼 2번 Task의 알림은 다른 인스턴스가 먼저 등록했다.
+	var added []string
+	moq.AddNotificationFunc = func(ctx context.Context, db store.Execer, n *entity.Notification) error {
+		added = append(added, *n.DedupeKey)
+		if *n.DedupeKey == "task_overdue:2:20" {
+			return fmt.Errorf("dedupe key %q already exists: %w", *n.DedupeKey, store.ErrAlreadyEntry)
+		}
+		return nil
+	}
+	type sent struct {
+		UserID entity.UserID
+		TaskID entity.TaskID
+		Key    string
+	}
+	var gots []sent
+	notifier := &NotifierMock{}
+	notifier.NotifyFunc = func(ctx context.Context, n *entity.Notification) error {
+		if n.Type != entity.NotificationTaskOverdue {
+			t.Errorf("want %q, but got %q", entity.NotificationTaskOverdue, n.Type)
+		}
+		gots = append(gots, sent{UserID: n.UserID, TaskID: *n.TaskID, Key: *n.DedupeKey})
+		return nil
+	}
+
+	sut := &NotifyOverdue{Repo: moq, Notifier: notifier, Clocker: c}
+	n, err := sut.NotifyOverdue(context.Background())
+	if err != nil {
+		t.Fatalf("want no error, but got %v", err)
+	}
+	want := []sent{
+		{UserID: 10, TaskID: 1, Key: "task_overdue:1:10"},
+		{UserID: 10, TaskID: 2, Key: "task_overdue:2:10"},
+	}
+	if n != len(want) {
+		t.Errorf("want %d, but got %d", len(want), n)
+	}
+	if d := cmp.Diff(gots, want); len(d) != 0 {
+		t.Errorf("differs: (-got +want)\n%s", d)
+	}
+	wantAdded := []string{"task_overdue:1:10", "task_overdue:2:10", "task_overdue:2:20"}
+	if d := cmp.Diff(added, wantAdded); len(d) != 0 {
+		t.Errorf("added differs: (-got +want)\n%s", d)
+	}
+}
+
+func TestAssignTask_Notify(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		assignee entity.UserID
+		want     int
+	}{
+		"other": {assignee: 20, want: 1},
+		// 자기 자신을 담당자로 지정하면 알리지 않는다.
+		"self": {assignee: 10, want: 0},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			t.Cleanup(func() { _ = db.Close() })
+			mock.ExpectBegin()
+			mock.ExpectCommit()
+
+			moq := &TaskAssignerMock{}
+			moq.IsProjectMemberFunc = func(ctx context.Context, db store.Queryer, id entity.ProjectID, uid entity.UserID) (bool, error) {
+				return true, nil
+			}
+			moq.GetTaskFunc = func(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.TaskID) (*entity.Task, error) {
+				pid := entity.ProjectID(1)
+				return &entity.Task{ID: id, UserID: uid, ProjectID: &pid, Title: "test"}, nil
+			}
+			moq.AssignTaskFunc = func(ctx context.Context, db store.ExecQueryer, t *entity.Task) error {
+				return nil
+			}
+			notifier := &NotifierMock{}
+			notifier.NotifyFunc = func(ctx context.Context, n *entity.Notification) error {
+				if n.UserID != tt.assignee || n.Type != entity.NotificationTaskAssigned {
+					t.Errorf("unexpected notification: %+v", n)
+				}
+				return nil
+			}
+
+			sut := &AssignTask{DB: sqlx.NewDb(db, "mysql"), Repo: moq, Notifier: notifier}
+			ctx := auth.SetUserID(context.Background(), 10)
+			if _, err := sut.AssignTask(ctx, 1, tt.assignee); err != nil {
+				t.Fatalf("want no error, but got %v", err)
+			}
+			if got := len(notifier.NotifyCalls()); got != tt.want {
+				t.Errorf("want %d notifications, but got %d", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,429 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+)
+
+var (
+	// ErrInvalidRedirectURI는 OAuth 클라이언트에 허용할 수 없는 리디렉션 URI를 등록할 때 반환된다.
+	ErrInvalidRedirectURI = errors.New("redirect uri must be an absolute https url (or http on loopback) without fragment")
+	// ErrInvalidCredentials는 동의 화면에서 입력한 사용자 이름이나 비밀번호가 틀렸을 때 반환된다.
+	ErrInvalidCredentials = errors.New("wrong user name or password")
+)
+
+// OAuth 2.0 에러 코드 (RFC 6749 4.1.2.1, 5.2)
+const (
+	OAuthInvalidRequest          = "invalid_request"
+	OAuthInvalidClient           = "invalid_client"
+	OAuthInvalidGrant            = "invalid_grant"
+	OAuthUnauthorizedClient      = "unauthorized_client"
+	OAuthUnsupportedGrantType    = "unsupported_grant_type"
+	OAuthUnsupportedResponseType = "unsupported_response_type"
+	OAuthInvalidScope            = "invalid_scope"
+	OAuthAccessDenied            = "access_denied"
+)
+
+// OAuthError는 OAuth 2.0에서 정한 형식으로 클라이언트에 알리는 에러이다.
+// RedirectURI가 있으면 인가 요청의 에러이며, 사용자를 에러 파라미터를 추가한 RedirectURI로 돌려보낸다.
+// 클라이언트나 리디렉션 URI를 확인할 수 없는 인가 요청의 에러는 돌려보내지 않고 사용자에게 보여준다.
+type OAuthError struct {
+	Code        string
+	Description string
+	RedirectURI string
+}
+
+func (e *OAuthError) Error() string {
+	return e.Code + ": " + e.Description
+}
+
+// OAuth는 서드파티 애플리케이션이 사용자를 대신해 API를 호출할 수 있도록 하는 OAuth 2.0 인가 서버이다.
+// 인가 코드 그랜트(PKCE 필수)와 클라이언트 자격 증명 그랜트를 지원한다.
+type OAuth struct {
+	DB    store.ExecQueryer
+	Repo  OAuthRepository
+	Codes AuthorizationCodeStore
+	// Tokens는 액세스 토큰을 발급한다. 토큰의 유효 시간은 TokenTTL이어야 한다.
+	Tokens   OAuthTokens
+	CodeTTL  time.Duration
+	TokenTTL time.Duration
+}
+
+// RegisterOAuthClient 메서드는 로그인한 사용자의 OAuth 클라이언트를 등록한다.
+// confidential이면 시크릿을 발급하며, 시크릿은 이 메서드의 반환값으로만 확인할 수 있다.
+func (o *OAuth) RegisterOAuthClient(
+	ctx context.Context, name string, redirectURIs []string, scopes entity.Scopes, confidential bool,
+) (*entity.OAuthClient, string, error) {
+	uid, ok := auth.GetUserID(ctx)
+	if !ok {
+		return nil, "", fmt.Errorf("user_id not found")
+	}
+	for _, s := range scopes {
+		if !entity.AllScopes.Has(s) {
+			return nil, "", fmt.Errorf("%q: %w", s, ErrUnknownScope)
+		}
+	}
+	for _, u := range redirectURIs {
+		if !validRedirectURI(u) {
+			return nil, "", fmt.Errorf("%q: %w", u, ErrInvalidRedirectURI)
+		}
+	}
+	id, err := randomToken(16)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to generate client id: %w", err)
+	}
+	c := &entity.OAuthClient{
+		ID:           entity.OAuthClientID(id),
+		UserID:       uid,
+		Name:         name,
+		RedirectURIs: redirectURIs,
+		Scopes:       scopes,
+	}
+	var secret string
+	if confidential {
+		s, hash, err := auth.NewOAuthClientSecret()
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to generate client secret: %w", err)
+		}
+		secret, c.SecretHash = s, &hash
+	}
+	if err := o.Repo.AddOAuthClient(ctx, o.DB, c); err != nil {
+		return nil, "", fmt.Errorf("failed to register: %w", err)
+	}
+	return c, secret, nil
+}
+
+func (o *OAuth) ListOAuthClients(ctx context.Context) (entity.OAuthClients, error) {
+	uid, ok := auth.GetUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("user_id not found")
+	}
+	cs, err := o.Repo.ListOAuthClients(ctx, o.DB, uid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list: %w", err)
+	}
+	return cs, nil
+}
+
+// DeleteOAuthClient 메서드는 OAuth 클라이언트를 삭제한다. 다른 사용자의 클라이언트이면 store.ErrNotFound를 반환한다.
+// 삭제한 클라이언트는 새 토큰을 받을 수 없고, 이미 발급한 토큰은 만료될 때까지 사용할 수 있다.
+func (o *OAuth) DeleteOAuthClient(ctx context.Context, id entity.OAuthClientID) error {
+	uid, ok := auth.GetUserID(ctx)
+	if !ok {
+		return fmt.Errorf("user_id not found")
+	}
+	if err := o.Repo.DeleteOAuthClient(ctx, o.DB, uid, id); err != nil {
+		return fmt.Errorf("failed to delete: %w", err)
+	}
+	return nil
+}
+
+// Authorize 메서드는 인가 요청을 검증하고, 동의 화면에 보여줄 클라이언트와 요청한 범위를 반환한다.
+// scope가 없으면 클라이언트에 허용한 모든 범위를 요청한 것으로 본다.
+func (o *OAuth) Authorize(
+	ctx context.Context, req *entity.AuthorizationRequest,
+) (*entity.OAuthClient, entity.Scopes, error) {
+	c, err := o.Repo.GetOAuthClient(ctx, o.DB, req.ClientID)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, nil, &OAuthError{Code: OAuthInvalidRequest, Description: "unknown client_id"}
+		}
+		return nil, nil, fmt.Errorf("failed to get client: %w", err)
+	}
+	// 등록하지 않은 리디렉션 URI로 코드나 에러를 보내지 않는다.
+	if !c.RedirectURIs.Has(req.RedirectURI) {
+		return nil, nil, &OAuthError{Code: OAuthInvalidRequest, Description: "redirect_uri is not registered for the client"}
+	}
+	redirect := func(code, desc string) error {
+		return &OAuthError{Code: code, Description: desc, RedirectURI: redirectURL(req.RedirectURI, url.Values{
+			"error": {code}, "error_description": {desc}, "state": {req.State},
+		})}
+	}
+	if req.ResponseType != "code" {
+		return nil, nil, redirect(OAuthUnsupportedResponseType, "response_type must be code")
+	}
+	scopes := entity.ParseScopes(req.Scope)
+	if len(scopes) == 0 {
+		scopes = c.Scopes
+	}
+	if !c.Scopes.Contains(scopes) {
+		return nil, nil, redirect(OAuthInvalidScope, "scope is not allowed for the client")
+	}
+	// 공개 클라이언트는 코드를 가로채도 토큰으로 교환할 수 없도록 모든 클라이언트에 PKCE(S256)를 요구한다.
+	if req.CodeChallengeMethod != "S256" || len(req.CodeChallenge) != 43 {
+		return nil, nil, redirect(OAuthInvalidRequest, "code_challenge with code_challenge_method S256 is required")
+	}
+	return c, scopes, nil
+}
+
+// Approve 메서드는 사용자가 동의 화면에서 이름과 비밀번호를 입력해 승인한 인가 요청의 인가 코드를 발급하고,
+// 코드를 추가한 리디렉션 URI를 반환한다. 이름이나 비밀번호가 틀리면 ErrInvalidCredentials를 반환한다.
+func (o *OAuth) Approve(ctx context.Context, req *entity.AuthorizationRequest, name, pw string) (string, error) {
+	// 동의 화면의 폼은 사용자가 바꿀 수 있으므로 다시 검증한다.
+	c, scopes, err := o.Authorize(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	u, err := o.Repo.GetUser(ctx, o.DB, name)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return "", ErrInvalidCredentials
+		}
+		return "", fmt.Errorf("failed to get user: %w", err)
+	}
+	if err := u.ComparePassword(pw); err != nil {
+		return "", ErrInvalidCredentials
+	}
+	if u.Disabled != nil {
+		return "", ErrUserDisabled
+	}
+	code, err := randomToken(32)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate code: %w", err)
+	}
+	ac := &entity.AuthorizationCode{
+		ClientID:      c.ID,
+		UserID:        u.ID,
+		RedirectURI:   req.RedirectURI,
+		Scopes:        scopes,
+		CodeChallenge: req.CodeChallenge,
+	}
+	if err := o.Codes.SaveAuthorizationCode(ctx, hashAuthorizationCode(code), ac, o.CodeTTL); err != nil {
+		return "", fmt.Errorf("failed to save code: %w", err)
+	}
+	return redirectURL(req.RedirectURI, url.Values{"code": {code}, "state": {req.State}}), nil
+}
+
+// Deny 메서드는 사용자가 거부한 인가 요청의 에러를 추가한 리디렉션 URI를 반환한다.
+func (o *OAuth) Deny(ctx context.Context, req *entity.AuthorizationRequest) (string, error) {
+	if _, _, err := o.Authorize(ctx, req); err != nil {
+		return "", err
+	}
+	return redirectURL(req.RedirectURI, url.Values{
+		"error": {OAuthAccessDenied}, "error_description": {"the user denied the request"}, "state": {req.State},
+	}), nil
+}
+
+// ExchangeAuthorizationCode 메서드는 인가 코드를 액세스 토큰으로 교환한다. (grant_type=authorization_code)
+// 코드는 한 번만 사용할 수 있으며, verifier는 인가 요청의 code_challenge와 일치해야 한다.
+func (o *OAuth) ExchangeAuthorizationCode(
+	ctx context.Context, cid entity.OAuthClientID, secret, code, redirectURI, verifier string,
+) (*entity.OAuthToken, error) {
+	c, err := o.authenticateClient(ctx, cid, secret)
+	if err != nil {
+		return nil, err
+	}
+	if code == "" || redirectURI == "" || verifier == "" {
+		return nil, &OAuthError{Code: OAuthInvalidRequest, Description: "code, redirect_uri and code_verifier are required"}
+	}
+	ac, err := o.Codes.UseAuthorizationCode(ctx, hashAuthorizationCode(code))
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, &OAuthError{Code: OAuthInvalidGrant, Description: "invalid, expired or used authorization code"}
+		}
+		return nil, fmt.Errorf("failed to use code: %w", err)
+	}
+	if ac.ClientID != c.ID || ac.RedirectURI != redirectURI {
+		return nil, &OAuthError{Code: OAuthInvalidGrant, Description: "authorization code was issued to another client or redirect_uri"}
+	}
+	if !verifyCodeChallenge(verifier, ac.CodeChallenge) {
+		return nil, &OAuthError{Code: OAuthInvalidGrant, Description: "code_verifier does not match code_challenge"}
+	}
+	u, err := o.Repo.GetUserByID(ctx, o.DB, ac.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if u.Disabled != nil {
+		return nil, &OAuthError{Code: OAuthInvalidGrant, Description: ErrUserDisabled.Error()}
+	}
+	return o.issue(ctx, u, c.ID, ac.Scopes)
+}
+
+// ClientCredentialsGrant 메서드는 기밀 클라이언트에 클라이언트를 등록한 사용자의 권한으로 액세스 토큰을 발급한다.
+// (grant_type=client_credentials) scope가 없으면 클라이언트에 허용한 모든 범위의 토큰을 발급한다.
+func (o *OAuth) ClientCredentialsGrant(
+	ctx context.Context, cid entity.OAuthClientID, secret, scope string,
+) (*entity.OAuthToken, error) {
+	c, err := o.authenticateClient(ctx, cid, secret)
+	if err != nil {
+		return nil, err
+	}
+	if !c.Confidential() {
+		return nil, &OAuthError{Code: OAuthUnauthorizedClient, Description: "public clients cannot use client_credentials"}
+	}
+	scopes := entity.ParseScopes(scope)
+	if len(scopes) == 0 {
+		scopes = c.Scopes
+	}
+	if !c.Scopes.Contains(scopes) {
+		return nil, &OAuthError{Code: OAuthInvalidScope, Description: "scope is not allowed for the client"}
+	}
+	u, err := o.Repo.GetUserByID(ctx, o.DB, c.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if u.Disabled != nil {
+		return nil, &OAuthError{Code: OAuthUnauthorizedClient, Description: "owner of the client is disabled"}
+	}
+	return o.issue(ctx, u, c.ID, scopes)
+}
+
+// RevokeToken 메서드는 클라이언트에 발급한 액세스 토큰을 폐기한다. (RFC 7009)
+// 이미 사용할 수 없는 토큰이면 아무것도 하지 않고 성공한다.
+func (o *OAuth) RevokeToken(ctx context.Context, cid entity.OAuthClientID, secret, token string) error {
+	c, err := o.authenticateClient(ctx, cid, secret)
+	if err != nil {
+		return err
+	}
+	claims, err := o.Tokens.InspectToken(ctx, token)
+	if err != nil {
+		return nil
+	}
+	if claims.ClientID != c.ID {
+		return &OAuthError{Code: OAuthUnauthorizedClient, Description: "token was not issued to the client"}
+	}
+	return o.Tokens.RevokeToken(ctx, claims.ID)
+}
+
+// IntrospectToken 메서드는 클라이언트에 발급한 액세스 토큰의 상태와 내용을 반환한다. (RFC 7662)
+// 토큰을 사용할 수 없거나 다른 클라이언트에 발급한 토큰이면 Active만 false로 반환한다.
+func (o *OAuth) IntrospectToken(
+	ctx context.Context, cid entity.OAuthClientID, secret, token string,
+) (*entity.TokenIntrospection, error) {
+	c, err := o.authenticateClient(ctx, cid, secret)
+	if err != nil {
+		return nil, err
+	}
+	claims, err := o.Tokens.InspectToken(ctx, token)
+	if err != nil || claims.ClientID != c.ID {
+		return &entity.TokenIntrospection{Active: false}, nil
+	}
+	return &entity.TokenIntrospection{
+		Active:    true,
+		Scope:     claims.Scopes.Join(),
+		ClientID:  claims.ClientID,
+		Username:  claims.UserName,
+		Subject:   strconv.FormatInt(int64(claims.UserID), 10),
+		TokenType: "Bearer",
+		Expires:   claims.Expires.Unix(),
+		IssuedAt:  claims.IssuedAt.Unix(),
+	}, nil
+}
+
+// authenticateClient 메서드는 토큰 엔드포인트에 요청한 클라이언트를 확인한다.
+// 기밀 클라이언트는 시크릿이 일치해야 하고, 공개 클라이언트는 시크릿을 보내지 않아야 한다.
+func (o *OAuth) authenticateClient(
+	ctx context.Context, cid entity.OAuthClientID, secret string,
+) (*entity.OAuthClient, error) {
+	invalid := &OAuthError{Code: OAuthInvalidClient, Description: "client authentication failed"}
+	if cid == "" {
+		return nil, invalid
+	}
+	c, err := o.Repo.GetOAuthClient(ctx, o.DB, cid)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, invalid
+		}
+		return nil, fmt.Errorf("failed to get client: %w", err)
+	}
+	if !c.Confidential() {
+		if secret != "" {
+			return nil, invalid
+		}
+		return c, nil
+	}
+	if subtle.ConstantTimeCompare([]byte(auth.HashOAuthClientSecret(secret)), []byte(*c.SecretHash)) != 1 {
+		return nil, invalid
+	}
+	return c, nil
+}
+
+func (o *OAuth) issue(
+	ctx context.Context, u *entity.User, cid entity.OAuthClientID, scopes entity.Scopes,
+) (*entity.OAuthToken, error) {
+	jwt, err := o.Tokens.GenerateOAuthToken(ctx, *u, cid, scopes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate JWT: %w", err)
+	}
+	return &entity.OAuthToken{
+		AccessToken: string(jwt),
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(o.TokenTTL.Seconds()),
+		Scope:       scopes.Join(),
+	}, nil
+}
+
+// validRedirectURI 함수는 리디렉션 URI로 등록할 수 있는지 확인한다. (RFC 6749 3.1.2, RFC 8252 7.3)
+// 코드가 평문으로 전송되지 않도록 https만 허용하되, 네이티브 앱을 위해 루프백 주소는 http도 허용한다.
+func validRedirectURI(s string) bool {
+	u, err := url.Parse(s)
+	if err != nil || u.Host == "" || strings.Contains(s, "#") {
+		return false
+	}
+	switch u.Scheme {
+	case "https":
+		return true
+	case "http":
+		if u.Hostname() == "localhost" {
+			return true
+		}
+		ip := net.ParseIP(u.Hostname())
+		return ip != nil && ip.IsLoopback()
+	default:
+		return false
+	}
+}
+
+// redirectURL 함수는 리디렉션 URI에 쿼리 파라미터를 추가한다. 값이 빈 파라미터는 추가하지 않는다.
+func redirectURL(base string, params url.Values) string {
+	for k, v := range params {
+		if len(v) == 0 || v[0] == "" {
+			params.Del(k)
+		}
+	}
+	sep := "?"
+	if strings.Contains(base, "?") {
+		sep = "&"
+	}
+	return base + sep + params.Encode()
+}
+
+// verifyCodeChallenge 함수는 code_verifier의 SHA-256 해시가 code_challenge와 일치하는지 확인한다. (RFC 7636 4.6)
+func verifyCodeChallenge(verifier, challenge string) bool {
+	if len(verifier) < 43 || len(verifier) > 128 {
+		return false
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	got := base64.RawURLEncoding.EncodeToString(sum[:])
+	return subtle.ConstantTimeCompare([]byte(got), []byte(challenge)) == 1
+}
+
+// hashAuthorizationCode 함수는 Redis에 보관할 인가 코드의 해시를 만든다. Redis가 유출되어도 코드를 사용할 수 없다.
+func hashAuthorizationCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+// randomToken 함수는 n바이트 난수를 URL에 사용할 수 있는 문자열로 만든다.
+func randomToken(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
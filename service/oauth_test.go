@@ -0,0 +1,402 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	testVerifier    = "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+	testRedirectURI = "https://app.example.com/callback"
+)
+
+func testChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// newTestOAuth 함수는 공개 클라이언트 "public", 기밀 클라이언트 "confidential"(시크릿 "secret")과
+// 사용자 10(비밀번호 "test12345")이 있는 OAuth를 만든다.
+func newTestOAuth(t *testing.T) *OAuth {
+	t.Helper()
+
+	hash := auth.HashOAuthClientSecret("secret")
+	clients := map[entity.OAuthClientID]*entity.OAuthClient{
+		"public": {
+			ID: "public", UserID: 10, Name: "app",
+			RedirectURIs: entity.RedirectURIs{testRedirectURI},
+			Scopes:       entity.Scopes{entity.ScopeTasksRead, entity.ScopeTasksWrite},
+		},
+		"confidential": {
+			ID: "confidential", UserID: 10, Name: "report", SecretHash: &hash,
+			RedirectURIs: entity.RedirectURIs{testRedirectURI},
+			Scopes:       entity.Scopes{entity.ScopeTasksRead},
+		},
+	}
+	pw, err := bcrypt.GenerateFromPassword([]byte("test12345"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u := &entity.User{ID: 10, Name: "alice", Password: string(pw), Role: "user"}
+	repo := &OAuthRepositoryMock{
+		GetOAuthClientFunc: func(ctx context.Context, db store.Queryer, id entity.OAuthClientID) (*entity.OAuthClient, error) {
+			c, ok := clients[id]
+			if !ok {
+				return nil, store.ErrNotFound
+			}
+			return c, nil
+		},
+		GetUserFunc: func(ctx context.Context, db store.Queryer, name string) (*entity.User, error) {
+			if name != u.Name {
+				return nil, store.ErrNotFound
+			}
+			return u, nil
+		},
+		GetUserByIDFunc: func(ctx context.Context, db store.Queryer, id entity.UserID) (*entity.User, error) {
+			return u, nil
+		},
+	}
+	codes := map[string]*entity.AuthorizationCode{}
+	cs := &AuthorizationCodeStoreMock{
+		SaveAuthorizationCodeFunc: func(ctx context.Context, key string, c *entity.AuthorizationCode, ttl time.Duration) error {
+			codes[key] = c
+			return nil
+		},
+		UseAuthorizationCodeFunc: func(ctx context.Context, key string) (*entity.AuthorizationCode, error) {
+			c, ok := codes[key]
+			if !ok {
+				return nil, store.ErrNotFound
+			}
+			delete(codes, key)
+			return c, nil
+		},
+	}
+	tokens := &OAuthTokensMock{
+		GenerateOAuthTokenFunc: func(
+			ctx context.Context, u entity.User, cid entity.OAuthClientID, scopes entity.Scopes,
+		) ([]byte, error) {
+			return []byte(string(cid) + ":" + scopes.Join()), nil
+		},
+	}
+	return &OAuth{Repo: repo, Codes: cs, Tokens: tokens, CodeTTL: time.Minute, TokenTTL: 30 * time.Minute}
+}
+
+func TestOAuth_Authorize(t *testing.T) {
+	t.Parallel()
+
+	valid := entity.AuthorizationRequest{
+		ResponseType:        "code",
+		ClientID:            "public",
+		RedirectURI:         testRedirectURI,
+		Scope:               "tasks:read",
+		State:               "xyz",
+		CodeChallenge:       testChallenge(testVerifier),
+		CodeChallengeMethod: "S256",
+	}
+	tests := map[string]struct {
+		modify       func(r *entity.AuthorizationRequest)
+		wantScopes   entity.Scopes
+		wantCode     string
+		wantRedirect bool
+	}{
+		"ok": {
+			wantScopes: entity.Scopes{entity.ScopeTasksRead},
+		},
+		"defaultScope": {
+			modify:     func(r *entity.AuthorizationRequest) { r.Scope = "" },
+			wantScopes: entity.Scopes{entity.ScopeTasksRead, entity.ScopeTasksWrite},
+		},
+		// 클라이언트나 리디렉션 URI를 확인할 수 없으면 돌려보내지 않는다.
+		"unknownClient": {
+			modify:   func(r *entity.AuthorizationRequest) { r.ClientID = "unknown" },
+			wantCode: OAuthInvalidRequest,
+		},
+		"unregisteredRedirectURI": {
+			modify:   func(r *entity.AuthorizationRequest) { r.RedirectURI = "https://evil.example.com/callback" },
+			wantCode: OAuthInvalidRequest,
+		},
+		"token": {
+			modify:       func(r *entity.AuthorizationRequest) { r.ResponseType = "token" },
+			wantCode:     OAuthUnsupportedResponseType,
+			wantRedirect: true,
+		},
+		"scopeNotAllowed": {
+			modify:       func(r *entity.AuthorizationRequest) { r.ClientID, r.Scope = "confidential", "tasks:write" },
+			wantCode:     OAuthInvalidScope,
+			wantRedirect: true,
+		},
+		"noPKCE": {
+			modify:       func(r *entity.AuthorizationRequest) { r.CodeChallenge, r.CodeChallengeMethod = "", "" },
+			wantCode:     OAuthInvalidRequest,
+			wantRedirect: true,
+		},
+		"plainPKCE": {
+			modify:       func(r *entity.AuthorizationRequest) { r.CodeChallengeMethod = "plain" },
+			wantCode:     OAuthInvalidRequest,
+			wantRedirect: true,
+		},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			req := valid
+			if tt.modify != nil {
+				tt.modify(&req)
+			}
+			sut := newTestOAuth(t)
+			c, scopes, err := sut.Authorize(context.Background(), &req)
+			if tt.wantCode == "" {
+				if err != nil {
+					t.Fatalf("want no error, but got %v", err)
+				}
+				if c.ID != req.ClientID {
+					t.Errorf("want client %q, but got %q", req.ClientID, c.ID)
+				}
+				if diff := cmp.Diff(scopes, tt.wantScopes); diff != "" {
+					t.Errorf("scopes differs: (-got +want)\n%s", diff)
+				}
+				return
+			}
+			var oe *OAuthError
+			if !errors.As(err, &oe) || oe.Code != tt.wantCode {
+				t.Fatalf("want OAuthError %q, but got %v", tt.wantCode, err)
+			}
+			if !tt.wantRedirect {
+				if oe.RedirectURI != "" {
+					t.Errorf("want no redirect, but got %q", oe.RedirectURI)
+				}
+				return
+			}
+			u, err := url.Parse(oe.RedirectURI)
+			if err != nil {
+				t.Fatal(err)
+			}
+			q := u.Query()
+			if !strings.HasPrefix(oe.RedirectURI, testRedirectURI+"?") || q.Get("error") != tt.wantCode || q.Get("state") != "xyz" {
+				t.Errorf("want redirect with error %q and state, but got %q", tt.wantCode, oe.RedirectURI)
+			}
+		})
+	}
+}
+
+func TestOAuth_ExchangeAuthorizationCode(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sut := newTestOAuth(t)
+	req := &entity.AuthorizationRequest{
+		ResponseType:        "code",
+		ClientID:            "public",
+		RedirectURI:         testRedirectURI,
+		Scope:               "tasks:read",
+		CodeChallenge:       testChallenge(testVerifier),
+		CodeChallengeMethod: "S256",
+	}
+	if _, err := sut.Approve(ctx, req, "alice", "wrong"); !errors.Is(err, ErrInvalidCredentials) {
+		t.Fatalf("want ErrInvalidCredentials, but got %v", err)
+	}
+	approve := func(t *testing.T) string {
+		t.Helper()
+		loc, err := sut.Approve(ctx, req, "alice", "test12345")
+		if err != nil {
+			t.Fatalf("want no error, but got %v", err)
+		}
+		u, err := url.Parse(loc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return u.Query().Get("code")
+	}
+
+	code := approve(t)
+	// 다른 클라이언트, 다른 리디렉션 URI, 틀린 verifier로는 교환할 수 없고, 실패한 코드는 다시 사용할 수 없다.
+	for n, tt := range map[string]struct {
+		cid, secret, redirect, verifier string
+		wantCode                        string
+	}{
+		"otherClient":  {cid: "confidential", secret: "secret", redirect: testRedirectURI, verifier: testVerifier, wantCode: OAuthInvalidGrant},
+		"wrongSecret":  {cid: "confidential", secret: "wrong", redirect: testRedirectURI, verifier: testVerifier, wantCode: OAuthInvalidClient},
+		"publicSecret": {cid: "public", secret: "secret", redirect: testRedirectURI, verifier: testVerifier, wantCode: OAuthInvalidClient},
+		"redirect":     {cid: "public", redirect: "https://app.example.com/other", verifier: testVerifier, wantCode: OAuthInvalidGrant},
+		"verifier":     {cid: "public", redirect: testRedirectURI, verifier: strings.Repeat("a", 43), wantCode: OAuthInvalidGrant},
+	} {
+		_, err := sut.ExchangeAuthorizationCode(ctx, entity.OAuthClientID(tt.cid), tt.secret, code, tt.redirect, tt.verifier)
+		var oe *OAuthError
+		if !errors.As(err, &oe) || oe.Code != tt.wantCode {
+			t.Errorf("%s: want OAuthError %q, but got %v", n, tt.wantCode, err)
+		}
+		if tt.wantCode == OAuthInvalidGrant {
+			code = approve(t)
+		}
+	}
+
+	got, err := sut.ExchangeAuthorizationCode(ctx, "public", "", code, testRedirectURI, testVerifier)
+	if err != nil {
+		t.Fatalf("want no error, but got %v", err)
+	}
+	want := &entity.OAuthToken{AccessToken: "public:tasks:read", TokenType: "Bearer", ExpiresIn: 1800, Scope: "tasks:read"}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("differs: (-got +want)\n%s", diff)
+	}
+	// 코드는 한 번만 사용할 수 있다.
+	_, err = sut.ExchangeAuthorizationCode(ctx, "public", "", code, testRedirectURI, testVerifier)
+	var oe *OAuthError
+	if !errors.As(err, &oe) || oe.Code != OAuthInvalidGrant {
+		t.Errorf("want OAuthError %q, but got %v", OAuthInvalidGrant, err)
+	}
+}
+
+func TestOAuth_ClientCredentialsGrant(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		cid, secret, scope string
+		want               *entity.OAuthToken
+		wantCode           string
+	}{
+		"ok": {
+			cid: "confidential", secret: "secret",
+			want: &entity.OAuthToken{AccessToken: "confidential:tasks:read", TokenType: "Bearer", ExpiresIn: 1800, Scope: "tasks:read"},
+		},
+		"wrongSecret":     {cid: "confidential", secret: "wrong", wantCode: OAuthInvalidClient},
+		"unknownClient":   {cid: "unknown", secret: "secret", wantCode: OAuthInvalidClient},
+		"publicClient":    {cid: "public", wantCode: OAuthUnauthorizedClient},
+		"scopeNotAllowed": {cid: "confidential", secret: "secret", scope: "tasks:write", wantCode: OAuthInvalidScope},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			sut := newTestOAuth(t)
+			got, err := sut.ClientCredentialsGrant(context.Background(), entity.OAuthClientID(tt.cid), tt.secret, tt.scope)
+			if tt.wantCode != "" {
+				var oe *OAuthError
+				if !errors.As(err, &oe) || oe.Code != tt.wantCode {
+					t.Fatalf("want OAuthError %q, but got %v", tt.wantCode, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("want no error, but got %v", err)
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("differs: (-got +want)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOAuth_IntrospectToken(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	claims := &entity.TokenClaims{
+		ID: "jti", UserID: 10, UserName: "alice", ClientID: "public",
+		Scopes: entity.Scopes{entity.ScopeTasksRead}, IssuedAt: now, Expires: now.Add(30 * time.Minute),
+	}
+	tests := map[string]struct {
+		cid         string
+		token       string
+		want        *entity.TokenIntrospection
+		wantRevoked bool
+		wantCode    string
+	}{
+		"active": {
+			cid: "public", token: "valid",
+			want: &entity.TokenIntrospection{
+				Active: true, Scope: "tasks:read", ClientID: "public", Username: "alice", Subject: "10",
+				TokenType: "Bearer", Expires: now.Add(30 * time.Minute).Unix(), IssuedAt: now.Unix(),
+			},
+			wantRevoked: true,
+		},
+		"invalid": {
+			cid: "public", token: "invalid",
+			want: &entity.TokenIntrospection{Active: false},
+		},
+		// 다른 클라이언트에 발급한 토큰은 확인하거나 폐기할 수 없다.
+		"otherClient": {
+			cid: "confidential", token: "valid",
+			want:     &entity.TokenIntrospection{Active: false},
+			wantCode: OAuthUnauthorizedClient,
+		},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			sut := newTestOAuth(t)
+			tokens := &OAuthTokensMock{
+				InspectTokenFunc: func(ctx context.Context, raw string) (*entity.TokenClaims, error) {
+					if raw != "valid" {
+						return nil, errors.New("invalid token")
+					}
+					return claims, nil
+				},
+				RevokeTokenFunc: func(ctx context.Context, jti string) error {
+					return nil
+				},
+			}
+			sut.Tokens = tokens
+			secret := ""
+			if tt.cid == "confidential" {
+				secret = "secret"
+			}
+			ctx := context.Background()
+			got, err := sut.IntrospectToken(ctx, entity.OAuthClientID(tt.cid), secret, tt.token)
+			if err != nil {
+				t.Fatalf("want no error, but got %v", err)
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("differs: (-got +want)\n%s", diff)
+			}
+
+			err = sut.RevokeToken(ctx, entity.OAuthClientID(tt.cid), secret, tt.token)
+			var oe *OAuthError
+			if tt.wantCode != "" && (!errors.As(err, &oe) || oe.Code != tt.wantCode) {
+				t.Errorf("want OAuthError %q, but got %v", tt.wantCode, err)
+			}
+			if tt.wantCode == "" && err != nil {
+				t.Errorf("want no error, but got %v", err)
+			}
+			if got := len(tokens.RevokeTokenCalls()) == 1; got != tt.wantRevoked {
+				t.Errorf("want revoked %t, but got %t", tt.wantRevoked, got)
+			}
+		})
+	}
+}
+
+func TestValidRedirectURI(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]bool{
+		"https://app.example.com/callback":     true,
+		"https://app.example.com/cb?from=todo": true,
+		"http://localhost:8080/callback":       true,
+		"http://127.0.0.1:53682/":              true,
+		"http://app.example.com/callback":      false,
+		"https://app.example.com/callback#x":   false,
+		"/callback":                            false,
+		"javascript:alert(1)":                  false,
+	}
+	for u, want := range tests {
+		if got := validRedirectURI(u); got != want {
+			t.Errorf("validRedirectURI(%q): want %t, but got %t", u, want, got)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/mail"
+	"github.com/gitwub5/go_todo_app/store"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// ErrInvalidResetToken은 패스워드 재설정 토큰이 없거나 만료되었을 때 반환된다.
+var ErrInvalidResetToken = errors.New("invalid or expired reset token")
+
+const (
+	// passwordResetTTL은 패스워드 재설정 토큰의 유효 기간이다.
+	passwordResetTTL = 30 * time.Minute
+	// passwordResetValidFor는 메일 본문에 적는 passwordResetTTL이다.
+	passwordResetValidFor = "30 minutes"
+)
+
+func passwordResetKey(token string) string {
+	return "password_reset:" + token
+}
+
+// PasswordReset은 메일로 보낸 토큰을 사용해 패스워드를 재설정한다.
+type PasswordReset struct {
+	DB       store.ExecQueryer
+	Repo     PasswordResetRepository
+	Store    TokenStore
+	Sessions SessionStore
+	Mailer   Mailer
+}
+
+// RequestReset 메서드는 사용자에게 패스워드 재설정 토큰을 메일로 보낸다.
+// 사용자 존재 여부가 드러나지 않도록, 사용자가 없거나 메일 주소가 없으면 아무것도 하지 않고 성공한다.
+// 메일 전송에 실패해도 같은 이유로 성공한다.
+func (p *PasswordReset) RequestReset(ctx context.Context, name string) error {
+	u, err := p.Repo.GetUser(ctx, p.DB, name)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	if u.Email == nil || *u.Email == "" {
+		return nil
+	}
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return err
+	}
+	token := hex.EncodeToString(b)
+	if err := p.Store.Save(ctx, passwordResetKey(token), u.ID, passwordResetTTL); err != nil {
+		return fmt.Errorf("failed to save token: %w", err)
+	}
+	msg, err := mail.Render(mail.TemplatePasswordReset, *u.Email, struct {
+		Name     string
+		Token    string
+		ValidFor string
+	}{u.Name, token, passwordResetValidFor})
+	if err != nil {
+		return err
+	}
+	// 전송 실패를 응답하면 메일 주소가 있는 사용자가 드러나므로, 기록만 하고 성공으로 처리한다.
+	if err := p.Mailer.Send(ctx, msg); err != nil {
+		log.Printf("failed to send password reset mail to user %d: %v", u.ID, err)
+	}
+	return nil
+}
+
+// ResetPassword 메서드는 토큰을 확인하고 패스워드를 변경한다. 토큰은 한 번만 사용할 수 있다.
+// 이전 패스워드로 로그인한 세션은 모두 삭제해, 세션의 액세스 토큰과 리프레시 토큰을 폐기한다.
+func (p *PasswordReset) ResetPassword(ctx context.Context, token, password string) error {
+	id, err := p.Store.Take(ctx, passwordResetKey(token))
+	if err != nil {
+		return ErrInvalidResetToken
+	}
+	pw, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("cannot hash password: %w", err)
+	}
+	if err := p.Repo.UpdateUserPassword(ctx, p.DB, id, string(pw)); err != nil {
+		return fmt.Errorf("failed to update password: %w", err)
+	}
+	if _, err := deleteSessions(ctx, p.Sessions, id); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/clock"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+)
+
+var (
+	// ErrUnknownScope는 허용할 수 없는 범위를 지정했을 때 반환된다.
+	ErrUnknownScope = errors.New("unknown scope")
+	// ErrInvalidExpiry는 이미 지난 만료 시간을 지정했을 때 반환된다.
+	ErrInvalidExpiry = errors.New("expiry must be in the future")
+)
+
+// lastUsedInterval은 개인 액세스 토큰을 마지막으로 사용한 시간을 기록하는 최소 간격이다.
+// 스크립트가 요청을 연달아 보내도 요청마다 RDBMS에 쓰지 않도록 한다.
+const lastUsedInterval = time.Minute
+
+// PersonalAccessTokens는 사용자의 개인 액세스 토큰을 발급, 조회, 폐기하고 검증한다.
+type PersonalAccessTokens struct {
+	DB      store.ExecQueryer
+	Repo    PersonalAccessTokenRepository
+	Clocker clock.Clocker
+}
+
+// CreatePersonalAccessToken 메서드는 개인 액세스 토큰을 발급한다. expires가 nil이면 만료되지 않는다.
+// 토큰은 이 메서드의 반환값으로만 확인할 수 있다.
+func (p *PersonalAccessTokens) CreatePersonalAccessToken(
+	ctx context.Context, name string, scopes entity.Scopes, expires *time.Time,
+) (*entity.PersonalAccessToken, string, error) {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return nil, "", fmt.Errorf("user_id not found")
+	}
+	for _, s := range scopes {
+		if !entity.AllScopes.Has(s) {
+			return nil, "", fmt.Errorf("%q: %w", s, ErrUnknownScope)
+		}
+	}
+	if expires != nil && !expires.After(p.Clocker.Now()) {
+		return nil, "", ErrInvalidExpiry
+	}
+	token, hash, err := auth.NewPersonalAccessToken()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to generate token: %w", err)
+	}
+	t := &entity.PersonalAccessToken{
+		UserID:    id,
+		Name:      name,
+		TokenHash: hash,
+		Scopes:    scopes,
+		Expires:   expires,
+	}
+	if err := p.Repo.AddPersonalAccessToken(ctx, p.DB, t); err != nil {
+		return nil, "", fmt.Errorf("failed to register: %w", err)
+	}
+	return t, token, nil
+}
+
+func (p *PersonalAccessTokens) ListPersonalAccessTokens(ctx context.Context) (entity.PersonalAccessTokens, error) {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("user_id not found")
+	}
+	ts, err := p.Repo.ListPersonalAccessTokens(ctx, p.DB, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list: %w", err)
+	}
+	return ts, nil
+}
+
+// DeletePersonalAccessToken 메서드는 개인 액세스 토큰을 폐기한다. 다른 사용자의 토큰이면 store.ErrNotFound를 반환한다.
+func (p *PersonalAccessTokens) DeletePersonalAccessToken(ctx context.Context, tid entity.PersonalAccessTokenID) error {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return fmt.Errorf("user_id not found")
+	}
+	if err := p.Repo.DeletePersonalAccessToken(ctx, p.DB, id, tid); err != nil {
+		return fmt.Errorf("failed to delete: %w", err)
+	}
+	return nil
+}
+
+// VerifyPersonalAccessToken 메서드는 개인 액세스 토큰을 검증하고, 토큰과 토큰의 소유자를 반환한다.
+// 토큰이 없거나 만료되었으면 auth.ErrInvalidPersonalAccessToken, 소유자가 비활성화되었으면 ErrUserDisabled를 반환한다.
+func (p *PersonalAccessTokens) VerifyPersonalAccessToken(
+	ctx context.Context, token string,
+) (*entity.PersonalAccessToken, *entity.User, error) {
+	t, err := p.Repo.GetPersonalAccessTokenByHash(ctx, p.DB, auth.HashPersonalAccessToken(token))
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, nil, auth.ErrInvalidPersonalAccessToken
+		}
+		return nil, nil, fmt.Errorf("failed to get token: %w", err)
+	}
+	if t.Expired(p.Clocker.Now()) {
+		return nil, nil, auth.ErrInvalidPersonalAccessToken
+	}
+	// 토큰을 발급한 뒤에 바뀐 역할과 비활성화 여부를 반영한다.
+	u, err := p.Repo.GetUserByID(ctx, p.DB, t.UserID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if u.Disabled != nil {
+		return nil, nil, ErrUserDisabled
+	}
+	// 사용 시간을 기록하지 못해도 요청은 처리한다.
+	if err := p.Repo.TouchPersonalAccessToken(ctx, p.DB, t.ID, lastUsedInterval); err != nil {
+		log.Printf("failed to record last use of personal access token %d: %v", t.ID, err)
+	}
+	return t, u, nil
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/clock"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+)
+
+func TestPersonalAccessTokens_CreatePersonalAccessToken(t *testing.T) {
+	t.Parallel()
+
+	now := clock.FixedClocker{}.Now()
+	past, future := now.Add(-time.Hour), now.AddDate(0, 1, 0)
+	tests := map[string]struct {
+		scopes  entity.Scopes
+		expires *time.Time
+		wantErr error
+	}{
+		"ok":           {scopes: entity.Scopes{entity.ScopeTasksRead}, expires: &future},
+		"noExpiry":     {scopes: entity.AllScopes},
+		"unknownScope": {scopes: entity.Scopes{"admin"}, wantErr: ErrUnknownScope},
+		"expired":      {scopes: entity.Scopes{entity.ScopeTasksRead}, expires: &past, wantErr: ErrInvalidExpiry},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			var saved *entity.PersonalAccessToken
+			repo := &PersonalAccessTokenRepositoryMock{
+				AddPersonalAccessTokenFunc: func(ctx context.Context, db store.Execer, pat *entity.PersonalAccessToken) error {
+					pat.ID = 1
+					saved = pat
+					return nil
+				},
+			}
+			sut := &PersonalAccessTokens{Repo: repo, Clocker: clock.FixedClocker{}}
+			ctx := auth.SetUserID(context.Background(), 10)
+			got, token, err := sut.CreatePersonalAccessToken(ctx, "ci", tt.scopes, tt.expires)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("want error %v, but got %v", tt.wantErr, err)
+			}
+			if tt.wantErr != nil {
+				if len(repo.AddPersonalAccessTokenCalls()) != 0 {
+					t.Error("want no token to be saved")
+				}
+				return
+			}
+			if !strings.HasPrefix(token, auth.PersonalAccessTokenPrefix) {
+				t.Errorf("want token with prefix %q, but got %q", auth.PersonalAccessTokenPrefix, token)
+			}
+			// 토큰 자체는 저장하지 않고 해시만 저장한다.
+			if saved.TokenHash != auth.HashPersonalAccessToken(token) || strings.Contains(saved.TokenHash, token) {
+				t.Errorf("want hash of token to be saved, but got %q", saved.TokenHash)
+			}
+			if got.UserID != 10 || got.ID != 1 {
+				t.Errorf("want token 1 of user 10, but got %d of user %d", got.ID, got.UserID)
+			}
+		})
+	}
+}
+
+func TestPersonalAccessTokens_VerifyPersonalAccessToken(t *testing.T) {
+	t.Parallel()
+
+	now := clock.FixedClocker{}.Now()
+	past, future := now.Add(-time.Second), now.Add(time.Second)
+	tests := map[string]struct {
+		token     *entity.PersonalAccessToken
+		user      *entity.User
+		touchErr  error
+		wantErr   error
+		wantTouch bool
+	}{
+		"ok": {
+			token:     &entity.PersonalAccessToken{ID: 1, UserID: 10, Expires: &future},
+			user:      &entity.User{ID: 10, Role: "user"},
+			wantTouch: true,
+		},
+		// 사용 시간을 기록하지 못해도 토큰은 사용할 수 있다.
+		"touchFailed": {
+			token:     &entity.PersonalAccessToken{ID: 1, UserID: 10},
+			user:      &entity.User{ID: 10, Role: "user"},
+			touchErr:  errors.New("error from mock"),
+			wantTouch: true,
+		},
+		"notFound": {
+			wantErr: auth.ErrInvalidPersonalAccessToken,
+		},
+		"expired": {
+			token:   &entity.PersonalAccessToken{ID: 1, UserID: 10, Expires: &past},
+			wantErr: auth.ErrInvalidPersonalAccessToken,
+		},
+		"disabled": {
+			token:   &entity.PersonalAccessToken{ID: 1, UserID: 10},
+			user:    &entity.User{ID: 10, Role: "user", Disabled: &past},
+			wantErr: ErrUserDisabled,
+		},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &PersonalAccessTokenRepositoryMock{
+				GetPersonalAccessTokenByHashFunc: func(ctx context.Context, db store.Queryer, hash string) (*entity.PersonalAccessToken, error) {
+					if hash != auth.HashPersonalAccessToken("todo_pat_token") {
+						t.Errorf("want hash of token, but got %q", hash)
+					}
+					if tt.token == nil {
+						return nil, store.ErrNotFound
+					}
+					return tt.token, nil
+				},
+				GetUserByIDFunc: func(ctx context.Context, db store.Queryer, id entity.UserID) (*entity.User, error) {
+					return tt.user, nil
+				},
+				TouchPersonalAccessTokenFunc: func(ctx context.Context, db store.Execer, id entity.PersonalAccessTokenID, interval time.Duration) error {
+					return tt.touchErr
+				},
+			}
+			sut := &PersonalAccessTokens{Repo: repo, Clocker: clock.FixedClocker{}}
+			pat, u, err := sut.VerifyPersonalAccessToken(context.Background(), "todo_pat_token")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("want error %v, but got %v", tt.wantErr, err)
+			}
+			if got := len(repo.TouchPersonalAccessTokenCalls()) == 1; got != tt.wantTouch {
+				t.Errorf("want touch %t, but got %t", tt.wantTouch, got)
+			}
+			if tt.wantErr == nil && (pat != tt.token || u != tt.user) {
+				t.Errorf("want token and user, but got %v, %v", pat, u)
+			}
+		})
+	}
+}
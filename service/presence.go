@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/clock"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+)
+
+// DefaultPresenceTTL은 갱신하지 않은 presence가 사라지기까지의 기본 시간이다.
+const DefaultPresenceTTL = 30 * time.Second
+
+// Presence는 프로젝트 보드를 보고 있는 사용자를 관리한다. 프로젝트 멤버만 보드를 볼 수 있다.
+type Presence struct {
+	DB      store.Queryer
+	Repo    PresenceRepository
+	Store   PresenceStore
+	Clocker clock.Clocker
+	// TTL은 Join으로 갱신하지 않으면 presence가 사라지기까지의 시간이다.
+	TTL time.Duration
+}
+
+// Join 메서드는 현재 사용자의 세션이 프로젝트 보드를 보고 있다고 기록하고, 보드를 보고 있는 사용자 목록을 반환한다.
+// presence를 유지하려면 TTL보다 짧은 주기로 다시 호출해야 한다.
+// 호출할 때마다 멤버인지 확인하므로, 프로젝트에서 제외되면 더 이상 갱신할 수 없다.
+func (p *Presence) Join(ctx context.Context, pid entity.ProjectID, session string) ([]entity.UserID, error) {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("user_id not found")
+	}
+	member, err := p.Repo.IsProjectMember(ctx, p.DB, pid, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check member: %w", err)
+	}
+	// 멤버가 아닌 사용자에게는 프로젝트가 있는지 드러내지 않는다.
+	if !member {
+		return nil, fmt.Errorf("project %d: %w", pid, store.ErrNotFound)
+	}
+	now := p.Clocker.Now()
+	ttl := p.TTL
+	if ttl <= 0 {
+		ttl = DefaultPresenceTTL
+	}
+	if err := p.Store.TouchPresence(ctx, pid, id, session, now.Add(ttl)); err != nil {
+		return nil, fmt.Errorf("failed to touch presence: %w", err)
+	}
+	uids, err := p.Store.ListPresence(ctx, pid, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list presence: %w", err)
+	}
+	return uids, nil
+}
+
+// Leave 메서드는 현재 사용자의 세션이 프로젝트 보드를 더 이상 보고 있지 않다고 기록한다.
+func (p *Presence) Leave(ctx context.Context, pid entity.ProjectID, session string) error {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return fmt.Errorf("user_id not found")
+	}
+	if err := p.Store.RemovePresence(ctx, pid, id, session); err != nil {
+		return fmt.Errorf("failed to remove presence: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,208 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+)
+
+var (
+	// ErrNotProjectOwner는 프로젝트 소유자만 할 수 있는 작업을 다른 멤버가 요청했을 때 반환된다.
+	ErrNotProjectOwner = errors.New("not project owner")
+	// ErrUnknownMember는 멤버로 추가하려는 사용자가 존재하지 않을 때 반환된다.
+	ErrUnknownMember = errors.New("unknown member")
+	// ErrRemoveProjectOwner는 프로젝트 소유자를 멤버에서 제외하려고 할 때 반환된다.
+	ErrRemoveProjectOwner = errors.New("cannot remove project owner")
+)
+
+// Projects는 프로젝트와 멤버를 관리하고, Task를 프로젝트에 넣거나 뺀다.
+// 프로젝트 멤버끼리는 서로에게 Task를 담당자로 지정할 수 있다.
+type Projects struct {
+	DB        store.TxExecQueryer
+	Repo      ProjectRepository
+	Publisher EventPublisher // Task 변경 이벤트를 전달한다. nil이면 전달하지 않는다.
+}
+
+// AddProject 메서드는 프로젝트를 만들고 요청한 사용자를 소유자이자 멤버로 등록한다.
+func (p *Projects) AddProject(ctx context.Context, name string) (*entity.Project, error) {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("user_id not found")
+	}
+	tx, err := p.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin: %w", err)
+	}
+	// Commit 이후의 Rollback은 아무것도 하지 않는다.
+	defer func() { _ = tx.Rollback() }()
+
+	pj := &entity.Project{OwnerID: id, Name: name}
+	if err := p.Repo.AddProject(ctx, tx, pj); err != nil {
+		return nil, fmt.Errorf("failed to register: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit: %w", err)
+	}
+	return pj, nil
+}
+
+// ListProjects 메서드는 사용자가 멤버인 프로젝트 목록을 반환한다.
+func (p *Projects) ListProjects(ctx context.Context) (entity.Projects, error) {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("user_id not found")
+	}
+	ps, err := p.Repo.ListProjects(ctx, p.DB, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list: %w", err)
+	}
+	return ps, nil
+}
+
+// ListProjectMembers 메서드는 프로젝트 멤버의 ID 목록을 반환한다. 멤버만 조회할 수 있다.
+func (p *Projects) ListProjectMembers(ctx context.Context, pid entity.ProjectID) ([]entity.UserID, error) {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("user_id not found")
+	}
+	if _, err := p.Repo.GetProject(ctx, p.DB, id, pid); err != nil {
+		return nil, fmt.Errorf("failed to get: %w", err)
+	}
+	uids, err := p.Repo.ListProjectMembers(ctx, p.DB, pid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list: %w", err)
+	}
+	return uids, nil
+}
+
+// AddProjectMember 메서드는 프로젝트에 멤버를 추가한다. 프로젝트 소유자만 추가할 수 있다.
+func (p *Projects) AddProjectMember(ctx context.Context, pid entity.ProjectID, uid entity.UserID) error {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return fmt.Errorf("user_id not found")
+	}
+	pj, err := p.Repo.GetProject(ctx, p.DB, id, pid)
+	if err != nil {
+		return fmt.Errorf("failed to get: %w", err)
+	}
+	if pj.OwnerID != id {
+		return fmt.Errorf("project %d: %w", pid, ErrNotProjectOwner)
+	}
+	if _, err := p.Repo.GetUserByID(ctx, p.DB, uid); err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return fmt.Errorf("user %d: %w", uid, ErrUnknownMember)
+		}
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	if err := p.Repo.AddProjectMember(ctx, p.DB, pid, uid); err != nil {
+		return fmt.Errorf("failed to add member: %w", err)
+	}
+	return nil
+}
+
+// RemoveProjectMember 메서드는 프로젝트에서 멤버를 제외한다.
+// 소유자는 다른 멤버를 제외할 수 있고, 멤버는 스스로 프로젝트에서 나갈 수 있다.
+// 제외된 멤버가 담당하던 프로젝트의 Task는 같은 트랜잭션에서 담당자를 해제한다.
+func (p *Projects) RemoveProjectMember(ctx context.Context, pid entity.ProjectID, uid entity.UserID) error {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return fmt.Errorf("user_id not found")
+	}
+	tx, err := p.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin: %w", err)
+	}
+	// Commit 이후의 Rollback은 아무것도 하지 않는다.
+	defer func() { _ = tx.Rollback() }()
+
+	pj, err := p.Repo.GetProject(ctx, tx, id, pid)
+	if err != nil {
+		return fmt.Errorf("failed to get: %w", err)
+	}
+	if uid == pj.OwnerID {
+		return fmt.Errorf("project %d: %w", pid, ErrRemoveProjectOwner)
+	}
+	if uid != id && pj.OwnerID != id {
+		return fmt.Errorf("project %d: %w", pid, ErrNotProjectOwner)
+	}
+	if err := p.Repo.DeleteProjectMember(ctx, tx, pid, uid); err != nil {
+		return fmt.Errorf("failed to remove member: %w", err)
+	}
+	ts, err := p.Repo.ListProjectTasks(ctx, tx, pid)
+	if err != nil {
+		return fmt.Errorf("failed to list tasks: %w", err)
+	}
+	unassigned := entity.Tasks{}
+	for _, t := range ts {
+		if t.AssigneeID == nil || *t.AssigneeID != uid || t.UserID == uid {
+			continue
+		}
+		t.AssigneeID = nil
+		if err := p.Repo.AssignTask(ctx, tx, t); err != nil {
+			return fmt.Errorf("failed to unassign: %w", err)
+		}
+		unassigned = append(unassigned, t)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit: %w", err)
+	}
+	for _, t := range unassigned {
+		publish(ctx, p.Publisher, entity.EventTaskUpdated, t)
+	}
+	return nil
+}
+
+// SetTaskProject 메서드는 Task를 프로젝트에 넣는다. pid가 nil이면 프로젝트에서 뺀다.
+// Task의 소유자가 멤버인 프로젝트에만 넣을 수 있고, 하위 Task는 함께 옮기지 않는다.
+// 담당자가 옮긴 프로젝트의 멤버가 아니면 담당자를 해제한다.
+func (p *Projects) SetTaskProject(
+	ctx context.Context, tid entity.TaskID, pid *entity.ProjectID,
+) (*entity.Task, error) {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("user_id not found")
+	}
+	tx, err := p.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin: %w", err)
+	}
+	// Commit 이후의 Rollback은 아무것도 하지 않는다.
+	defer func() { _ = tx.Rollback() }()
+
+	t, err := p.Repo.GetTask(ctx, tx, id, tid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get: %w", err)
+	}
+	if pid != nil {
+		if _, err := p.Repo.GetProject(ctx, tx, id, *pid); err != nil {
+			return nil, fmt.Errorf("failed to get project: %w", err)
+		}
+	}
+	t.ProjectID = pid
+	if err := p.Repo.SetTaskProject(ctx, tx, t); err != nil {
+		return nil, fmt.Errorf("failed to move: %w", err)
+	}
+	if t.AssigneeID != nil && *t.AssigneeID != id {
+		member := false
+		if pid != nil {
+			if member, err = p.Repo.IsProjectMember(ctx, tx, *pid, *t.AssigneeID); err != nil {
+				return nil, fmt.Errorf("failed to check member: %w", err)
+			}
+		}
+		if !member {
+			t.AssigneeID = nil
+			if err := p.Repo.AssignTask(ctx, tx, t); err != nil {
+				return nil, fmt.Errorf("failed to unassign: %w", err)
+			}
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit: %w", err)
+	}
+	publish(ctx, p.Publisher, entity.EventTaskUpdated, t)
+	return t, nil
+}
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/jmoiron/sqlx"
+)
+
+func TestProjects_RemoveProjectMember(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		caller     entity.UserID
+		member     entity.UserID
+		wantErr    error
+		unassigned []entity.TaskID
+	}{
+		// 제외된 멤버가 담당하던 다른 사용자의 Task만 담당자를 해제한다.
+		"owner":      {caller: 10, member: 20, unassigned: []entity.TaskID{1}},
+		"leave":      {caller: 20, member: 20, unassigned: []entity.TaskID{1}},
+		"notOwner":   {caller: 30, member: 20, wantErr: ErrNotProjectOwner},
+		"removeSelf": {caller: 10, member: 10, wantErr: ErrRemoveProjectOwner},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			t.Cleanup(func() { _ = db.Close() })
+			mock.ExpectBegin()
+			if tt.wantErr == nil {
+				mock.ExpectCommit()
+			} else {
+				mock.ExpectRollback()
+			}
+
+			pid := entity.ProjectID(5)
+			member := tt.member
+			moq := &ProjectRepositoryMock{}
+			moq.GetProjectFunc = func(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.ProjectID) (*entity.Project, error) {
+				return &entity.Project{ID: id, OwnerID: 10, Name: "release"}, nil
+			}
+			moq.DeleteProjectMemberFunc = func(ctx context.Context, db store.Execer, id entity.ProjectID, uid entity.UserID) error {
+				return nil
+			}
+			moq.ListProjectTasksFunc = func(ctx context.Context, db store.Queryer, id entity.ProjectID) (entity.Tasks, error) {
+				return entity.Tasks{
+					{ID: 1, UserID: 10, ProjectID: &pid, AssigneeID: &member},
+					{ID: 2, UserID: 10, ProjectID: &pid},
+					// 스스로 소유한 Task는 그대로 둔다.
+					{ID: 3, UserID: member, ProjectID: &pid, AssigneeID: &member},
+				}, nil
+			}
+			var got []entity.TaskID
+			moq.AssignTaskFunc = func(ctx context.Context, db store.ExecQueryer, task *entity.Task) error {
+				if task.AssigneeID != nil {
+					t.Errorf("want unassigned, but got %d", *task.AssigneeID)
+				}
+				got = append(got, task.ID)
+				return nil
+			}
+
+			sut := &Projects{DB: sqlx.NewDb(db, "mysql"), Repo: moq}
+			ctx := auth.SetUserID(context.Background(), tt.caller)
+			err = sut.RemoveProjectMember(ctx, pid, tt.member)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("want %v, but got %v", tt.wantErr, err)
+			}
+			if len(got) != len(tt.unassigned) || (len(got) > 0 && got[0] != tt.unassigned[0]) {
+				t.Errorf("want unassigned %v, but got %v", tt.unassigned, got)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestProjects_SetTaskProject(t *testing.T) {
+	t.Parallel()
+
+	pid := entity.ProjectID(5)
+	tests := map[string]struct {
+		project      *entity.ProjectID
+		member       bool
+		wantAssignee bool
+	}{
+		"member":    {project: &pid, member: true, wantAssignee: true},
+		"notMember": {project: &pid},
+		// 프로젝트에서 빼면 다른 사용자는 더 이상 담당자일 수 없다.
+		"remove": {},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			t.Cleanup(func() { _ = db.Close() })
+			mock.ExpectBegin()
+			mock.ExpectCommit()
+
+			assignee := entity.UserID(20)
+			moq := &ProjectRepositoryMock{}
+			moq.GetTaskFunc = func(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.TaskID) (*entity.Task, error) {
+				return &entity.Task{ID: id, UserID: uid, AssigneeID: &assignee}, nil
+			}
+			moq.GetProjectFunc = func(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.ProjectID) (*entity.Project, error) {
+				return &entity.Project{ID: id, OwnerID: uid}, nil
+			}
+			moq.SetTaskProjectFunc = func(ctx context.Context, db store.Execer, t *entity.Task) error {
+				return nil
+			}
+			moq.IsProjectMemberFunc = func(ctx context.Context, db store.Queryer, id entity.ProjectID, uid entity.UserID) (bool, error) {
+				return tt.member, nil
+			}
+			moq.AssignTaskFunc = func(ctx context.Context, db store.ExecQueryer, t *entity.Task) error {
+				return nil
+			}
+
+			sut := &Projects{DB: sqlx.NewDb(db, "mysql"), Repo: moq}
+			ctx := auth.SetUserID(context.Background(), 10)
+			got, err := sut.SetTaskProject(ctx, 1, tt.project)
+			if err != nil {
+				t.Fatalf("want no error, but got %v", err)
+			}
+			if (got.AssigneeID != nil) != tt.wantAssignee {
+				t.Errorf("want assignee %v, but got %v", tt.wantAssignee, got.AssigneeID)
+			}
+			if got.ProjectID != tt.project {
+				t.Errorf("want project %v, but got %v", tt.project, got.ProjectID)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+)
+
+// RefreshToken은 리프레시 토큰을 교체하고 새 액세스 토큰을 발급한다.
+type RefreshToken struct {
+	DB     store.Queryer
+	Repo   UserByIDGetter
+	Tokens TokenRotator
+	// Sessions는 비활성화된 사용자의 세션을 삭제한다.
+	Sessions SessionStore
+}
+
+// Refresh 메서드는 리프레시 토큰을 같은 세션의 새 토큰으로 교체하고, 현재 사용자 정보로 액세스 토큰을 발급한다.
+// 토큰이 올바르지 않거나 다시 사용되었으면 auth.ErrInvalidRefreshToken, auth.ErrRefreshTokenReused를 반환한다.
+// 사용자가 비활성화되었으면 교체한 토큰을 다시 사용할 수 없도록 세션을 삭제하고 ErrUserDisabled를 반환한다.
+func (r *RefreshToken) Refresh(ctx context.Context, token string) (*entity.Tokens, error) {
+	uid, sid, refresh, err := r.Tokens.RotateRefreshToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	// 로그인한 뒤에 바뀐 역할과 비활성화 여부를 반영한다.
+	u, err := r.Repo.GetUserByID(ctx, r.DB, uid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if u.Disabled != nil {
+		if err := r.Sessions.DeleteSession(ctx, sid); err != nil && !errors.Is(err, store.ErrNotFound) {
+			return nil, fmt.Errorf("failed to delete session: %w", err)
+		}
+		return nil, ErrUserDisabled
+	}
+	jwt, err := r.Tokens.GenerateToken(ctx, *u, sid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate JWT: %w", err)
+	}
+	return &entity.Tokens{AccessToken: string(jwt), RefreshToken: refresh}, nil
+}
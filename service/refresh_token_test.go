@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRefreshToken_Refresh(t *testing.T) {
+	t.Parallel()
+
+	disabled := time.Date(2022, 5, 10, 12, 34, 56, 0, time.UTC)
+	tests := map[string]struct {
+		rotateErr error
+		user      *entity.User
+		want      *entity.Tokens
+		wantErr   error
+		// 삭제해야 하는 세션
+		wantDeleted []entity.SessionID
+	}{
+		"ok": {
+			user: &entity.User{ID: 1, Name: "john", Role: "admin"},
+			want: &entity.Tokens{AccessToken: "access:john:admin", RefreshToken: "next"},
+		},
+		"reused": {
+			rotateErr: auth.ErrRefreshTokenReused,
+			wantErr:   auth.ErrRefreshTokenReused,
+		},
+		"disabled": {
+			user:        &entity.User{ID: 1, Name: "john", Role: "user", Disabled: &disabled},
+			wantErr:     ErrUserDisabled,
+			wantDeleted: []entity.SessionID{"session"},
+		},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			tokens := &TokenRotatorMock{
+				RotateRefreshTokenFunc: func(
+					ctx context.Context, token string,
+				) (entity.UserID, entity.SessionID, string, error) {
+					if token != "current" {
+						t.Errorf("want token %q, but got %q", "current", token)
+					}
+					return 1, "session", "next", tt.rotateErr
+				},
+				GenerateTokenFunc: func(ctx context.Context, u entity.User, sid entity.SessionID) ([]byte, error) {
+					// 액세스 토큰은 리프레시 토큰과 같은 세션에서 발급한다.
+					if sid != "session" {
+						t.Errorf("want session %q, but got %q", "session", sid)
+					}
+					// 액세스 토큰은 DB에서 다시 읽은 사용자 정보로 발급한다.
+					return []byte("access:" + u.Name + ":" + u.Role), nil
+				},
+			}
+			repo := &UserByIDGetterMock{
+				GetUserByIDFunc: func(ctx context.Context, db store.Queryer, id entity.UserID) (*entity.User, error) {
+					return tt.user, nil
+				},
+			}
+			var deleted []entity.SessionID
+			sessions := &SessionStoreMock{
+				DeleteSessionFunc: func(ctx context.Context, id entity.SessionID) error {
+					deleted = append(deleted, id)
+					return nil
+				},
+			}
+			sut := &RefreshToken{Repo: repo, Tokens: tokens, Sessions: sessions}
+			got, err := sut.Refresh(context.Background(), "current")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("want error %v, but got %v", tt.wantErr, err)
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("differs: (-got +want)\n%s", diff)
+			}
+			if diff := cmp.Diff(deleted, tt.wantDeleted); diff != "" {
+				t.Errorf("deleted sessions differ: (-got +want)\n%s", diff)
+			}
+		})
+	}
+}
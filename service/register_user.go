@@ -15,7 +15,7 @@ type RegisterUser struct {
 }
 
 func (r *RegisterUser) RegisterUser(
-	ctx context.Context, name, password, role string,
+	ctx context.Context, name, password, role string, email *string,
 ) (*entity.User, error) {
 	// 해시화된 패스워드 생성
 	pw, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
@@ -26,6 +26,7 @@ func (r *RegisterUser) RegisterUser(
 		Name:     name,
 		Password: string(pw),
 		Role:     role,
+		Email:    email,
 	}
 	if err := r.Repo.RegisterUser(ctx, r.DB, u); err != nil {
 		return nil, fmt.Errorf("failed to register: %w", err)
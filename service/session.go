@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+)
+
+// Sessions는 로그인한 사용자의 세션을 조회하고 삭제한다.
+type Sessions struct {
+	Store SessionStore
+}
+
+// ListSessions 메서드는 사용자의 세션을 최근에 로그인한 순서로 반환한다. 요청에 사용한 토큰의 세션은 Current가 true이다.
+func (s *Sessions) ListSessions(ctx context.Context) ([]*entity.Session, error) {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("user_id not found")
+	}
+	ss, err := s.Store.ListSessions(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+	current, _ := auth.GetSessionID(ctx)
+	for _, ss := range ss {
+		ss.Current = ss.ID == current
+	}
+	return ss, nil
+}
+
+// DeleteSession 메서드는 사용자의 세션을 삭제해 그 세션에서 발급한 토큰을 모두 폐기한다.
+// 다른 사용자의 세션이면 store.ErrNotFound를 반환한다.
+func (s *Sessions) DeleteSession(ctx context.Context, sid entity.SessionID) error {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return fmt.Errorf("user_id not found")
+	}
+	ss, err := s.Store.ListSessions(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to list sessions: %w", err)
+	}
+	for _, ss := range ss {
+		if ss.ID == sid {
+			return s.Store.DeleteSession(ctx, sid)
+		}
+	}
+	return fmt.Errorf("session %q: %w", sid, store.ErrNotFound)
+}
+
+// DeleteSessions 메서드는 사용자의 모든 세션을 삭제해 모든 기기에서 로그아웃한다. 삭제한 세션 수를 반환한다.
+func (s *Sessions) DeleteSessions(ctx context.Context) (int, error) {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return 0, fmt.Errorf("user_id not found")
+	}
+	return deleteSessions(ctx, s.Store, id)
+}
+
+// deleteSessions 함수는 사용자의 모든 세션을 삭제하고, 삭제한 세션 수를 반환한다.
+func deleteSessions(ctx context.Context, s SessionStore, uid entity.UserID) (int, error) {
+	ss, err := s.ListSessions(ctx, uid)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list sessions: %w", err)
+	}
+	n := 0
+	for _, ss := range ss {
+		// 목록을 조회한 뒤에 만료되었거나 다른 요청이 삭제한 세션은 건너뛴다.
+		if err := s.DeleteSession(ctx, ss.ID); err != nil && !errors.Is(err, store.ErrNotFound) {
+			return n, fmt.Errorf("failed to delete session %q: %w", ss.ID, err)
+		}
+		n++
+	}
+	return n, nil
+}
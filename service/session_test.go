@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSessions(t *testing.T) {
+	t.Parallel()
+
+	newStore := func() (*SessionStoreMock, *[]entity.SessionID) {
+		var deleted []entity.SessionID
+		return &SessionStoreMock{
+			ListSessionsFunc: func(ctx context.Context, uid entity.UserID) ([]*entity.Session, error) {
+				if uid != 1 {
+					t.Errorf("want user 1, but got %d", uid)
+				}
+				return []*entity.Session{{ID: "b", UserID: 1}, {ID: "a", UserID: 1}}, nil
+			},
+			DeleteSessionFunc: func(ctx context.Context, id entity.SessionID) error {
+				deleted = append(deleted, id)
+				return nil
+			},
+		}, &deleted
+	}
+	ctx := auth.SetSessionID(auth.SetUserID(context.Background(), 1), "a")
+
+	t.Run("list", func(t *testing.T) {
+		t.Parallel()
+
+		s, _ := newStore()
+		got, err := (&Sessions{Store: s}).ListSessions(ctx)
+		if err != nil {
+			t.Fatalf("want no error, but got %v", err)
+		}
+		want := []*entity.Session{{ID: "b", UserID: 1}, {ID: "a", UserID: 1, Current: true}}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("differs: (-got +want)\n%s", diff)
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		t.Parallel()
+
+		s, deleted := newStore()
+		sut := &Sessions{Store: s}
+		if err := sut.DeleteSession(ctx, "b"); err != nil {
+			t.Fatalf("want no error, but got %v", err)
+		}
+		// 다른 사용자의 세션은 삭제하지 않는다.
+		if err := sut.DeleteSession(ctx, "other"); !errors.Is(err, store.ErrNotFound) {
+			t.Errorf("want ErrNotFound, but got %v", err)
+		}
+		if diff := cmp.Diff(*deleted, []entity.SessionID{"b"}); diff != "" {
+			t.Errorf("deleted sessions differ: (-got +want)\n%s", diff)
+		}
+	})
+
+	t.Run("deleteAll", func(t *testing.T) {
+		t.Parallel()
+
+		s, deleted := newStore()
+		n, err := (&Sessions{Store: s}).DeleteSessions(ctx)
+		if err != nil {
+			t.Fatalf("want no error, but got %v", err)
+		}
+		if n != 2 {
+			t.Errorf("want 2 deleted sessions, but got %d", n)
+		}
+		if diff := cmp.Diff(*deleted, []entity.SessionID{"b", "a"}); diff != "" {
+			t.Errorf("deleted sessions differ: (-got +want)\n%s", diff)
+		}
+	})
+}
@@ -0,0 +1,356 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+)
+
+// ErrInvalidSyncToken은 동기화 토큰을 해석할 수 없을 때 반환된다.
+var ErrInvalidSyncToken = errors.New("invalid sync token")
+
+// DefaultSyncLimit은 한 번에 반환하는 변경 내역의 기본 최대 개수이다.
+const DefaultSyncLimit = 500
+
+/*
+오프라인 클라이언트를 위한 동기화 프로토콜
+
+동기화 토큰은 클라이언트가 마지막으로 받은 변경 내역의 사용자별 순번이다. 클라이언트는 토큰을 해석하지 않고 그대로 돌려보내야 한다.
+순번은 Task를 변경한 트랜잭션이 커밋한 순서대로 커지므로, 토큰보다 작은 순번의 변경이 나중에 커밋되어 빠지는 일은 없다.
+토큰 없이 요청하면 볼 수 있는 모든 Task를 반환하고(스냅숏), 이후에는 토큰 이후의 변경 내역만 반환한다.
+같은 Task의 변경이 여러 번 있으면 최신 상태 하나만 반환한다.
+
+충돌 해결 정책
+  - create: client_id로 멱등하게 처리한다. 같은 client_id의 Task가 이미 있으면 그 Task를 적용 결과로 반환한다.
+  - update: Task가 삭제되었으면 삭제가 우선한다(conflict). base_modified 이후에 서버에서 변경되었으면
+    적용하지 않고 서버의 Task를 반환한다(conflict). base_modified를 생략하면 나중에 쓴 쪽이 이긴다.
+  - delete: 이미 삭제된 Task는 적용된 것으로 본다. base_modified 이후에 서버에서 변경되었으면 삭제하지 않는다(conflict).
+    하위 Task도 함께 삭제한다.
+변경 요청은 하나의 트랜잭션에서 순서대로 처리하며, conflict나 rejected인 요청이 있어도 나머지 요청은 적용한다.
+앞의 create로 만든 Task를 뒤의 요청에서 client_id로 참조할 수 있다.
+*/
+
+// Sync는 오프라인 클라이언트와 Task를 동기화한다.
+type Sync struct {
+	DB        store.TxQueryer
+	Repo      SyncRepository
+	Publisher EventPublisher // Task 변경 이벤트를 전달한다. nil이면 전달하지 않는다.
+	// Limit은 한 번에 반환하는 변경 내역의 최대 개수이다.
+	Limit int
+}
+
+// Pull 메서드는 동기화 토큰 이후의 변경 내역을 반환한다. since가 비어 있으면 스냅숏을 반환한다.
+func (s *Sync) Pull(ctx context.Context, since string) (*entity.SyncDelta, error) {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("user_id not found")
+	}
+	if since == "" {
+		return s.snapshot(ctx, id)
+	}
+	seq, err := parseSyncToken(since)
+	if err != nil {
+		return nil, err
+	}
+	limit := s.Limit
+	if limit <= 0 {
+		limit = DefaultSyncLimit
+	}
+	cs, err := s.Repo.ListTaskChanges(ctx, s.DB, id, seq, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list changes: %w", err)
+	}
+	delta := &entity.SyncDelta{Token: formatSyncToken(seq), Changes: []*entity.SyncChange{}}
+	if len(cs) > limit {
+		cs = cs[:limit]
+		delta.HasMore = true
+	}
+	if len(cs) == 0 {
+		return delta, nil
+	}
+	delta.Token = formatSyncToken(cs[len(cs)-1].Seq)
+
+	// 같은 Task의 변경은 마지막 것만 남긴다.
+	last := map[entity.TaskID]int{}
+	for i, c := range cs {
+		last[c.TaskID] = i
+	}
+	ids := []entity.TaskID{}
+	for i, c := range cs {
+		if last[c.TaskID] == i && c.Op == entity.TaskChangeUpsert {
+			ids = append(ids, c.TaskID)
+		}
+	}
+	ts, err := s.Repo.ListTasksByIDs(ctx, s.DB, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+	tasks := map[entity.TaskID]*entity.Task{}
+	for _, t := range ts {
+		tasks[t.ID] = t
+	}
+	for i, c := range cs {
+		if last[c.TaskID] != i {
+			continue
+		}
+		// 변경 내역을 기록한 뒤에 삭제되었거나 볼 수 없게 된 Task는 삭제로 전달한다.
+		t := tasks[c.TaskID]
+		if c.Op == entity.TaskChangeDelete || t == nil || !visible(t, id) {
+			delta.Changes = append(delta.Changes, &entity.SyncChange{Op: entity.TaskChangeDelete, TaskID: c.TaskID})
+			continue
+		}
+		delta.Changes = append(delta.Changes, &entity.SyncChange{Op: entity.TaskChangeUpsert, TaskID: t.ID, Task: t})
+	}
+	return delta, nil
+}
+
+func (s *Sync) snapshot(ctx context.Context, id entity.UserID) (*entity.SyncDelta, error) {
+	// 순번을 먼저 읽어야 목록을 읽는 사이의 변경이 다음 동기화에서 빠지지 않는다.
+	seq, err := s.Repo.LatestTaskChangeSeq(ctx, s.DB, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest change: %w", err)
+	}
+	ts, err := s.Repo.ListWorkTasks(ctx, s.DB, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+	delta := &entity.SyncDelta{Token: formatSyncToken(seq), Changes: []*entity.SyncChange{}}
+	for _, t := range ts {
+		delta.Changes = append(delta.Changes, &entity.SyncChange{Op: entity.TaskChangeUpsert, TaskID: t.ID, Task: t})
+	}
+	return delta, nil
+}
+
+// Push 메서드는 클라이언트의 변경 요청을 적용하고, 요청별 결과와 since 이후의 변경 내역을 반환한다.
+func (s *Sync) Push(
+	ctx context.Context, since string, ms []*entity.SyncMutation,
+) ([]*entity.SyncResult, *entity.SyncDelta, error) {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return nil, nil, fmt.Errorf("user_id not found")
+	}
+	// 변경 요청을 적용한 뒤에 토큰이 잘못된 것을 알게 되지 않도록 먼저 확인한다.
+	if since != "" {
+		if _, err := parseSyncToken(since); err != nil {
+			return nil, nil, err
+		}
+	}
+	tx, err := s.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to begin: %w", err)
+	}
+	// Commit 이후의 Rollback은 아무것도 하지 않는다.
+	defer func() { _ = tx.Rollback() }()
+
+	defs, err := loadTaskStatuses(ctx, tx, s.Repo, id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list statuses: %w", err)
+	}
+	m := &syncMutator{ctx: ctx, db: tx, repo: s.Repo, uid: id, defs: defs}
+	results := make([]*entity.SyncResult, 0, len(ms))
+	for _, mu := range ms {
+		r, err := m.apply(mu)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to apply %s: %w", mu.Op, err)
+		}
+		results = append(results, r)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, nil, fmt.Errorf("failed to commit: %w", err)
+	}
+	// 커밋이 끝난 변경만 이벤트로 전달한다.
+	for _, e := range m.events {
+		publish(ctx, s.Publisher, e.Type, e.Task)
+	}
+	delta, err := s.Pull(ctx, since)
+	if err != nil {
+		return nil, nil, err
+	}
+	return results, delta, nil
+}
+
+// syncMutator는 하나의 트랜잭션 안에서 변경 요청을 적용한다.
+type syncMutator struct {
+	ctx    context.Context
+	db     store.ExecQueryer
+	repo   SyncRepository
+	uid    entity.UserID
+	defs   entity.TaskStatusDefs
+	events []*entity.TaskEvent
+}
+
+func (m *syncMutator) apply(mu *entity.SyncMutation) (*entity.SyncResult, error) {
+	switch mu.Op {
+	case entity.SyncCreate:
+		return m.create(mu)
+	case entity.SyncUpdate:
+		return m.update(mu)
+	case entity.SyncDelete:
+		return m.delete(mu)
+	default:
+		return rejected(mu, fmt.Sprintf("unknown op %q", mu.Op)), nil
+	}
+}
+
+func (m *syncMutator) create(mu *entity.SyncMutation) (*entity.SyncResult, error) {
+	if mu.ClientID == "" {
+		return rejected(mu, "client_id is required"), nil
+	}
+	// 응답을 받지 못한 클라이언트가 같은 요청을 다시 보낸 경우이다.
+	t, err := m.repo.GetTaskByClientID(m.ctx, m.db, m.uid, mu.ClientID)
+	if err == nil {
+		return applied(mu, t), nil
+	}
+	if !errors.Is(err, store.ErrNotFound) {
+		return nil, err
+	}
+	if mu.Title == nil || *mu.Title == "" {
+		return rejected(mu, "title is required"), nil
+	}
+	t = &entity.Task{
+		UserID: m.uid,
+		Title:  *mu.Title,
+		Status: entity.TaskStatusTodo,
+		Due:    mu.Due,
+	}
+	clientID := mu.ClientID
+	t.ClientID = &clientID
+	if mu.ParentID != nil || mu.ParentClientID != "" {
+		parent, err := m.resolve(mu.ParentID, mu.ParentClientID)
+		if errors.Is(err, store.ErrNotFound) {
+			return rejected(mu, "parent not found"), nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		t.ParentID = &parent.ID
+	}
+	if mu.Status != nil {
+		if _, ok := m.defs.Find(*mu.Status); !ok {
+			return rejected(mu, fmt.Sprintf("unknown status %q", *mu.Status)), nil
+		}
+		t.Status = *mu.Status
+	}
+	if err := m.repo.AddTask(m.ctx, m.db, t); err != nil {
+		return nil, err
+	}
+	m.events = append(m.events, &entity.TaskEvent{Type: entity.EventTaskCreated, UserID: t.UserID, Task: t})
+	return applied(mu, t), nil
+}
+
+func (m *syncMutator) update(mu *entity.SyncMutation) (*entity.SyncResult, error) {
+	t, err := m.resolve(mu.ID, mu.ClientID)
+	if errors.Is(err, store.ErrNotFound) {
+		return conflict(mu, nil, "task was deleted"), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if mu.BaseModified != nil && t.Modified.After(*mu.BaseModified) {
+		return conflict(mu, t, "task was modified on the server"), nil
+	}
+	completed := false
+	if mu.Status != nil {
+		if _, ok := m.defs.Find(*mu.Status); !ok {
+			return rejected(mu, fmt.Sprintf("unknown status %q", *mu.Status)), nil
+		}
+		completed = !m.defs.IsClosed(t.Status) && m.defs.IsClosed(*mu.Status)
+		t.Status = *mu.Status
+	}
+	if mu.Title != nil {
+		if *mu.Title == "" {
+			return rejected(mu, "title must not be empty"), nil
+		}
+		t.Title = *mu.Title
+	}
+	if mu.Due != nil {
+		t.Due = mu.Due
+	}
+	if err := m.repo.UpdateTask(m.ctx, m.db, t); err != nil {
+		return nil, err
+	}
+	m.events = append(m.events, &entity.TaskEvent{Type: entity.EventTaskUpdated, UserID: t.UserID, Task: t})
+	if completed {
+		m.events = append(m.events, &entity.TaskEvent{Type: entity.EventTaskCompleted, UserID: t.UserID, Task: t})
+	}
+	return applied(mu, t), nil
+}
+
+func (m *syncMutator) delete(mu *entity.SyncMutation) (*entity.SyncResult, error) {
+	t, err := m.resolve(mu.ID, mu.ClientID)
+	if errors.Is(err, store.ErrNotFound) {
+		r := applied(mu, nil)
+		r.ID = mu.ID
+		return r, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if mu.BaseModified != nil && t.Modified.After(*mu.BaseModified) {
+		return conflict(mu, t, "task was modified on the server"), nil
+	}
+	deleted, err := deleteTaskTree(m.ctx, m.db, m.repo, t)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range deleted {
+		m.events = append(m.events, &entity.TaskEvent{Type: entity.EventTaskDeleted, UserID: d.UserID, Task: d})
+	}
+	r := applied(mu, nil)
+	r.ID = &t.ID
+	return r, nil
+}
+
+// resolve 메서드는 서버의 ID나 클라이언트가 생성한 ID로 사용자가 소유한 Task를 찾는다.
+func (m *syncMutator) resolve(id *entity.TaskID, clientID string) (*entity.Task, error) {
+	if id != nil {
+		return m.repo.GetTask(m.ctx, m.db, m.uid, *id)
+	}
+	if clientID != "" {
+		return m.repo.GetTaskByClientID(m.ctx, m.db, m.uid, clientID)
+	}
+	return nil, fmt.Errorf("no id: %w", store.ErrNotFound)
+}
+
+func applied(mu *entity.SyncMutation, t *entity.Task) *entity.SyncResult {
+	r := &entity.SyncResult{ClientID: mu.ClientID, Status: entity.SyncApplied, Task: t}
+	if t != nil {
+		r.ID = &t.ID
+	}
+	return r
+}
+
+func conflict(mu *entity.SyncMutation, t *entity.Task, reason string) *entity.SyncResult {
+	r := &entity.SyncResult{ClientID: mu.ClientID, ID: mu.ID, Status: entity.SyncConflict, Reason: reason, Task: t}
+	if t != nil {
+		r.ID = &t.ID
+	}
+	return r
+}
+
+func rejected(mu *entity.SyncMutation, reason string) *entity.SyncResult {
+	return &entity.SyncResult{ClientID: mu.ClientID, ID: mu.ID, Status: entity.SyncRejected, Reason: reason}
+}
+
+// visible 함수는 사용자가 Task의 소유자나 담당자인지 확인한다.
+func visible(t *entity.Task, uid entity.UserID) bool {
+	return t.UserID == uid || (t.AssigneeID != nil && *t.AssigneeID == uid)
+}
+
+func formatSyncToken(seq int64) string {
+	return strconv.FormatInt(seq, 10)
+}
+
+func parseSyncToken(token string) (int64, error) {
+	seq, err := strconv.ParseInt(strings.TrimSpace(token), 10, 64)
+	if err != nil || seq < 0 {
+		return 0, fmt.Errorf("%q: %w", token, ErrInvalidSyncToken)
+	}
+	return seq, nil
+}
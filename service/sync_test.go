@@ -0,0 +1,259 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/clock"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/google/go-cmp/cmp"
+	"github.com/jmoiron/sqlx"
+)
+
+// newSyncRepository 함수는 메모리에 Task와 변경 내역을 저장하는 SyncRepositoryMock을 반환한다.
+func newSyncRepository(seed ...*entity.Task) (*SyncRepositoryMock, *[]entity.TaskID) {
+	c := clock.FixedClocker{}
+	tasks := map[entity.TaskID]*entity.Task{}
+	for _, t := range seed {
+		tasks[t.ID] = t
+	}
+	var changes entity.TaskChanges
+	var deleted []entity.TaskID
+	nextID := entity.TaskID(100)
+	record := func(t *entity.Task, op entity.TaskChangeOp) {
+		changes = append(changes, &entity.TaskChange{Seq: int64(len(changes) + 1), UserID: t.UserID, TaskID: t.ID, Op: op})
+	}
+	get := func(uid entity.UserID, id entity.TaskID) (*entity.Task, error) {
+		t, ok := tasks[id]
+		if !ok || t.UserID != uid {
+			return nil, fmt.Errorf("task %d: %w", id, store.ErrNotFound)
+		}
+		cp := *t
+		return &cp, nil
+	}
+	moq := &SyncRepositoryMock{}
+	moq.ListTaskStatusesFunc = func(ctx context.Context, db store.Queryer, id entity.UserID) (entity.TaskStatusDefs, error) {
+		return nil, nil
+	}
+	moq.GetTaskFunc = func(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.TaskID) (*entity.Task, error) {
+		return get(uid, id)
+	}
+	moq.GetTaskByClientIDFunc = func(ctx context.Context, db store.Queryer, uid entity.UserID, clientID string) (*entity.Task, error) {
+		for _, t := range tasks {
+			if t.ClientID != nil && *t.ClientID == clientID {
+				return get(uid, t.ID)
+			}
+		}
+		return nil, fmt.Errorf("task %q: %w", clientID, store.ErrNotFound)
+	}
+	moq.ListTasksFunc = func(ctx context.Context, db store.Queryer, id entity.UserID) (entity.Tasks, error) {
+		ts := entity.Tasks{}
+		for _, t := range tasks {
+			if t.UserID == id {
+				ts = append(ts, t)
+			}
+		}
+		sort.Slice(ts, func(i, j int) bool { return ts[i].ID < ts[j].ID })
+		return ts, nil
+	}
+	moq.AddTaskFunc = func(ctx context.Context, db store.Execer, t *entity.Task) error {
+		t.ID = nextID
+		nextID++
+		t.Modified = c.Now()
+		cp := *t
+		tasks[t.ID] = &cp
+		record(t, entity.TaskChangeUpsert)
+		return nil
+	}
+	moq.UpdateTaskFunc = func(ctx context.Context, db store.Execer, t *entity.Task) error {
+		t.Modified = c.Now().Add(time.Hour)
+		cp := *t
+		tasks[t.ID] = &cp
+		record(t, entity.TaskChangeUpsert)
+		return nil
+	}
+	moq.DeleteTaskFunc = func(ctx context.Context, db store.Execer, t *entity.Task) error {
+		delete(tasks, t.ID)
+		deleted = append(deleted, t.ID)
+		record(t, entity.TaskChangeDelete)
+		return nil
+	}
+	moq.ListTaskChangesFunc = func(ctx context.Context, db store.Queryer, uid entity.UserID, since int64, limit int) (entity.TaskChanges, error) {
+		cs := entity.TaskChanges{}
+		for _, c := range changes {
+			if c.UserID == uid && c.Seq > since && len(cs) < limit {
+				cs = append(cs, c)
+			}
+		}
+		return cs, nil
+	}
+	moq.ListTasksByIDsFunc = func(ctx context.Context, db store.Queryer, ids []entity.TaskID) (entity.Tasks, error) {
+		ts := entity.Tasks{}
+		for _, id := range ids {
+			if t, ok := tasks[id]; ok {
+				ts = append(ts, t)
+			}
+		}
+		return ts, nil
+	}
+	return moq, &deleted
+}
+
+func TestSync_Push(t *testing.T) {
+	t.Parallel()
+
+	uid := entity.UserID(1)
+	now := clock.FixedClocker{}.Now()
+	parent, child := entity.TaskID(1), entity.TaskID(2)
+	moq, deleted := newSyncRepository(
+		&entity.Task{ID: parent, UserID: uid, Title: "parent", Status: entity.TaskStatusTodo, Modified: now},
+		&entity.Task{ID: child, UserID: uid, ParentID: &parent, Title: "child", Status: entity.TaskStatusTodo, Modified: now},
+		&entity.Task{ID: 3, UserID: uid, Title: "edited", Status: entity.TaskStatusTodo, Modified: now.Add(time.Minute)},
+		&entity.Task{ID: 4, UserID: 2, Title: "others", Status: entity.TaskStatusTodo, Modified: now},
+	)
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+
+	var published []entity.EventType
+	pub := &EventPublisherMock{}
+	pub.PublishFunc = func(ctx context.Context, e *entity.TaskEvent) error {
+		published = append(published, e.Type)
+		return nil
+	}
+	sut := &Sync{DB: sqlx.NewDb(db, "mysql"), Repo: moq, Publisher: pub}
+
+	title := func(s string) *string { return &s }
+	done := entity.TaskStatusDone
+	unknown := entity.TaskStatus("unknown")
+	id := func(id entity.TaskID) *entity.TaskID { return &id }
+	ms := []*entity.SyncMutation{
+		{Op: entity.SyncCreate, ClientID: "a", Title: title("offline")},
+		// 응답을 받지 못해 다시 보낸 요청은 같은 Task로 처리한다.
+		{Op: entity.SyncCreate, ClientID: "a", Title: title("offline")},
+		{Op: entity.SyncCreate, ClientID: "b", ParentClientID: "a", Title: title("sub")},
+		{Op: entity.SyncCreate, ClientID: "c", ParentID: id(4), Title: title("under others")},
+		{Op: entity.SyncUpdate, ClientID: "a", Status: &done},
+		{Op: entity.SyncUpdate, ID: id(3), Title: title("stale"), BaseModified: &now},
+		{Op: entity.SyncUpdate, ID: id(3), Status: &unknown},
+		{Op: entity.SyncUpdate, ID: id(9), Title: title("gone")},
+		{Op: entity.SyncDelete, ID: id(parent), BaseModified: &now},
+		{Op: entity.SyncDelete, ID: id(9)},
+	}
+	ctx := auth.SetUserID(context.Background(), uid)
+	results, delta, err := sut.Push(ctx, "0", ms)
+	if err != nil {
+		t.Fatalf("want no error, but got %v", err)
+	}
+
+	type got struct {
+		ID     entity.TaskID
+		Status entity.SyncResultStatus
+		Reason string
+	}
+	gots := []got{}
+	for _, r := range results {
+		g := got{Status: r.Status, Reason: r.Reason}
+		if r.ID != nil {
+			g.ID = *r.ID
+		}
+		gots = append(gots, g)
+	}
+	want := []got{
+		{ID: 100, Status: entity.SyncApplied},
+		{ID: 100, Status: entity.SyncApplied},
+		{ID: 101, Status: entity.SyncApplied},
+		{Status: entity.SyncRejected, Reason: "parent not found"},
+		{ID: 100, Status: entity.SyncApplied},
+		{ID: 3, Status: entity.SyncConflict, Reason: "task was modified on the server"},
+		{ID: 3, Status: entity.SyncRejected, Reason: `unknown status "unknown"`},
+		{ID: 9, Status: entity.SyncConflict, Reason: "task was deleted"},
+		{ID: 1, Status: entity.SyncApplied},
+		{ID: 9, Status: entity.SyncApplied},
+	}
+	if d := cmp.Diff(want, gots); d != "" {
+		t.Errorf("differs: (-want +got)\n%s", d)
+	}
+	// 하위 Task부터 삭제한다.
+	if d := cmp.Diff([]entity.TaskID{child, parent}, *deleted); d != "" {
+		t.Errorf("differs: (-want +got)\n%s", d)
+	}
+	wantEvents := []entity.EventType{
+		entity.EventTaskCreated, entity.EventTaskCreated,
+		entity.EventTaskUpdated, entity.EventTaskCompleted,
+		entity.EventTaskDeleted, entity.EventTaskDeleted,
+	}
+	if d := cmp.Diff(wantEvents, published); d != "" {
+		t.Errorf("differs: (-want +got)\n%s", d)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+
+	// 같은 Task의 변경은 최신 상태 하나만 전달하고, 삭제된 Task는 tombstone으로 전달한다.
+	type change struct {
+		Op     entity.TaskChangeOp
+		TaskID entity.TaskID
+		Status entity.TaskStatus
+	}
+	changes := []change{}
+	for _, c := range delta.Changes {
+		ch := change{Op: c.Op, TaskID: c.TaskID}
+		if c.Task != nil {
+			ch.Status = c.Task.Status
+		}
+		changes = append(changes, ch)
+	}
+	wantChanges := []change{
+		{Op: entity.TaskChangeUpsert, TaskID: 101, Status: entity.TaskStatusTodo},
+		{Op: entity.TaskChangeUpsert, TaskID: 100, Status: entity.TaskStatusDone},
+		{Op: entity.TaskChangeDelete, TaskID: child},
+		{Op: entity.TaskChangeDelete, TaskID: parent},
+	}
+	if d := cmp.Diff(wantChanges, changes); d != "" {
+		t.Errorf("differs: (-want +got)\n%s", d)
+	}
+	if delta.Token != "5" || delta.HasMore {
+		t.Errorf("want token 5 without more, but got %q, %v", delta.Token, delta.HasMore)
+	}
+}
+
+func TestSync_Pull(t *testing.T) {
+	t.Parallel()
+
+	moq, _ := newSyncRepository()
+	sut := &Sync{Repo: moq, Limit: 1}
+	ctx := auth.SetUserID(context.Background(), 1)
+	if _, err := sut.Pull(ctx, "abc"); err == nil {
+		t.Fatal("want error for invalid token")
+	}
+	for i := 0; i < 2; i++ {
+		if err := moq.AddTask(ctx, nil, &entity.Task{UserID: 1, Title: "t"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	d, err := sut.Pull(ctx, "0")
+	if err != nil {
+		t.Fatalf("want no error, but got %v", err)
+	}
+	if d.Token != "1" || !d.HasMore || len(d.Changes) != 1 {
+		t.Errorf("want first page, but got %+v", d)
+	}
+	d, err = sut.Pull(ctx, d.Token)
+	if err != nil {
+		t.Fatalf("want no error, but got %v", err)
+	}
+	if d.Token != "2" || d.HasMore || len(d.Changes) != 1 {
+		t.Errorf("want last page, but got %+v", d)
+	}
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+)
+
+// loadTaskStatuses는 사용자 정의 상태를 조회하고, 아직 정의하지 않은 사용자에게는 기본 상태를 돌려준다.
+func loadTaskStatuses(
+	ctx context.Context, db store.Queryer, repo TaskStatusLister, id entity.UserID,
+) (entity.TaskStatusDefs, error) {
+	defs, err := repo.ListTaskStatuses(ctx, db, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(defs) == 0 {
+		return entity.DefaultTaskStatusDefs(id), nil
+	}
+	return defs, nil
+}
+
+type ListTaskStatus struct {
+	DB   store.Queryer
+	Repo TaskStatusLister
+}
+
+func (l *ListTaskStatus) ListTaskStatuses(ctx context.Context) (entity.TaskStatusDefs, error) {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("user_id not found")
+	}
+	defs, err := loadTaskStatuses(ctx, l.DB, l.Repo, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list: %w", err)
+	}
+	return defs, nil
+}
+
+type AddTaskStatus struct {
+	DB   store.TxBeginner
+	Repo TaskStatusRepository
+}
+
+// AddTaskStatus 메서드는 사용자 정의 상태를 등록한다.
+// 처음 상태를 추가하는 사용자는 기본 상태도 함께 저장하며, 하나의 트랜잭션에서 처리해서 일부만 저장되지 않도록 한다.
+func (a *AddTaskStatus) AddTaskStatus(
+	ctx context.Context, name entity.TaskStatus, category entity.TaskStatusCategory,
+) (*entity.TaskStatusDef, error) {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("user_id not found")
+	}
+	tx, err := a.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin: %w", err)
+	}
+	// Commit 이후의 Rollback은 아무것도 하지 않는다.
+	defer func() { _ = tx.Rollback() }()
+
+	defs, err := a.Repo.ListTaskStatuses(ctx, tx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list: %w", err)
+	}
+	// 처음 상태를 추가하는 사용자는 기본 상태를 먼저 저장해서 기존 Task가 계속 유효하도록 한다.
+	// 동시에 처음 추가하는 요청이 이미 저장한 기본 상태는 (user_id, name)의 유일 키로 중복 에러가 되므로 건너뛴다.
+	if len(defs) == 0 {
+		for _, d := range entity.DefaultTaskStatusDefs(id) {
+			if err := a.Repo.AddTaskStatus(ctx, tx, d); err != nil && !errors.Is(err, store.ErrAlreadyEntry) {
+				return nil, fmt.Errorf("failed to register default: %w", err)
+			}
+			defs = append(defs, d)
+		}
+	}
+	s := &entity.TaskStatusDef{
+		UserID:   id,
+		Name:     name,
+		Category: category,
+		Position: len(defs),
+	}
+	if err := a.Repo.AddTaskStatus(ctx, tx, s); err != nil {
+		return nil, fmt.Errorf("failed to register: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit: %w", err)
+	}
+	return s, nil
+}
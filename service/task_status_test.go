@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/google/go-cmp/cmp"
+	"github.com/jmoiron/sqlx"
+)
+
+func TestAddTaskStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		existing entity.TaskStatusDefs
+		dup      entity.TaskStatus // 중복 에러를 반환할 상태
+		commit   bool
+		want     []entity.TaskStatus
+	}{
+		// 처음 추가하면 기본 상태를 같은 트랜잭션에서 먼저 저장한다.
+		"first": {
+			commit: true,
+			want:   []entity.TaskStatus{"todo", "doing", "done", "review"},
+		},
+		"existing": {
+			existing: entity.DefaultTaskStatusDefs(10),
+			commit:   true,
+			want:     []entity.TaskStatus{"review"},
+		},
+		// 동시에 처음 추가한 요청이 저장한 기본 상태는 건너뛴다.
+		"concurrentDefaults": {
+			dup:    "doing",
+			commit: true,
+			want:   []entity.TaskStatus{"todo", "done", "review"},
+		},
+		// 새 상태를 저장하지 못하면 기본 상태도 저장하지 않는다.
+		"rollback": {
+			dup: "review",
+		},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			t.Cleanup(func() { _ = db.Close() })
+			mock.ExpectBegin()
+			if tt.commit {
+				mock.ExpectCommit()
+			} else {
+				mock.ExpectRollback()
+			}
+
+			var got []entity.TaskStatus
+			moq := &TaskStatusRepositoryMock{}
+			moq.ListTaskStatusesFunc = func(
+				ctx context.Context, db store.Queryer, id entity.UserID,
+			) (entity.TaskStatusDefs, error) {
+				if _, ok := db.(*sqlx.Tx); !ok {
+					t.Errorf("want transaction, but got %T", db)
+				}
+				return tt.existing, nil
+			}
+			moq.AddTaskStatusFunc = func(ctx context.Context, db store.Execer, s *entity.TaskStatusDef) error {
+				if _, ok := db.(*sqlx.Tx); !ok {
+					t.Errorf("want transaction, but got %T", db)
+				}
+				if s.Name == tt.dup {
+					return fmt.Errorf("error from mock: %w", store.ErrAlreadyEntry)
+				}
+				got = append(got, s.Name)
+				return nil
+			}
+
+			sut := &AddTaskStatus{DB: sqlx.NewDb(db, "mysql"), Repo: moq}
+			ctx := auth.SetUserID(context.Background(), 10)
+			s, err := sut.AddTaskStatus(ctx, "review", entity.TaskStatusCategoryInProgress)
+			if tt.commit {
+				if err != nil {
+					t.Fatalf("want no error, but got %v", err)
+				}
+				if s.Position != 3 {
+					t.Errorf("want position 3, but got %d", s.Position)
+				}
+				if d := cmp.Diff(got, tt.want); len(d) != 0 {
+					t.Errorf("differs: (-got +want)\n%s", d)
+				}
+			} else if !errors.Is(err, store.ErrAlreadyEntry) {
+				t.Errorf("want ErrAlreadyEntry, but got %v", err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+)
+
+type AddTemplate struct {
+	DB   store.ExecQueryer
+	Repo TemplateRepository
+}
+
+// AddTemplate 메서드는 Task와 그 하위 Task를 이름을 붙인 템플릿으로 저장한다.
+// 마감 시간은 기준 Task의 마감 시간(없으면 트리에서 가장 이른 마감 시간)으로부터의 차이로 저장한다.
+// project를 지정하면 그 프로젝트의 멤버 모두가 템플릿을 사용할 수 있다. 사용자가 멤버인 프로젝트만 지정할 수 있다.
+func (a *AddTemplate) AddTemplate(
+	ctx context.Context, name string, tid entity.TaskID, project *entity.ProjectID,
+) (*entity.Template, error) {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("user_id not found")
+	}
+	if project != nil {
+		ok, err := a.Repo.IsProjectMember(ctx, a.DB, *project, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check member: %w", err)
+		}
+		if !ok {
+			return nil, fmt.Errorf("project %d: %w", *project, store.ErrNotFound)
+		}
+	}
+	root, err := a.Repo.GetTask(ctx, a.DB, id, tid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get: %w", err)
+	}
+	all, err := a.Repo.ListTasks(ctx, a.DB, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list: %w", err)
+	}
+	children := map[entity.TaskID]entity.Tasks{}
+	for _, t := range all {
+		if t.ParentID != nil {
+			children[*t.ParentID] = append(children[*t.ParentID], t)
+		}
+	}
+
+	anchor := root.Due
+	if anchor == nil {
+		anchor = earliestDue(root, children)
+	}
+	tmpl := &entity.Template{
+		UserID:    id,
+		ProjectID: project,
+		Name:      name,
+		Items:     entity.TemplateItems{templateItem(root, children, anchor, map[entity.TaskID]bool{})},
+	}
+	if err := a.Repo.AddTemplate(ctx, a.DB, tmpl); err != nil {
+		return nil, fmt.Errorf("failed to register: %w", err)
+	}
+	return tmpl, nil
+}
+
+// templateItem 함수는 Task 트리를 템플릿 항목으로 변환한다. seen은 순환 참조를 막기 위해 사용한다.
+func templateItem(
+	t *entity.Task, children map[entity.TaskID]entity.Tasks, anchor *time.Time, seen map[entity.TaskID]bool,
+) *entity.TemplateItem {
+	seen[t.ID] = true
+	item := &entity.TemplateItem{Title: t.Title, TaskAttributes: t.TaskAttributes}
+	if t.Due != nil && anchor != nil {
+		offset := int64(t.Due.Sub(*anchor).Seconds())
+		item.DueOffset = &offset
+	}
+	for _, c := range children[t.ID] {
+		if !seen[c.ID] {
+			item.Children = append(item.Children, templateItem(c, children, anchor, seen))
+		}
+	}
+	return item
+}
+
+// earliestDue 함수는 트리에서 가장 이른 마감 시간을 반환한다.
+func earliestDue(root *entity.Task, children map[entity.TaskID]entity.Tasks) *time.Time {
+	var min *time.Time
+	queue := entity.Tasks{root}
+	seen := map[entity.TaskID]bool{}
+	for len(queue) > 0 {
+		t := queue[0]
+		queue = queue[1:]
+		if seen[t.ID] {
+			continue
+		}
+		seen[t.ID] = true
+		if t.Due != nil && (min == nil || t.Due.Before(*min)) {
+			min = t.Due
+		}
+		queue = append(queue, children[t.ID]...)
+	}
+	return min
+}
+
+type ListTemplate struct {
+	DB   store.Queryer
+	Repo TemplateRepository
+}
+
+func (l *ListTemplate) ListTemplates(ctx context.Context) (entity.Templates, error) {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("user_id not found")
+	}
+	ts, err := l.Repo.ListTemplates(ctx, l.DB, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list: %w", err)
+	}
+	return ts, nil
+}
+
+type InstantiateTemplate struct {
+	DB        store.TxBeginner
+	Repo      TemplateRepository
+	Publisher EventPublisher // Task 변경 이벤트를 전달한다. nil이면 전달하지 않는다.
+}
+
+// InstantiateTemplate 메서드는 템플릿의 Task 트리를 하나의 트랜잭션 안에서 모두 등록한다.
+// 각 Task의 마감 시간은 anchor에 DueOffset을 더한 시각이고, 라벨, 우선순위, 반복 규칙은 템플릿 항목의 값이다.
+// 프로젝트에 공유된 템플릿이면 등록한 Task도 그 프로젝트에 속한다.
+// 단, 인스턴스화하는 사용자가 그 프로젝트의 멤버가 아니면(템플릿을 만든 뒤 프로젝트에서 나간 경우 등) 프로젝트에 속하지 않는 Task로 등록한다.
+func (it *InstantiateTemplate) InstantiateTemplate(
+	ctx context.Context, id entity.TemplateID, anchor time.Time,
+) (entity.Tasks, error) {
+	uid, ok := auth.GetUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("user_id not found")
+	}
+	tx, err := it.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin: %w", err)
+	}
+	// Commit 이후의 Rollback은 아무것도 하지 않는다.
+	defer func() { _ = tx.Rollback() }()
+
+	tmpl, err := it.Repo.GetTemplate(ctx, tx, uid, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get: %w", err)
+	}
+	project := tmpl.ProjectID
+	if project != nil {
+		ok, err := it.Repo.IsProjectMember(ctx, tx, *project, uid)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check member: %w", err)
+		}
+		if !ok {
+			project = nil
+		}
+	}
+	tasks := entity.Tasks{}
+	var create func(items entity.TemplateItems, parent *entity.TaskID) error
+	create = func(items entity.TemplateItems, parent *entity.TaskID) error {
+		for _, item := range items {
+			t := &entity.Task{
+				UserID:    uid,
+				ProjectID: project,
+				ParentID:  parent,
+				Title:     item.Title,
+				Status:    entity.TaskStatusTodo,
+
+				TaskAttributes: item.TaskAttributes,
+			}
+			if item.DueOffset != nil {
+				due := anchor.Add(time.Duration(*item.DueOffset) * time.Second)
+				t.Due = &due
+			}
+			if err := it.Repo.AddTask(ctx, tx, t); err != nil {
+				return err
+			}
+			tasks = append(tasks, t)
+			if err := create(item.Children, &t.ID); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := create(tmpl.Items, nil); err != nil {
+		return nil, fmt.Errorf("failed to register: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit: %w", err)
+	}
+	// 커밋이 끝난 Task만 이벤트로 전달한다.
+	for _, t := range tasks {
+		publish(ctx, it.Publisher, entity.EventTaskCreated, t)
+	}
+	return tasks, nil
+}
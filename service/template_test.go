@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/google/go-cmp/cmp"
+	"github.com/jmoiron/sqlx"
+)
+
+func TestInstantiateTemplate(t *testing.T) {
+	t.Parallel()
+
+	day := int64(24 * 60 * 60)
+	attrs := entity.TaskAttributes{Labels: entity.Labels{"ops"}, Priority: entity.TaskPriorityHigh}
+	items := entity.TemplateItems{
+		{
+			Title:          "release",
+			TaskAttributes: attrs,
+			Children: entity.TemplateItems{
+				{Title: "changelog", DueOffset: &day},
+				{Title: "tag"},
+			},
+		},
+	}
+	anchor := time.Date(2022, 5, 10, 9, 0, 0, 0, time.UTC)
+
+	type got struct {
+		title   string
+		parent  entity.TaskID
+		due     *time.Time
+		attrs   entity.TaskAttributes
+		project *entity.ProjectID
+	}
+	due := anchor.Add(24 * time.Hour)
+	pid := entity.ProjectID(5)
+	tests := map[string]struct {
+		project *entity.ProjectID
+		member  bool
+		fail    string
+		commit  bool
+		want    []got
+	}{
+		"ok": {
+			commit: true,
+			want: []got{
+				{title: "release", attrs: attrs},
+				{title: "changelog", parent: 1, due: &due},
+				{title: "tag", parent: 1},
+			},
+		},
+		// 프로젝트에 공유된 템플릿으로 등록한 Task는 그 프로젝트에 속한다.
+		"shared": {
+			project: &pid,
+			member:  true,
+			commit:  true,
+			want: []got{
+				{title: "release", attrs: attrs, project: &pid},
+				{title: "changelog", parent: 1, due: &due, project: &pid},
+				{title: "tag", parent: 1, project: &pid},
+			},
+		},
+		// 인스턴스화하는 사용자가 프로젝트의 멤버가 아니면 프로젝트에 속하지 않는 Task로 등록한다.
+		"leftProject": {
+			project: &pid,
+			commit:  true,
+			want: []got{
+				{title: "release", attrs: attrs},
+				{title: "changelog", parent: 1, due: &due},
+				{title: "tag", parent: 1},
+			},
+		},
+		// 중간에 실패하면 트랜잭션 전체를 롤백한다.
+		"rollback": {
+			fail: "tag",
+		},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			t.Cleanup(func() { _ = db.Close() })
+			mock.ExpectBegin()
+			if tt.commit {
+				mock.ExpectCommit()
+			} else {
+				mock.ExpectRollback()
+			}
+
+			var gots []got
+			moq := &TemplateRepositoryMock{}
+			moq.GetTemplateFunc = func(
+				ctx context.Context, db store.Queryer, uid entity.UserID, id entity.TemplateID,
+			) (*entity.Template, error) {
+				return &entity.Template{ID: id, UserID: uid, ProjectID: tt.project, Name: "release", Items: items}, nil
+			}
+			moq.IsProjectMemberFunc = func(
+				ctx context.Context, db store.Queryer, id entity.ProjectID, uid entity.UserID,
+			) (bool, error) {
+				return tt.member, nil
+			}
+			moq.AddTaskFunc = func(ctx context.Context, db store.Execer, task *entity.Task) error {
+				if task.Title == tt.fail {
+					return errors.New("error from mock")
+				}
+				task.ID = entity.TaskID(len(gots) + 1)
+				g := got{title: task.Title, due: task.Due, attrs: task.TaskAttributes, project: task.ProjectID}
+				if task.ParentID != nil {
+					g.parent = *task.ParentID
+				}
+				gots = append(gots, g)
+				return nil
+			}
+
+			sut := &InstantiateTemplate{DB: sqlx.NewDb(db, "mysql"), Repo: moq}
+			ctx := auth.SetUserID(context.Background(), 10)
+			tasks, err := sut.InstantiateTemplate(ctx, 1, anchor)
+			if tt.commit {
+				if err != nil {
+					t.Fatalf("want no error, but got %v", err)
+				}
+				if len(tasks) != len(tt.want) {
+					t.Errorf("want %d tasks, but got %d", len(tt.want), len(tasks))
+				}
+				if d := cmp.Diff(gots, tt.want, cmp.AllowUnexported(got{})); len(d) != 0 {
+					t.Errorf("differs: (-got +want)\n%s", d)
+				}
+			} else if err == nil {
+				t.Error("want error, but got nil")
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestAddTemplate(t *testing.T) {
+	t.Parallel()
+
+	pid := entity.ProjectID(5)
+	attrs := entity.TaskAttributes{
+		Labels:     entity.Labels{"finance"},
+		Priority:   entity.TaskPriorityUrgent,
+		Recurrence: &entity.Recurrence{Frequency: "monthly", Interval: 1, MonthDay: 1},
+	}
+	tests := map[string]struct {
+		project *entity.ProjectID
+		member  bool
+		wantErr error
+	}{
+		"private": {},
+		"shared":  {project: &pid, member: true},
+		// 멤버가 아닌 프로젝트에는 공유할 수 없다.
+		"notMember": {project: &pid, wantErr: store.ErrNotFound},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			moq := &TemplateRepositoryMock{}
+			moq.IsProjectMemberFunc = func(
+				ctx context.Context, db store.Queryer, id entity.ProjectID, uid entity.UserID,
+			) (bool, error) {
+				return tt.member, nil
+			}
+			moq.GetTaskFunc = func(
+				ctx context.Context, db store.Queryer, uid entity.UserID, id entity.TaskID,
+			) (*entity.Task, error) {
+				return &entity.Task{ID: id, UserID: uid, Title: "pay rent", TaskAttributes: attrs}, nil
+			}
+			moq.ListTasksFunc = func(ctx context.Context, db store.Queryer, id entity.UserID) (entity.Tasks, error) {
+				return entity.Tasks{}, nil
+			}
+			moq.AddTemplateFunc = func(ctx context.Context, db store.Execer, tmpl *entity.Template) error {
+				return nil
+			}
+
+			sut := &AddTemplate{Repo: moq}
+			ctx := auth.SetUserID(context.Background(), 10)
+			got, err := sut.AddTemplate(ctx, "rent", 1, tt.project)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("want %v, but got %v", tt.wantErr, err)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if got.ProjectID != tt.project {
+				t.Errorf("want project %v, but got %v", tt.project, got.ProjectID)
+			}
+			// 라벨, 우선순위, 반복 규칙도 템플릿에 저장한다.
+			if d := cmp.Diff(got.Items[0].TaskAttributes, attrs); len(d) != 0 {
+				t.Errorf("differs: (-got +want)\n%s", d)
+			}
+		})
+	}
+}
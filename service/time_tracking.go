@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/clock"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+)
+
+var (
+	ErrTimerRunning    = errors.New("timer is already running")
+	ErrTimerNotRunning = errors.New("timer is not running")
+	ErrInvalidPeriod   = errors.New("invalid period")
+)
+
+// 모든 작업 시간은 Clocker를 기준으로 계산해서 테스트에서 시간을 제어할 수 있도록 한다.
+
+type StartTimer struct {
+	DB      store.ExecQueryer
+	Repo    TimeTracker
+	Clocker clock.Clocker
+}
+
+func (s *StartTimer) StartTimer(ctx context.Context, tid entity.TaskID) (*entity.TimeEntry, error) {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("user_id not found")
+	}
+	if _, err := s.Repo.GetTask(ctx, s.DB, id, tid); err != nil {
+		return nil, fmt.Errorf("failed to get: %w", err)
+	}
+	// 실행 중인 타이머는 사용자당 하나만 허용한다.
+	running, err := s.Repo.GetRunningTimeEntry(ctx, s.DB, id)
+	if err == nil {
+		return nil, fmt.Errorf("task %d: %w", running.TaskID, ErrTimerRunning)
+	}
+	if !errors.Is(err, store.ErrNotFound) {
+		return nil, fmt.Errorf("failed to get running timer: %w", err)
+	}
+	e := &entity.TimeEntry{
+		UserID:  id,
+		TaskID:  tid,
+		Started: s.Clocker.Now(),
+	}
+	if err := s.Repo.AddTimeEntry(ctx, s.DB, e); err != nil {
+		if errors.Is(err, store.ErrAlreadyEntry) {
+			return nil, fmt.Errorf("%w: %w", ErrTimerRunning, err)
+		}
+		return nil, fmt.Errorf("failed to register: %w", err)
+	}
+	return e, nil
+}
+
+type StopTimer struct {
+	DB      store.ExecQueryer
+	Repo    TimeTracker
+	Clocker clock.Clocker
+}
+
+func (s *StopTimer) StopTimer(ctx context.Context, tid entity.TaskID) (*entity.TimeEntry, error) {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("user_id not found")
+	}
+	e, err := s.Repo.GetRunningTimeEntry(ctx, s.DB, id)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, fmt.Errorf("task %d: %w", tid, ErrTimerNotRunning)
+		}
+		return nil, fmt.Errorf("failed to get running timer: %w", err)
+	}
+	if e.TaskID != tid {
+		return nil, fmt.Errorf("task %d: %w", tid, ErrTimerNotRunning)
+	}
+	now := s.Clocker.Now()
+	e.Stopped = &now
+	if err := s.Repo.StopTimeEntry(ctx, s.DB, e); err != nil {
+		return nil, fmt.Errorf("failed to stop: %w", err)
+	}
+	return e, nil
+}
+
+type AddTimeEntry struct {
+	DB   store.ExecQueryer
+	Repo TimeTracker
+}
+
+// AddTimeEntry 메서드는 타이머를 사용하지 않고 작업 시간을 직접 기록한다.
+func (a *AddTimeEntry) AddTimeEntry(
+	ctx context.Context, tid entity.TaskID, started, stopped time.Time,
+) (*entity.TimeEntry, error) {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("user_id not found")
+	}
+	if !stopped.After(started) {
+		return nil, fmt.Errorf("stopped must be after started: %w", ErrInvalidPeriod)
+	}
+	if _, err := a.Repo.GetTask(ctx, a.DB, id, tid); err != nil {
+		return nil, fmt.Errorf("failed to get: %w", err)
+	}
+	e := &entity.TimeEntry{
+		UserID:  id,
+		TaskID:  tid,
+		Started: started,
+		Stopped: &stopped,
+		Manual:  true,
+	}
+	if err := a.Repo.AddTimeEntry(ctx, a.DB, e); err != nil {
+		return nil, fmt.Errorf("failed to register: %w", err)
+	}
+	return e, nil
+}
+
+type GetTaskTime struct {
+	DB      store.Queryer
+	Repo    TimeTracker
+	Clocker clock.Clocker
+}
+
+// GetTaskTime 메서드는 Task의 작업 시간 합계와 loc 기준의 날짜별 합계를 반환한다.
+func (g *GetTaskTime) GetTaskTime(
+	ctx context.Context, tid entity.TaskID, loc *time.Location,
+) (*entity.TaskTime, error) {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("user_id not found")
+	}
+	if _, err := g.Repo.GetTask(ctx, g.DB, id, tid); err != nil {
+		return nil, fmt.Errorf("failed to get: %w", err)
+	}
+	entries, err := g.Repo.ListTimeEntries(ctx, g.DB, id, tid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list: %w", err)
+	}
+	now := g.Clocker.Now()
+	tt := &entity.TaskTime{TaskID: tid, Entries: []entity.TimedEntry{}}
+	days := map[string]time.Duration{}
+	for _, e := range entries {
+		d := e.Duration(now)
+		tt.Total += d
+		tt.Running = tt.Running || e.Running()
+		tt.Entries = append(tt.Entries, entity.TimedEntry{TimeEntry: e, Duration: d})
+		for _, dt := range entity.SplitByDay(e.Started, e.End(now), loc) {
+			days[dt.Date] += dt.Duration
+		}
+	}
+	tt.Days = sortedDays(days)
+	return tt, nil
+}
+
+type GetTimesheet struct {
+	DB      store.Queryer
+	Repo    TimeTracker
+	Clocker clock.Clocker
+}
+
+// GetTimesheet 메서드는 기간 [from, to)의 작업 시간을 날짜별, Task별로 집계한다.
+// 날짜는 from의 타임존을 기준으로 나눈다.
+func (g *GetTimesheet) GetTimesheet(
+	ctx context.Context, from, to time.Time,
+) (*entity.Timesheet, error) {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("user_id not found")
+	}
+	if !to.After(from) {
+		return nil, fmt.Errorf("to must be after from: %w", ErrInvalidPeriod)
+	}
+	entries, err := g.Repo.ListTimeEntriesBetween(ctx, g.DB, id, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list: %w", err)
+	}
+	now := g.Clocker.Now()
+	loc := from.Location()
+	byDay := map[string]map[entity.TaskID]time.Duration{}
+	for _, e := range entries {
+		// 기간 밖으로 벗어난 부분은 잘라낸다.
+		start, end := e.Started, e.End(now)
+		if start.Before(from) {
+			start = from
+		}
+		if end.After(to) {
+			end = to
+		}
+		for _, dt := range entity.SplitByDay(start, end, loc) {
+			if byDay[dt.Date] == nil {
+				byDay[dt.Date] = map[entity.TaskID]time.Duration{}
+			}
+			byDay[dt.Date][e.TaskID] += dt.Duration
+		}
+	}
+
+	ts := &entity.Timesheet{
+		From: from.Format(time.DateOnly),
+		To:   to.AddDate(0, 0, -1).Format(time.DateOnly),
+		Days: []entity.TimesheetDay{},
+	}
+	dates := make([]string, 0, len(byDay))
+	for d := range byDay {
+		dates = append(dates, d)
+	}
+	sort.Strings(dates)
+	for _, d := range dates {
+		day := entity.TimesheetDay{Date: d}
+		for tid, dur := range byDay[d] {
+			day.Total += dur
+			day.Tasks = append(day.Tasks, entity.TaskDuration{TaskID: tid, Duration: dur})
+		}
+		sort.Slice(day.Tasks, func(i, j int) bool {
+			return day.Tasks[i].TaskID < day.Tasks[j].TaskID
+		})
+		ts.Total += day.Total
+		ts.Days = append(ts.Days, day)
+	}
+	return ts, nil
+}
+
+// sortedDays 함수는 날짜별 합계를 날짜 순으로 정렬한다.
+func sortedDays(days map[string]time.Duration) []entity.DailyTime {
+	rs := make([]entity.DailyTime, 0, len(days))
+	for d, dur := range days {
+		rs = append(rs, entity.DailyTime{Date: d, Duration: dur})
+	}
+	sort.Slice(rs, func(i, j int) bool { return rs[i].Date < rs[j].Date })
+	return rs
+}
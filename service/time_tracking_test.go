@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/clock"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGetTaskTime(t *testing.T) {
+	t.Parallel()
+
+	// 실행 중인 타이머는 Clocker의 현재 시각(2022-05-10 12:34:56)까지를 작업 시간으로 본다.
+	c := clock.FixedClocker{}
+	stopped := time.Date(2022, 5, 9, 1, 0, 0, 0, time.UTC)
+	entries := entity.TimeEntries{
+		{ID: 1, TaskID: 1, Started: time.Date(2022, 5, 8, 23, 0, 0, 0, time.UTC), Stopped: &stopped},
+		{ID: 2, TaskID: 1, Started: c.Now().Add(-30 * time.Minute)},
+	}
+	moq := &TimeTrackerMock{}
+	moq.GetTaskFunc = func(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.TaskID) (*entity.Task, error) {
+		return &entity.Task{ID: id, UserID: uid}, nil
+	}
+	moq.ListTimeEntriesFunc = func(ctx context.Context, db store.Queryer, uid entity.UserID, tid entity.TaskID) (entity.TimeEntries, error) {
+		return entries, nil
+	}
+
+	sut := &GetTaskTime{Repo: moq, Clocker: c}
+	ctx := auth.SetUserID(context.Background(), 10)
+	got, err := sut.GetTaskTime(ctx, 1, time.UTC)
+	if err != nil {
+		t.Fatalf("want no error, but got %v", err)
+	}
+	if want := 150 * time.Minute; got.Total != want {
+		t.Errorf("want total %v, but got %v", want, got.Total)
+	}
+	if !got.Running {
+		t.Error("want running, but not")
+	}
+	wantDays := []entity.DailyTime{
+		{Date: "2022-05-08", Duration: time.Hour},
+		{Date: "2022-05-09", Duration: time.Hour},
+		{Date: "2022-05-10", Duration: 30 * time.Minute},
+	}
+	if d := cmp.Diff(got.Days, wantDays); len(d) != 0 {
+		t.Errorf("differs: (-got +want)\n%s", d)
+	}
+}
+
+func TestStartTimer_AlreadyRunning(t *testing.T) {
+	t.Parallel()
+
+	moq := &TimeTrackerMock{}
+	moq.GetTaskFunc = func(ctx context.Context, db store.Queryer, uid entity.UserID, id entity.TaskID) (*entity.Task, error) {
+		return &entity.Task{ID: id, UserID: uid}, nil
+	}
+	moq.GetRunningTimeEntryFunc = func(ctx context.Context, db store.Queryer, uid entity.UserID) (*entity.TimeEntry, error) {
+		return &entity.TimeEntry{ID: 3, TaskID: 2, UserID: uid}, nil
+	}
+
+	sut := &StartTimer{Repo: moq, Clocker: clock.FixedClocker{}}
+	ctx := auth.SetUserID(context.Background(), 10)
+	_, err := sut.StartTimer(ctx, 1)
+	if !errors.Is(err, ErrTimerRunning) {
+		t.Errorf("want %v, but got %v", ErrTimerRunning, err)
+	}
+	if n := len(moq.AddTimeEntryCalls()); n != 0 {
+		t.Errorf("want no insert, but called %d times", n)
+	}
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+)
+
+// ErrUnknownStatus는 사용자가 정의하지 않은 상태로 변경하려고 할 때 반환된다.
+var ErrUnknownStatus = errors.New("unknown status")
+
+type UpdateTask struct {
+	DB        store.TxBeginner
+	Repo      TaskEditor
+	Publisher EventPublisher // Task 변경 이벤트를 전달한다. nil이면 전달하지 않는다.
+}
+
+// UpdateTask 메서드는 nil이 아닌 항목만 갱신한다.
+func (u *UpdateTask) UpdateTask(
+	ctx context.Context, tid entity.TaskID, title *string, status *entity.TaskStatus,
+) (*entity.Task, error) {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("user_id not found")
+	}
+	// Task와 변경 내역을 함께 기록한다.
+	tx, err := u.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin: %w", err)
+	}
+	// Commit 이후의 Rollback은 아무것도 하지 않는다.
+	defer func() { _ = tx.Rollback() }()
+
+	t, err := u.Repo.GetTask(ctx, tx, id, tid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get: %w", err)
+	}
+	if title != nil {
+		t.Title = *title
+	}
+	completed := false
+	if status != nil {
+		defs, err := loadTaskStatuses(ctx, tx, u.Repo, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list statuses: %w", err)
+		}
+		if _, ok := defs.Find(*status); !ok {
+			return nil, fmt.Errorf("%q: %w", *status, ErrUnknownStatus)
+		}
+		// 완료 분류가 아닌 상태에서 완료 분류의 상태로 바뀌면 완료 이벤트를 함께 전달한다.
+		completed = !defs.IsClosed(t.Status) && defs.IsClosed(*status)
+		t.Status = *status
+	}
+	if err := u.Repo.UpdateTask(ctx, tx, t); err != nil {
+		return nil, fmt.Errorf("failed to update: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit: %w", err)
+	}
+	publish(ctx, u.Publisher, entity.EventTaskUpdated, t)
+	if completed {
+		publish(ctx, u.Publisher, entity.EventTaskCompleted, t)
+	}
+	return t, nil
+}
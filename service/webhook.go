@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/gitwub5/go_todo_app/webhook"
+)
+
+// ErrUnknownEvent는 구독할 수 없는 이벤트 종류를 지정했을 때 반환된다.
+var ErrUnknownEvent = errors.New("unknown event")
+
+// ErrInvalidWebhookURL은 Webhook URL의 호스트를 찾을 수 없거나 내부 주소를 가리킬 때 반환된다.
+var ErrInvalidWebhookURL = errors.New("invalid webhook url")
+
+// maxWebhookDeliveries는 전송 기록 조회 시 반환하는 최대 건수이다.
+const maxWebhookDeliveries = 100
+
+type AddWebhook struct {
+	DB       store.Execer
+	Repo     WebhookRepository
+	Resolver webhook.Resolver // nil이면 net.DefaultResolver를 사용한다.
+}
+
+// AddWebhook 메서드는 Webhook을 등록한다. 서명 키는 이 메서드의 반환값으로만 확인할 수 있다.
+func (a *AddWebhook) AddWebhook(
+	ctx context.Context, url string, events []entity.EventType,
+) (*entity.Webhook, error) {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("user_id not found")
+	}
+	for _, e := range events {
+		if !entity.WebhookEvents(entity.EventTypes).Has(e) {
+			return nil, fmt.Errorf("%q: %w", e, ErrUnknownEvent)
+		}
+	}
+	// 전송할 때도 접속 직전에 다시 확인하지만, 내부 주소를 가리키는 URL은 등록부터 거부한다.
+	if err := webhook.ValidateURL(ctx, a.Resolver, url); err != nil {
+		return nil, fmt.Errorf("%v: %w", err, ErrInvalidWebhookURL)
+	}
+	secret, err := webhook.NewSecret()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate secret: %w", err)
+	}
+	w := &entity.Webhook{
+		UserID: id,
+		URL:    url,
+		Events: events,
+		Secret: secret,
+		Active: true,
+	}
+	if err := a.Repo.AddWebhook(ctx, a.DB, w); err != nil {
+		return nil, fmt.Errorf("failed to register: %w", err)
+	}
+	return w, nil
+}
+
+type ListWebhook struct {
+	DB   store.Queryer
+	Repo WebhookRepository
+}
+
+func (l *ListWebhook) ListWebhooks(ctx context.Context) (entity.Webhooks, error) {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("user_id not found")
+	}
+	ws, err := l.Repo.ListWebhooks(ctx, l.DB, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list: %w", err)
+	}
+	return ws, nil
+}
+
+// ListWebhookDeliveries 메서드는 사용자의 Webhook 전송 기록을 최신순으로 반환한다.
+func (l *ListWebhook) ListWebhookDeliveries(
+	ctx context.Context, wid entity.WebhookID,
+) (entity.WebhookDeliveries, error) {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("user_id not found")
+	}
+	if _, err := l.Repo.GetWebhook(ctx, l.DB, id, wid); err != nil {
+		return nil, fmt.Errorf("failed to get: %w", err)
+	}
+	ds, err := l.Repo.ListWebhookDeliveries(ctx, l.DB, wid, maxWebhookDeliveries)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list: %w", err)
+	}
+	return ds, nil
+}
+
+type EditWebhook struct {
+	DB   store.ExecQueryer
+	Repo WebhookRepository
+}
+
+func (e *EditWebhook) DeleteWebhook(ctx context.Context, wid entity.WebhookID) error {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return fmt.Errorf("user_id not found")
+	}
+	if err := e.Repo.DeleteWebhook(ctx, e.DB, id, wid); err != nil {
+		return fmt.Errorf("failed to delete: %w", err)
+	}
+	return nil
+}
+
+// EnableWebhook 메서드는 연속 실패로 비활성화된 Webhook을 다시 활성화한다.
+func (e *EditWebhook) EnableWebhook(ctx context.Context, wid entity.WebhookID) (*entity.Webhook, error) {
+	id, ok := auth.GetUserID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("user_id not found")
+	}
+	w, err := e.Repo.GetWebhook(ctx, e.DB, id, wid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get: %w", err)
+	}
+	w.Active = true
+	w.FailureCount = 0
+	if err := e.Repo.UpdateWebhookStatus(ctx, e.DB, w); err != nil {
+		return nil, fmt.Errorf("failed to update: %w", err)
+	}
+	return w, nil
+}
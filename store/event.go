@@ -0,0 +1,112 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/go-redis/redis/v8"
+)
+
+/*
+Redis를 사용해 실시간 스트림 이벤트를 여러 서버 인스턴스에 전달하고, 재전송을 위해 최근 이벤트를 보관한다.
+*/
+
+const (
+	eventSeqKey    = "event:seq"    // 이벤트 ID를 발급하는 카운터
+	eventChannel   = "event:stream" // 이벤트를 전달하는 Pub/Sub 채널
+	eventReplayTTL = 24 * time.Hour // 재전송 버퍼를 보관하는 기간
+)
+
+func eventReplayKey(uid entity.UserID) string {
+	return fmt.Sprintf("event:replay:%d", uid)
+}
+
+// NextEventID는 모든 서버 인스턴스에서 단조 증가하는 이벤트 ID를 발급한다.
+func (k *KVS) NextEventID(ctx context.Context) (int64, error) {
+	return k.Cli.Incr(ctx, eventSeqKey).Result()
+}
+
+// AppendEvent는 사용자의 재전송 버퍼에 이벤트를 추가하고, 최근 size개만 남긴다.
+func (k *KVS) AppendEvent(ctx context.Context, e *entity.StreamEvent, size int64) error {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	key := eventReplayKey(e.UserID)
+	_, err = k.Cli.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		p.RPush(ctx, key, b)
+		p.LTrim(ctx, key, -size, -1)
+		p.Expire(ctx, key, eventReplayTTL)
+		return nil
+	})
+	return err
+}
+
+// ListEvents는 재전송 버퍼에서 after보다 ID가 큰 이벤트를 오래된 순서로 반환한다.
+func (k *KVS) ListEvents(ctx context.Context, uid entity.UserID, after int64) ([]*entity.StreamEvent, error) {
+	vs, err := k.Cli.LRange(ctx, eventReplayKey(uid), 0, -1).Result()
+	if err != nil {
+		return nil, err
+	}
+	es := []*entity.StreamEvent{}
+	for _, v := range vs {
+		var e entity.StreamEvent
+		if err := json.Unmarshal([]byte(v), &e); err != nil {
+			return nil, err
+		}
+		if e.ID > after {
+			es = append(es, &e)
+		}
+	}
+	return es, nil
+}
+
+// PublishEvent는 모든 서버 인스턴스에 이벤트를 발행한다.
+func (k *KVS) PublishEvent(ctx context.Context, e *entity.StreamEvent) error {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	return k.Cli.Publish(ctx, eventChannel, b).Err()
+}
+
+// SubscribeEvents는 모든 서버 인스턴스에서 발행된 이벤트를 받는 채널을 반환한다.
+// 구독이 완료된 뒤에 반환하며, ctx가 종료되면 구독을 해제하고 채널을 닫는다.
+func (k *KVS) SubscribeEvents(ctx context.Context) (<-chan *entity.StreamEvent, error) {
+	ps := k.Cli.Subscribe(ctx, eventChannel)
+	if _, err := ps.Receive(ctx); err != nil {
+		_ = ps.Close()
+		return nil, err
+	}
+	ch := make(chan *entity.StreamEvent)
+	go func() {
+		defer close(ch)
+		defer ps.Close()
+		msgs := ps.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case m, ok := <-msgs:
+				if !ok {
+					return
+				}
+				var e entity.StreamEvent
+				if err := json.Unmarshal([]byte(m.Payload), &e); err != nil {
+					log.Printf("failed to decode stream event: %v", err)
+					continue
+				}
+				select {
+				case ch <- &e:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return ch, nil
+}
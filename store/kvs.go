@@ -3,6 +3,7 @@ package store
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/gitwub5/go_todo_app/config"
@@ -30,10 +31,15 @@ type KVS struct {
 	Cli *redis.Client
 }
 
-// Save는 주어진 키에 주어진 사용자 ID를 저장한다.
-func (k *KVS) Save(ctx context.Context, key string, userID entity.UserID) error {
+// Save는 주어진 키에 주어진 사용자 ID를 ttl 동안 저장한다.
+func (k *KVS) Save(ctx context.Context, key string, userID entity.UserID, ttl time.Duration) error {
 	id := int64(userID)
-	return k.Cli.Set(ctx, key, id, 30*time.Minute).Err()
+	return k.Cli.Set(ctx, key, id, ttl).Err()
+}
+
+// Delete는 주어진 키를 삭제한다.
+func (k *KVS) Delete(ctx context.Context, key string) error {
+	return k.Cli.Del(ctx, key).Err()
 }
 
 // Load는 주어진 키에 저장된 사용자 ID를 반환한다.
@@ -44,3 +50,50 @@ func (k *KVS) Load(ctx context.Context, key string) (entity.UserID, error) {
 	}
 	return entity.UserID(id), nil
 }
+
+// Take는 주어진 키를 삭제하고 저장되어 있던 사용자 ID를 반환한다.
+// 여러 요청이 같은 키를 동시에 사용해도 한 요청만 사용자 ID를 얻는다.
+func (k *KVS) Take(ctx context.Context, key string) (entity.UserID, error) {
+	id, err := k.Cli.GetDel(ctx, key).Int64()
+	if err != nil {
+		return 0, fmt.Errorf("failed to take %q: %w", key, ErrNotFound)
+	}
+	return entity.UserID(id), nil
+}
+
+// accessTokenKeyPattern은 액세스 토큰의 키(JTI, UUID)와 일치하는 패턴이다.
+const accessTokenKeyPattern = "????????-????-????-????-????????????"
+
+// ListTokenKeys는 사용자에게 발급한 액세스 토큰의 키를 찾는다. uid가 0이면 모든 사용자의 키를 찾는다.
+// 토큰을 사용자별로 색인하지 않으므로 모든 키를 훑는다. 운영 도구처럼 드물게 실행하는 곳에서만 사용한다.
+func (k *KVS) ListTokenKeys(ctx context.Context, uid entity.UserID) ([]string, error) {
+	return k.scanUserKeys(ctx, accessTokenKeyPattern, uid)
+}
+
+// scanUserKeys 메서드는 pattern과 일치하고 값이 uid인 키를 찾는다. uid가 0이면 값을 확인하지 않는다.
+func (k *KVS) scanUserKeys(ctx context.Context, pattern string, uid entity.UserID) ([]string, error) {
+	keys := []string{}
+	iter := k.Cli.Scan(ctx, 0, pattern, 1000).Iterator()
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+	}
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+	if uid == 0 || len(keys) == 0 {
+		return keys, nil
+	}
+	vs, err := k.Cli.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+	want := strconv.FormatInt(int64(uid), 10)
+	owned := []string{}
+	for i, v := range vs {
+		// 훑는 사이에 만료된 키는 nil이다.
+		if v == want {
+			owned = append(owned, keys[i])
+		}
+	}
+	return owned, nil
+}
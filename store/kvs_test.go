@@ -3,11 +3,15 @@ package store
 import (
 	"context"
 	"errors"
+	"slices"
+	"sort"
 	"testing"
 	"time"
 
 	"github.com/gitwub5/go_todo_app/entity"
 	"github.com/gitwub5/go_todo_app/testutil"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
 )
 
 func TestKVS_Save(t *testing.T) {
@@ -22,9 +26,12 @@ func TestKVS_Save(t *testing.T) {
 	t.Cleanup(func() {
 		cli.Del(ctx, key)
 	})
-	if err := sut.Save(ctx, key, uid); err != nil {
+	if err := sut.Save(ctx, key, uid, 10*time.Minute); err != nil {
 		t.Errorf("want no error, but got %v", err)
 	}
+	if ttl := cli.TTL(ctx, key).Val(); ttl <= 0 || ttl > 10*time.Minute {
+		t.Errorf("want ttl in (0, 10m], but got %v", ttl)
+	}
 }
 
 func TestKVS_Load(t *testing.T) {
@@ -63,3 +70,74 @@ func TestKVS_Load(t *testing.T) {
 		}
 	})
 }
+
+func TestKVS_Take(t *testing.T) {
+	t.Parallel()
+
+	cli := testutil.OpenRedisForTest(t)
+	sut := &KVS{Cli: cli}
+	key := "TestKVS_Take"
+	uid := entity.UserID(1234)
+	ctx := context.Background()
+	cli.Set(ctx, key, int64(uid), 30*time.Minute)
+	t.Cleanup(func() {
+		cli.Del(ctx, key)
+	})
+
+	got, err := sut.Take(ctx, key)
+	if err != nil {
+		t.Fatalf("want no error, but got %v", err)
+	}
+	if got != uid {
+		t.Errorf("want %d, but got %d", uid, got)
+	}
+	// 한 번 사용한 키는 다시 사용할 수 없다.
+	if _, err := sut.Take(ctx, key); !errors.Is(err, ErrNotFound) {
+		t.Errorf("want %v, but got %v", ErrNotFound, err)
+	}
+}
+
+func TestKVS_ListTokenKeys(t *testing.T) {
+	t.Parallel()
+
+	cli := testutil.OpenRedisForTest(t)
+	sut := &KVS{Cli: cli}
+	ctx := context.Background()
+
+	// 다른 테스트의 토큰과 겹치지 않도록 임의의 사용자 ID를 사용한다.
+	uid := entity.UserID(time.Now().UnixNano())
+	mine := []string{uuid.New().String(), uuid.New().String()}
+	other := uuid.New().String()
+	notToken := "password_reset:TestKVS_ListTokenKeys"
+	for _, k := range mine {
+		cli.Set(ctx, k, int64(uid), 30*time.Minute)
+	}
+	cli.Set(ctx, other, int64(uid)+1, 30*time.Minute)
+	cli.Set(ctx, notToken, int64(uid), 30*time.Minute)
+	t.Cleanup(func() {
+		cli.Del(ctx, append(mine, other, notToken)...)
+	})
+
+	got, err := sut.ListTokenKeys(ctx, uid)
+	if err != nil {
+		t.Fatalf("want no error, but got %v", err)
+	}
+	sort.Strings(got)
+	sort.Strings(mine)
+	if diff := cmp.Diff(got, mine); diff != "" {
+		t.Errorf("differs: (-got +want)\n%s", diff)
+	}
+
+	all, err := sut.ListTokenKeys(ctx, 0)
+	if err != nil {
+		t.Fatalf("want no error, but got %v", err)
+	}
+	for _, k := range append(mine, other) {
+		if !slices.Contains(all, k) {
+			t.Errorf("want %s in all tokens", k)
+		}
+	}
+	if slices.Contains(all, notToken) {
+		t.Errorf("want %s not to be a token", notToken)
+	}
+}
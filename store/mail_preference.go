@@ -0,0 +1,33 @@
+package store
+
+import (
+	"context"
+
+	"github.com/gitwub5/go_todo_app/entity"
+)
+
+// RDBMS로부터 사용자가 수신을 거부한 메일 종류를 가져오는 메서드
+func (r *Repository) ListMailOptOuts(
+	ctx context.Context, db Queryer, uid entity.UserID,
+) ([]entity.MailKind, error) {
+	kinds := []entity.MailKind{}
+	sql := `SELECT kind FROM mail_opt_out WHERE user_id = ? ORDER BY kind;`
+	if err := db.SelectContext(ctx, &kinds, sql, uid); err != nil {
+		return nil, err
+	}
+	return kinds, nil
+}
+
+// RDBMS에 메일 수신 거부 여부를 저장하는 메서드
+func (r *Repository) SetMailOptOut(
+	ctx context.Context, db Execer, uid entity.UserID, kind entity.MailKind, optOut bool,
+) error {
+	if !optOut {
+		sql := `DELETE FROM mail_opt_out WHERE user_id = ? AND kind = ?`
+		_, err := db.ExecContext(ctx, sql, uid, kind)
+		return err
+	}
+	sql := `INSERT IGNORE INTO mail_opt_out (user_id, kind, created) VALUES (?, ?, ?)`
+	_, err := db.ExecContext(ctx, sql, uid, kind, r.Clocker.Now())
+	return err
+}
@@ -0,0 +1,148 @@
+package store
+
+import (
+	"context"
+	dbsql "database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/go-sql-driver/mysql"
+)
+
+// RDBMS에 알림을 등록하는 메서드
+// DedupeKey가 같은 알림이 이미 있으면 ErrAlreadyEntry를 반환한다.
+func (r *Repository) AddNotification(
+	ctx context.Context, db Execer, n *entity.Notification,
+) error {
+	n.Created = r.Clocker.Now()
+	sql := `INSERT INTO notification
+			(user_id, type, task_id, message, dedupe_key, created)
+	VALUES (?, ?, ?, ?, ?, ?)`
+	result, err := db.ExecContext(
+		ctx, sql, n.UserID, n.Type, n.TaskID, n.Message, n.DedupeKey, n.Created,
+	)
+	if err != nil {
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == ErrCodeMySQLDuplicateEntry {
+			return fmt.Errorf("notification is already sent: %w", ErrAlreadyEntry)
+		}
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	n.ID = entity.NotificationID(id)
+	return nil
+}
+
+// RDBMS로부터 사용자의 알림 목록을 최신순으로 가져오는 메서드
+// unreadOnly가 true이면 읽지 않은 알림만 가져온다.
+func (r *Repository) ListNotifications(
+	ctx context.Context, db Queryer, uid entity.UserID, unreadOnly bool,
+) (entity.Notifications, error) {
+	ns := entity.Notifications{}
+	sql := `SELECT
+				id, user_id, type, task_id, message, dedupe_key, read_at, created
+			FROM notification
+			WHERE user_id = ? AND (? = FALSE OR read_at IS NULL)
+			ORDER BY id DESC;`
+	if err := db.SelectContext(ctx, &ns, sql, uid, unreadOnly); err != nil {
+		return nil, err
+	}
+	return ns, nil
+}
+
+// RDBMS로부터 사용자의 읽지 않은 알림 수를 가져오는 메서드
+func (r *Repository) CountUnreadNotifications(
+	ctx context.Context, db Queryer, uid entity.UserID,
+) (int, error) {
+	var n int
+	sql := `SELECT COUNT(*) FROM notification
+			WHERE user_id = ? AND read_at IS NULL;`
+	if err := db.GetContext(ctx, &n, sql, uid); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// RDBMS의 알림 하나를 읽음으로 표시하는 메서드
+// 이미 읽은 알림은 읽은 시간을 바꾸지 않는다.
+func (r *Repository) MarkNotificationRead(
+	ctx context.Context, db ExecQueryer, uid entity.UserID, id entity.NotificationID,
+) error {
+	// 이미 읽은 알림은 갱신되는 행이 없으므로 RowsAffected 대신 존재 여부를 먼저 확인한다.
+	var exists int
+	sql := `SELECT 1 FROM notification WHERE id = ? AND user_id = ?;`
+	if err := db.GetContext(ctx, &exists, sql, id, uid); err != nil {
+		if errors.Is(err, dbsql.ErrNoRows) {
+			return fmt.Errorf("notification %d: %w", id, ErrNotFound)
+		}
+		return err
+	}
+	sql = `UPDATE notification SET read_at = ?
+			WHERE id = ? AND user_id = ? AND read_at IS NULL`
+	_, err := db.ExecContext(ctx, sql, r.Clocker.Now(), id, uid)
+	return err
+}
+
+// RDBMS의 사용자 알림을 모두 읽음으로 표시하고, 표시한 알림 수를 반환하는 메서드
+func (r *Repository) MarkAllNotificationsRead(
+	ctx context.Context, db Execer, uid entity.UserID,
+) (int64, error) {
+	sql := `UPDATE notification SET read_at = ?
+			WHERE user_id = ? AND read_at IS NULL`
+	result, err := db.ExecContext(ctx, sql, r.Clocker.Now(), uid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// RDBMS에서 before 이전에 읽은 알림을 삭제하고, 삭제한 알림 수를 반환하는 메서드
+// 마감 초과 알림은 ListOverdueTasks가 이미 알린 태스크를 거르는 데 사용하므로 삭제하지 않는다.
+func (r *Repository) DeleteReadNotifications(
+	ctx context.Context, db Execer, before time.Time,
+) (int64, error) {
+	sql := `DELETE FROM notification
+			WHERE read_at IS NOT NULL AND read_at < ? AND type <> ?`
+	result, err := db.ExecContext(ctx, sql, before, entity.NotificationTaskOverdue)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// RDBMS로부터 now 시점에 마감 시간이 지났고 소유자나 담당자 중 아직 마감 초과 알림을 받지 않은 사용자가 있는 미완료 태스크를 가져오는 메서드
+// 알림은 받는 사용자마다 따로 기록하므로, 한 사용자에게 알렸다고 다른 사용자에게 알리지 않는 일이 없도록 각각 확인한다.
+// 완료 여부는 소유자가 정의한 상태 분류로 판단하고, 정의하지 않은 상태는 기본 상태 이름(done)으로 판단한다.
+// 완료한 태스크를 조회할 때마다 다시 읽지 않도록 SQL에서 걸러낸다.
+func (r *Repository) ListOverdueTasks(
+	ctx context.Context, db Queryer, now time.Time,
+) (entity.Tasks, error) {
+	tasks := entity.Tasks{}
+	sql := `SELECT
+				t.id, t.user_id, t.project_id, t.parent_id, t.assignee_id, t.title,
+				t.status, t.due, t.created, t.modified
+			FROM task t
+			LEFT JOIN task_status s
+				ON s.user_id = t.user_id AND s.name = t.status
+			LEFT JOIN notification o
+				ON o.task_id = t.id AND o.user_id = t.user_id AND o.type = ?
+			LEFT JOIN notification a
+				ON a.task_id = t.id AND a.user_id = t.assignee_id AND a.type = ?
+			WHERE t.due IS NOT NULL AND t.due < ?
+				AND (o.id IS NULL OR (t.assignee_id <> t.user_id AND a.id IS NULL))
+				AND COALESCE(s.category, IF(t.status = ?, ?, ?)) <> ?
+			ORDER BY t.id;`
+	if err := db.SelectContext(
+		ctx, &tasks, sql, entity.NotificationTaskOverdue, entity.NotificationTaskOverdue, now,
+		entity.TaskStatusDone, entity.TaskStatusCategoryClosed, entity.TaskStatusCategoryOpen,
+		entity.TaskStatusCategoryClosed,
+	); err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
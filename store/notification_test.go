@@ -0,0 +1,131 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/clock"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/testutil"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRepository_ListOverdueTasks(t *testing.T) {
+	ctx := context.Background()
+	tx, err := testutil.OpenDBForTest(t).BeginTxx(ctx, nil)
+	t.Cleanup(func() { _ = tx.Rollback() })
+	if err != nil {
+		t.Fatal(err)
+	}
+	uid := prepareUser(ctx, t, tx)
+	c := clock.FixedClocker{}
+	sut := &Repository{Clocker: c}
+	if err := sut.AddTaskStatus(ctx, tx, &entity.TaskStatusDef{
+		UserID: uid, Name: "shipped", Category: entity.TaskStatusCategoryClosed,
+	}); err != nil {
+		t.Fatalf("failed to add status: %v", err)
+	}
+
+	due := c.Now().Add(-time.Hour)
+	tasks := map[string]*entity.Task{}
+	for _, s := range []entity.TaskStatus{"todo", "review", "done", "shipped", "notified"} {
+		task := &entity.Task{UserID: uid, Title: string(s), Status: s, Due: &due}
+		if err := sut.AddTask(ctx, tx, task); err != nil {
+			t.Fatalf("failed to add task: %v", err)
+		}
+		tasks[string(s)] = task
+	}
+	// 담당자가 있는 Task는 소유자와 담당자 모두에게 알렸을 때만 제외한다.
+	assignee := prepareUser(ctx, t, tx)
+	for _, n := range []string{"ownerNotified", "assigneeNotified", "allNotified"} {
+		task := &entity.Task{UserID: uid, Title: n, Status: "todo", Due: &due}
+		if err := sut.AddTask(ctx, tx, task); err != nil {
+			t.Fatalf("failed to add task: %v", err)
+		}
+		task.AssigneeID = &assignee
+		if err := sut.AssignTask(ctx, tx, task); err != nil {
+			t.Fatalf("failed to assign task: %v", err)
+		}
+		tasks[n] = task
+	}
+	for n, uids := range map[string][]entity.UserID{
+		"notified":         {uid},
+		"ownerNotified":    {uid},
+		"assigneeNotified": {assignee},
+		"allNotified":      {uid, assignee},
+	} {
+		for _, id := range uids {
+			key := entity.OverdueDedupeKey(tasks[n].ID, id)
+			if err := sut.AddNotification(ctx, tx, &entity.Notification{
+				UserID: id, Type: entity.NotificationTaskOverdue,
+				TaskID: &tasks[n].ID, DedupeKey: &key,
+			}); err != nil {
+				t.Fatalf("failed to add notification: %v", err)
+			}
+		}
+	}
+
+	ts, err := sut.ListOverdueTasks(ctx, tx, c.Now())
+	if err != nil {
+		t.Fatalf("failed to list: %v", err)
+	}
+	got := []entity.TaskID{}
+	for _, task := range ts {
+		if task.UserID == uid {
+			got = append(got, task.ID)
+		}
+	}
+	// 완료 분류의 상태와 모든 수신자에게 이미 알린 Task는 제외한다.
+	want := []entity.TaskID{
+		tasks["todo"].ID, tasks["review"].ID,
+		tasks["ownerNotified"].ID, tasks["assigneeNotified"].ID,
+	}
+	if d := cmp.Diff(got, want); d != "" {
+		t.Errorf("differs: (-got +want)\n%s", d)
+	}
+}
+
+func TestRepository_DeleteReadNotifications(t *testing.T) {
+	ctx := context.Background()
+	tx, err := testutil.OpenDBForTest(t).BeginTxx(ctx, nil)
+	t.Cleanup(func() { _ = tx.Rollback() })
+	if err != nil {
+		t.Fatal(err)
+	}
+	uid := prepareUser(ctx, t, tx)
+	sut := &Repository{Clocker: clock.FixedClocker{}}
+
+	// 다른 테스트의 알림을 삭제하지 않도록 충분히 이전 시점을 기준으로 한다.
+	before := time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC)
+	old := before.AddDate(0, 0, -1)
+	rows := []struct {
+		typ    entity.NotificationType
+		readAt *time.Time
+	}{
+		{entity.NotificationTaskAssigned, &old},
+		{entity.NotificationTaskAssigned, nil},
+		{entity.NotificationTaskOverdue, &old},
+	}
+	for _, r := range rows {
+		if _, err := tx.ExecContext(ctx,
+			`INSERT INTO notification (user_id, type, message, read_at, created) VALUES (?, ?, 'm', ?, ?)`,
+			uid, r.typ, r.readAt, old); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := sut.DeleteReadNotifications(ctx, tx, before); err != nil {
+		t.Fatalf("failed to delete: %v", err)
+	}
+	var got []entity.NotificationType
+	if err := tx.SelectContext(ctx, &got,
+		`SELECT type FROM notification WHERE user_id = ? ORDER BY id`, uid); err != nil {
+		t.Fatal(err)
+	}
+	// 읽지 않은 알림과 마감 초과 알림은 남긴다.
+	want := []entity.NotificationType{entity.NotificationTaskAssigned, entity.NotificationTaskOverdue}
+	if d := cmp.Diff(got, want); d != "" {
+		t.Errorf("differs: (-got +want)\n%s", d)
+	}
+}
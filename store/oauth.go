@@ -0,0 +1,110 @@
+package store
+
+import (
+	"context"
+	dbsql "database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/go-redis/redis/v8"
+)
+
+// RDBMS에 OAuth 클라이언트를 등록하는 메서드
+func (r *Repository) AddOAuthClient(ctx context.Context, db Execer, c *entity.OAuthClient) error {
+	c.Created = r.Clocker.Now()
+	sql := `INSERT INTO oauth_client
+			(id, user_id, name, secret_hash, redirect_uris, scopes, created)
+	VALUES (?, ?, ?, ?, ?, ?, ?)`
+	_, err := db.ExecContext(
+		ctx, sql, c.ID, c.UserID, c.Name, c.SecretHash, c.RedirectURIs, c.Scopes, c.Created,
+	)
+	return err
+}
+
+// RDBMS로부터 사용자가 등록한 OAuth 클라이언트 목록을 가져오는 메서드
+func (r *Repository) ListOAuthClients(
+	ctx context.Context, db Queryer, uid entity.UserID,
+) (entity.OAuthClients, error) {
+	cs := entity.OAuthClients{}
+	sql := `SELECT
+				id, user_id, name, secret_hash, redirect_uris, scopes, created
+			FROM oauth_client
+			WHERE user_id = ?
+			ORDER BY created, id;`
+	if err := db.SelectContext(ctx, &cs, sql, uid); err != nil {
+		return nil, err
+	}
+	return cs, nil
+}
+
+// RDBMS로부터 OAuth 클라이언트를 가져오는 메서드
+func (r *Repository) GetOAuthClient(
+	ctx context.Context, db Queryer, id entity.OAuthClientID,
+) (*entity.OAuthClient, error) {
+	c := &entity.OAuthClient{}
+	sql := `SELECT
+				id, user_id, name, secret_hash, redirect_uris, scopes, created
+			FROM oauth_client
+			WHERE id = ?;`
+	if err := db.GetContext(ctx, c, sql, id); err != nil {
+		if errors.Is(err, dbsql.ErrNoRows) {
+			return nil, fmt.Errorf("oauth client %q: %w", id, ErrNotFound)
+		}
+		return nil, err
+	}
+	return c, nil
+}
+
+// RDBMS로부터 사용자가 등록한 OAuth 클라이언트를 삭제하는 메서드
+func (r *Repository) DeleteOAuthClient(
+	ctx context.Context, db Execer, uid entity.UserID, id entity.OAuthClientID,
+) error {
+	sql := `DELETE FROM oauth_client WHERE id = ? AND user_id = ?`
+	result, err := db.ExecContext(ctx, sql, id, uid)
+	if err != nil {
+		return err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("oauth client %q: %w", id, ErrNotFound)
+	}
+	return nil
+}
+
+func authorizationCodeKey(key string) string {
+	return "oauth_code:" + key
+}
+
+// SaveAuthorizationCode는 인가 코드를 ttl 동안 저장한다.
+func (k *KVS) SaveAuthorizationCode(
+	ctx context.Context, key string, c *entity.AuthorizationCode, ttl time.Duration,
+) error {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+	return k.Cli.Set(ctx, authorizationCodeKey(key), b, ttl).Err()
+}
+
+// UseAuthorizationCode는 인가 코드를 삭제하고 내용을 반환한다. 여러 요청이 같은 코드를 동시에 사용해도 한 요청만 내용을 받는다.
+// 코드가 없거나 만료되었거나 이미 사용했으면 ErrNotFound를 반환한다.
+func (k *KVS) UseAuthorizationCode(ctx context.Context, key string) (*entity.AuthorizationCode, error) {
+	b, err := k.Cli.GetDel(ctx, authorizationCodeKey(key)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, fmt.Errorf("authorization code: %w", ErrNotFound)
+		}
+		return nil, err
+	}
+	c := &entity.AuthorizationCode{}
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
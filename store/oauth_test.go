@@ -0,0 +1,50 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/testutil"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+)
+
+func TestKVS_AuthorizationCode(t *testing.T) {
+	t.Parallel()
+
+	cli := testutil.OpenRedisForTest(t)
+	sut := &KVS{Cli: cli}
+	ctx := context.Background()
+
+	key := uuid.New().String()
+	t.Cleanup(func() {
+		cli.Del(ctx, authorizationCodeKey(key))
+	})
+	want := &entity.AuthorizationCode{
+		ClientID:      "client",
+		UserID:        10,
+		RedirectURI:   "https://app.example.com/callback",
+		Scopes:        entity.Scopes{entity.ScopeTasksRead},
+		CodeChallenge: "challenge",
+	}
+	if err := sut.SaveAuthorizationCode(ctx, key, want, time.Minute); err != nil {
+		t.Fatalf("want no error, but got %v", err)
+	}
+	if ttl := cli.TTL(ctx, authorizationCodeKey(key)).Val(); ttl <= 0 || ttl > time.Minute {
+		t.Errorf("want ttl of at most a minute, but got %v", ttl)
+	}
+	got, err := sut.UseAuthorizationCode(ctx, key)
+	if err != nil {
+		t.Fatalf("want no error, but got %v", err)
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("differs: (-got +want)\n%s", diff)
+	}
+	// 코드는 한 번만 사용할 수 있다.
+	if _, err := sut.UseAuthorizationCode(ctx, key); !errors.Is(err, ErrNotFound) {
+		t.Errorf("want ErrNotFound, but got %v", err)
+	}
+}
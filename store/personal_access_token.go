@@ -0,0 +1,99 @@
+package store
+
+import (
+	"context"
+	dbsql "database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/entity"
+)
+
+// RDBMS에 개인 액세스 토큰을 등록하는 메서드
+func (r *Repository) AddPersonalAccessToken(
+	ctx context.Context, db Execer, t *entity.PersonalAccessToken,
+) error {
+	t.Created = r.Clocker.Now()
+	sql := `INSERT INTO personal_access_token
+			(user_id, name, token_hash, scopes, expires, created)
+	VALUES (?, ?, ?, ?, ?, ?)`
+	result, err := db.ExecContext(
+		ctx, sql, t.UserID, t.Name, t.TokenHash, t.Scopes, t.Expires, t.Created,
+	)
+	if err != nil {
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	t.ID = entity.PersonalAccessTokenID(id)
+	return nil
+}
+
+// RDBMS로부터 사용자의 개인 액세스 토큰 목록을 가져오는 메서드
+func (r *Repository) ListPersonalAccessTokens(
+	ctx context.Context, db Queryer, uid entity.UserID,
+) (entity.PersonalAccessTokens, error) {
+	ts := entity.PersonalAccessTokens{}
+	sql := `SELECT
+				id, user_id, name, token_hash, scopes, expires, last_used, created
+			FROM personal_access_token
+			WHERE user_id = ?
+			ORDER BY id;`
+	if err := db.SelectContext(ctx, &ts, sql, uid); err != nil {
+		return nil, err
+	}
+	return ts, nil
+}
+
+// RDBMS로부터 토큰의 해시로 개인 액세스 토큰을 가져오는 메서드
+func (r *Repository) GetPersonalAccessTokenByHash(
+	ctx context.Context, db Queryer, hash string,
+) (*entity.PersonalAccessToken, error) {
+	t := &entity.PersonalAccessToken{}
+	sql := `SELECT
+				id, user_id, name, token_hash, scopes, expires, last_used, created
+			FROM personal_access_token
+			WHERE token_hash = ?;`
+	if err := db.GetContext(ctx, t, sql, hash); err != nil {
+		if errors.Is(err, dbsql.ErrNoRows) {
+			return nil, fmt.Errorf("personal access token: %w", ErrNotFound)
+		}
+		return nil, err
+	}
+	return t, nil
+}
+
+// RDBMS로부터 사용자의 개인 액세스 토큰을 삭제하는 메서드
+func (r *Repository) DeletePersonalAccessToken(
+	ctx context.Context, db Execer, uid entity.UserID, id entity.PersonalAccessTokenID,
+) error {
+	sql := `DELETE FROM personal_access_token WHERE id = ? AND user_id = ?`
+	result, err := db.ExecContext(ctx, sql, id, uid)
+	if err != nil {
+		return err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("personal access token %d: %w", id, ErrNotFound)
+	}
+	return nil
+}
+
+// RDBMS에 개인 액세스 토큰을 마지막으로 사용한 시간을 기록하는 메서드
+// 요청마다 쓰지 않도록 마지막으로 기록한 시간에서 interval이 지나지 않았으면 갱신하지 않는다.
+func (r *Repository) TouchPersonalAccessToken(
+	ctx context.Context, db Execer, id entity.PersonalAccessTokenID, interval time.Duration,
+) error {
+	now := r.Clocker.Now()
+	sql := `UPDATE personal_access_token
+			SET last_used = ?
+			WHERE id = ? AND (last_used IS NULL OR last_used <= ?)`
+	_, err := db.ExecContext(ctx, sql, now, id, now.Add(-interval))
+	return err
+}
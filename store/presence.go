@@ -0,0 +1,75 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/go-redis/redis/v8"
+)
+
+/*
+Redis의 정렬 집합(sorted set)을 사용해 프로젝트 보드를 보고 있는 사용자(presence)를 관리한다.
+멤버는 "<사용자 ID>:<세션 ID>", 점수는 만료 시각(Unix 초)이다.
+같은 사용자가 여러 창에서 보고 있어도 한 창을 닫았을 때 목록에서 빠지지 않도록 세션마다 따로 기록한다.
+*/
+
+// presenceKeyTTL은 마지막으로 갱신된 뒤 presence 기록 전체를 보관하는 기간이다.
+// 멤버별 만료는 점수로 판단하므로, 이 값은 아무도 보지 않는 프로젝트의 기록을 정리하기 위한 것이다.
+const presenceKeyTTL = 24 * time.Hour
+
+func presenceKey(pid entity.ProjectID) string {
+	return fmt.Sprintf("presence:project:%d", pid)
+}
+
+func presenceMember(uid entity.UserID, session string) string {
+	return fmt.Sprintf("%d:%s", uid, session)
+}
+
+// TouchPresence는 세션이 프로젝트 보드를 보고 있다고 기록하고, expires까지 유지한다.
+func (k *KVS) TouchPresence(ctx context.Context, pid entity.ProjectID, uid entity.UserID, session string, expires time.Time) error {
+	key := presenceKey(pid)
+	_, err := k.Cli.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		p.ZAdd(ctx, key, &redis.Z{Score: float64(expires.Unix()), Member: presenceMember(uid, session)})
+		p.Expire(ctx, key, presenceKeyTTL)
+		return nil
+	})
+	return err
+}
+
+// RemovePresence는 세션이 프로젝트 보드를 더 이상 보고 있지 않다고 기록한다.
+func (k *KVS) RemovePresence(ctx context.Context, pid entity.ProjectID, uid entity.UserID, session string) error {
+	return k.Cli.ZRem(ctx, presenceKey(pid), presenceMember(uid, session)).Err()
+}
+
+// ListPresence는 now 시점에 프로젝트 보드를 보고 있는 사용자 ID를 중복 없이 오름차순으로 반환한다.
+func (k *KVS) ListPresence(ctx context.Context, pid entity.ProjectID, now time.Time) ([]entity.UserID, error) {
+	key := presenceKey(pid)
+	// 만료된 세션을 먼저 정리한다.
+	if err := k.Cli.ZRemRangeByScore(ctx, key, "-inf", strconv.FormatInt(now.Unix(), 10)).Err(); err != nil {
+		return nil, err
+	}
+	ms, err := k.Cli.ZRange(ctx, key, 0, -1).Result()
+	if err != nil {
+		return nil, err
+	}
+	seen := map[entity.UserID]bool{}
+	uids := []entity.UserID{}
+	for _, m := range ms {
+		v, _, _ := strings.Cut(m, ":")
+		id, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid presence member %q: %w", m, err)
+		}
+		if uid := entity.UserID(id); !seen[uid] {
+			seen[uid] = true
+			uids = append(uids, uid)
+		}
+	}
+	sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+	return uids, nil
+}
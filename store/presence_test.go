@@ -0,0 +1,65 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/clock"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/testutil"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestKVS_Presence(t *testing.T) {
+	t.Parallel()
+
+	cli := testutil.OpenRedisForTest(t)
+	sut := &KVS{Cli: cli}
+	ctx := context.Background()
+	// 다른 테스트와 겹치지 않도록 프로젝트 ID를 시각으로 정한다.
+	pid := entity.ProjectID(time.Now().UnixNano())
+	t.Cleanup(func() {
+		cli.Del(ctx, presenceKey(pid))
+	})
+	now := clock.RealClocker{}.Now()
+
+	// 같은 사용자가 두 세션에서 보고 있고, 다른 사용자 한 명의 presence는 이미 만료되었다.
+	touch := []struct {
+		uid     entity.UserID
+		session string
+		expires time.Time
+	}{
+		{2, "a", now.Add(time.Minute)},
+		{2, "b", now.Add(time.Minute)},
+		{1, "c", now.Add(time.Minute)},
+		{3, "d", now.Add(-time.Second)},
+	}
+	for _, p := range touch {
+		if err := sut.TouchPresence(ctx, pid, p.uid, p.session, p.expires); err != nil {
+			t.Fatalf("want no error, but got %v", err)
+		}
+	}
+	got, err := sut.ListPresence(ctx, pid, now)
+	if err != nil {
+		t.Fatalf("want no error, but got %v", err)
+	}
+	if d := cmp.Diff([]entity.UserID{1, 2}, got); d != "" {
+		t.Errorf("differs: (-want +got)\n%s", d)
+	}
+
+	// 한 세션을 닫아도 다른 세션이 남아 있으면 목록에 남는다.
+	if err := sut.RemovePresence(ctx, pid, 2, "a"); err != nil {
+		t.Fatalf("want no error, but got %v", err)
+	}
+	if err := sut.RemovePresence(ctx, pid, 1, "c"); err != nil {
+		t.Fatalf("want no error, but got %v", err)
+	}
+	got, err = sut.ListPresence(ctx, pid, now)
+	if err != nil {
+		t.Fatalf("want no error, but got %v", err)
+	}
+	if d := cmp.Diff([]entity.UserID{2}, got); d != "" {
+		t.Errorf("differs: (-want +got)\n%s", d)
+	}
+}
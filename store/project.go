@@ -0,0 +1,196 @@
+package store
+
+import (
+	"context"
+	dbsql "database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/go-sql-driver/mysql"
+	"github.com/jmoiron/sqlx"
+)
+
+// RDBMS에 프로젝트를 등록하고, 프로젝트를 만든 사용자를 멤버로 등록하는 메서드
+// 두 레코드를 함께 등록하도록 트랜잭션을 전달해야 한다.
+func (r *Repository) AddProject(
+	ctx context.Context, db Execer, p *entity.Project,
+) error {
+	p.Created = r.Clocker.Now()
+	p.Modified = r.Clocker.Now()
+	sql := `INSERT INTO project
+			(owner_id, name, created, modified)
+	VALUES (?, ?, ?, ?)`
+	result, err := db.ExecContext(ctx, sql, p.OwnerID, p.Name, p.Created, p.Modified)
+	if err != nil {
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	p.ID = entity.ProjectID(id)
+	return r.AddProjectMember(ctx, db, p.ID, p.OwnerID)
+}
+
+// RDBMS로부터 사용자가 멤버인 프로젝트 목록을 가져오는 메서드
+func (r *Repository) ListProjects(
+	ctx context.Context, db Queryer, uid entity.UserID,
+) (entity.Projects, error) {
+	ps := entity.Projects{}
+	sql := `SELECT
+				p.id, p.owner_id, p.name, p.created, p.modified
+			FROM project p
+			JOIN project_member m ON m.project_id = p.id
+			WHERE m.user_id = ?
+			ORDER BY p.id;`
+	if err := db.SelectContext(ctx, &ps, sql, uid); err != nil {
+		return nil, err
+	}
+	return ps, nil
+}
+
+// RDBMS로부터 사용자가 멤버인 프로젝트 하나를 가져오는 메서드
+// 멤버가 아닌 프로젝트는 존재하지 않는 프로젝트와 구별하지 않고 ErrNotFound를 반환한다.
+func (r *Repository) GetProject(
+	ctx context.Context, db Queryer, uid entity.UserID, id entity.ProjectID,
+) (*entity.Project, error) {
+	p := &entity.Project{}
+	sql := `SELECT
+				p.id, p.owner_id, p.name, p.created, p.modified
+			FROM project p
+			JOIN project_member m ON m.project_id = p.id
+			WHERE p.id = ? AND m.user_id = ?;`
+	if err := db.GetContext(ctx, p, sql, id, uid); err != nil {
+		if errors.Is(err, dbsql.ErrNoRows) {
+			return nil, fmt.Errorf("project %d: %w", id, ErrNotFound)
+		}
+		return nil, err
+	}
+	return p, nil
+}
+
+// RDBMS로부터 ids 중 사용자가 멤버인 프로젝트를 가져오는 메서드
+func (r *Repository) ListProjectsByIDs(
+	ctx context.Context, db Queryer, uid entity.UserID, ids []entity.ProjectID,
+) (entity.Projects, error) {
+	ps := entity.Projects{}
+	if len(ids) == 0 {
+		return ps, nil
+	}
+	query, args, err := sqlx.In(`SELECT
+			p.id, p.owner_id, p.name, p.created, p.modified
+		FROM project p
+		JOIN project_member m ON m.project_id = p.id
+		WHERE p.id IN (?) AND m.user_id = ?
+		ORDER BY p.id`, ids, uid)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.SelectContext(ctx, &ps, query, args...); err != nil {
+		return nil, err
+	}
+	return ps, nil
+}
+
+// RDBMS로부터 프로젝트 멤버의 ID 목록을 가져오는 메서드
+func (r *Repository) ListProjectMembers(
+	ctx context.Context, db Queryer, id entity.ProjectID,
+) ([]entity.UserID, error) {
+	uids := []entity.UserID{}
+	sql := `SELECT user_id FROM project_member
+			WHERE project_id = ?
+			ORDER BY user_id;`
+	if err := db.SelectContext(ctx, &uids, sql, id); err != nil {
+		return nil, err
+	}
+	return uids, nil
+}
+
+// RDBMS에서 사용자가 프로젝트의 멤버인지 확인하는 메서드
+func (r *Repository) IsProjectMember(
+	ctx context.Context, db Queryer, id entity.ProjectID, uid entity.UserID,
+) (bool, error) {
+	var n int
+	sql := `SELECT COUNT(*) FROM project_member
+			WHERE project_id = ? AND user_id = ?;`
+	if err := db.GetContext(ctx, &n, sql, id, uid); err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// RDBMS에 프로젝트 멤버를 등록하는 메서드
+func (r *Repository) AddProjectMember(
+	ctx context.Context, db Execer, id entity.ProjectID, uid entity.UserID,
+) error {
+	sql := `INSERT INTO project_member
+			(project_id, user_id, created)
+	VALUES (?, ?, ?)`
+	if _, err := db.ExecContext(ctx, sql, id, uid, r.Clocker.Now()); err != nil {
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == ErrCodeMySQLDuplicateEntry {
+			return fmt.Errorf("user %d is already a member: %w", uid, ErrAlreadyEntry)
+		}
+		return err
+	}
+	return nil
+}
+
+// RDBMS에서 프로젝트 멤버를 삭제하는 메서드
+func (r *Repository) DeleteProjectMember(
+	ctx context.Context, db Execer, id entity.ProjectID, uid entity.UserID,
+) error {
+	sql := `DELETE FROM project_member WHERE project_id = ? AND user_id = ?`
+	result, err := db.ExecContext(ctx, sql, id, uid)
+	if err != nil {
+		return err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("member %d: %w", uid, ErrNotFound)
+	}
+	return nil
+}
+
+// RDBMS로부터 프로젝트에 속한 태스크 목록을 가져오는 메서드
+func (r *Repository) ListProjectTasks(
+	ctx context.Context, db Queryer, id entity.ProjectID,
+) (entity.Tasks, error) {
+	tasks := entity.Tasks{}
+	sql := `SELECT
+				id, user_id, project_id, parent_id, assignee_id, client_id, title,
+				status, due, labels, priority, recurrence, created, modified
+			FROM task
+			WHERE project_id = ?
+			ORDER BY id;`
+	if err := db.SelectContext(ctx, &tasks, sql, id); err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+// RDBMS의 태스크가 속한 프로젝트를 갱신하는 메서드. 프로젝트에서 뺄 때는 t.ProjectID를 nil로 전달한다.
+func (r *Repository) SetTaskProject(
+	ctx context.Context, db Execer, t *entity.Task,
+) error {
+	t.Modified = r.Clocker.Now()
+	sql := `UPDATE task
+			SET project_id = ?, modified = ?
+			WHERE id = ? AND user_id = ?`
+	result, err := db.ExecContext(ctx, sql, t.ProjectID, t.Modified, t.ID, t.UserID)
+	if err != nil {
+		return err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("task %d: %w", t.ID, ErrNotFound)
+	}
+	return addTaskChanges(ctx, db, t, entity.TaskChangeUpsert, t.Modified)
+}
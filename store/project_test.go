@@ -0,0 +1,90 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gitwub5/go_todo_app/clock"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/testutil"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRepository_Project(t *testing.T) {
+	ctx := context.Background()
+	tx, err := testutil.OpenDBForTest(t).BeginTxx(ctx, nil)
+	t.Cleanup(func() { _ = tx.Rollback() })
+	if err != nil {
+		t.Fatal(err)
+	}
+	owner := prepareUser(ctx, t, tx)
+	member := prepareUser(ctx, t, tx)
+	stranger := prepareUser(ctx, t, tx)
+
+	sut := &Repository{Clocker: clock.FixedClocker{}}
+	p := &entity.Project{OwnerID: owner, Name: "release"}
+	if err := sut.AddProject(ctx, tx, p); err != nil {
+		t.Fatalf("failed to add project: %v", err)
+	}
+	if err := sut.AddProjectMember(ctx, tx, p.ID, member); err != nil {
+		t.Fatalf("failed to add member: %v", err)
+	}
+	if err := sut.AddProjectMember(ctx, tx, p.ID, member); !errors.Is(err, ErrAlreadyEntry) {
+		t.Errorf("want ErrAlreadyEntry, but got %v", err)
+	}
+
+	got, err := sut.ListProjectMembers(ctx, tx, p.ID)
+	if err != nil {
+		t.Fatalf("failed to list members: %v", err)
+	}
+	if d := cmp.Diff(got, []entity.UserID{owner, member}); d != "" {
+		t.Errorf("members differ: (-got +want)\n%s", d)
+	}
+	ps, err := sut.ListProjects(ctx, tx, member)
+	if err != nil {
+		t.Fatalf("failed to list projects: %v", err)
+	}
+	if len(ps) != 1 || ps[0].ID != p.ID || ps[0].OwnerID != owner {
+		t.Errorf("want project %d, but got %v", p.ID, ps)
+	}
+	// 멤버가 아니면 프로젝트가 없는 것과 같다.
+	if _, err := sut.GetProject(ctx, tx, stranger, p.ID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("want ErrNotFound, but got %v", err)
+	}
+	if ok, err := sut.IsProjectMember(ctx, tx, p.ID, stranger); err != nil || ok {
+		t.Errorf("want stranger not to be a member, but got %v, %v", ok, err)
+	}
+	for uid, want := range map[entity.UserID]int{member: 1, stranger: 0} {
+		ps, err := sut.ListProjectsByIDs(ctx, tx, uid, []entity.ProjectID{p.ID})
+		if err != nil {
+			t.Fatalf("failed to list projects by ids: %v", err)
+		}
+		if len(ps) != want {
+			t.Errorf("want %d projects for user %d, but got %v", want, uid, ps)
+		}
+	}
+
+	task := &entity.Task{UserID: owner, Title: "ship", Status: entity.TaskStatusTodo}
+	if err := sut.AddTask(ctx, tx, task); err != nil {
+		t.Fatalf("failed to add task: %v", err)
+	}
+	task.ProjectID = &p.ID
+	if err := sut.SetTaskProject(ctx, tx, task); err != nil {
+		t.Fatalf("failed to set project: %v", err)
+	}
+	ts, err := sut.ListProjectTasks(ctx, tx, p.ID)
+	if err != nil {
+		t.Fatalf("failed to list tasks: %v", err)
+	}
+	if len(ts) != 1 || ts[0].ID != task.ID {
+		t.Errorf("want task %d, but got %v", task.ID, ts)
+	}
+
+	if err := sut.DeleteProjectMember(ctx, tx, p.ID, member); err != nil {
+		t.Fatalf("failed to delete member: %v", err)
+	}
+	if err := sut.DeleteProjectMember(ctx, tx, p.ID, member); !errors.Is(err, ErrNotFound) {
+		t.Errorf("want ErrNotFound, but got %v", err)
+	}
+}
@@ -0,0 +1,59 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/go-redis/redis/v8"
+)
+
+/*
+리프레시 토큰은 로그인할 때 시작한 세션에 속하며, 교체할 때마다 같은 세션의 토큰을 새로 발급한다.
+세션을 삭제하면 그 세션의 모든 리프레시 토큰을 사용할 수 없다.
+*/
+
+func refreshTokenKey(key string) string {
+	return "refresh_token:" + key
+}
+
+// SaveRefreshToken은 세션에 속한 리프레시 토큰을 ttl 동안 저장하고, 세션의 만료 시간도 ttl로 다시 시작한다.
+func (k *KVS) SaveRefreshToken(
+	ctx context.Context, key string, sid entity.SessionID, userID entity.UserID, ttl time.Duration,
+) error {
+	_, err := k.Cli.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		p.HSet(ctx, refreshTokenKey(key), "session", string(sid))
+		p.Expire(ctx, refreshTokenKey(key), ttl)
+		touchSession(ctx, p, sid, userID, ttl)
+		return nil
+	})
+	return err
+}
+
+// UseRefreshToken은 리프레시 토큰을 사용한 것으로 표시하고, 토큰이 속한 세션과 사용자 ID를 반환한다.
+// 이미 사용한 토큰이면 used가 true이다. 여러 요청이 같은 토큰을 동시에 사용해도 한 요청만 처음 사용한 것이 된다.
+// 토큰이 없거나 세션이 삭제되었으면 ErrNotFound를 반환한다.
+func (k *KVS) UseRefreshToken(
+	ctx context.Context, key string,
+) (sid entity.SessionID, userID entity.UserID, used bool, err error) {
+	tk := refreshTokenKey(key)
+	pipe := k.Cli.TxPipeline()
+	get := pipe.HGet(ctx, tk, "session")
+	first := pipe.HSetNX(ctx, tk, "used", 1)
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return "", 0, false, err
+	}
+	sid = entity.SessionID(get.Val())
+	if sid == "" {
+		// 없는 토큰이면 HSETNX가 만료 시간 없이 만든 키를 지운다.
+		_ = k.Cli.Del(ctx, tk).Err()
+		return "", 0, false, fmt.Errorf("refresh token: %w", ErrNotFound)
+	}
+	uid, err := k.sessionUserID(ctx, sid)
+	if err != nil {
+		return "", 0, false, err
+	}
+	return sid, uid, !first.Val(), nil
+}
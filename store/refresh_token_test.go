@@ -0,0 +1,77 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/testutil"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+)
+
+func TestKVS_RefreshToken(t *testing.T) {
+	t.Parallel()
+
+	cli := testutil.OpenRedisForTest(t)
+	sut := &KVS{Cli: cli}
+	ctx := context.Background()
+
+	// 다른 테스트의 키와 겹치지 않도록 임의의 세션, 토큰, 사용자 ID를 사용한다.
+	uid := entity.UserID(time.Now().UnixNano())
+	sid := entity.SessionID(uuid.New().String())
+	first, second := uuid.New().String(), uuid.New().String()
+	t.Cleanup(func() {
+		cli.Del(ctx, sessionKey(sid), userSessionsKey(uid), refreshTokenKey(first), refreshTokenKey(second))
+	})
+	s := &entity.Session{ID: sid, UserID: uid, IssuedAt: time.Now()}
+	if err := sut.SaveSession(ctx, s, 30*time.Minute); err != nil {
+		t.Fatalf("want no error, but got %v", err)
+	}
+	for _, k := range []string{first, second} {
+		if err := sut.SaveRefreshToken(ctx, k, sid, uid, 30*time.Minute); err != nil {
+			t.Fatalf("want no error, but got %v", err)
+		}
+	}
+
+	type result struct {
+		Session entity.SessionID
+		UserID  entity.UserID
+		Used    bool
+	}
+	use := func(t *testing.T, key string) (result, error) {
+		t.Helper()
+		s, id, used, err := sut.UseRefreshToken(ctx, key)
+		return result{s, id, used}, err
+	}
+
+	// 처음 사용하면 used가 false이고, 다시 사용하면 true이다.
+	for _, used := range []bool{false, true} {
+		got, err := use(t, first)
+		if err != nil {
+			t.Fatalf("want no error, but got %v", err)
+		}
+		if diff := cmp.Diff(got, result{sid, uid, used}); diff != "" {
+			t.Errorf("differs: (-got +want)\n%s", diff)
+		}
+	}
+
+	// 없는 토큰은 만료 시간이 없는 키를 남기지 않는다.
+	unknown := uuid.New().String()
+	if _, err := use(t, unknown); !errors.Is(err, ErrNotFound) {
+		t.Errorf("want ErrNotFound, but got %v", err)
+	}
+	if n := cli.Exists(ctx, refreshTokenKey(unknown)).Val(); n != 0 {
+		t.Errorf("want unknown token key to be deleted, but got %d keys", n)
+	}
+
+	// 세션을 삭제하면 사용하지 않은 토큰도 사용할 수 없다.
+	if err := sut.DeleteSession(ctx, sid); err != nil {
+		t.Fatalf("want no error, but got %v", err)
+	}
+	if _, err := use(t, second); !errors.Is(err, ErrNotFound) {
+		t.Errorf("want ErrNotFound, but got %v", err)
+	}
+}
@@ -51,6 +51,11 @@ type Beginner interface {
 	BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error)
 }
 
+// TxBeginner는 sqlx의 트랜잭션을 시작할 수 있는 DB를 나타낸다.
+type TxBeginner interface {
+	BeginTxx(ctx context.Context, opts *sql.TxOptions) (*sqlx.Tx, error)
+}
+
 type Preparer interface {
 	PreparexContext(ctx context.Context, query string) (*sqlx.Stmt, error)
 }
@@ -70,13 +75,39 @@ type Queryer interface {
 
 var (
 	// 인터페이스가 구현되었는지 확인하기 위해 빈 인터페이스를 사용한다.
-	_ Beginner = (*sqlx.DB)(nil)
-	_ Preparer = (*sqlx.DB)(nil)
-	_ Queryer  = (*sqlx.DB)(nil)
-	_ Execer   = (*sqlx.DB)(nil)
-	_ Execer   = (*sqlx.Tx)(nil)
+	_ Beginner   = (*sqlx.DB)(nil)
+	_ TxBeginner = (*sqlx.DB)(nil)
+	_ Preparer   = (*sqlx.DB)(nil)
+	_ Queryer    = (*sqlx.DB)(nil)
+	_ Execer     = (*sqlx.DB)(nil)
+	_ Execer     = (*sqlx.Tx)(nil)
 )
 
 type Repository struct {
 	Clocker clock.Clocker
 }
+
+// ExecQueryer는 조회와 갱신을 모두 수행하는 서비스에서 사용한다.
+type ExecQueryer interface {
+	Execer
+	Queryer
+}
+
+// TxQueryer는 트랜잭션을 시작할 수 있고, 트랜잭션 밖에서 조회도 할 수 있는 DB를 나타낸다.
+type TxQueryer interface {
+	TxBeginner
+	Queryer
+}
+
+// TxExecQueryer는 트랜잭션을 시작할 수 있고, 트랜잭션 밖에서 조회와 갱신도 할 수 있는 DB를 나타낸다.
+type TxExecQueryer interface {
+	TxBeginner
+	ExecQueryer
+}
+
+var (
+	_ ExecQueryer   = (*sqlx.DB)(nil)
+	_ ExecQueryer   = (*sqlx.Tx)(nil)
+	_ TxQueryer     = (*sqlx.DB)(nil)
+	_ TxExecQueryer = (*sqlx.DB)(nil)
+)
@@ -0,0 +1,170 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/go-redis/redis/v8"
+)
+
+/*
+로그인 한 번으로 시작하는 세션을 다음 키로 관리한다.
+
+	session:<세션 ID>         세션 정보(사용자 ID, 기기, IP, 로그인 시간)를 담은 해시
+	session_tokens:<세션 ID>  세션에서 발급한 액세스 토큰의 JTI 집합
+	user_sessions:<사용자 ID> 사용자의 세션 ID 집합
+
+세션의 키는 리프레시 토큰과 같은 시간 동안 보관하며, 리프레시 토큰을 교체할 때마다 만료 시간을 다시 시작한다.
+*/
+
+func sessionKey(id entity.SessionID) string {
+	return "session:" + string(id)
+}
+
+func sessionTokensKey(id entity.SessionID) string {
+	return "session_tokens:" + string(id)
+}
+
+func userSessionsKey(uid entity.UserID) string {
+	return fmt.Sprintf("user_sessions:%d", uid)
+}
+
+// SaveSession은 세션을 ttl 동안 저장하고 사용자의 세션 목록에 추가한다.
+func (k *KVS) SaveSession(ctx context.Context, s *entity.Session, ttl time.Duration) error {
+	_, err := k.Cli.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		p.HSet(ctx, sessionKey(s.ID),
+			"user_id", int64(s.UserID),
+			"device", s.Device,
+			"ip", s.IP,
+			"issued_at", s.IssuedAt.UnixMicro(),
+		)
+		p.Expire(ctx, sessionKey(s.ID), ttl)
+		p.SAdd(ctx, userSessionsKey(s.UserID), string(s.ID))
+		// 사용자의 세션은 모두 ttl 이내에 만료되므로 목록도 마지막 세션과 함께 만료된다.
+		p.Expire(ctx, userSessionsKey(s.UserID), ttl)
+		return nil
+	})
+	return err
+}
+
+// touchSession 메서드는 p에 세션과 사용자의 세션 목록의 만료 시간을 ttl로 다시 시작하는 명령을 추가한다.
+func touchSession(ctx context.Context, p redis.Pipeliner, id entity.SessionID, uid entity.UserID, ttl time.Duration) {
+	p.Expire(ctx, sessionKey(id), ttl)
+	p.Expire(ctx, sessionTokensKey(id), ttl)
+	p.Expire(ctx, userSessionsKey(uid), ttl)
+}
+
+// AddSessionToken은 세션에서 발급한 액세스 토큰의 JTI를 기록한다. 세션을 삭제하면 기록한 토큰도 폐기한다.
+// ttl은 세션의 만료 시간과 같게 리프레시 토큰의 유효 시간을 전달한다.
+func (k *KVS) AddSessionToken(ctx context.Context, id entity.SessionID, jti string, ttl time.Duration) error {
+	_, err := k.Cli.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		p.SAdd(ctx, sessionTokensKey(id), jti)
+		p.Expire(ctx, sessionTokensKey(id), ttl)
+		return nil
+	})
+	return err
+}
+
+// sessionUserID 메서드는 세션의 사용자 ID를 반환한다. 세션이 없으면 ErrNotFound를 반환한다.
+func (k *KVS) sessionUserID(ctx context.Context, id entity.SessionID) (entity.UserID, error) {
+	uid, err := k.Cli.HGet(ctx, sessionKey(id), "user_id").Int64()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return 0, fmt.Errorf("session %q: %w", id, ErrNotFound)
+		}
+		return 0, err
+	}
+	return entity.UserID(uid), nil
+}
+
+// ListSessions는 사용자의 세션을 로그인한 시간의 역순으로 반환한다.
+// 만료된 세션은 목록에서 정리한다.
+func (k *KVS) ListSessions(ctx context.Context, uid entity.UserID) ([]*entity.Session, error) {
+	ids, err := k.Cli.SMembers(ctx, userSessionsKey(uid)).Result()
+	if err != nil {
+		return nil, err
+	}
+	cmds := make([]*redis.StringStringMapCmd, len(ids))
+	if _, err := k.Cli.Pipelined(ctx, func(p redis.Pipeliner) error {
+		for i, id := range ids {
+			cmds[i] = p.HGetAll(ctx, sessionKey(entity.SessionID(id)))
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	ss := []*entity.Session{}
+	var expired []any
+	for i, cmd := range cmds {
+		v := cmd.Val()
+		if len(v) == 0 {
+			expired = append(expired, ids[i])
+			continue
+		}
+		s, err := parseSession(entity.SessionID(ids[i]), v)
+		if err != nil {
+			return nil, err
+		}
+		ss = append(ss, s)
+	}
+	if len(expired) > 0 {
+		if err := k.Cli.SRem(ctx, userSessionsKey(uid), expired...).Err(); err != nil {
+			return nil, err
+		}
+	}
+	sort.Slice(ss, func(i, j int) bool { return ss[i].IssuedAt.After(ss[j].IssuedAt) })
+	return ss, nil
+}
+
+func parseSession(id entity.SessionID, v map[string]string) (*entity.Session, error) {
+	uid, err := strconv.ParseInt(v["user_id"], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("session %q: invalid user_id: %w", id, err)
+	}
+	issued, err := strconv.ParseInt(v["issued_at"], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("session %q: invalid issued_at: %w", id, err)
+	}
+	return &entity.Session{
+		ID:       id,
+		UserID:   entity.UserID(uid),
+		Client:   entity.Client{Device: v["device"], IP: v["ip"]},
+		IssuedAt: time.UnixMicro(issued).UTC(),
+	}, nil
+}
+
+// DeleteSession은 세션을 삭제하고, 세션에서 발급한 액세스 토큰을 폐기한다.
+// 세션의 리프레시 토큰은 세션이 없으면 사용할 수 없다. 세션이 없으면 ErrNotFound를 반환한다.
+func (k *KVS) DeleteSession(ctx context.Context, id entity.SessionID) error {
+	uid, err := k.sessionUserID(ctx, id)
+	if err != nil {
+		return err
+	}
+	jtis, err := k.Cli.SMembers(ctx, sessionTokensKey(id)).Result()
+	if err != nil {
+		return err
+	}
+	_, err = k.Cli.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		keys := append([]string{sessionKey(id), sessionTokensKey(id)}, jtis...)
+		p.Del(ctx, keys...)
+		p.SRem(ctx, userSessionsKey(uid), string(id))
+		return nil
+	})
+	return err
+}
+
+// CountSessions는 모든 사용자의 세션 수를 센다.
+// 모든 키를 훑으므로 운영 도구처럼 드물게 실행하는 곳에서만 사용한다.
+func (k *KVS) CountSessions(ctx context.Context) (int, error) {
+	keys, err := k.scanUserKeys(ctx, sessionKey("*"), 0)
+	if err != nil {
+		return 0, err
+	}
+	return len(keys), nil
+}
@@ -0,0 +1,89 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/testutil"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+)
+
+func TestKVS_Session(t *testing.T) {
+	t.Parallel()
+
+	cli := testutil.OpenRedisForTest(t)
+	sut := &KVS{Cli: cli}
+	ctx := context.Background()
+
+	// 다른 테스트의 키와 겹치지 않도록 임의의 사용자 ID를 사용한다.
+	uid := entity.UserID(time.Now().UnixNano())
+	issued := time.Date(2022, 5, 10, 12, 34, 56, 0, time.UTC)
+	older := &entity.Session{
+		ID:       entity.SessionID(uuid.New().String()),
+		UserID:   uid,
+		Client:   entity.Client{Device: "todo-cli/1.0", IP: "192.0.2.1"},
+		IssuedAt: issued,
+	}
+	newer := &entity.Session{
+		ID:       entity.SessionID(uuid.New().String()),
+		UserID:   uid,
+		Client:   entity.Client{Device: "Mozilla/5.0", IP: "2001:db8::1"},
+		IssuedAt: issued.Add(time.Hour),
+	}
+	// 목록에는 있지만 만료된 세션
+	expired := entity.SessionID(uuid.New().String())
+	jtis := []string{uuid.New().String(), uuid.New().String()}
+	t.Cleanup(func() {
+		cli.Del(ctx, userSessionsKey(uid), sessionKey(older.ID), sessionKey(newer.ID), sessionTokensKey(older.ID))
+		cli.Del(ctx, jtis...)
+	})
+	for _, s := range []*entity.Session{older, newer} {
+		if err := sut.SaveSession(ctx, s, 30*time.Minute); err != nil {
+			t.Fatalf("want no error, but got %v", err)
+		}
+	}
+	cli.SAdd(ctx, userSessionsKey(uid), string(expired))
+	for _, jti := range jtis {
+		if err := sut.Save(ctx, jti, uid, 30*time.Minute); err != nil {
+			t.Fatal(err)
+		}
+		if err := sut.AddSessionToken(ctx, older.ID, jti, 30*time.Minute); err != nil {
+			t.Fatalf("want no error, but got %v", err)
+		}
+	}
+
+	got, err := sut.ListSessions(ctx, uid)
+	if err != nil {
+		t.Fatalf("want no error, but got %v", err)
+	}
+	if diff := cmp.Diff(got, []*entity.Session{newer, older}); diff != "" {
+		t.Errorf("ListSessions differs: (-got +want)\n%s", diff)
+	}
+	if cli.SIsMember(ctx, userSessionsKey(uid), string(expired)).Val() {
+		t.Errorf("want expired session %s to be removed from the list", expired)
+	}
+
+	// 세션을 삭제하면 세션에서 발급한 액세스 토큰도 폐기한다.
+	if err := sut.DeleteSession(ctx, older.ID); err != nil {
+		t.Fatalf("want no error, but got %v", err)
+	}
+	for _, jti := range jtis {
+		if _, err := sut.Load(ctx, jti); !errors.Is(err, ErrNotFound) {
+			t.Errorf("want token %s to be revoked, but got %v", jti, err)
+		}
+	}
+	got, err = sut.ListSessions(ctx, uid)
+	if err != nil {
+		t.Fatalf("want no error, but got %v", err)
+	}
+	if diff := cmp.Diff(got, []*entity.Session{newer}); diff != "" {
+		t.Errorf("ListSessions differs: (-got +want)\n%s", diff)
+	}
+	if err := sut.DeleteSession(ctx, older.ID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("want ErrNotFound, but got %v", err)
+	}
+}
@@ -0,0 +1,72 @@
+package store
+
+import (
+	"context"
+	"fmt"
+)
+
+// statsTables는 Stats에서 레코드 수를 세는 테이블이다.
+var statsTables = []string{
+	"user", "task", "task_change", "task_status", "time_entry", "task_template",
+	"notification", "webhook", "webhook_delivery", "mail_opt_out",
+	"personal_access_token", "oauth_client",
+}
+
+// Stats는 운영자가 확인하는 RDBMS의 통계이다.
+type Stats struct {
+	// Rows는 테이블별 레코드 수이다.
+	Rows map[string]int64 `json:"rows"`
+	// UsersByRole은 역할별 사용자 수이다.
+	UsersByRole   map[string]int64 `json:"users_by_role"`
+	DisabledUsers int64            `json:"disabled_users"`
+	// TasksByStatus는 상태별 태스크 수이다.
+	TasksByStatus       map[string]int64 `json:"tasks_by_status"`
+	UnreadNotifications int64            `json:"unread_notifications"`
+}
+
+// RDBMS의 통계를 가져오는 메서드
+func (r *Repository) Stats(ctx context.Context, db Queryer) (*Stats, error) {
+	s := &Stats{Rows: map[string]int64{}}
+	for _, t := range statsTables {
+		var n int64
+		if err := db.GetContext(ctx, &n, fmt.Sprintf("SELECT COUNT(*) FROM `%s`", t)); err != nil {
+			return nil, fmt.Errorf("failed to count %s: %w", t, err)
+		}
+		s.Rows[t] = n
+	}
+	var err error
+	if s.UsersByRole, err = countBy(ctx, db, `SELECT role, COUNT(*) FROM user GROUP BY role`); err != nil {
+		return nil, err
+	}
+	if s.TasksByStatus, err = countBy(ctx, db, `SELECT status, COUNT(*) FROM task GROUP BY status`); err != nil {
+		return nil, err
+	}
+	if err := db.GetContext(ctx, &s.DisabledUsers, `SELECT COUNT(*) FROM user WHERE disabled IS NOT NULL`); err != nil {
+		return nil, err
+	}
+	if err := db.GetContext(ctx, &s.UnreadNotifications, `SELECT COUNT(*) FROM notification WHERE read_at IS NULL`); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// countBy 함수는 (키, 개수)를 반환하는 쿼리의 결과를 맵으로 읽는다.
+func countBy(ctx context.Context, db Queryer, sql string) (map[string]int64, error) {
+	rows, err := db.QueryxContext(ctx, sql)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	m := map[string]int64{}
+	for rows.Next() {
+		var (
+			k string
+			n int64
+		)
+		if err := rows.Scan(&k, &n); err != nil {
+			return nil, err
+		}
+		m[k] = n
+	}
+	return m, rows.Err()
+}
@@ -0,0 +1,123 @@
+package store
+
+import (
+	"context"
+	dbsql "database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/jmoiron/sqlx"
+)
+
+// addTaskChanges 함수는 Task의 변경 내역을 소유자와 담당자 각각에게 기록한다.
+// Task를 변경하는 모든 메서드에서 호출하며, 반드시 Task를 변경한 트랜잭션을 전달해야 한다.
+func addTaskChanges(
+	ctx context.Context, db Execer, t *entity.Task, op entity.TaskChangeOp, now time.Time,
+) error {
+	uids := []entity.UserID{t.UserID}
+	if t.AssigneeID != nil && *t.AssigneeID != t.UserID {
+		uids = append(uids, *t.AssigneeID)
+	}
+	return addTaskChangesFor(ctx, db, uids, t.ID, op, now)
+}
+
+// addTaskChangesFor 함수는 사용자마다 task_change_counter에서 다음 순번을 발급받아 변경 내역을 기록한다.
+// 카운터 행의 잠금은 트랜잭션이 끝날 때까지 유지되므로, 같은 사용자의 순번은 커밋한 순서대로 커진다.
+// 따라서 커밋된 변경 내역만 보는 Pull이 어떤 순번을 반환한 뒤에 그보다 작은 순번이 커밋되는 일은 없다.
+func addTaskChangesFor(
+	ctx context.Context, db Execer, uids []entity.UserID, tid entity.TaskID, op entity.TaskChangeOp, now time.Time,
+) error {
+	// 여러 사용자의 카운터를 잠글 때 교착 상태가 되지 않도록 항상 같은 순서로 잠근다.
+	uids = slices.Clone(uids)
+	slices.Sort(uids)
+	for _, uid := range slices.Compact(uids) {
+		sql := `INSERT INTO task_change_counter (user_id, seq, modified) VALUES (?, 1, ?)
+				ON DUPLICATE KEY UPDATE seq = seq + 1, modified = ?`
+		if _, err := db.ExecContext(ctx, sql, uid, now, now); err != nil {
+			return fmt.Errorf("failed to count task change: %w", err)
+		}
+		sql = `INSERT INTO task_change (user_id, seq, task_id, op, created)
+				SELECT user_id, seq, ?, ?, ? FROM task_change_counter WHERE user_id = ?`
+		if _, err := db.ExecContext(ctx, sql, tid, op, now, uid); err != nil {
+			return fmt.Errorf("failed to add task change: %w", err)
+		}
+	}
+	return nil
+}
+
+// RDBMS에서 특정 사용자의 since 이후 Task 변경 내역을 오래된 순서로 최대 limit개 가져오는 메서드
+func (r *Repository) ListTaskChanges(
+	ctx context.Context, db Queryer, uid entity.UserID, since int64, limit int,
+) (entity.TaskChanges, error) {
+	cs := entity.TaskChanges{}
+	sql := `SELECT seq, user_id, task_id, op, created
+			FROM task_change
+			WHERE user_id = ? AND seq > ?
+			ORDER BY seq
+			LIMIT ?;`
+	if err := db.SelectContext(ctx, &cs, sql, uid, since, limit); err != nil {
+		return nil, err
+	}
+	return cs, nil
+}
+
+// RDBMS에서 특정 사용자에게 마지막으로 발급한 Task 변경 순번을 가져오는 메서드 (기록이 없으면 0)
+// 커밋되지 않은 트랜잭션이 발급한 순번은 보이지 않으므로, 반환한 순번 이후의 변경 내역은 모두 이후에 커밋된다.
+func (r *Repository) LatestTaskChangeSeq(
+	ctx context.Context, db Queryer, uid entity.UserID,
+) (int64, error) {
+	var seq int64
+	sql := `SELECT seq FROM task_change_counter WHERE user_id = ?;`
+	if err := db.GetContext(ctx, &seq, sql, uid); err != nil {
+		if errors.Is(err, dbsql.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return seq, nil
+}
+
+// RDBMS에서 ID 목록에 해당하는 태스크를 가져오는 메서드 (삭제된 태스크는 포함되지 않는다)
+func (r *Repository) ListTasksByIDs(
+	ctx context.Context, db Queryer, ids []entity.TaskID,
+) (entity.Tasks, error) {
+	tasks := entity.Tasks{}
+	if len(ids) == 0 {
+		return tasks, nil
+	}
+	query, args, err := sqlx.In(`SELECT
+				id, user_id, project_id, parent_id, assignee_id, client_id, title,
+				status, due, labels, priority, recurrence, created, modified
+			FROM task
+			WHERE id IN (?)
+			ORDER BY id;`, ids)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.SelectContext(ctx, &tasks, query, args...); err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+// RDBMS에서 클라이언트가 생성한 ID로 사용자의 태스크를 가져오는 메서드
+func (r *Repository) GetTaskByClientID(
+	ctx context.Context, db Queryer, uid entity.UserID, clientID string,
+) (*entity.Task, error) {
+	t := &entity.Task{}
+	sql := `SELECT
+				id, user_id, project_id, parent_id, assignee_id, client_id, title,
+				status, due, labels, priority, recurrence, created, modified
+			FROM task
+			WHERE user_id = ? AND client_id = ?;`
+	if err := db.GetContext(ctx, t, sql, uid, clientID); err != nil {
+		if errors.Is(err, dbsql.ErrNoRows) {
+			return nil, fmt.Errorf("task %q: %w", clientID, ErrNotFound)
+		}
+		return nil, err
+	}
+	return t, nil
+}
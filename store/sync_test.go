@@ -0,0 +1,99 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gitwub5/go_todo_app/clock"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/testutil"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRepository_TaskChanges(t *testing.T) {
+	ctx := context.Background()
+	tx, err := testutil.OpenDBForTest(t).BeginTxx(ctx, nil)
+	t.Cleanup(func() { _ = tx.Rollback() })
+	if err != nil {
+		t.Fatal(err)
+	}
+	owner := prepareUser(ctx, t, tx)
+	alice := prepareUser(ctx, t, tx)
+	bob := prepareUser(ctx, t, tx)
+
+	sut := &Repository{Clocker: clock.FixedClocker{}}
+	clientID := "c-1"
+	task := &entity.Task{UserID: owner, ClientID: &clientID, Title: "sync task", Status: entity.TaskStatusTodo}
+	if err := sut.AddTask(ctx, tx, task); err != nil {
+		t.Fatalf("failed to add: %v", err)
+	}
+	if err := sut.AddTask(ctx, tx, &entity.Task{UserID: owner, ClientID: &clientID, Title: "dup", Status: entity.TaskStatusTodo}); !errors.Is(err, ErrAlreadyEntry) {
+		t.Errorf("want %v, but got %v", ErrAlreadyEntry, err)
+	}
+	// alice에게 맡긴 뒤 bob으로 바꾸면 alice에게는 삭제로 기록된다.
+	task.AssigneeID = &alice
+	if err := sut.AssignTask(ctx, tx, task); err != nil {
+		t.Fatalf("failed to assign: %v", err)
+	}
+	task.AssigneeID = &bob
+	if err := sut.AssignTask(ctx, tx, task); err != nil {
+		t.Fatalf("failed to assign: %v", err)
+	}
+	if err := sut.DeleteTask(ctx, tx, task); err != nil {
+		t.Fatalf("failed to delete: %v", err)
+	}
+	if err := sut.DeleteTask(ctx, tx, task); !errors.Is(err, ErrNotFound) {
+		t.Errorf("want %v, but got %v", ErrNotFound, err)
+	}
+
+	type change struct {
+		TaskID entity.TaskID
+		Op     entity.TaskChangeOp
+	}
+	// 변경 순번은 사용자별로 1부터 발급한다.
+	tests := map[string]struct {
+		uid  entity.UserID
+		want []change
+	}{
+		"owner": {uid: owner, want: []change{
+			{task.ID, entity.TaskChangeUpsert},
+			{task.ID, entity.TaskChangeUpsert},
+			{task.ID, entity.TaskChangeUpsert},
+			{task.ID, entity.TaskChangeDelete},
+		}},
+		"previousAssignee": {uid: alice, want: []change{
+			{task.ID, entity.TaskChangeUpsert},
+			{task.ID, entity.TaskChangeDelete},
+		}},
+		"assignee": {uid: bob, want: []change{
+			{task.ID, entity.TaskChangeUpsert},
+			{task.ID, entity.TaskChangeDelete},
+		}},
+	}
+	for n, tt := range tests {
+		t.Run(n, func(t *testing.T) {
+			cs, err := sut.ListTaskChanges(ctx, tx, tt.uid, 0, 10)
+			if err != nil {
+				t.Fatalf("want no error, but got %v", err)
+			}
+			got := []change{}
+			for i, c := range cs {
+				if c.Seq != int64(i+1) {
+					t.Errorf("want seq %d, but got %d", i+1, c.Seq)
+				}
+				got = append(got, change{c.TaskID, c.Op})
+			}
+			if d := cmp.Diff(tt.want, got); d != "" {
+				t.Errorf("differs: (-want +got)\n%s", d)
+			}
+			latest, err := sut.LatestTaskChangeSeq(ctx, tx, tt.uid)
+			if err != nil {
+				t.Fatalf("want no error, but got %v", err)
+			}
+			if want := int64(len(tt.want)); latest != want {
+				t.Errorf("want latest seq %d, but got %d", want, latest)
+			}
+		})
+	}
+}
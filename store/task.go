@@ -2,8 +2,13 @@ package store
 
 import (
 	"context"
+	dbsql "database/sql"
+	"errors"
+	"fmt"
 
 	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/go-sql-driver/mysql"
+	"github.com/jmoiron/sqlx"
 )
 
 // RDBMS에 태스크를 등록하는 메서드
@@ -13,13 +18,18 @@ func (r *Repository) AddTask(
 	t.Created = r.Clocker.Now()
 	t.Modified = r.Clocker.Now()
 	sql := `INSERT INTO task
-			(user_id, title, status, created, modified)
-	VALUES (?, ?, ?, ?, ?)`
+			(user_id, project_id, parent_id, client_id, title, status, due,
+			 labels, priority, recurrence, created, modified)
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
 	result, err := db.ExecContext(
-		ctx, sql, t.UserID, t.Title, t.Status,
-		t.Created, t.Modified,
+		ctx, sql, t.UserID, t.ProjectID, t.ParentID, t.ClientID, t.Title, t.Status, t.Due,
+		t.Labels, t.Priority, t.Recurrence, t.Created, t.Modified,
 	)
 	if err != nil {
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == ErrCodeMySQLDuplicateEntry {
+			return fmt.Errorf("cannot create same client_id task: %w", ErrAlreadyEntry)
+		}
 		return err
 	}
 	id, err := result.LastInsertId()
@@ -27,7 +37,7 @@ func (r *Repository) AddTask(
 		return err
 	}
 	t.ID = entity.TaskID(id)
-	return nil
+	return addTaskChanges(ctx, db, t, entity.TaskChangeUpsert, r.Clocker.Now())
 }
 
 // RDBMS로부터 태스크를 가져오는 메서드
@@ -36,12 +46,185 @@ func (r *Repository) ListTasks(
 ) (entity.Tasks, error) {
 	tasks := entity.Tasks{}
 	sql := `SELECT 
-				id, user_id, title,
-				status, created, modified 
+				id, user_id, project_id, parent_id, assignee_id, client_id, title,
+				status, due, labels, priority, recurrence, created, modified
 			FROM task
-			WHERE user_id = ?;`
+			WHERE user_id = ?
+			ORDER BY id;`
 	if err := db.SelectContext(ctx, &tasks, sql, id); err != nil {
 		return nil, err
 	}
 	return tasks, nil
 }
+
+// RDBMS로부터 사용자의 태스크 하나를 가져오는 메서드
+func (r *Repository) GetTask(
+	ctx context.Context, db Queryer, uid entity.UserID, id entity.TaskID,
+) (*entity.Task, error) {
+	t := &entity.Task{}
+	sql := `SELECT
+				id, user_id, project_id, parent_id, assignee_id, client_id, title,
+				status, due, labels, priority, recurrence, created, modified
+			FROM task
+			WHERE id = ? AND user_id = ?;`
+	if err := db.GetContext(ctx, t, sql, id, uid); err != nil {
+		if errors.Is(err, dbsql.ErrNoRows) {
+			return nil, fmt.Errorf("task %d: %w", id, ErrNotFound)
+		}
+		return nil, err
+	}
+	return t, nil
+}
+
+// RDBMS의 태스크 제목과 상태, 마감 시간을 갱신하는 메서드
+func (r *Repository) UpdateTask(
+	ctx context.Context, db Execer, t *entity.Task,
+) error {
+	t.Modified = r.Clocker.Now()
+	sql := `UPDATE task
+			SET title = ?, status = ?, due = ?, modified = ?
+			WHERE id = ? AND user_id = ?`
+	result, err := db.ExecContext(
+		ctx, sql, t.Title, t.Status, t.Due, t.Modified, t.ID, t.UserID,
+	)
+	if err != nil {
+		return err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("task %d: %w", t.ID, ErrNotFound)
+	}
+	return addTaskChanges(ctx, db, t, entity.TaskChangeUpsert, t.Modified)
+}
+
+// RDBMS에서 특정 사용자가 담당하는 태스크 목록을 가져오는 메서드
+func (r *Repository) ListAssignedTasks(
+	ctx context.Context, db Queryer, id entity.UserID,
+) (entity.Tasks, error) {
+	tasks := entity.Tasks{}
+	sql := `SELECT
+				id, user_id, project_id, parent_id, assignee_id, client_id, title,
+				status, due, labels, priority, recurrence, created, modified
+			FROM task
+			WHERE assignee_id = ?
+			ORDER BY id;`
+	if err := db.SelectContext(ctx, &tasks, sql, id); err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+// RDBMS에서 특정 사용자가 소유하거나 담당하는 태스크 목록을 가져오는 메서드
+func (r *Repository) ListWorkTasks(
+	ctx context.Context, db Queryer, id entity.UserID,
+) (entity.Tasks, error) {
+	tasks := entity.Tasks{}
+	sql := `SELECT
+				id, user_id, project_id, parent_id, assignee_id, client_id, title,
+				status, due, labels, priority, recurrence, created, modified
+			FROM task
+			WHERE user_id = ? OR assignee_id = ?
+			ORDER BY id;`
+	if err := db.SelectContext(ctx, &tasks, sql, id, id); err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+// RDBMS에서 특정 사용자가 소유하거나 담당하는 태스크를 가져오는 메서드
+func (r *Repository) GetWorkTask(
+	ctx context.Context, db Queryer, uid entity.UserID, id entity.TaskID,
+) (*entity.Task, error) {
+	t := &entity.Task{}
+	sql := `SELECT
+				id, user_id, project_id, parent_id, assignee_id, client_id, title,
+				status, due, labels, priority, recurrence, created, modified
+			FROM task
+			WHERE id = ? AND (user_id = ? OR assignee_id = ?);`
+	if err := db.GetContext(ctx, t, sql, id, uid, uid); err != nil {
+		if errors.Is(err, dbsql.ErrNoRows) {
+			return nil, fmt.Errorf("task %d: %w", id, ErrNotFound)
+		}
+		return nil, err
+	}
+	return t, nil
+}
+
+// RDBMS의 태스크 담당자를 갱신하는 메서드. 담당자를 해제할 때는 t.AssigneeID를 nil로 전달한다.
+// 이전 담당자를 읽고 갱신하는 사이에 다른 요청이 담당자를 바꾸지 않도록 트랜잭션을 전달해야 한다.
+func (r *Repository) AssignTask(
+	ctx context.Context, db ExecQueryer, t *entity.Task,
+) error {
+	t.Modified = r.Clocker.Now()
+	var prev *entity.UserID
+	sql := `SELECT assignee_id FROM task WHERE id = ? AND user_id = ? FOR UPDATE`
+	if err := db.GetContext(ctx, &prev, sql, t.ID, t.UserID); err != nil {
+		if errors.Is(err, dbsql.ErrNoRows) {
+			return fmt.Errorf("task %d: %w", t.ID, ErrNotFound)
+		}
+		return err
+	}
+	sql = `UPDATE task
+			SET assignee_id = ?, modified = ?
+			WHERE id = ? AND user_id = ?`
+	if _, err := db.ExecContext(
+		ctx, sql, t.AssigneeID, t.Modified, t.ID, t.UserID,
+	); err != nil {
+		return err
+	}
+	// 담당자가 바뀌면 이전 담당자에게는 Task가 보이지 않으므로 삭제로 기록한다.
+	if prev != nil && *prev != t.UserID && (t.AssigneeID == nil || *t.AssigneeID != *prev) {
+		if err := addTaskChangesFor(
+			ctx, db, []entity.UserID{*prev}, t.ID, entity.TaskChangeDelete, t.Modified,
+		); err != nil {
+			return err
+		}
+	}
+	return addTaskChanges(ctx, db, t, entity.TaskChangeUpsert, t.Modified)
+}
+
+// RDBMS에서 태스크를 삭제하는 메서드
+// 하위 태스크는 외래 키에 의해 함께 삭제되지만 변경 내역은 남지 않으므로, 하위 태스크부터 삭제해야 한다.
+func (r *Repository) DeleteTask(
+	ctx context.Context, db Execer, t *entity.Task,
+) error {
+	sql := `DELETE FROM task WHERE id = ? AND user_id = ?`
+	result, err := db.ExecContext(ctx, sql, t.ID, t.UserID)
+	if err != nil {
+		return err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("task %d: %w", t.ID, ErrNotFound)
+	}
+	return addTaskChanges(ctx, db, t, entity.TaskChangeDelete, r.Clocker.Now())
+}
+
+// RDBMS에서 부모 태스크 ID 목록에 해당하는 하위 태스크를 가져오는 메서드
+func (r *Repository) ListSubtasks(
+	ctx context.Context, db Queryer, parents []entity.TaskID,
+) (entity.Tasks, error) {
+	tasks := entity.Tasks{}
+	if len(parents) == 0 {
+		return tasks, nil
+	}
+	query, args, err := sqlx.In(`SELECT
+				id, user_id, project_id, parent_id, assignee_id, client_id, title,
+				status, due, labels, priority, recurrence, created, modified
+			FROM task
+			WHERE parent_id IN (?)
+			ORDER BY id;`, parents)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.SelectContext(ctx, &tasks, query, args...); err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
@@ -0,0 +1,55 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/go-sql-driver/mysql"
+)
+
+// RDBMS로부터 사용자 정의 상태 목록을 가져오는 메서드
+func (r *Repository) ListTaskStatuses(
+	ctx context.Context, db Queryer, id entity.UserID,
+) (entity.TaskStatusDefs, error) {
+	defs := entity.TaskStatusDefs{}
+	sql := `SELECT
+				id, user_id, name, category,
+				position, created, modified
+			FROM task_status
+			WHERE user_id = ?
+			ORDER BY position, id;`
+	if err := db.SelectContext(ctx, &defs, sql, id); err != nil {
+		return nil, err
+	}
+	return defs, nil
+}
+
+// RDBMS에 사용자 정의 상태를 등록하는 메서드
+func (r *Repository) AddTaskStatus(
+	ctx context.Context, db Execer, s *entity.TaskStatusDef,
+) error {
+	s.Created = r.Clocker.Now()
+	s.Modified = r.Clocker.Now()
+	sql := `INSERT INTO task_status
+			(user_id, name, category, position, created, modified)
+	VALUES (?, ?, ?, ?, ?, ?)`
+	result, err := db.ExecContext(
+		ctx, sql, s.UserID, s.Name, s.Category, s.Position,
+		s.Created, s.Modified,
+	)
+	if err != nil {
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == ErrCodeMySQLDuplicateEntry {
+			return fmt.Errorf("cannot create same name status: %w", ErrAlreadyEntry)
+		}
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	s.ID = entity.TaskStatusDefID(id)
+	return nil
+}
@@ -2,6 +2,8 @@ package store
 
 import (
 	"context"
+	"database/sql/driver"
+	"errors"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -76,6 +78,18 @@ func prepareTasks(ctx context.Context, t *testing.T, con Execer) (entity.UserID,
 	return userID, wants
 }
 
+// expectTaskChange 함수는 사용자의 카운터에서 순번을 발급받아 변경 내역을 기록하는 쿼리를 기대한다.
+func expectTaskChange(mock sqlmock.Sqlmock, uid entity.UserID, tid any, op entity.TaskChangeOp, now any) {
+	mock.ExpectExec(
+		`INSERT INTO task_change_counter \(user_id, seq, modified\) VALUES \(\?, 1, \?\) ON DUPLICATE KEY UPDATE seq = seq \+ 1, modified = \?`,
+	).WithArgs(uid, now, now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(
+		`INSERT INTO task_change \(user_id, seq, task_id, op, created\) SELECT user_id, seq, \?, \?, \? FROM task_change_counter WHERE user_id = \?`,
+	).WithArgs(tid, op, now, uid).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+}
+
 func TestRepository_ListTasks(t *testing.T) {
 	ctx := context.Background()
 	// entity.Task를 작성하는 다른 테스트 케이스와 섞이면 테스트가 실패한다.
@@ -105,9 +119,14 @@ func TestRepository_AddTask(t *testing.T) {
 	c := clock.FixedClocker{}
 	var wantID int64 = 20
 	okTask := &entity.Task{
-		UserID:   33,
-		Title:    "ok task",
-		Status:   "todo",
+		UserID: 33,
+		Title:  "ok task",
+		Status: "todo",
+		TaskAttributes: entity.TaskAttributes{
+			Labels:     entity.Labels{"finance"},
+			Priority:   entity.TaskPriorityHigh,
+			Recurrence: &entity.Recurrence{Frequency: "monthly", Interval: 1, MonthDay: 1},
+		},
 		Created:  c.Now(),
 		Modified: c.Now(),
 	}
@@ -119,13 +138,169 @@ func TestRepository_AddTask(t *testing.T) {
 	t.Cleanup(func() { _ = db.Close() })
 	mock.ExpectExec(
 		// 이스케이프 필요
-		`INSERT INTO task \(user_id, title, status, created, modified\) VALUES \(\?, \?, \?, \?, \?\)`,
-	).WithArgs(okTask.UserID, okTask.Title, okTask.Status, okTask.Created, okTask.Modified).
+		`INSERT INTO task \(user_id, project_id, parent_id, client_id, title, status, due, labels, priority, recurrence, created, modified\) VALUES \(\?, \?, \?, \?, \?, \?, \?, \?, \?, \?, \?, \?\)`,
+	).WithArgs(
+		okTask.UserID, okTask.ProjectID, okTask.ParentID, okTask.ClientID, okTask.Title, okTask.Status, okTask.Due,
+		`["finance"]`, okTask.Priority, `{"frequency":"monthly","interval":1,"month_day":1}`, okTask.Created, okTask.Modified,
+	).
 		WillReturnResult(sqlmock.NewResult(wantID, 1))
+	// 동기화를 위한 변경 내역도 함께 기록한다.
+	expectTaskChange(mock, okTask.UserID, wantID, entity.TaskChangeUpsert, c.Now())
 
 	xdb := sqlx.NewDb(db, "mysql")
 	r := &Repository{Clocker: c}
 	if err := r.AddTask(ctx, xdb, okTask); err != nil {
 		t.Errorf("want no error, but got %v", err)
 	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+// 라벨, 우선순위, 반복 규칙을 저장하고 그대로 읽을 수 있는지 확인한다.
+func TestRepository_AddTask_Attributes(t *testing.T) {
+	ctx := context.Background()
+	tx, err := testutil.OpenDBForTest(t).BeginTxx(ctx, nil)
+	t.Cleanup(func() { _ = tx.Rollback() })
+	if err != nil {
+		t.Fatal(err)
+	}
+	uid := prepareUser(ctx, t, tx)
+
+	sut := &Repository{Clocker: clock.FixedClocker{}}
+	tests := map[string]entity.TaskAttributes{
+		"all": {
+			Labels:     entity.Labels{"finance", "home"},
+			Priority:   entity.TaskPriorityHigh,
+			Recurrence: &entity.Recurrence{Frequency: "weekly", Interval: 2, Weekday: "monday"},
+		},
+		"none": {},
+	}
+	for n, attrs := range tests {
+		t.Run(n, func(t *testing.T) {
+			task := &entity.Task{UserID: uid, Title: n, Status: entity.TaskStatusTodo, TaskAttributes: attrs}
+			if err := sut.AddTask(ctx, tx, task); err != nil {
+				t.Fatalf("failed to add: %v", err)
+			}
+			got, err := sut.GetTask(ctx, tx, uid, task.ID)
+			if err != nil {
+				t.Fatalf("failed to get: %v", err)
+			}
+			if d := cmp.Diff(got.TaskAttributes, attrs); d != "" {
+				t.Errorf("differs: (-got +want)\n%s", d)
+			}
+		})
+	}
+}
+
+func TestRepository_UpdateTask(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	c := clock.FixedClocker{}
+	tests := map[string]struct {
+		affected int64
+		wantErr  error
+	}{
+		"ok":       {affected: 1},
+		"notFound": {affected: 0, wantErr: ErrNotFound},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			task := &entity.Task{
+				ID:     10,
+				UserID: 33,
+				Title:  "updated task",
+				Status: "review",
+			}
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			t.Cleanup(func() { _ = db.Close() })
+			mock.ExpectExec(
+				`UPDATE task SET title = \?, status = \?, due = \?, modified = \? WHERE id = \? AND user_id = \?`,
+			).WithArgs(task.Title, task.Status, task.Due, c.Now(), task.ID, task.UserID).
+				WillReturnResult(sqlmock.NewResult(0, tt.affected))
+			if tt.wantErr == nil {
+				expectTaskChange(mock, task.UserID, task.ID, entity.TaskChangeUpsert, c.Now())
+			}
+
+			xdb := sqlx.NewDb(db, "mysql")
+			r := &Repository{Clocker: c}
+			err = r.UpdateTask(ctx, xdb, task)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("want %v, but got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestRepository_AssignTask(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	c := clock.FixedClocker{}
+	prev, assignee := entity.UserID(43), entity.UserID(44)
+	tests := map[string]struct {
+		prev     *entity.UserID
+		assignee *entity.UserID
+		wantErr  error
+	}{
+		"assign":   {assignee: &assignee},
+		"reassign": {prev: &prev, assignee: &assignee},
+		"unassign": {prev: &prev},
+		"notFound": {assignee: &assignee, wantErr: ErrNotFound},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			task := &entity.Task{ID: 10, UserID: 33, AssigneeID: tt.assignee}
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			t.Cleanup(func() { _ = db.Close() })
+			q := mock.ExpectQuery(
+				`SELECT assignee_id FROM task WHERE id = \? AND user_id = \? FOR UPDATE`,
+			).WithArgs(task.ID, task.UserID)
+			if tt.wantErr != nil {
+				q.WillReturnRows(sqlmock.NewRows([]string{"assignee_id"}))
+			} else {
+				var v driver.Value
+				if tt.prev != nil {
+					v = int64(*tt.prev)
+				}
+				q.WillReturnRows(sqlmock.NewRows([]string{"assignee_id"}).AddRow(v))
+				mock.ExpectExec(
+					`UPDATE task SET assignee_id = \?, modified = \? WHERE id = \? AND user_id = \?`,
+				).WithArgs(tt.assignee, c.Now(), task.ID, task.UserID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				// 이전 담당자에게는 삭제로 기록한다.
+				if tt.prev != nil {
+					expectTaskChange(mock, *tt.prev, task.ID, entity.TaskChangeDelete, c.Now())
+				}
+				// 소유자와 새로운 담당자에게 변경으로 기록한다.
+				expectTaskChange(mock, task.UserID, task.ID, entity.TaskChangeUpsert, c.Now())
+				if tt.assignee != nil {
+					expectTaskChange(mock, *tt.assignee, task.ID, entity.TaskChangeUpsert, c.Now())
+				}
+			}
+
+			xdb := sqlx.NewDb(db, "mysql")
+			r := &Repository{Clocker: c}
+			err = r.AssignTask(ctx, xdb, task)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("want %v, but got %v", tt.wantErr, err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
 }
@@ -0,0 +1,70 @@
+package store
+
+import (
+	"context"
+	dbsql "database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/gitwub5/go_todo_app/entity"
+)
+
+// RDBMS에 Task 템플릿을 등록하는 메서드
+func (r *Repository) AddTemplate(
+	ctx context.Context, db Execer, t *entity.Template,
+) error {
+	t.Created = r.Clocker.Now()
+	t.Modified = r.Clocker.Now()
+	sql := `INSERT INTO task_template
+			(user_id, project_id, name, items, created, modified)
+	VALUES (?, ?, ?, ?, ?, ?)`
+	result, err := db.ExecContext(
+		ctx, sql, t.UserID, t.ProjectID, t.Name, t.Items, t.Created, t.Modified,
+	)
+	if err != nil {
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	t.ID = entity.TemplateID(id)
+	return nil
+}
+
+// RDBMS로부터 사용자가 만들었거나 사용자가 멤버인 프로젝트에 공유된 Task 템플릿 목록을 가져오는 메서드
+func (r *Repository) ListTemplates(
+	ctx context.Context, db Queryer, uid entity.UserID,
+) (entity.Templates, error) {
+	ts := entity.Templates{}
+	sql := `SELECT
+				id, user_id, project_id, name, items, created, modified
+			FROM task_template
+			WHERE user_id = ?
+				OR project_id IN (SELECT project_id FROM project_member WHERE user_id = ?)
+			ORDER BY id;`
+	if err := db.SelectContext(ctx, &ts, sql, uid, uid); err != nil {
+		return nil, err
+	}
+	return ts, nil
+}
+
+// RDBMS로부터 사용자가 사용할 수 있는 Task 템플릿 하나를 가져오는 메서드
+// 사용자가 만들었거나 사용자가 멤버인 프로젝트에 공유된 템플릿이 아니면 ErrNotFound를 반환한다.
+func (r *Repository) GetTemplate(
+	ctx context.Context, db Queryer, uid entity.UserID, id entity.TemplateID,
+) (*entity.Template, error) {
+	t := &entity.Template{}
+	sql := `SELECT
+				id, user_id, project_id, name, items, created, modified
+			FROM task_template
+			WHERE id = ? AND (user_id = ?
+				OR project_id IN (SELECT project_id FROM project_member WHERE user_id = ?));`
+	if err := db.GetContext(ctx, t, sql, id, uid, uid); err != nil {
+		if errors.Is(err, dbsql.ErrNoRows) {
+			return nil, fmt.Errorf("template %d: %w", id, ErrNotFound)
+		}
+		return nil, err
+	}
+	return t, nil
+}
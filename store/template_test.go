@@ -0,0 +1,79 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gitwub5/go_todo_app/clock"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/testutil"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRepository_Template_Shared(t *testing.T) {
+	ctx := context.Background()
+	tx, err := testutil.OpenDBForTest(t).BeginTxx(ctx, nil)
+	t.Cleanup(func() { _ = tx.Rollback() })
+	if err != nil {
+		t.Fatal(err)
+	}
+	owner := prepareUser(ctx, t, tx)
+	member := prepareUser(ctx, t, tx)
+	stranger := prepareUser(ctx, t, tx)
+
+	sut := &Repository{Clocker: clock.FixedClocker{}}
+	p := &entity.Project{OwnerID: owner, Name: "release"}
+	if err := sut.AddProject(ctx, tx, p); err != nil {
+		t.Fatalf("failed to add project: %v", err)
+	}
+	if err := sut.AddProjectMember(ctx, tx, p.ID, member); err != nil {
+		t.Fatalf("failed to add member: %v", err)
+	}
+	items := entity.TemplateItems{{
+		Title:          "release",
+		TaskAttributes: entity.TaskAttributes{Labels: entity.Labels{"ops"}, Priority: entity.TaskPriorityHigh},
+	}}
+	private := &entity.Template{UserID: owner, Name: "private", Items: items}
+	shared := &entity.Template{UserID: owner, ProjectID: &p.ID, Name: "shared", Items: items}
+	for _, tmpl := range []*entity.Template{private, shared} {
+		if err := sut.AddTemplate(ctx, tx, tmpl); err != nil {
+			t.Fatalf("failed to add template: %v", err)
+		}
+	}
+
+	tests := map[string]struct {
+		uid  entity.UserID
+		want []entity.TemplateID
+	}{
+		"owner":    {uid: owner, want: []entity.TemplateID{private.ID, shared.ID}},
+		"member":   {uid: member, want: []entity.TemplateID{shared.ID}},
+		"stranger": {uid: stranger, want: []entity.TemplateID{}},
+	}
+	for n, tt := range tests {
+		t.Run(n, func(t *testing.T) {
+			ts, err := sut.ListTemplates(ctx, tx, tt.uid)
+			if err != nil {
+				t.Fatalf("failed to list: %v", err)
+			}
+			got := []entity.TemplateID{}
+			for _, tmpl := range ts {
+				got = append(got, tmpl.ID)
+			}
+			if d := cmp.Diff(got, tt.want); d != "" {
+				t.Errorf("differs: (-got +want)\n%s", d)
+			}
+		})
+	}
+
+	got, err := sut.GetTemplate(ctx, tx, member, shared.ID)
+	if err != nil {
+		t.Fatalf("failed to get shared template: %v", err)
+	}
+	if d := cmp.Diff(got.Items, items); d != "" {
+		t.Errorf("items differ: (-got +want)\n%s", d)
+	}
+	if _, err := sut.GetTemplate(ctx, tx, member, private.ID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("want ErrNotFound, but got %v", err)
+	}
+}
@@ -0,0 +1,116 @@
+package store
+
+import (
+	"context"
+	dbsql "database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/go-sql-driver/mysql"
+)
+
+// RDBMS에 작업 시간 기록을 등록하는 메서드
+// 실행 중인 타이머는 사용자당 하나만 허용되며, 중복되면 ErrAlreadyEntry를 반환한다.
+func (r *Repository) AddTimeEntry(
+	ctx context.Context, db Execer, e *entity.TimeEntry,
+) error {
+	e.Created = r.Clocker.Now()
+	e.Modified = r.Clocker.Now()
+	sql := `INSERT INTO time_entry
+			(user_id, task_id, started, stopped, manual, created, modified)
+	VALUES (?, ?, ?, ?, ?, ?, ?)`
+	result, err := db.ExecContext(
+		ctx, sql, e.UserID, e.TaskID, e.Started, e.Stopped, e.Manual,
+		e.Created, e.Modified,
+	)
+	if err != nil {
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == ErrCodeMySQLDuplicateEntry {
+			return fmt.Errorf("timer is already running: %w", ErrAlreadyEntry)
+		}
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	e.ID = entity.TimeEntryID(id)
+	return nil
+}
+
+// RDBMS로부터 사용자의 실행 중인 타이머를 가져오는 메서드
+func (r *Repository) GetRunningTimeEntry(
+	ctx context.Context, db Queryer, uid entity.UserID,
+) (*entity.TimeEntry, error) {
+	e := &entity.TimeEntry{}
+	sql := `SELECT
+				id, user_id, task_id, started, stopped,
+				manual, created, modified
+			FROM time_entry
+			WHERE user_id = ? AND stopped IS NULL;`
+	if err := db.GetContext(ctx, e, sql, uid); err != nil {
+		if errors.Is(err, dbsql.ErrNoRows) {
+			return nil, fmt.Errorf("running timer: %w", ErrNotFound)
+		}
+		return nil, err
+	}
+	return e, nil
+}
+
+// RDBMS의 실행 중인 타이머를 멈추는 메서드
+func (r *Repository) StopTimeEntry(
+	ctx context.Context, db Execer, e *entity.TimeEntry,
+) error {
+	e.Modified = r.Clocker.Now()
+	sql := `UPDATE time_entry
+			SET stopped = ?, modified = ?
+			WHERE id = ? AND user_id = ? AND stopped IS NULL`
+	result, err := db.ExecContext(ctx, sql, e.Stopped, e.Modified, e.ID, e.UserID)
+	if err != nil {
+		return err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("running timer %d: %w", e.ID, ErrNotFound)
+	}
+	return nil
+}
+
+// RDBMS로부터 Task에 기록된 작업 시간 목록을 가져오는 메서드
+func (r *Repository) ListTimeEntries(
+	ctx context.Context, db Queryer, uid entity.UserID, tid entity.TaskID,
+) (entity.TimeEntries, error) {
+	entries := entity.TimeEntries{}
+	sql := `SELECT
+				id, user_id, task_id, started, stopped,
+				manual, created, modified
+			FROM time_entry
+			WHERE user_id = ? AND task_id = ?
+			ORDER BY started;`
+	if err := db.SelectContext(ctx, &entries, sql, uid, tid); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// RDBMS로부터 기간 [from, to)와 겹치는 작업 시간 목록을 가져오는 메서드
+func (r *Repository) ListTimeEntriesBetween(
+	ctx context.Context, db Queryer, uid entity.UserID, from, to time.Time,
+) (entity.TimeEntries, error) {
+	entries := entity.TimeEntries{}
+	sql := `SELECT
+				id, user_id, task_id, started, stopped,
+				manual, created, modified
+			FROM time_entry
+			WHERE user_id = ? AND started < ? AND (stopped IS NULL OR stopped > ?)
+			ORDER BY started;`
+	if err := db.SelectContext(ctx, &entries, sql, uid, to, from); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
@@ -0,0 +1,62 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gitwub5/go_todo_app/clock"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/go-sql-driver/mysql"
+	"github.com/jmoiron/sqlx"
+)
+
+func TestRepository_AddTimeEntry(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	c := clock.FixedClocker{}
+	tests := map[string]struct {
+		execErr error
+		wantErr error
+	}{
+		"ok": {},
+		// 실행 중인 타이머가 이미 있으면 uix_running_user_id 제약 조건에 걸린다.
+		"alreadyRunning": {
+			execErr: &mysql.MySQLError{Number: ErrCodeMySQLDuplicateEntry},
+			wantErr: ErrAlreadyEntry,
+		},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			e := &entity.TimeEntry{UserID: 33, TaskID: 10, Started: c.Now()}
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			t.Cleanup(func() { _ = db.Close() })
+			exp := mock.ExpectExec(
+				`INSERT INTO time_entry \(user_id, task_id, started, stopped, manual, created, modified\) VALUES \(\?, \?, \?, \?, \?, \?, \?\)`,
+			).WithArgs(e.UserID, e.TaskID, e.Started, e.Stopped, e.Manual, c.Now(), c.Now())
+			if tt.execErr != nil {
+				exp.WillReturnError(tt.execErr)
+			} else {
+				exp.WillReturnResult(sqlmock.NewResult(7, 1))
+			}
+
+			xdb := sqlx.NewDb(db, "mysql")
+			r := &Repository{Clocker: c}
+			err = r.AddTimeEntry(ctx, xdb, e)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("want %v, but got %v", tt.wantErr, err)
+			}
+			if tt.wantErr == nil && e.ID != 7 {
+				t.Errorf("want id 7, but got %d", e.ID)
+			}
+		})
+	}
+}
@@ -2,11 +2,14 @@ package store
 
 import (
 	"context"
+	dbsql "database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/gitwub5/go_todo_app/entity"
 	"github.com/go-sql-driver/mysql"
+	"github.com/jmoiron/sqlx"
 )
 
 // 유저 회원가입
@@ -14,9 +17,9 @@ func (r *Repository) RegisterUser(ctx context.Context, db Execer, u *entity.User
 	u.Created = r.Clocker.Now()
 	u.Modified = r.Clocker.Now()
 	sql := `INSERT INTO user (
-			name, password, role, created, modified
-			) VALUES (?, ?, ?, ?, ?)`
-	result, err := db.ExecContext(ctx, sql, u.Name, u.Password, u.Role, u.Created, u.Modified)
+			name, password, role, email, created, modified
+			) VALUES (?, ?, ?, ?, ?, ?)`
+	result, err := db.ExecContext(ctx, sql, u.Name, u.Password, u.Role, u.Email, u.Created, u.Modified)
 	if err != nil {
 		var mysqlErr *mysql.MySQLError
 		if errors.As(err, &mysqlErr) && mysqlErr.Number == ErrCodeMySQLDuplicateEntry {
@@ -38,10 +41,144 @@ func (r *Repository) GetUser(
 ) (*entity.User, error) {
 	u := &entity.User{}
 	sql := `SELECT
-		id, name, password, role, created, modified 
+		id, name, password, role, email, disabled, created, modified 
 		FROM user WHERE name = ?`
 	if err := db.GetContext(ctx, u, sql, name); err != nil {
+		if errors.Is(err, dbsql.ErrNoRows) {
+			return nil, fmt.Errorf("user %q: %w", name, ErrNotFound)
+		}
 		return nil, err
 	}
 	return u, nil
 }
+
+// ID로 유저 정보 가져오기
+func (r *Repository) GetUserByID(
+	ctx context.Context, db Queryer, id entity.UserID,
+) (*entity.User, error) {
+	u := &entity.User{}
+	sql := `SELECT
+		id, name, password, role, email, disabled, created, modified 
+		FROM user WHERE id = ?`
+	if err := db.GetContext(ctx, u, sql, id); err != nil {
+		if errors.Is(err, dbsql.ErrNoRows) {
+			return nil, fmt.Errorf("user %d: %w", id, ErrNotFound)
+		}
+		return nil, err
+	}
+	return u, nil
+}
+
+// 유저 메일 주소 변경
+func (r *Repository) UpdateUserEmail(
+	ctx context.Context, db Execer, id entity.UserID, email *string,
+) error {
+	sql := `UPDATE user SET email = ?, modified = ? WHERE id = ?`
+	_, err := db.ExecContext(ctx, sql, email, r.Clocker.Now(), id)
+	return err
+}
+
+// 유저 패스워드 변경
+func (r *Repository) UpdateUserPassword(
+	ctx context.Context, db Execer, id entity.UserID, password string,
+) error {
+	sql := `UPDATE user SET password = ?, modified = ? WHERE id = ?`
+	result, err := db.ExecContext(ctx, sql, password, r.Clocker.Now(), id)
+	if err != nil {
+		return err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("user %d: %w", id, ErrNotFound)
+	}
+	return nil
+}
+
+// ID 목록에 해당하는 유저 정보 가져오기
+func (r *Repository) ListUsersByIDs(
+	ctx context.Context, db Queryer, ids []entity.UserID,
+) ([]*entity.User, error) {
+	users := []*entity.User{}
+	if len(ids) == 0 {
+		return users, nil
+	}
+	query, args, err := sqlx.In(`SELECT
+		id, name, password, role, email, disabled, created, modified
+		FROM user WHERE id IN (?)
+		ORDER BY id`, ids)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.SelectContext(ctx, &users, query, args...); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// RDBMS로부터 ids 중 uid 자신이거나, uid와 Task 또는 프로젝트를 공유하는 사용자의 ID를 가져오는 메서드
+// Task를 공유한다는 것은 한 사용자가 소유한 Task의 담당자가 다른 사용자라는 뜻이다.
+func (r *Repository) ListRelatedUserIDs(
+	ctx context.Context, db Queryer, uid entity.UserID, ids []entity.UserID,
+) ([]entity.UserID, error) {
+	related := []entity.UserID{}
+	if len(ids) == 0 {
+		return related, nil
+	}
+	query, args, err := sqlx.In(`SELECT u.id FROM user u
+		WHERE u.id IN (?) AND (
+			u.id = ?
+			OR EXISTS (
+				SELECT 1 FROM task t
+				WHERE (t.user_id = ? AND t.assignee_id = u.id)
+					OR (t.user_id = u.id AND t.assignee_id = ?)
+			)
+			OR EXISTS (
+				SELECT 1 FROM project_member a
+				JOIN project_member b ON b.project_id = a.project_id
+				WHERE a.user_id = ? AND b.user_id = u.id
+			)
+		)
+		ORDER BY u.id`, ids, uid, uid, uid, uid)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.SelectContext(ctx, &related, query, args...); err != nil {
+		return nil, err
+	}
+	return related, nil
+}
+
+// 유저 역할 변경
+func (r *Repository) UpdateUserRole(
+	ctx context.Context, db Execer, id entity.UserID, role string,
+) error {
+	sql := `UPDATE user SET role = ?, modified = ? WHERE id = ?`
+	return updateUser(ctx, db, id, sql, role, r.Clocker.Now(), id)
+}
+
+// 유저 비활성화 시간 변경 (nil이면 다시 활성화한다)
+func (r *Repository) UpdateUserDisabled(
+	ctx context.Context, db Execer, id entity.UserID, disabled *time.Time,
+) error {
+	sql := `UPDATE user SET disabled = ?, modified = ? WHERE id = ?`
+	return updateUser(ctx, db, id, sql, disabled, r.Clocker.Now(), id)
+}
+
+// updateUser 함수는 유저 한 명을 갱신하는 쿼리를 실행하고, 유저가 없으면 ErrNotFound를 반환한다.
+func updateUser(ctx context.Context, db Execer, id entity.UserID, sql string, args ...any) error {
+	result, err := db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("user %d: %w", id, ErrNotFound)
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package store
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gitwub5/go_todo_app/clock"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/testutil"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRepository_ListRelatedUserIDs(t *testing.T) {
+	ctx := context.Background()
+	tx, err := testutil.OpenDBForTest(t).BeginTxx(ctx, nil)
+	t.Cleanup(func() { _ = tx.Rollback() })
+	if err != nil {
+		t.Fatal(err)
+	}
+	me := prepareUser(ctx, t, tx)
+	assignee := prepareUser(ctx, t, tx)
+	assigner := prepareUser(ctx, t, tx)
+	member := prepareUser(ctx, t, tx)
+	stranger := prepareUser(ctx, t, tx)
+
+	sut := &Repository{Clocker: clock.FixedClocker{}}
+	tasks := entity.Tasks{
+		{UserID: me, AssigneeID: &assignee, Title: "mine", Status: entity.TaskStatusTodo},
+		{UserID: assigner, AssigneeID: &me, Title: "theirs", Status: entity.TaskStatusTodo},
+	}
+	for _, task := range tasks {
+		if err := sut.AddTask(ctx, tx, task); err != nil {
+			t.Fatalf("failed to add task: %v", err)
+		}
+		if err := sut.AssignTask(ctx, tx, task); err != nil {
+			t.Fatalf("failed to assign task: %v", err)
+		}
+	}
+	p := &entity.Project{OwnerID: member, Name: "release"}
+	if err := sut.AddProject(ctx, tx, p); err != nil {
+		t.Fatalf("failed to add project: %v", err)
+	}
+	if err := sut.AddProjectMember(ctx, tx, p.ID, me); err != nil {
+		t.Fatalf("failed to add member: %v", err)
+	}
+
+	got, err := sut.ListRelatedUserIDs(ctx, tx, me, []entity.UserID{me, assignee, assigner, member, stranger})
+	if err != nil {
+		t.Fatalf("failed to list: %v", err)
+	}
+	// Task나 프로젝트를 공유하지 않는 사용자는 제외한다.
+	if d := cmp.Diff(got, []entity.UserID{me, assignee, assigner, member}); d != "" {
+		t.Errorf("differs: (-got +want)\n%s", d)
+	}
+}
@@ -0,0 +1,200 @@
+package store
+
+import (
+	"context"
+	dbsql "database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/entity"
+)
+
+// RDBMS에 Webhook을 등록하는 메서드
+func (r *Repository) AddWebhook(
+	ctx context.Context, db Execer, w *entity.Webhook,
+) error {
+	w.Created = r.Clocker.Now()
+	w.Modified = r.Clocker.Now()
+	sql := `INSERT INTO webhook
+			(user_id, url, events, secret, active, failure_count, created, modified)
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+	result, err := db.ExecContext(
+		ctx, sql, w.UserID, w.URL, w.Events, w.Secret, w.Active, w.FailureCount,
+		w.Created, w.Modified,
+	)
+	if err != nil {
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	w.ID = entity.WebhookID(id)
+	return nil
+}
+
+// RDBMS로부터 사용자의 Webhook 목록을 가져오는 메서드
+func (r *Repository) ListWebhooks(
+	ctx context.Context, db Queryer, uid entity.UserID,
+) (entity.Webhooks, error) {
+	ws := entity.Webhooks{}
+	sql := `SELECT
+				id, user_id, url, events, secret, active, failure_count, created, modified
+			FROM webhook
+			WHERE user_id = ?
+			ORDER BY id;`
+	if err := db.SelectContext(ctx, &ws, sql, uid); err != nil {
+		return nil, err
+	}
+	return ws, nil
+}
+
+// RDBMS로부터 사용자의 Webhook 하나를 가져오는 메서드
+func (r *Repository) GetWebhook(
+	ctx context.Context, db Queryer, uid entity.UserID, id entity.WebhookID,
+) (*entity.Webhook, error) {
+	w := &entity.Webhook{}
+	sql := `SELECT
+				id, user_id, url, events, secret, active, failure_count, created, modified
+			FROM webhook
+			WHERE id = ? AND user_id = ?;`
+	if err := db.GetContext(ctx, w, sql, id, uid); err != nil {
+		if errors.Is(err, dbsql.ErrNoRows) {
+			return nil, fmt.Errorf("webhook %d: %w", id, ErrNotFound)
+		}
+		return nil, err
+	}
+	return w, nil
+}
+
+// RDBMS로부터 사용자의 Webhook을 삭제하는 메서드
+func (r *Repository) DeleteWebhook(
+	ctx context.Context, db Execer, uid entity.UserID, id entity.WebhookID,
+) error {
+	sql := `DELETE FROM webhook WHERE id = ? AND user_id = ?`
+	result, err := db.ExecContext(ctx, sql, id, uid)
+	if err != nil {
+		return err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("webhook %d: %w", id, ErrNotFound)
+	}
+	return nil
+}
+
+// RDBMS의 Webhook 활성화 여부와 연속 실패 횟수를 갱신하는 메서드
+func (r *Repository) UpdateWebhookStatus(
+	ctx context.Context, db Execer, w *entity.Webhook,
+) error {
+	w.Modified = r.Clocker.Now()
+	sql := `UPDATE webhook
+			SET active = ?, failure_count = ?, modified = ?
+			WHERE id = ? AND user_id = ?`
+	result, err := db.ExecContext(
+		ctx, sql, w.Active, w.FailureCount, w.Modified, w.ID, w.UserID,
+	)
+	if err != nil {
+		return err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("webhook %d: %w", w.ID, ErrNotFound)
+	}
+	return nil
+}
+
+// RDBMS의 Webhook 연속 실패 횟수를 하나 늘리고, disableAfter 번에 이르면 비활성화하는 메서드
+// 동시에 끝난 전송끼리 값을 덮어쓰지 않도록 저장된 값을 기준으로 갱신한다.
+// MySQL은 SET 절을 왼쪽부터 평가하므로 active를 failure_count보다 먼저 계산한다.
+func (r *Repository) AddWebhookFailure(
+	ctx context.Context, db Execer, id entity.WebhookID, disableAfter int,
+) error {
+	sql := `UPDATE webhook
+			SET active = active AND failure_count + 1 < ?,
+				failure_count = failure_count + 1, modified = ?
+			WHERE id = ?`
+	result, err := db.ExecContext(ctx, sql, disableAfter, r.Clocker.Now(), id)
+	if err != nil {
+		return err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("webhook %d: %w", id, ErrNotFound)
+	}
+	return nil
+}
+
+// RDBMS의 Webhook 연속 실패 횟수를 초기화하는 메서드
+// 실패 횟수가 이미 0이면 아무것도 갱신하지 않는다.
+func (r *Repository) ResetWebhookFailures(
+	ctx context.Context, db Execer, id entity.WebhookID,
+) error {
+	sql := `UPDATE webhook
+			SET failure_count = 0, modified = ?
+			WHERE id = ? AND failure_count > 0`
+	_, err := db.ExecContext(ctx, sql, r.Clocker.Now(), id)
+	return err
+}
+
+// RDBMS에 Webhook 전송 기록을 등록하는 메서드
+func (r *Repository) AddWebhookDelivery(
+	ctx context.Context, db Execer, d *entity.WebhookDelivery,
+) error {
+	d.Created = r.Clocker.Now()
+	sql := `INSERT INTO webhook_delivery
+			(webhook_id, delivery_id, event, attempt, status_code, error, duration_ms, created)
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+	result, err := db.ExecContext(
+		ctx, sql, d.WebhookID, d.DeliveryID, d.Event, d.Attempt, d.StatusCode,
+		d.Error, d.DurationMS, d.Created,
+	)
+	if err != nil {
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	d.ID = entity.WebhookDeliveryID(id)
+	return nil
+}
+
+// RDBMS로부터 Webhook의 전송 기록을 최신순으로 가져오는 메서드
+func (r *Repository) ListWebhookDeliveries(
+	ctx context.Context, db Queryer, id entity.WebhookID, limit int,
+) (entity.WebhookDeliveries, error) {
+	ds := entity.WebhookDeliveries{}
+	sql := `SELECT
+				id, webhook_id, delivery_id, event, attempt, status_code, error, duration_ms, created
+			FROM webhook_delivery
+			WHERE webhook_id = ?
+			ORDER BY id DESC
+			LIMIT ?;`
+	if err := db.SelectContext(ctx, &ds, sql, id, limit); err != nil {
+		return nil, err
+	}
+	return ds, nil
+}
+
+// RDBMS에서 before 이전에 기록된 Webhook 전송 기록을 삭제하고, 삭제한 기록 수를 반환하는 메서드
+func (r *Repository) DeleteWebhookDeliveries(
+	ctx context.Context, db Execer, before time.Time,
+) (int64, error) {
+	sql := `DELETE FROM webhook_delivery WHERE created < ?`
+	result, err := db.ExecContext(ctx, sql, before)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
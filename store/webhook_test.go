@@ -0,0 +1,61 @@
+package store
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gitwub5/go_todo_app/clock"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/testutil"
+)
+
+func TestRepository_WebhookFailures(t *testing.T) {
+	ctx := context.Background()
+	tx, err := testutil.OpenDBForTest(t).BeginTxx(ctx, nil)
+	t.Cleanup(func() { _ = tx.Rollback() })
+	if err != nil {
+		t.Fatal(err)
+	}
+	uid := prepareUser(ctx, t, tx)
+	sut := &Repository{Clocker: clock.FixedClocker{}}
+	w := &entity.Webhook{
+		UserID: uid, URL: "https://example.com/hook",
+		Events: entity.WebhookEvents{entity.EventTaskCreated}, Secret: "secret", Active: true,
+	}
+	if err := sut.AddWebhook(ctx, tx, w); err != nil {
+		t.Fatalf("failed to add webhook: %v", err)
+	}
+
+	steps := []struct {
+		fail         bool
+		wantFailures int
+		wantActive   bool
+	}{
+		{fail: true, wantFailures: 1, wantActive: true},
+		// 성공하면 연속 실패 횟수만 초기화한다.
+		{fail: false, wantFailures: 0, wantActive: true},
+		{fail: true, wantFailures: 1, wantActive: true},
+		{fail: true, wantFailures: 2, wantActive: true},
+		{fail: true, wantFailures: 3, wantActive: false},
+		// 비활성화된 Webhook은 성공해도 다시 활성화하지 않는다.
+		{fail: false, wantFailures: 0, wantActive: false},
+	}
+	for i, s := range steps {
+		if s.fail {
+			err = sut.AddWebhookFailure(ctx, tx, w.ID, 3)
+		} else {
+			err = sut.ResetWebhookFailures(ctx, tx, w.ID)
+		}
+		if err != nil {
+			t.Fatalf("step %d: failed to update: %v", i, err)
+		}
+		got, err := sut.GetWebhook(ctx, tx, uid, w.ID)
+		if err != nil {
+			t.Fatalf("step %d: failed to get: %v", i, err)
+		}
+		if got.FailureCount != s.wantFailures || got.Active != s.wantActive {
+			t.Errorf("step %d: want failures %d active %v, but got %d %v",
+				i, s.wantFailures, s.wantActive, got.FailureCount, got.Active)
+		}
+	}
+}
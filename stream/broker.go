@@ -0,0 +1,205 @@
+package stream
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/clock"
+	"github.com/gitwub5/go_todo_app/entity"
+)
+
+// Backend는 여러 서버 인스턴스 사이에서 이벤트를 주고받고, 재전송할 이벤트를 보관하는 저장소이다.
+// store.KVS가 Redis Pub/Sub으로 구현한다.
+type Backend interface {
+	NextEventID(ctx context.Context) (int64, error)
+	AppendEvent(ctx context.Context, e *entity.StreamEvent, size int64) error
+	ListEvents(ctx context.Context, uid entity.UserID, after int64) ([]*entity.StreamEvent, error)
+	PublishEvent(ctx context.Context, e *entity.StreamEvent) error
+	SubscribeEvents(ctx context.Context) (<-chan *entity.StreamEvent, error)
+}
+
+// 기본 설정값
+const (
+	DefaultReplaySize = 100
+	DefaultBufferSize = 16
+)
+
+// Broker는 Task 변경 이벤트를 이벤트가 발생한 Task의 소유자와 담당자의 스트림으로 전달한다.
+// 프로젝트에 속한 Task의 이벤트는 프로젝트를 구독한 연결에도 전달한다.
+// 이벤트는 Backend를 거쳐 모든 서버 인스턴스에 전달되므로, 어느 인스턴스에 연결한 클라이언트도 같은 이벤트를 받는다.
+type Broker struct {
+	Backend Backend
+	Clocker clock.Clocker
+	// ReplaySize는 Last-Event-ID로 재전송하기 위해 사용자별로 보관하는 이벤트 수이다.
+	ReplaySize int64
+	// BufferSize는 구독자별로 쌓아 둘 수 있는 이벤트 수이다. 가득 차면 구독을 끊는다.
+	BufferSize int
+
+	mu   sync.Mutex
+	subs map[topic]map[chan *entity.StreamEvent]struct{}
+}
+
+// topic은 구독 대상으로, 사용자와 프로젝트 중 하나만 설정한다.
+type topic struct {
+	user    entity.UserID
+	project entity.ProjectID
+}
+
+func topicOf(e *entity.StreamEvent) topic {
+	if e.ProjectID != 0 {
+		return topic{project: e.ProjectID}
+	}
+	return topic{user: e.UserID}
+}
+
+// Start 메서드는 Backend의 이벤트를 구독하고, 받은 이벤트를 이 인스턴스의 구독자에게 전달하기 시작한다.
+// ctx가 종료되면 구독을 해제한다.
+func (b *Broker) Start(ctx context.Context) error {
+	ch, err := b.Backend.SubscribeEvents(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe events: %w", err)
+	}
+	go func() {
+		for e := range ch {
+			b.dispatch(e)
+		}
+	}()
+	return nil
+}
+
+// Publish 메서드는 Task의 소유자와 담당자, Task가 속한 프로젝트의 구독자에게 이벤트를 발행한다.
+// 프로젝트 이벤트는 재전송 버퍼에 보관하지 않는다.
+func (b *Broker) Publish(ctx context.Context, e *entity.TaskEvent) error {
+	occurred := e.Occurred
+	if occurred.IsZero() {
+		occurred = b.Clocker.Now()
+	}
+	for _, uid := range recipients(e) {
+		id, err := b.Backend.NextEventID(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to issue event id: %w", err)
+		}
+		se := &entity.StreamEvent{ID: id, UserID: uid, Type: e.Type, Task: e.Task, Occurred: occurred}
+		if err := b.Backend.AppendEvent(ctx, se, b.replaySize()); err != nil {
+			return fmt.Errorf("failed to append event: %w", err)
+		}
+		if err := b.Backend.PublishEvent(ctx, se); err != nil {
+			return fmt.Errorf("failed to publish event: %w", err)
+		}
+	}
+	if e.Task == nil || e.Task.ProjectID == nil {
+		return nil
+	}
+	id, err := b.Backend.NextEventID(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to issue event id: %w", err)
+	}
+	se := &entity.StreamEvent{ID: id, ProjectID: *e.Task.ProjectID, Type: e.Type, Task: e.Task, Occurred: occurred}
+	if err := b.Backend.PublishEvent(ctx, se); err != nil {
+		return fmt.Errorf("failed to publish event: %w", err)
+	}
+	return nil
+}
+
+// Subscribe 메서드는 현재 사용자의 이벤트 구독을 시작한다.
+// lastID가 0보다 크면 재전송 버퍼에서 lastID 이후의 이벤트를 함께 반환한다.
+// 구독을 먼저 등록한 뒤 재전송 버퍼를 읽으므로, 두 결과에 같은 이벤트가 포함될 수 있다.
+// 반환된 채널은 구독자가 이벤트를 제때 읽지 못하거나 cancel을 호출하면 닫힌다.
+func (b *Broker) Subscribe(ctx context.Context, lastID int64) ([]*entity.StreamEvent, <-chan *entity.StreamEvent, func(), error) {
+	uid, ok := auth.GetUserID(ctx)
+	if !ok {
+		return nil, nil, nil, fmt.Errorf("user_id not found")
+	}
+	ch, cancel := b.subscribe(topic{user: uid})
+
+	replay := []*entity.StreamEvent{}
+	if lastID > 0 {
+		es, err := b.Backend.ListEvents(ctx, uid, lastID)
+		if err != nil {
+			cancel()
+			return nil, nil, nil, fmt.Errorf("failed to list events: %w", err)
+		}
+		replay = es
+	}
+	return replay, ch, cancel, nil
+}
+
+// SubscribeProject 메서드는 프로젝트의 이벤트 구독을 시작한다.
+// 프로젝트 멤버인지는 확인하지 않으므로, 호출하는 쪽에서 확인해야 한다.
+// 반환된 채널은 구독자가 이벤트를 제때 읽지 못하거나 cancel을 호출하면 닫힌다.
+func (b *Broker) SubscribeProject(ctx context.Context, pid entity.ProjectID) (<-chan *entity.StreamEvent, func(), error) {
+	ch, cancel := b.subscribe(topic{project: pid})
+	return ch, cancel, nil
+}
+
+func (b *Broker) subscribe(t topic) (chan *entity.StreamEvent, func()) {
+	size := b.BufferSize
+	if size <= 0 {
+		size = DefaultBufferSize
+	}
+	ch := make(chan *entity.StreamEvent, size)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.subs == nil {
+		b.subs = map[topic]map[chan *entity.StreamEvent]struct{}{}
+	}
+	if b.subs[t] == nil {
+		b.subs[t] = map[chan *entity.StreamEvent]struct{}{}
+	}
+	b.subs[t][ch] = struct{}{}
+	return ch, func() { b.unsubscribe(t, ch) }
+}
+
+// dispatch 메서드는 이벤트를 받을 사용자나 프로젝트의 구독자에게 전달한다.
+// 느린 구독자 때문에 다른 구독자가 막히지 않도록, 버퍼가 가득 찬 구독자는 끊는다.
+// 끊긴 클라이언트는 Last-Event-ID로 다시 연결해 놓친 이벤트를 받을 수 있다.
+func (b *Broker) dispatch(e *entity.StreamEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	t := topicOf(e)
+	for ch := range b.subs[t] {
+		select {
+		case ch <- e:
+		default:
+			log.Printf("drop slow event subscriber of %+v", t)
+			b.remove(t, ch)
+		}
+	}
+}
+
+func (b *Broker) unsubscribe(t topic, ch chan *entity.StreamEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.remove(t, ch)
+}
+
+// remove 메서드는 b.mu를 잡은 상태에서 호출해야 한다.
+func (b *Broker) remove(t topic, ch chan *entity.StreamEvent) {
+	if _, ok := b.subs[t][ch]; !ok {
+		return
+	}
+	delete(b.subs[t], ch)
+	if len(b.subs[t]) == 0 {
+		delete(b.subs, t)
+	}
+	close(ch)
+}
+
+func (b *Broker) replaySize() int64 {
+	if b.ReplaySize <= 0 {
+		return DefaultReplaySize
+	}
+	return b.ReplaySize
+}
+
+// recipients 함수는 이벤트를 받을 사용자 ID를 반환한다. (소유자와, 소유자가 아닌 담당자)
+func recipients(e *entity.TaskEvent) []entity.UserID {
+	uids := []entity.UserID{e.UserID}
+	if e.Task != nil && e.Task.AssigneeID != nil && *e.Task.AssigneeID != e.UserID {
+		uids = append(uids, *e.Task.AssigneeID)
+	}
+	return uids
+}
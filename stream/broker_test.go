@@ -0,0 +1,130 @@
+package stream
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/auth"
+	"github.com/gitwub5/go_todo_app/clock"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/gitwub5/go_todo_app/testutil"
+)
+
+func TestBroker(t *testing.T) {
+	t.Parallel()
+
+	cli := testutil.OpenRedisForTest(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	// 다른 테스트와 겹치지 않도록 사용자 ID를 시각으로 정한다.
+	owner := entity.UserID(time.Now().UnixNano())
+	assignee := owner + 1
+	t.Cleanup(func() {
+		cli.Del(context.Background(),
+			fmt.Sprintf("event:replay:%d", owner), fmt.Sprintf("event:replay:%d", assignee))
+	})
+
+	// 서로 다른 서버 인스턴스를 흉내 내기 위해 Broker를 두 개 만든다.
+	kvs := &store.KVS{Cli: cli}
+	pub := &Broker{Backend: kvs, Clocker: clock.FixedClocker{}, ReplaySize: 2}
+	sub := &Broker{Backend: kvs, Clocker: clock.FixedClocker{}, ReplaySize: 2}
+	for _, b := range []*Broker{pub, sub} {
+		if err := b.Start(ctx); err != nil {
+			t.Fatalf("failed to start broker: %v", err)
+		}
+	}
+
+	_, ownerEvents, ownerCancel, err := sub.Subscribe(auth.SetUserID(ctx, owner), 0)
+	if err != nil {
+		t.Fatalf("want no error, but got %v", err)
+	}
+	defer ownerCancel()
+	_, assigneeEvents, assigneeCancel, err := sub.Subscribe(auth.SetUserID(ctx, assignee), 0)
+	if err != nil {
+		t.Fatalf("want no error, but got %v", err)
+	}
+	defer assigneeCancel()
+	pid := entity.ProjectID(owner)
+	projectEvents, projectCancel, err := sub.SubscribeProject(ctx, pid)
+	if err != nil {
+		t.Fatalf("want no error, but got %v", err)
+	}
+	defer projectCancel()
+
+	task := &entity.Task{ID: 1, UserID: owner, ProjectID: &pid, AssigneeID: &assignee, Title: "test", Status: entity.TaskStatusTodo}
+	types := []entity.EventType{entity.EventTaskCreated, entity.EventTaskUpdated, entity.EventTaskCompleted}
+	for _, typ := range types {
+		if err := pub.Publish(ctx, &entity.TaskEvent{Type: typ, UserID: owner, Task: task}); err != nil {
+			t.Fatalf("failed to publish: %v", err)
+		}
+	}
+
+	receive := func(ch <-chan *entity.StreamEvent) []*entity.StreamEvent {
+		t.Helper()
+		var got []*entity.StreamEvent
+		for range types {
+			select {
+			case e := <-ch:
+				got = append(got, e)
+			case <-time.After(3 * time.Second):
+				t.Fatalf("timeout waiting for events, got %d", len(got))
+			}
+		}
+		return got
+	}
+	owned := receive(ownerEvents)
+	for i, e := range owned {
+		if e.Type != types[i] || e.UserID != owner || e.Task.ID != task.ID {
+			t.Errorf("unexpected event %d: %+v", i, e)
+		}
+		if i > 0 && e.ID <= owned[i-1].ID {
+			t.Errorf("want increasing ids, but got %d after %d", e.ID, owned[i-1].ID)
+		}
+	}
+	if got := receive(assigneeEvents); got[0].UserID != assignee {
+		t.Errorf("want event for assignee %d, but got %d", assignee, got[0].UserID)
+	}
+	// 프로젝트 구독자는 사용자와 관계없이 프로젝트에 속한 Task의 이벤트를 받는다.
+	for i, e := range receive(projectEvents) {
+		if e.Type != types[i] || e.ProjectID != pid || e.UserID != 0 || e.Task.ID != task.ID {
+			t.Errorf("unexpected project event %d: %+v", i, e)
+		}
+	}
+
+	// 재전송 버퍼에는 최근 ReplaySize개만 남는다.
+	replay, _, replayCancel, err := sub.Subscribe(auth.SetUserID(ctx, owner), owned[0].ID)
+	if err != nil {
+		t.Fatalf("want no error, but got %v", err)
+	}
+	defer replayCancel()
+	if len(replay) != 2 || replay[0].ID != owned[1].ID || replay[1].ID != owned[2].ID {
+		t.Errorf("want events %d and %d replayed, but got %+v", owned[1].ID, owned[2].ID, replay)
+	}
+}
+
+func TestBroker_SlowSubscriber(t *testing.T) {
+	t.Parallel()
+
+	sut := &Broker{BufferSize: 1}
+	uid := entity.UserID(1)
+	_, ch, cancel, err := sut.Subscribe(auth.SetUserID(context.Background(), uid), 0)
+	if err != nil {
+		t.Fatalf("want no error, but got %v", err)
+	}
+	defer cancel()
+
+	// 버퍼가 가득 찬 구독자는 끊기고, 이후 이벤트는 막히지 않고 버려진다.
+	for i := int64(1); i <= 3; i++ {
+		sut.dispatch(&entity.StreamEvent{ID: i, UserID: uid})
+	}
+	if e := <-ch; e.ID != 1 {
+		t.Errorf("want event 1, but got %d", e.ID)
+	}
+	if _, ok := <-ch; ok {
+		t.Error("want channel closed")
+	}
+}
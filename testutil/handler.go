@@ -4,11 +4,13 @@ package testutil
 import (
 	"encoding/json"
 	"io"
+	"mime"
 	"net/http"
 	"os"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/vmihailenco/msgpack/v5"
 )
 
 // want와 got을 비교하는 헬퍼 함수
@@ -28,6 +30,8 @@ func AssertJSON(t *testing.T, want, got []byte) {
 }
 
 // http.Response와 상태 코드, 응답 바디를 비교하는 헬퍼 함수
+// 응답의 Content-Type이 MessagePack이면 JSON으로 변환해 JSON golden 파일과 비교하고,
+// CSV이면 CSV golden 파일과 그대로 비교한다.
 func AssertResponse(t *testing.T, got *http.Response, status int, body []byte) {
 	t.Helper()
 	t.Cleanup(func() { _ = got.Body.Close() })
@@ -43,9 +47,40 @@ func AssertResponse(t *testing.T, got *http.Response, status int, body []byte) {
 		// 어느 쪽도 바디가 없는 경우 AssertJSON을 호출할 필요가 없다.
 		return
 	}
+	mt, _, _ := mime.ParseMediaType(got.Header.Get("Content-Type"))
+	switch mt {
+	case "application/msgpack":
+		var v any
+		if err := msgpack.Unmarshal(gb, &v); err != nil {
+			t.Fatalf("cannot unmarshal msgpack %q: %v", gb, err)
+		}
+		if gb, err = json.Marshal(v); err != nil {
+			t.Fatal(err)
+		}
+	case "text/csv":
+		if diff := cmp.Diff(string(gb), string(body)); diff != "" {
+			t.Errorf("got differs: (-got +want)\n%s", diff)
+		}
+		return
+	}
 	AssertJSON(t, body, gb)
 }
 
+// JSON을 같은 구조의 MessagePack으로 변환하는 헬퍼 함수
+func JSONToMsgpack(t *testing.T, b []byte) []byte {
+	t.Helper()
+
+	var v any
+	if err := json.Unmarshal(b, &v); err != nil {
+		t.Fatalf("cannot unmarshal %q: %v", b, err)
+	}
+	mb, err := msgpack.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return mb
+}
+
 // 테스트용 파일을 로드하는 헬퍼 함수
 func LoadFile(t *testing.T, path string) []byte {
 	t.Helper()
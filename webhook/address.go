@@ -0,0 +1,113 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+// ErrForbiddenAddress는 Webhook URL이 루프백, 링크 로컬, 사설망 등 내부 주소를 가리킬 때 반환된다.
+var ErrForbiddenAddress = errors.New("forbidden address")
+
+// Resolver는 호스트 이름을 IP 주소로 변환한다. *net.Resolver가 구현한다.
+type Resolver interface {
+	LookupNetIP(ctx context.Context, network, host string) ([]netip.Addr, error)
+}
+
+var (
+	// forbiddenPrefixes는 netip.Addr의 메서드로 구분할 수 없는 내부용 IPv4 대역이다.
+	forbiddenPrefixes = []netip.Prefix{
+		netip.MustParsePrefix("0.0.0.0/8"),     // 현재 네트워크 (RFC 1122)
+		netip.MustParsePrefix("100.64.0.0/10"), // 통신사 NAT 공유 주소 (RFC 6598)
+		netip.MustParsePrefix("198.18.0.0/15"), // 네트워크 장비 벤치마크 (RFC 2544)
+	}
+	// nat64Prefix와 sixToFourPrefix는 IPv4 주소를 포함하는 IPv6 대역이다.
+	nat64Prefix     = netip.MustParsePrefix("64:ff9b::/96") // 하위 32비트 (RFC 6052)
+	sixToFourPrefix = netip.MustParsePrefix("2002::/16")    // 17~48비트 (RFC 3056)
+)
+
+// embeddedIPv4 함수는 IPv4-mapped, NAT64, 6to4 주소에 포함된 IPv4 주소를 꺼낸다.
+// 포함된 IPv4 주소가 없으면 ip를 그대로 반환한다.
+func embeddedIPv4(ip netip.Addr) netip.Addr {
+	b := ip.As16()
+	switch {
+	case ip.Is4In6():
+		return ip.Unmap()
+	case nat64Prefix.Contains(ip):
+		return netip.AddrFrom4([4]byte{b[12], b[13], b[14], b[15]})
+	case sixToFourPrefix.Contains(ip):
+		return netip.AddrFrom4([4]byte{b[2], b[3], b[4], b[5]})
+	}
+	return ip
+}
+
+// allowed 함수는 Webhook을 전송해도 되는 공개 주소인지 확인한다.
+// IPv6 주소에 포함된 IPv4 주소로 내부망에 접속할 수 있으므로, 포함된 IPv4 주소를 확인한다.
+func allowed(ip netip.Addr) bool {
+	if !ip.IsValid() {
+		return false
+	}
+	ip = embeddedIPv4(ip)
+	for _, p := range forbiddenPrefixes {
+		if p.Contains(ip) {
+			return false
+		}
+	}
+	return !ip.IsLoopback() &&
+		!ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() &&
+		!ip.IsPrivate() &&
+		!ip.IsUnspecified()
+}
+
+// ValidateURL 함수는 Webhook URL의 호스트가 가리키는 모든 주소가 공개 주소인지 확인한다.
+// r이 nil이면 net.DefaultResolver를 사용한다.
+func ValidateURL(ctx context.Context, r Resolver, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	if r == nil {
+		r = net.DefaultResolver
+	}
+	addrs, err := r.LookupNetIP(ctx, "ip", u.Hostname())
+	if err != nil {
+		return fmt.Errorf("failed to resolve %q: %w", u.Hostname(), err)
+	}
+	for _, ip := range addrs {
+		if !allowed(ip) {
+			return fmt.Errorf("%s resolves to %s: %w", u.Hostname(), ip, ErrForbiddenAddress)
+		}
+	}
+	return nil
+}
+
+// Control 함수는 net.Dialer.Control로 사용해 접속 직전에 대상 주소를 다시 확인한다.
+// 등록 후 DNS 응답이 바뀌거나 리다이렉트로 내부 주소에 접속하는 것을 막는다.
+func Control(network, address string, _ syscall.RawConn) error {
+	ap, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return err
+	}
+	if !allowed(ap.Addr()) {
+		return fmt.Errorf("%s: %w", ap.Addr(), ErrForbiddenAddress)
+	}
+	return nil
+}
+
+// NewClient 함수는 내부 주소로는 접속하지 않는 Webhook 전송용 HTTP 클라이언트를 반환한다.
+// 프록시를 거치면 접속 대상을 확인할 수 없으므로 환경 변수의 프록시 설정은 사용하지 않는다.
+func NewClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second, Control: Control}
+	tr := http.DefaultTransport.(*http.Transport).Clone()
+	tr.Proxy = nil
+	tr.DialContext = dialer.DialContext
+	return &http.Client{Timeout: timeout, Transport: tr}
+}
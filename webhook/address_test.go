@@ -0,0 +1,63 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestValidateURL(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		url     string
+		wantErr error
+	}{
+		"public":      {url: "https://93.184.216.34/hook"},
+		"publicV6":    {url: "https://[2606:2800:220:1:248:1893:25c8:1946]/hook"},
+		"loopback":    {url: "http://127.0.0.1:8080/hook", wantErr: ErrForbiddenAddress},
+		"loopbackV6":  {url: "http://[::1]/hook", wantErr: ErrForbiddenAddress},
+		"mapped":      {url: "http://[::ffff:127.0.0.1]/hook", wantErr: ErrForbiddenAddress},
+		"private":     {url: "http://10.0.0.1/hook", wantErr: ErrForbiddenAddress},
+		"linkLocal":   {url: "http://169.254.169.254/latest/meta-data", wantErr: ErrForbiddenAddress},
+		"unspecified": {url: "http://0.0.0.0/hook", wantErr: ErrForbiddenAddress},
+		"thisNetwork": {url: "http://0.1.2.3/hook", wantErr: ErrForbiddenAddress},
+		"sharedCGNAT": {url: "http://100.64.0.1/hook", wantErr: ErrForbiddenAddress},
+		"benchmark":   {url: "http://198.19.255.254/hook", wantErr: ErrForbiddenAddress},
+		// IPv6 주소에 포함된 IPv4 주소로 판단한다.
+		"nat64":             {url: "http://[64:ff9b::5db8:d822]/hook"},
+		"nat64Loopback":     {url: "http://[64:ff9b::127.0.0.1]/hook", wantErr: ErrForbiddenAddress},
+		"nat64Private":      {url: "http://[64:ff9b::a00:1]/hook", wantErr: ErrForbiddenAddress},
+		"sixToFour":         {url: "http://[2002:5db8:d822::1]/hook"},
+		"sixToFourLoopback": {url: "http://[2002:7f00:1::1]/hook", wantErr: ErrForbiddenAddress},
+		"sixToFourPrivate":  {url: "http://[2002:c0a8:101::1]/hook", wantErr: ErrForbiddenAddress},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateURL(context.Background(), nil, tt.url)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("want %v, but got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("want not to connect to loopback address")
+	}))
+	t.Cleanup(srv.Close)
+
+	_, err := NewClient(time.Second).Get(srv.URL)
+	if !errors.Is(err, ErrForbiddenAddress) {
+		t.Errorf("want %v, but got %v", ErrForbiddenAddress, err)
+	}
+}
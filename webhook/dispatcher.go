@@ -0,0 +1,217 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/clock"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+)
+
+//go:generate go run github.com/matryer/moq -out moq_test.go . Repository
+type Repository interface {
+	ListWebhooks(ctx context.Context, db store.Queryer, uid entity.UserID) (entity.Webhooks, error)
+	AddWebhookFailure(ctx context.Context, db store.Execer, id entity.WebhookID, disableAfter int) error
+	ResetWebhookFailures(ctx context.Context, db store.Execer, id entity.WebhookID) error
+	AddWebhookDelivery(ctx context.Context, db store.Execer, d *entity.WebhookDelivery) error
+}
+
+// 기본 설정값
+const (
+	DefaultMaxAttempts  = 5
+	DefaultBackoff      = time.Second
+	DefaultDisableAfter = 5
+)
+
+// Dispatcher는 Task 변경 이벤트를 구독 중인 Webhook으로 전송한다.
+// 전송은 요청 처리와 분리된 고루틴에서 이루어지며, 실패하면 지수 백오프로 재시도한다.
+type Dispatcher struct {
+	DB   store.ExecQueryer
+	Repo Repository
+	// Client가 nil이면 내부 주소로는 접속하지 않는 NewClient의 클라이언트를 사용한다.
+	Client  *http.Client
+	Clocker clock.Clocker
+	// MaxAttempts는 전송 한 건당 최대 시도 횟수이다.
+	MaxAttempts int
+	// Backoff는 첫 번째 재시도까지의 대기 시간이다. 이후에는 두 배씩 늘어난다.
+	Backoff time.Duration
+	// DisableAfter는 Webhook을 비활성화하기까지 허용하는 연속 전송 실패 횟수이다.
+	DisableAfter int
+
+	wg     sync.WaitGroup
+	once   sync.Once
+	ctx    context.Context // Close하면 취소되어 진행 중인 전송을 멈춘다.
+	cancel context.CancelFunc
+}
+
+// defaultClient는 Client를 지정하지 않았을 때 사용하는 클라이언트이다.
+var defaultClient = NewClient(10 * time.Second)
+
+// payload는 Webhook으로 전송하는 JSON 본문이다.
+type payload struct {
+	ID       string           `json:"id"`
+	Event    entity.EventType `json:"event"`
+	Occurred time.Time        `json:"occurred"`
+	Task     *entity.Task     `json:"task"`
+}
+
+// Publish 메서드는 이벤트를 구독 중인 활성화된 Webhook마다 전송을 시작한다.
+// 전송 결과를 기다리지 않으며, 에러는 Webhook 목록을 가져오지 못한 경우에만 반환한다.
+func (d *Dispatcher) Publish(ctx context.Context, e *entity.TaskEvent) error {
+	ws, err := d.Repo.ListWebhooks(ctx, d.DB, e.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to list webhooks: %w", err)
+	}
+	occurred := e.Occurred
+	if occurred.IsZero() {
+		occurred = d.Clocker.Now()
+	}
+	for _, w := range ws {
+		if !w.Active || !w.Events.Has(e.Type) {
+			continue
+		}
+		id, err := randomHex(16)
+		if err != nil {
+			return err
+		}
+		body, err := json.Marshal(payload{ID: id, Event: e.Type, Occurred: occurred, Task: e.Task})
+		if err != nil {
+			return err
+		}
+		d.wg.Add(1)
+		go func(w *entity.Webhook) {
+			defer d.wg.Done()
+			ctx, cancel := d.detach(ctx)
+			defer cancel()
+			d.deliver(ctx, w, e.Type, id, body)
+		}(w)
+	}
+	return nil
+}
+
+// Wait 메서드는 진행 중인 모든 전송이 끝날 때까지 기다린다.
+func (d *Dispatcher) Wait() {
+	d.wg.Wait()
+}
+
+// Close 메서드는 재시도 대기를 포함해 진행 중인 전송을 취소하고, 모두 끝날 때까지 기다린다.
+// 취소된 전송은 Webhook의 실패로 기록하지 않는다.
+func (d *Dispatcher) Close() {
+	d.init()
+	d.cancel()
+	d.wg.Wait()
+}
+
+func (d *Dispatcher) init() {
+	d.once.Do(func() {
+		d.ctx, d.cancel = context.WithCancel(context.Background())
+	})
+}
+
+// detach 메서드는 요청의 취소 신호 대신 Close로 취소되는 컨텍스트를 반환한다.
+// 요청이 끝나도 전송은 계속되어야 하기 때문이다.
+func (d *Dispatcher) detach(ctx context.Context) (context.Context, context.CancelFunc) {
+	d.init()
+	ctx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	stop := context.AfterFunc(d.ctx, cancel)
+	return ctx, func() {
+		stop()
+		cancel()
+	}
+}
+
+func (d *Dispatcher) deliver(
+	ctx context.Context, w *entity.Webhook, event entity.EventType, id string, body []byte,
+) {
+	backoff := d.Backoff
+	if backoff <= 0 {
+		backoff = DefaultBackoff
+	}
+	attempts := d.MaxAttempts
+	if attempts <= 0 {
+		attempts = DefaultMaxAttempts
+	}
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if attempt > 1 {
+			t := time.NewTimer(backoff)
+			select {
+			case <-ctx.Done():
+				t.Stop()
+				return
+			case <-t.C:
+			}
+			backoff *= 2
+		}
+		if d.send(ctx, w, event, id, body, attempt) {
+			// 목록을 가져온 뒤 다른 전송이 실패했을 수 있으므로 가져온 값과 관계없이 초기화한다.
+			if err := d.Repo.ResetWebhookFailures(ctx, d.DB, w.ID); err != nil {
+				log.Printf("failed to reset failures of webhook %d: %v", w.ID, err)
+			}
+			return
+		}
+		if ctx.Err() != nil {
+			return
+		}
+	}
+	disableAfter := d.DisableAfter
+	if disableAfter <= 0 {
+		disableAfter = DefaultDisableAfter
+	}
+	if err := d.Repo.AddWebhookFailure(ctx, d.DB, w.ID, disableAfter); err != nil {
+		log.Printf("failed to record failure of webhook %d: %v", w.ID, err)
+	}
+}
+
+// send 메서드는 한 번 전송을 시도하고 결과를 전송 기록에 남긴다. 2xx 응답을 받으면 true를 반환한다.
+func (d *Dispatcher) send(
+	ctx context.Context, w *entity.Webhook, event entity.EventType, id string, body []byte, attempt int,
+) bool {
+	rec := &entity.WebhookDelivery{
+		WebhookID:  w.ID,
+		DeliveryID: id,
+		Event:      event,
+		Attempt:    attempt,
+	}
+	start := d.Clocker.Now()
+	err := func() error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(HeaderEvent, string(event))
+		req.Header.Set(HeaderDelivery, id)
+		req.Header.Set(HeaderSignature, Sign(w.Secret, body))
+		client := d.Client
+		if client == nil {
+			client = defaultClient
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		_, _ = io.Copy(io.Discard, resp.Body)
+		rec.StatusCode = resp.StatusCode
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return fmt.Errorf("unexpected status: %s", resp.Status)
+		}
+		return nil
+	}()
+	rec.DurationMS = d.Clocker.Now().Sub(start).Milliseconds()
+	if err != nil {
+		rec.Error = err.Error()
+	}
+	if err := d.Repo.AddWebhookDelivery(ctx, d.DB, rec); err != nil {
+		log.Printf("failed to record delivery of webhook %d: %v", w.ID, err)
+	}
+	return err == nil
+}
@@ -0,0 +1,200 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gitwub5/go_todo_app/clock"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDispatcher_Publish(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		failures     int32 // 서버가 처음 몇 번 실패를 응답할지
+		wantAttempts int
+		wantFailed   bool
+	}{
+		// 성공하면 연속 실패 횟수를 초기화한다.
+		"ok": {
+			wantAttempts: 1,
+		},
+		"retry": {
+			failures:     2,
+			wantAttempts: 3,
+		},
+		// 모든 시도에 실패하면 연속 실패 횟수를 늘린다.
+		"failed": {
+			failures:     10,
+			wantAttempts: 3,
+			wantFailed:   true,
+		},
+	}
+	for n, tt := range tests {
+		tt := tt
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			const secret = "secret"
+			var calls int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				if err != nil {
+					t.Error(err)
+				}
+				if !Verify(secret, body, r.Header.Get(HeaderSignature)) {
+					t.Errorf("invalid signature: %q", r.Header.Get(HeaderSignature))
+				}
+				if got := r.Header.Get(HeaderEvent); got != string(entity.EventTaskCreated) {
+					t.Errorf("want event %q, but got %q", entity.EventTaskCreated, got)
+				}
+				var p payload
+				if err := json.Unmarshal(body, &p); err != nil {
+					t.Error(err)
+				}
+				if p.ID != r.Header.Get(HeaderDelivery) || p.Task.ID != 1 {
+					t.Errorf("unexpected payload: %s", body)
+				}
+				if atomic.AddInt32(&calls, 1) <= tt.failures {
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			t.Cleanup(srv.Close)
+
+			var mu sync.Mutex
+			var deliveries entity.WebhookDeliveries
+			var resets, failures []entity.WebhookID
+			moq := &RepositoryMock{}
+			moq.ListWebhooksFunc = func(ctx context.Context, db store.Queryer, uid entity.UserID) (entity.Webhooks, error) {
+				return entity.Webhooks{
+					{ID: 1, UserID: uid, URL: srv.URL, Events: entity.WebhookEvents{entity.EventTaskCreated}, Secret: secret, Active: true},
+					// 구독하지 않은 이벤트와 비활성화된 Webhook에는 전송하지 않는다.
+					{ID: 2, UserID: uid, URL: srv.URL, Events: entity.WebhookEvents{entity.EventTaskUpdated}, Secret: secret, Active: true},
+					{ID: 3, UserID: uid, URL: srv.URL, Events: entity.WebhookEvents{entity.EventTaskCreated}, Secret: secret, Active: false},
+				}, nil
+			}
+			moq.AddWebhookDeliveryFunc = func(ctx context.Context, db store.Execer, d *entity.WebhookDelivery) error {
+				mu.Lock()
+				defer mu.Unlock()
+				deliveries = append(deliveries, d)
+				return nil
+			}
+			moq.ResetWebhookFailuresFunc = func(ctx context.Context, db store.Execer, id entity.WebhookID) error {
+				mu.Lock()
+				defer mu.Unlock()
+				resets = append(resets, id)
+				return nil
+			}
+			moq.AddWebhookFailureFunc = func(ctx context.Context, db store.Execer, id entity.WebhookID, disableAfter int) error {
+				mu.Lock()
+				defer mu.Unlock()
+				if disableAfter != 2 {
+					t.Errorf("want disableAfter 2, but got %d", disableAfter)
+				}
+				failures = append(failures, id)
+				return nil
+			}
+
+			sut := &Dispatcher{
+				Repo:         moq,
+				Client:       srv.Client(),
+				Clocker:      clock.FixedClocker{},
+				MaxAttempts:  3,
+				Backoff:      time.Millisecond,
+				DisableAfter: 2,
+			}
+			err := sut.Publish(context.Background(), &entity.TaskEvent{
+				Type:   entity.EventTaskCreated,
+				UserID: 10,
+				Task:   &entity.Task{ID: 1, UserID: 10, Title: "test"},
+			})
+			if err != nil {
+				t.Fatalf("want no error, but got %v", err)
+			}
+			sut.Wait()
+
+			if len(deliveries) != tt.wantAttempts {
+				t.Fatalf("want %d attempts, but got %d", tt.wantAttempts, len(deliveries))
+			}
+			for i, d := range deliveries {
+				if d.WebhookID != 1 || d.Attempt != i+1 || d.DeliveryID != deliveries[0].DeliveryID {
+					t.Errorf("unexpected delivery: %+v", d)
+				}
+			}
+			wantResets, wantFailures := []entity.WebhookID{1}, []entity.WebhookID(nil)
+			if tt.wantFailed {
+				wantResets, wantFailures = nil, []entity.WebhookID{1}
+			}
+			if d := cmp.Diff(resets, wantResets); d != "" {
+				t.Errorf("resets differ: (-got +want)\n%s", d)
+			}
+			if d := cmp.Diff(failures, wantFailures); d != "" {
+				t.Errorf("failures differ: (-got +want)\n%s", d)
+			}
+		})
+	}
+}
+
+func TestDispatcher_Close(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	t.Cleanup(srv.Close)
+
+	delivered := make(chan struct{}, 1)
+	moq := &RepositoryMock{}
+	moq.ListWebhooksFunc = func(ctx context.Context, db store.Queryer, uid entity.UserID) (entity.Webhooks, error) {
+		return entity.Webhooks{
+			{ID: 1, UserID: uid, URL: srv.URL, Events: entity.WebhookEvents{entity.EventTaskCreated}, Active: true},
+		}, nil
+	}
+	moq.AddWebhookDeliveryFunc = func(ctx context.Context, db store.Execer, d *entity.WebhookDelivery) error {
+		delivered <- struct{}{}
+		return nil
+	}
+
+	sut := &Dispatcher{
+		Repo:        moq,
+		Client:      srv.Client(),
+		Clocker:     clock.FixedClocker{},
+		MaxAttempts: 3,
+		Backoff:     time.Hour,
+	}
+	if err := sut.Publish(context.Background(), &entity.TaskEvent{
+		Type:   entity.EventTaskCreated,
+		UserID: 10,
+		Task:   &entity.Task{ID: 1, UserID: 10, Title: "test"},
+	}); err != nil {
+		t.Fatalf("want no error, but got %v", err)
+	}
+	<-delivered
+
+	// 재시도를 기다리는 중이어도 Close하면 바로 끝나고, 실패로 기록하지 않는다.
+	done := make(chan struct{})
+	go func() {
+		sut.Close()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Close did not interrupt backoff")
+	}
+	if n := len(moq.AddWebhookFailureCalls()); n != 0 {
+		t.Errorf("want no failure recorded, but got %d", n)
+	}
+}
@@ -0,0 +1,263 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package webhook
+
+import (
+	"context"
+	"github.com/gitwub5/go_todo_app/entity"
+	"github.com/gitwub5/go_todo_app/store"
+	"sync"
+)
+
+// Ensure, that RepositoryMock does implement Repository.
+// If this is not the case, regenerate this file with moq.
+var _ Repository = &RepositoryMock{}
+
+// RepositoryMock is a mock implementation of Repository.
+//
+//	func TestSomethingThatUsesRepository(t *testing.T) {
+//
+//		// make and configure a mocked Repository
+//		mockedRepository := &RepositoryMock{
+//			AddWebhookDeliveryFunc: func(ctx context.Context, db store.Execer, d *entity.WebhookDelivery) error {
+//				panic("mock out the AddWebhookDelivery method")
+//			},
+//			AddWebhookFailureFunc: func(ctx context.Context, db store.Execer, id entity.WebhookID, disableAfter int) error {
+//				panic("mock out the AddWebhookFailure method")
+//			},
+//			ListWebhooksFunc: func(ctx context.Context, db store.Queryer, uid entity.UserID) (entity.Webhooks, error) {
+//				panic("mock out the ListWebhooks method")
+//			},
+//			ResetWebhookFailuresFunc: func(ctx context.Context, db store.Execer, id entity.WebhookID) error {
+//				panic("mock out the ResetWebhookFailures method")
+//			},
+//		}
+//
+//		// use mockedRepository in code that requires Repository
+//		// and then make assertions.
+//
+//	}
+type RepositoryMock struct {
+	// AddWebhookDeliveryFunc mocks the AddWebhookDelivery method.
+	AddWebhookDeliveryFunc func(ctx context.Context, db store.Execer, d *entity.WebhookDelivery) error
+
+	// AddWebhookFailureFunc mocks the AddWebhookFailure method.
+	AddWebhookFailureFunc func(ctx context.Context, db store.Execer, id entity.WebhookID, disableAfter int) error
+
+	// ListWebhooksFunc mocks the ListWebhooks method.
+	ListWebhooksFunc func(ctx context.Context, db store.Queryer, uid entity.UserID) (entity.Webhooks, error)
+
+	// ResetWebhookFailuresFunc mocks the ResetWebhookFailures method.
+	ResetWebhookFailuresFunc func(ctx context.Context, db store.Execer, id entity.WebhookID) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AddWebhookDelivery holds details about calls to the AddWebhookDelivery method.
+		AddWebhookDelivery []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Execer
+			// D is the d argument value.
+			D *entity.WebhookDelivery
+		}
+		// AddWebhookFailure holds details about calls to the AddWebhookFailure method.
+		AddWebhookFailure []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Execer
+			// ID is the id argument value.
+			ID entity.WebhookID
+			// DisableAfter is the disableAfter argument value.
+			DisableAfter int
+		}
+		// ListWebhooks holds details about calls to the ListWebhooks method.
+		ListWebhooks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Queryer
+			// UID is the uid argument value.
+			UID entity.UserID
+		}
+		// ResetWebhookFailures holds details about calls to the ResetWebhookFailures method.
+		ResetWebhookFailures []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Db is the db argument value.
+			Db store.Execer
+			// ID is the id argument value.
+			ID entity.WebhookID
+		}
+	}
+	lockAddWebhookDelivery   sync.RWMutex
+	lockAddWebhookFailure    sync.RWMutex
+	lockListWebhooks         sync.RWMutex
+	lockResetWebhookFailures sync.RWMutex
+}
+
+// AddWebhookDelivery calls AddWebhookDeliveryFunc.
+func (mock *RepositoryMock) AddWebhookDelivery(ctx context.Context, db store.Execer, d *entity.WebhookDelivery) error {
+	if mock.AddWebhookDeliveryFunc == nil {
+		panic("RepositoryMock.AddWebhookDeliveryFunc: method is nil but Repository.AddWebhookDelivery was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Execer
+		D   *entity.WebhookDelivery
+	}{
+		Ctx: ctx,
+		Db:  db,
+		D:   d,
+	}
+	mock.lockAddWebhookDelivery.Lock()
+	mock.calls.AddWebhookDelivery = append(mock.calls.AddWebhookDelivery, callInfo)
+	mock.lockAddWebhookDelivery.Unlock()
+	return mock.AddWebhookDeliveryFunc(ctx, db, d)
+}
+
+// AddWebhookDeliveryCalls gets all the calls that were made to AddWebhookDelivery.
+// Check the length with:
+//
+//	len(mockedRepository.AddWebhookDeliveryCalls())
+func (mock *RepositoryMock) AddWebhookDeliveryCalls() []struct {
+	Ctx context.Context
+	Db  store.Execer
+	D   *entity.WebhookDelivery
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Execer
+		D   *entity.WebhookDelivery
+	}
+	mock.lockAddWebhookDelivery.RLock()
+	calls = mock.calls.AddWebhookDelivery
+	mock.lockAddWebhookDelivery.RUnlock()
+	return calls
+}
+
+// AddWebhookFailure calls AddWebhookFailureFunc.
+func (mock *RepositoryMock) AddWebhookFailure(ctx context.Context, db store.Execer, id entity.WebhookID, disableAfter int) error {
+	if mock.AddWebhookFailureFunc == nil {
+		panic("RepositoryMock.AddWebhookFailureFunc: method is nil but Repository.AddWebhookFailure was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		Db           store.Execer
+		ID           entity.WebhookID
+		DisableAfter int
+	}{
+		Ctx:          ctx,
+		Db:           db,
+		ID:           id,
+		DisableAfter: disableAfter,
+	}
+	mock.lockAddWebhookFailure.Lock()
+	mock.calls.AddWebhookFailure = append(mock.calls.AddWebhookFailure, callInfo)
+	mock.lockAddWebhookFailure.Unlock()
+	return mock.AddWebhookFailureFunc(ctx, db, id, disableAfter)
+}
+
+// AddWebhookFailureCalls gets all the calls that were made to AddWebhookFailure.
+// Check the length with:
+//
+//	len(mockedRepository.AddWebhookFailureCalls())
+func (mock *RepositoryMock) AddWebhookFailureCalls() []struct {
+	Ctx          context.Context
+	Db           store.Execer
+	ID           entity.WebhookID
+	DisableAfter int
+} {
+	var calls []struct {
+		Ctx          context.Context
+		Db           store.Execer
+		ID           entity.WebhookID
+		DisableAfter int
+	}
+	mock.lockAddWebhookFailure.RLock()
+	calls = mock.calls.AddWebhookFailure
+	mock.lockAddWebhookFailure.RUnlock()
+	return calls
+}
+
+// ListWebhooks calls ListWebhooksFunc.
+func (mock *RepositoryMock) ListWebhooks(ctx context.Context, db store.Queryer, uid entity.UserID) (entity.Webhooks, error) {
+	if mock.ListWebhooksFunc == nil {
+		panic("RepositoryMock.ListWebhooksFunc: method is nil but Repository.ListWebhooks was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		UID: uid,
+	}
+	mock.lockListWebhooks.Lock()
+	mock.calls.ListWebhooks = append(mock.calls.ListWebhooks, callInfo)
+	mock.lockListWebhooks.Unlock()
+	return mock.ListWebhooksFunc(ctx, db, uid)
+}
+
+// ListWebhooksCalls gets all the calls that were made to ListWebhooks.
+// Check the length with:
+//
+//	len(mockedRepository.ListWebhooksCalls())
+func (mock *RepositoryMock) ListWebhooksCalls() []struct {
+	Ctx context.Context
+	Db  store.Queryer
+	UID entity.UserID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Queryer
+		UID entity.UserID
+	}
+	mock.lockListWebhooks.RLock()
+	calls = mock.calls.ListWebhooks
+	mock.lockListWebhooks.RUnlock()
+	return calls
+}
+
+// ResetWebhookFailures calls ResetWebhookFailuresFunc.
+func (mock *RepositoryMock) ResetWebhookFailures(ctx context.Context, db store.Execer, id entity.WebhookID) error {
+	if mock.ResetWebhookFailuresFunc == nil {
+		panic("RepositoryMock.ResetWebhookFailuresFunc: method is nil but Repository.ResetWebhookFailures was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Db  store.Execer
+		ID  entity.WebhookID
+	}{
+		Ctx: ctx,
+		Db:  db,
+		ID:  id,
+	}
+	mock.lockResetWebhookFailures.Lock()
+	mock.calls.ResetWebhookFailures = append(mock.calls.ResetWebhookFailures, callInfo)
+	mock.lockResetWebhookFailures.Unlock()
+	return mock.ResetWebhookFailuresFunc(ctx, db, id)
+}
+
+// ResetWebhookFailuresCalls gets all the calls that were made to ResetWebhookFailures.
+// Check the length with:
+//
+//	len(mockedRepository.ResetWebhookFailuresCalls())
+func (mock *RepositoryMock) ResetWebhookFailuresCalls() []struct {
+	Ctx context.Context
+	Db  store.Execer
+	ID  entity.WebhookID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Db  store.Execer
+		ID  entity.WebhookID
+	}
+	mock.lockResetWebhookFailures.RLock()
+	calls = mock.calls.ResetWebhookFailures
+	mock.lockResetWebhookFailures.RUnlock()
+	return calls
+}